@@ -0,0 +1,5 @@
+drop table if exists stock_transfers cascade;
+drop index if exists idx_fk_stock_transfer_source_pharmacy_id;
+drop index if exists idx_fk_stock_transfer_destination_pharmacy_id;
+drop index if exists idx_fk_stock_transfer_product_id;
+drop index if exists idx_stock_transfer_status;
\ No newline at end of file
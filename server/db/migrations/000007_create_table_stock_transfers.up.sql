@@ -0,0 +1,19 @@
+create table if not exists stock_transfers(
+    id bigserial primary key,
+    source_pharmacy_id bigint not null references pharmacies(id),
+    destination_pharmacy_id bigint not null references pharmacies(id),
+    product_id bigint not null references products(id),
+    quantity int not null,
+    transfer_status varchar(255) not null,
+    requested_by bigint not null references users(id),
+    reviewed_by bigint references users(id) default null,
+    created_at timestamp not null default current_timestamp,
+    updated_at timestamp not null default current_timestamp,
+    constraint chk_stock_transfer_pharmacies check (source_pharmacy_id <> destination_pharmacy_id),
+    constraint chk_stock_transfer_quantity check (quantity > 0)
+);
+
+create index if not exists idx_fk_stock_transfer_source_pharmacy_id on stock_transfers(source_pharmacy_id);
+create index if not exists idx_fk_stock_transfer_destination_pharmacy_id on stock_transfers(destination_pharmacy_id);
+create index if not exists idx_fk_stock_transfer_product_id on stock_transfers(product_id);
+create index if not exists idx_stock_transfer_status on stock_transfers(transfer_status);
\ No newline at end of file
@@ -0,0 +1,2 @@
+drop table if exists product_imports cascade;
+drop index if exists idx_fk_product_import_admin_id;
\ No newline at end of file
@@ -0,0 +1,15 @@
+create table if not exists product_imports(
+    id bigserial primary key,
+    admin_id bigint not null references users(id),
+    file_name varchar(255) not null,
+    import_status varchar(255) not null,
+    is_dry_run boolean not null default false,
+    total_rows int not null default 0,
+    success_rows int not null default 0,
+    failed_rows int not null default 0,
+    report text default null,
+    created_at timestamp not null default current_timestamp,
+    updated_at timestamp not null default current_timestamp
+);
+
+create index if not exists idx_fk_product_import_admin_id on product_imports(admin_id);
\ No newline at end of file
@@ -0,0 +1,2 @@
+drop table if exists pharmacy_product_imports cascade;
+drop index if exists idx_fk_pharmacy_product_import_pharmacy_id;
\ No newline at end of file
@@ -0,0 +1,15 @@
+create table if not exists pharmacy_product_imports(
+    id bigserial primary key,
+    pharmacy_id bigint not null references pharmacies(id) on delete cascade,
+    pharmacist_id bigint not null references users(id),
+    file_name varchar(255) not null,
+    import_status varchar(255) not null,
+    total_rows int not null default 0,
+    success_rows int not null default 0,
+    failed_rows int not null default 0,
+    report text default null,
+    created_at timestamp not null default current_timestamp,
+    updated_at timestamp not null default current_timestamp
+);
+
+create index if not exists idx_fk_pharmacy_product_import_pharmacy_id on pharmacy_product_imports(pharmacy_id);
\ No newline at end of file
@@ -0,0 +1,2 @@
+alter table products drop column if exists thumbnail_webp_url;
+alter table products drop column if exists image_webp_url;
\ No newline at end of file
@@ -0,0 +1,2 @@
+alter table products add column if not exists thumbnail_webp_url text default null;
+alter table products add column if not exists image_webp_url text default null;
\ No newline at end of file
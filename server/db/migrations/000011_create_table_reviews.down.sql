@@ -0,0 +1,3 @@
+drop table if exists reviews cascade;
+drop index if exists idx_fk_review_user_id;
+drop index if exists idx_fk_review_pharmacy_product_id;
\ No newline at end of file
@@ -0,0 +1,20 @@
+create table if not exists reviews(
+    id bigserial primary key,
+    user_id bigint not null references users(id),
+    order_id bigint not null references orders(id),
+    pharmacy_product_id bigint not null references pharmacy_products(id),
+    rating int not null check (rating between 1 and 5),
+    comment text default null,
+    image_url text default null,
+    reply text default null,
+    replied_by bigint references users(id) default null,
+    replied_at timestamp default null,
+    is_hidden boolean not null default false,
+    created_at timestamp not null default current_timestamp,
+    updated_at timestamp not null default current_timestamp,
+    deleted_at timestamp default null,
+    constraint uc_reviews unique(order_id, pharmacy_product_id)
+);
+
+create index if not exists idx_fk_review_user_id on reviews(user_id);
+create index if not exists idx_fk_review_pharmacy_product_id on reviews(pharmacy_product_id);
\ No newline at end of file
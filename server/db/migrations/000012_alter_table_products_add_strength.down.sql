@@ -0,0 +1,2 @@
+drop index if exists idx_products_generic_name;
+alter table products drop column if exists strength;
\ No newline at end of file
@@ -0,0 +1,3 @@
+alter table products add column if not exists strength varchar(255) default null;
+
+create index if not exists idx_products_generic_name on products(lower(generic_name));
\ No newline at end of file
@@ -0,0 +1,4 @@
+drop function if exists refresh_product_co_purchases_view;
+drop materialized view if exists product_co_purchases;
+drop index if exists idx_product_co_purchases_unique;
+drop index if exists idx_product_co_purchases_frequency;
\ No newline at end of file
@@ -0,0 +1,24 @@
+create materialized view if not exists product_co_purchases as
+select
+    pp.product_id,
+    rpp.product_id as related_product_id,
+    count(distinct o.id) as frequency
+from orders o
+join order_products op on op.order_id = o.id
+join order_products rop on rop.order_id = o.id
+join pharmacy_products pp on pp.id = op.pharmacy_product_id
+join pharmacy_products rpp on rpp.id = rop.pharmacy_product_id
+where o.order_status = 'CONFIRMED'
+and o.deleted_at is null
+and pp.product_id <> rpp.product_id
+group by pp.product_id, rpp.product_id;
+
+create or replace function refresh_product_co_purchases_view()
+returns void as $$
+begin
+    refresh materialized view concurrently product_co_purchases;
+end;
+$$ language plpgsql;
+
+create unique index if not exists idx_product_co_purchases_unique on product_co_purchases(product_id, related_product_id);
+create index if not exists idx_product_co_purchases_frequency on product_co_purchases(product_id, frequency desc);
\ No newline at end of file
@@ -0,0 +1,8 @@
+alter table orders drop column if exists interaction_overridden_at;
+alter table orders drop column if exists interaction_overridden_by;
+alter table orders drop column if exists interaction_hold;
+
+drop index if exists idx_drug_interactions_ingredient_b;
+drop index if exists idx_drug_interactions_ingredient_a;
+drop index if exists uc_drug_interactions;
+drop table if exists drug_interactions cascade;
\ No newline at end of file
@@ -0,0 +1,18 @@
+create table if not exists drug_interactions(
+    id bigserial primary key,
+    ingredient_a varchar(255) not null,
+    ingredient_b varchar(255) not null,
+    severity varchar(50) not null check (severity in ('MINOR', 'MODERATE', 'SEVERE')),
+    description text default null,
+    created_at timestamp not null default current_timestamp,
+    updated_at timestamp not null default current_timestamp,
+    deleted_at timestamp default null
+);
+
+create unique index if not exists uc_drug_interactions on drug_interactions(least(lower(ingredient_a), lower(ingredient_b)), greatest(lower(ingredient_a), lower(ingredient_b))) where deleted_at is null;
+create index if not exists idx_drug_interactions_ingredient_a on drug_interactions(lower(ingredient_a));
+create index if not exists idx_drug_interactions_ingredient_b on drug_interactions(lower(ingredient_b));
+
+alter table orders add column if not exists interaction_hold boolean not null default false;
+alter table orders add column if not exists interaction_overridden_by bigint references users(id) default null;
+alter table orders add column if not exists interaction_overridden_at timestamp default null;
\ No newline at end of file
@@ -0,0 +1,5 @@
+drop index if exists idx_fk_medical_profile_access_log_user_id;
+drop index if exists idx_fk_medical_profile_entry_user_id;
+drop table if exists medical_profile_access_logs cascade;
+drop table if exists medical_profile_entries cascade;
+drop table if exists medical_profiles cascade;
\ No newline at end of file
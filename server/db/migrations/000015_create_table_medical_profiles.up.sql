@@ -0,0 +1,31 @@
+create table if not exists medical_profiles(
+    id bigserial primary key,
+    user_id bigint not null unique references users(id) on delete cascade,
+    pregnancy_status varchar(50) not null default 'NONE' check (pregnancy_status in ('NONE', 'PREGNANT', 'BREASTFEEDING')),
+    created_at timestamp not null default current_timestamp,
+    updated_at timestamp not null default current_timestamp,
+    deleted_at timestamp default null
+);
+
+create table if not exists medical_profile_entries(
+    id bigserial primary key,
+    user_id bigint not null references users(id) on delete cascade,
+    entry_type varchar(50) not null check (entry_type in ('ALLERGY', 'CONDITION', 'MEDICATION')),
+    name varchar(255) not null,
+    description text default null,
+    created_at timestamp not null default current_timestamp,
+    updated_at timestamp not null default current_timestamp
+);
+
+create table if not exists medical_profile_access_logs(
+    id bigserial primary key,
+    user_id bigint not null references users(id) on delete cascade,
+    accessed_by bigint not null references users(id),
+    accessor_role int not null,
+    action varchar(50) not null,
+    order_id bigint references orders(id) default null,
+    created_at timestamp not null default current_timestamp
+);
+
+create index if not exists idx_fk_medical_profile_entry_user_id on medical_profile_entries(user_id);
+create index if not exists idx_fk_medical_profile_access_log_user_id on medical_profile_access_logs(user_id);
\ No newline at end of file
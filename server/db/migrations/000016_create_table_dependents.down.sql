@@ -0,0 +1,4 @@
+alter table orders drop column if exists dependent_id;
+
+drop index if exists idx_fk_dependent_user_id;
+drop table if exists dependents cascade;
\ No newline at end of file
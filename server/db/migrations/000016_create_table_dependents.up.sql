@@ -0,0 +1,16 @@
+create table if not exists dependents(
+    id bigserial primary key,
+    user_id bigint not null references users(id) on delete cascade,
+    full_name varchar(255) not null,
+    relationship varchar(50) not null,
+    birth_date date not null,
+    weight decimal(5,2) default null,
+    medical_notes text default null,
+    created_at timestamp not null default current_timestamp,
+    updated_at timestamp not null default current_timestamp,
+    deleted_at timestamp default null
+);
+
+create index if not exists idx_fk_dependent_user_id on dependents(user_id);
+
+alter table orders add column if not exists dependent_id bigint references dependents(id) default null;
\ No newline at end of file
@@ -0,0 +1,6 @@
+drop index if exists idx_fk_refill_subscription_item_subscription_id;
+drop index if exists idx_refill_subscription_next_refill_at;
+drop index if exists idx_fk_refill_subscription_user_id;
+
+drop table if exists refill_subscription_items cascade;
+drop table if exists refill_subscriptions cascade;
\ No newline at end of file
@@ -0,0 +1,31 @@
+create table if not exists refill_subscriptions(
+    id bigserial primary key,
+    user_id bigint not null references users(id) on delete cascade,
+    pharmacy_id bigint not null references pharmacies(id) on delete cascade,
+    address_id bigint not null references user_addresses(id) on delete cascade,
+    logistic_id bigint not null references logistics(id),
+    dependent_id bigint references dependents(id) default null,
+    interval_days int not null check (interval_days between 7 and 90),
+    status varchar(20) not null default 'ACTIVE' check (status in ('ACTIVE', 'PAUSED', 'CANCELLED')),
+    next_refill_at date not null,
+    reminded_at timestamp default null,
+    last_order_id bigint references orders(id) default null,
+    description text default null,
+    created_at timestamp not null default current_timestamp,
+    updated_at timestamp not null default current_timestamp,
+    deleted_at timestamp default null
+);
+
+create table if not exists refill_subscription_items(
+    id bigserial primary key,
+    subscription_id bigint not null references refill_subscriptions(id) on delete cascade,
+    pharmacy_product_id bigint not null references pharmacy_products(id) on delete cascade,
+    quantity int not null check (quantity > 0),
+    created_at timestamp not null default current_timestamp,
+    updated_at timestamp not null default current_timestamp,
+    constraint uc_refill_subscription_item unique(subscription_id, pharmacy_product_id)
+);
+
+create index if not exists idx_fk_refill_subscription_user_id on refill_subscriptions(user_id);
+create index if not exists idx_refill_subscription_next_refill_at on refill_subscriptions(status, next_refill_at) where deleted_at is null;
+create index if not exists idx_fk_refill_subscription_item_subscription_id on refill_subscription_items(subscription_id);
\ No newline at end of file
@@ -0,0 +1,7 @@
+drop index if exists idx_medication_dose_reminder;
+drop index if exists idx_fk_medication_dose_schedule_id;
+drop index if exists idx_fk_medication_schedule_order_id;
+drop index if exists idx_fk_medication_schedule_user_id;
+
+drop table if exists medication_doses cascade;
+drop table if exists medication_schedules cascade;
\ No newline at end of file
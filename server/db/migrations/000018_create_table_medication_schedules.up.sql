@@ -0,0 +1,33 @@
+create table if not exists medication_schedules(
+    id bigserial primary key,
+    user_id bigint not null references users(id) on delete cascade,
+    order_id bigint references orders(id) on delete set null default null,
+    pharmacy_product_id bigint references pharmacy_products(id) on delete set null default null,
+    medicine_name varchar(255) not null,
+    dosage varchar(100) not null,
+    times_per_day int not null check (times_per_day between 1 and 6),
+    duration_days int not null check (duration_days between 1 and 90),
+    start_date date default null,
+    notes text default null,
+    status varchar(20) not null check (status in ('PROPOSED', 'ACTIVE', 'COMPLETED', 'CANCELLED')),
+    created_by bigint not null references users(id),
+    created_at timestamp not null default current_timestamp,
+    updated_at timestamp not null default current_timestamp,
+    deleted_at timestamp default null
+);
+
+create table if not exists medication_doses(
+    id bigserial primary key,
+    schedule_id bigint not null references medication_schedules(id) on delete cascade,
+    scheduled_at timestamp not null,
+    status varchar(20) not null default 'PENDING' check (status in ('PENDING', 'TAKEN', 'SKIPPED')),
+    reminded_at timestamp default null,
+    logged_at timestamp default null,
+    created_at timestamp not null default current_timestamp,
+    updated_at timestamp not null default current_timestamp
+);
+
+create index if not exists idx_fk_medication_schedule_user_id on medication_schedules(user_id);
+create index if not exists idx_fk_medication_schedule_order_id on medication_schedules(order_id);
+create index if not exists idx_fk_medication_dose_schedule_id on medication_doses(schedule_id);
+create index if not exists idx_medication_dose_reminder on medication_doses(scheduled_at) where status = 'PENDING' and reminded_at is null;
\ No newline at end of file
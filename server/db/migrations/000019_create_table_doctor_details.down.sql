@@ -0,0 +1,2 @@
+drop index if exists idx_doctor_detail_specialization;
+drop table if exists doctor_details cascade;
\ No newline at end of file
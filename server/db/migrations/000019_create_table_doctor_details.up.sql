@@ -0,0 +1,13 @@
+create table if not exists doctor_details(
+    id bigserial primary key,
+    user_id bigint not null unique references users(id) on delete cascade,
+    license_number varchar(255) not null unique,
+    specialization varchar(255) not null,
+    consultation_fee decimal(13,2) not null default 0,
+    is_online boolean not null default false,
+    created_at timestamp not null default current_timestamp,
+    updated_at timestamp not null default current_timestamp,
+    deleted_at timestamp default null
+);
+
+create index if not exists idx_doctor_detail_specialization on doctor_details(specialization);
\ No newline at end of file
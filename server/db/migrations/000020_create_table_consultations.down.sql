@@ -0,0 +1,9 @@
+drop index if exists idx_fk_consultation_note_user_id;
+drop index if exists idx_fk_consultation_message_consultation_id;
+drop index if exists idx_consultation_status;
+drop index if exists idx_fk_consultation_doctor_id;
+drop index if exists idx_fk_consultation_user_id;
+
+drop table if exists consultation_notes cascade;
+drop table if exists consultation_messages cascade;
+drop table if exists consultations cascade;
\ No newline at end of file
@@ -0,0 +1,41 @@
+create table if not exists consultations(
+    id bigserial primary key,
+    user_id bigint not null references users(id) on delete cascade,
+    doctor_id bigint not null references users(id) on delete cascade,
+    dependent_id bigint references dependents(id) default null,
+    complaint text not null,
+    consultation_fee decimal(13,2) not null default 0,
+    status varchar(20) not null default 'WAITING' check (status in ('WAITING', 'ONGOING', 'COMPLETED', 'REJECTED', 'CANCELLED')),
+    accepted_at timestamp default null,
+    ended_at timestamp default null,
+    created_at timestamp not null default current_timestamp,
+    updated_at timestamp not null default current_timestamp
+);
+
+create table if not exists consultation_messages(
+    id bigserial primary key,
+    consultation_id bigint not null references consultations(id) on delete cascade,
+    sender_id bigint not null references users(id) on delete cascade,
+    message text default null,
+    attachment_url text default null,
+    attachment_type varchar(100) default null,
+    created_at timestamp not null default current_timestamp
+);
+
+create table if not exists consultation_notes(
+    id bigserial primary key,
+    consultation_id bigint not null unique references consultations(id) on delete cascade,
+    user_id bigint not null references users(id) on delete cascade,
+    doctor_id bigint not null references users(id) on delete cascade,
+    dependent_id bigint references dependents(id) default null,
+    diagnosis text not null,
+    advice text not null,
+    created_at timestamp not null default current_timestamp,
+    updated_at timestamp not null default current_timestamp
+);
+
+create index if not exists idx_fk_consultation_user_id on consultations(user_id);
+create index if not exists idx_fk_consultation_doctor_id on consultations(doctor_id);
+create index if not exists idx_consultation_status on consultations(status);
+create index if not exists idx_fk_consultation_message_consultation_id on consultation_messages(consultation_id);
+create index if not exists idx_fk_consultation_note_user_id on consultation_notes(user_id);
\ No newline at end of file
@@ -0,0 +1,11 @@
+alter table order_products drop column if exists prescription_item_id;
+alter table user_cart_items drop column if exists prescription_item_id;
+
+drop index if exists idx_fk_prescription_redemption_prescription_id;
+drop index if exists idx_fk_prescription_item_prescription_id;
+drop index if exists idx_fk_prescription_user_id;
+drop index if exists idx_fk_prescription_prescriber_id;
+
+drop table if exists prescription_redemptions cascade;
+drop table if exists prescription_items cascade;
+drop table if exists prescriptions cascade;
\ No newline at end of file
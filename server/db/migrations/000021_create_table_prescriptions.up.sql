@@ -0,0 +1,42 @@
+create table if not exists prescriptions(
+    id bigserial primary key,
+    code varchar(20) not null unique,
+    prescriber_id bigint not null references users(id) on delete cascade,
+    user_id bigint not null references users(id) on delete cascade,
+    dependent_id bigint references dependents(id) default null,
+    consultation_id bigint references consultations(id) default null,
+    notes text default null,
+    refills_allowed int not null default 0 check (refills_allowed >= 0),
+    redemptions_remaining int not null check (redemptions_remaining >= 0),
+    expires_at timestamp not null,
+    created_at timestamp not null default current_timestamp,
+    updated_at timestamp not null default current_timestamp
+);
+
+create table if not exists prescription_items(
+    id bigserial primary key,
+    prescription_id bigint not null references prescriptions(id) on delete cascade,
+    product_id bigint references products(id) default null,
+    generic_name varchar(255) default null,
+    dosage varchar(255) not null,
+    quantity int not null check (quantity > 0),
+    created_at timestamp not null default current_timestamp,
+    updated_at timestamp not null default current_timestamp,
+    constraint chk_prescription_item_product check (product_id is not null or generic_name is not null)
+);
+
+create table if not exists prescription_redemptions(
+    id bigserial primary key,
+    prescription_id bigint not null references prescriptions(id) on delete cascade,
+    user_id bigint not null references users(id) on delete cascade,
+    pharmacy_id bigint not null references pharmacies(id) on delete cascade,
+    created_at timestamp not null default current_timestamp
+);
+
+create index if not exists idx_fk_prescription_prescriber_id on prescriptions(prescriber_id);
+create index if not exists idx_fk_prescription_user_id on prescriptions(user_id);
+create index if not exists idx_fk_prescription_item_prescription_id on prescription_items(prescription_id);
+create index if not exists idx_fk_prescription_redemption_prescription_id on prescription_redemptions(prescription_id);
+
+alter table user_cart_items add column if not exists prescription_item_id bigint references prescription_items(id) default null;
+alter table order_products add column if not exists prescription_item_id bigint references prescription_items(id) default null;
\ No newline at end of file
@@ -0,0 +1,7 @@
+drop index if exists idx_fk_chat_message_room_id;
+drop index if exists idx_fk_chat_room_pharmacy_id;
+drop index if exists uc_chat_room_pharmacy;
+drop index if exists uc_chat_room_order;
+
+drop table if exists chat_messages cascade;
+drop table if exists chat_rooms cascade;
\ No newline at end of file
@@ -0,0 +1,23 @@
+create table if not exists chat_rooms(
+    id bigserial primary key,
+    user_id bigint not null references users(id) on delete cascade,
+    pharmacy_id bigint not null references pharmacies(id) on delete cascade,
+    order_id bigint references orders(id) on delete cascade default null,
+    last_message_at timestamp default null,
+    created_at timestamp not null default current_timestamp,
+    updated_at timestamp not null default current_timestamp
+);
+
+create table if not exists chat_messages(
+    id bigserial primary key,
+    room_id bigint not null references chat_rooms(id) on delete cascade,
+    sender_id bigint not null references users(id) on delete cascade,
+    message text not null,
+    read_at timestamp default null,
+    created_at timestamp not null default current_timestamp
+);
+
+create unique index if not exists uc_chat_room_order on chat_rooms(user_id, order_id) where order_id is not null;
+create unique index if not exists uc_chat_room_pharmacy on chat_rooms(user_id, pharmacy_id) where order_id is null;
+create index if not exists idx_fk_chat_room_pharmacy_id on chat_rooms(pharmacy_id);
+create index if not exists idx_fk_chat_message_room_id on chat_messages(room_id);
\ No newline at end of file
@@ -0,0 +1,4 @@
+drop index if exists idx_notification_unread;
+drop index if exists idx_fk_notification_user_id;
+
+drop table if exists notifications cascade;
\ No newline at end of file
@@ -0,0 +1,13 @@
+create table if not exists notifications(
+    id bigserial primary key,
+    user_id bigint not null references users(id) on delete cascade,
+    type varchar not null,
+    title varchar not null,
+    body text not null,
+    link varchar default null,
+    read_at timestamp default null,
+    created_at timestamp not null default current_timestamp
+);
+
+create index if not exists idx_fk_notification_user_id on notifications(user_id, id);
+create index if not exists idx_notification_unread on notifications(user_id) where read_at is null;
\ No newline at end of file
@@ -0,0 +1,2 @@
+drop table if exists notification_quiet_hours cascade;
+drop table if exists notification_preferences cascade;
\ No newline at end of file
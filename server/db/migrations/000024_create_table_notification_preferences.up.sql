@@ -0,0 +1,19 @@
+create table if not exists notification_preferences(
+    id bigserial primary key,
+    user_id bigint not null references users(id) on delete cascade,
+    type varchar not null,
+    channel varchar not null,
+    enabled boolean not null,
+    created_at timestamp not null default current_timestamp,
+    updated_at timestamp not null default current_timestamp,
+    constraint uc_notification_preference unique(user_id, type, channel)
+);
+
+create table if not exists notification_quiet_hours(
+    user_id bigint primary key references users(id) on delete cascade,
+    start_time time not null,
+    end_time time not null,
+    created_at timestamp not null default current_timestamp,
+    updated_at timestamp not null default current_timestamp,
+    constraint chk_notification_quiet_hours check(start_time <> end_time)
+);
\ No newline at end of file
@@ -0,0 +1,3 @@
+drop table if exists email_templates cascade;
+
+alter table users drop column if exists locale;
\ No newline at end of file
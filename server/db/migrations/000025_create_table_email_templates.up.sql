@@ -0,0 +1,17 @@
+alter table users add column if not exists locale varchar(2) not null default 'en';
+
+create table if not exists email_templates(
+    id bigserial primary key,
+    code varchar not null,
+    locale varchar(2) not null,
+    version int not null,
+    subject varchar(255) not null,
+    body text not null,
+    is_active boolean not null default false,
+    created_by bigint default null references users(id) on delete set null,
+    created_at timestamp not null default current_timestamp,
+    updated_at timestamp not null default current_timestamp,
+    constraint uc_email_template_version unique(code, locale, version)
+);
+
+create unique index if not exists idx_email_template_active on email_templates(code, locale) where is_active;
\ No newline at end of file
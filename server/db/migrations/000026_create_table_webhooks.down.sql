@@ -0,0 +1,2 @@
+drop table if exists webhook_deliveries cascade;
+drop table if exists webhook_endpoints cascade;
\ No newline at end of file
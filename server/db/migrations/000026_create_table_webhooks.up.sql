@@ -0,0 +1,30 @@
+create table if not exists webhook_endpoints(
+    id bigserial primary key,
+    partner_id bigint not null references pharmacy_partners(id) on delete cascade,
+    url varchar(2048) not null,
+    secret varchar not null,
+    events varchar[] not null,
+    is_active boolean not null default true,
+    created_at timestamp not null default current_timestamp,
+    updated_at timestamp not null default current_timestamp,
+    deleted_at timestamp
+);
+
+create table if not exists webhook_deliveries(
+    id bigserial primary key,
+    webhook_endpoint_id bigint not null references webhook_endpoints(id) on delete cascade,
+    event varchar not null,
+    payload jsonb not null,
+    status varchar not null default 'PENDING',
+    attempts int not null default 0,
+    response_status int,
+    response_body text,
+    error text,
+    last_attempt_at timestamp,
+    delivered_at timestamp,
+    created_at timestamp not null default current_timestamp,
+    updated_at timestamp not null default current_timestamp
+);
+
+create index if not exists idx_fk_webhook_endpoint_partner_id on webhook_endpoints(partner_id);
+create index if not exists idx_fk_webhook_delivery_endpoint_id on webhook_deliveries(webhook_endpoint_id);
\ No newline at end of file
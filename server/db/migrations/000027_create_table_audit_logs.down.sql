@@ -0,0 +1,2 @@
+drop table if exists audit_logs cascade;
+drop function if exists reject_audit_log_change();
\ No newline at end of file
@@ -0,0 +1,27 @@
+create table if not exists audit_logs(
+    id bigserial primary key,
+    actor_id bigint,
+    actor_role varchar not null,
+    action varchar not null,
+    entity_type varchar not null,
+    entity_id bigint not null,
+    changes jsonb not null default '{}',
+    ip_address varchar,
+    request_id varchar,
+    created_at timestamp not null default current_timestamp
+);
+
+create index if not exists idx_audit_log_entity on audit_logs(entity_type, entity_id);
+create index if not exists idx_audit_log_actor_id on audit_logs(actor_id);
+create index if not exists idx_audit_log_created_at on audit_logs(created_at);
+
+create or replace function reject_audit_log_change() returns trigger as $$
+begin
+    raise exception 'audit_logs is append-only';
+end;
+$$ language plpgsql;
+
+drop trigger if exists trg_audit_logs_append_only on audit_logs;
+create trigger trg_audit_logs_append_only
+before update or delete on audit_logs
+for each row execute function reject_audit_log_change();
\ No newline at end of file
@@ -0,0 +1,4 @@
+alter table medical_profile_access_logs drop column if exists dependent_id;
+
+drop index if exists idx_fk_dependent_allergy_dependent_id;
+drop table if exists dependent_allergies;
\ No newline at end of file
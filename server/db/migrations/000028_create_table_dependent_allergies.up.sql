@@ -0,0 +1,10 @@
+create table if not exists dependent_allergies(
+    id bigserial primary key,
+    dependent_id bigint not null references dependents(id) on delete cascade,
+    name varchar(255) not null,
+    created_at timestamp not null default current_timestamp
+);
+
+create index if not exists idx_fk_dependent_allergy_dependent_id on dependent_allergies(dependent_id);
+
+alter table medical_profile_access_logs add column if not exists dependent_id bigint references dependents(id) default null;
\ No newline at end of file
@@ -0,0 +1,14 @@
+package apperror
+
+import (
+	"errors"
+
+	"healthcare-app/internal/audit/constant"
+	"healthcare-app/pkg/apperror"
+)
+
+func NewInvalidAuditLogDateRangeError() *apperror.AppError {
+	msg := constant.InvalidAuditLogDateRange
+	err := errors.New(msg)
+	return apperror.NewAppError(err, apperror.DefaultClientErrorCode, msg)
+}
@@ -0,0 +1,32 @@
+package constant
+
+const (
+	ACTION_CREATE = "CREATE"
+	ACTION_UPDATE = "UPDATE"
+	ACTION_DELETE = "DELETE"
+	ACTION_CANCEL = "CANCEL"
+)
+
+const (
+	ENTITY_PHARMACIST       = "pharmacist"
+	ENTITY_PARTNER          = "partner"
+	ENTITY_PHARMACY         = "pharmacy"
+	ENTITY_PRODUCT          = "product"
+	ENTITY_PHARMACY_PRODUCT = "pharmacy_product"
+	ENTITY_ORDER            = "order"
+	ENTITY_STOCK_TRANSFER   = "stock_transfer"
+	ENTITY_WEBHOOK_ENDPOINT = "webhook_endpoint"
+)
+
+const (
+	MAX_EXPORT_ROWS = 10000
+	REDACTED_VALUE  = "[REDACTED]"
+)
+
+var (
+	// RedactedFields are never written to the log as they are, a change to
+	// any of them only shows that it happened.
+	RedactedFields = []string{"password", "secret", "token"}
+
+	AuditLogExportHeaders = []string{"id", "created_at", "actor_id", "actor_role", "action", "entity_type", "entity_id", "changes", "ip_address", "request_id"}
+)
@@ -0,0 +1,5 @@
+package constant
+
+const (
+	InvalidAuditLogDateRange = "start_date must not be after end_date"
+)
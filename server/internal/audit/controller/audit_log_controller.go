@@ -0,0 +1,59 @@
+package controller
+
+import (
+	"fmt"
+
+	"healthcare-app/internal/audit/dto"
+	"healthcare-app/internal/audit/usecase"
+	"healthcare-app/internal/audit/utils"
+	"healthcare-app/pkg/logger"
+	"healthcare-app/pkg/utils/ginutils"
+
+	"github.com/gin-gonic/gin"
+)
+
+type AuditLogController struct {
+	auditLogUseCase usecase.AuditLogUseCase
+}
+
+func NewAuditLogController(auditLogUseCase usecase.AuditLogUseCase) *AuditLogController {
+	return &AuditLogController{
+		auditLogUseCase: auditLogUseCase,
+	}
+}
+
+func (c *AuditLogController) Search(ctx *gin.Context) {
+	req := new(dto.SearchAuditLogRequest)
+	if err := ctx.ShouldBindQuery(req); err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	res, paging, err := c.auditLogUseCase.Search(ctx, req)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+	ginutils.ResponseOKSeekPagination(ctx, res, paging)
+}
+
+func (c *AuditLogController) Export(ctx *gin.Context) {
+	req := new(dto.ExportAuditLogRequest)
+	if err := ctx.ShouldBindQuery(req); err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	fileName, contentType := utils.AuditLogExportFile()
+	ctx.Header("Content-Disposition", fmt.Sprintf("attachment; filename=%q", fileName))
+	ctx.Header("Content-Type", contentType)
+
+	if err := c.auditLogUseCase.Export(ctx, req, ctx.Writer); err != nil {
+		if ctx.Writer.Written() {
+			logger.Log.Error("error streaming audit logs export:", err)
+			return
+		}
+		ctx.Writer.Header().Del("Content-Disposition")
+		ctx.Error(err)
+	}
+}
@@ -0,0 +1,80 @@
+package dto
+
+import (
+	"encoding/json"
+	"time"
+
+	"healthcare-app/internal/audit/entity"
+)
+
+type AuditLogResponse struct {
+	ID         int64           `json:"id"`
+	ActorID    *int64          `json:"actor_id"`
+	ActorRole  string          `json:"actor_role"`
+	Action     string          `json:"action"`
+	EntityType string          `json:"entity_type"`
+	EntityID   int64           `json:"entity_id"`
+	Changes    json.RawMessage `json:"changes"`
+	IPAddress  string          `json:"ip_address"`
+	RequestID  string          `json:"request_id"`
+	CreatedAt  time.Time       `json:"created_at"`
+}
+
+type AuditLogFilter struct {
+	EntityType string `form:"entity_type"`
+	EntityID   int64  `form:"entity_id" binding:"omitempty,gte=1"`
+	ActorID    int64  `form:"actor_id" binding:"omitempty,gte=1"`
+	Action     string `form:"action" binding:"omitempty,oneof=CREATE UPDATE DELETE CANCEL"`
+	StartDate  string `form:"start_date" binding:"omitempty,time_format=2006-01-02"`
+	EndDate    string `form:"end_date" binding:"omitempty,time_format=2006-01-02"`
+}
+
+type SearchAuditLogRequest struct {
+	AuditLogFilter
+	Last  string `form:"last" binding:"omitempty,numeric,gte=0"`
+	Limit int64  `form:"limit" binding:"numeric,gte=1,lte=50"`
+}
+
+type ExportAuditLogRequest struct {
+	AuditLogFilter
+}
+
+// AuditEntry is one action to record. Before and After are the state of the
+// entity around the action, nil for a creation or a deletion, only the fields
+// that differ end up in the log. The actor, ip and request id are taken from
+// the context.
+type AuditEntry struct {
+	Action     string
+	EntityType string
+	EntityID   int64
+	Before     any
+	After      any
+}
+
+type Change struct {
+	Before any `json:"before"`
+	After  any `json:"after"`
+}
+
+func ConvertToAuditLogResponses(auditLogs []*entity.AuditLog) []*AuditLogResponse {
+	res := []*AuditLogResponse{}
+	for _, auditLog := range auditLogs {
+		res = append(res, ConvertToAuditLogResponse(auditLog))
+	}
+	return res
+}
+
+func ConvertToAuditLogResponse(auditLog *entity.AuditLog) *AuditLogResponse {
+	return &AuditLogResponse{
+		ID:         auditLog.ID,
+		ActorID:    auditLog.ActorID,
+		ActorRole:  auditLog.ActorRole,
+		Action:     auditLog.Action,
+		EntityType: auditLog.EntityType,
+		EntityID:   auditLog.EntityID,
+		Changes:    auditLog.Changes,
+		IPAddress:  auditLog.IPAddress,
+		RequestID:  auditLog.RequestID,
+		CreatedAt:  auditLog.CreatedAt,
+	}
+}
@@ -0,0 +1,16 @@
+package entity
+
+import "time"
+
+type AuditLog struct {
+	ID         int64
+	ActorID    *int64
+	ActorRole  string
+	Action     string
+	EntityType string
+	EntityID   int64
+	Changes    []byte
+	IPAddress  string
+	RequestID  string
+	CreatedAt  time.Time
+}
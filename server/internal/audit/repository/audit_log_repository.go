@@ -0,0 +1,162 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"healthcare-app/internal/audit/constant"
+	"healthcare-app/internal/audit/dto"
+	"healthcare-app/internal/audit/entity"
+	"healthcare-app/pkg/database/transactor"
+)
+
+// AuditLogRepository only ever appends, audit_logs rejects updates and
+// deletes on its own as well.
+type AuditLogRepository interface {
+	Search(ctx context.Context, request *dto.SearchAuditLogRequest) ([]*entity.AuditLog, error)
+	FindAll(ctx context.Context, request *dto.ExportAuditLogRequest) ([]*entity.AuditLog, error)
+	Save(ctx context.Context, auditLog *entity.AuditLog) error
+}
+
+type auditLogRepositoryImpl struct {
+	db *sql.DB
+}
+
+func NewAuditLogRepository(db *sql.DB) *auditLogRepositoryImpl {
+	return &auditLogRepositoryImpl{
+		db: db,
+	}
+}
+
+const auditLogSelectQuery = `
+	select id, actor_id, actor_role, action, entity_type, entity_id, changes, coalesce(ip_address, ''), coalesce(request_id, ''), created_at
+	from audit_logs
+	where true
+`
+
+// Search pages backwards from the newest entry, last is the smallest id the
+// client already has. One extra row is fetched to tell whether there is an
+// older page.
+func (r *auditLogRepositoryImpl) Search(ctx context.Context, request *dto.SearchAuditLogRequest) ([]*entity.AuditLog, error) {
+	queryBuilder, args := r.filter(&request.AuditLogFilter)
+
+	if lastID, _ := strconv.Atoi(request.Last); lastID != 0 {
+		queryBuilder.WriteString(fmt.Sprintf(" and id < $%v", len(args)+1))
+		args = append(args, lastID)
+	}
+	queryBuilder.WriteString(fmt.Sprintf(" order by id desc limit $%v", len(args)+1))
+	args = append(args, request.Limit+1)
+
+	return r.findAll(ctx, queryBuilder.String(), args...)
+}
+
+func (r *auditLogRepositoryImpl) FindAll(ctx context.Context, request *dto.ExportAuditLogRequest) ([]*entity.AuditLog, error) {
+	queryBuilder, args := r.filter(&request.AuditLogFilter)
+
+	queryBuilder.WriteString(fmt.Sprintf(" order by id desc limit $%v", len(args)+1))
+	args = append(args, constant.MAX_EXPORT_ROWS)
+
+	return r.findAll(ctx, queryBuilder.String(), args...)
+}
+
+func (r *auditLogRepositoryImpl) Save(ctx context.Context, auditLog *entity.AuditLog) error {
+	query := `
+		insert into audit_logs(actor_id, actor_role, action, entity_type, entity_id, changes, ip_address, request_id)
+		values ($1, $2, $3, $4, $5, $6, nullif($7, ''), nullif($8, ''))
+		returning id, created_at
+	`
+	tx := transactor.ExtractTx(ctx)
+
+	var err error
+	if tx != nil {
+		err = tx.QueryRowContext(ctx, query, auditLog.ActorID, auditLog.ActorRole, auditLog.Action, auditLog.EntityType, auditLog.EntityID, auditLog.Changes, auditLog.IPAddress, auditLog.RequestID).
+			Scan(&auditLog.ID, &auditLog.CreatedAt)
+	} else {
+		err = r.db.QueryRowContext(ctx, query, auditLog.ActorID, auditLog.ActorRole, auditLog.Action, auditLog.EntityType, auditLog.EntityID, auditLog.Changes, auditLog.IPAddress, auditLog.RequestID).
+			Scan(&auditLog.ID, &auditLog.CreatedAt)
+	}
+
+	return err
+}
+
+// filter starts the select with the filters shared by search and export. The
+// date range covers whole days, end_date included.
+func (r *auditLogRepositoryImpl) filter(request *dto.AuditLogFilter) (*strings.Builder, []any) {
+	args := []any{}
+	queryBuilder := new(strings.Builder)
+	queryBuilder.WriteString(auditLogSelectQuery)
+
+	if request.EntityType != "" {
+		queryBuilder.WriteString(fmt.Sprintf(" and entity_type = $%v", len(args)+1))
+		args = append(args, request.EntityType)
+	}
+	if request.EntityID != 0 {
+		queryBuilder.WriteString(fmt.Sprintf(" and entity_id = $%v", len(args)+1))
+		args = append(args, request.EntityID)
+	}
+	if request.ActorID != 0 {
+		queryBuilder.WriteString(fmt.Sprintf(" and actor_id = $%v", len(args)+1))
+		args = append(args, request.ActorID)
+	}
+	if request.Action != "" {
+		queryBuilder.WriteString(fmt.Sprintf(" and action = $%v", len(args)+1))
+		args = append(args, request.Action)
+	}
+	if request.StartDate != "" {
+		queryBuilder.WriteString(fmt.Sprintf(" and created_at >= $%v::date", len(args)+1))
+		args = append(args, request.StartDate)
+	}
+	if request.EndDate != "" {
+		queryBuilder.WriteString(fmt.Sprintf(" and created_at < $%v::date + 1", len(args)+1))
+		args = append(args, request.EndDate)
+	}
+	return queryBuilder, args
+}
+
+func (r *auditLogRepositoryImpl) findAll(ctx context.Context, query string, args ...any) ([]*entity.AuditLog, error) {
+	tx := transactor.ExtractTx(ctx)
+
+	var (
+		err  error
+		rows *sql.Rows
+	)
+	if tx != nil {
+		rows, err = tx.QueryContext(ctx, query, args...)
+	} else {
+		rows, err = r.db.QueryContext(ctx, query, args...)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	auditLogs := []*entity.AuditLog{}
+	for rows.Next() {
+		auditLog := new(entity.AuditLog)
+		if err := rows.Scan(
+			&auditLog.ID,
+			&auditLog.ActorID,
+			&auditLog.ActorRole,
+			&auditLog.Action,
+			&auditLog.EntityType,
+			&auditLog.EntityID,
+			&auditLog.Changes,
+			&auditLog.IPAddress,
+			&auditLog.RequestID,
+			&auditLog.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		auditLogs = append(auditLogs, auditLog)
+	}
+
+	err = rows.Err()
+	if err != nil {
+		return nil, err
+	}
+	return auditLogs, nil
+}
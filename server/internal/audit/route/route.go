@@ -0,0 +1,17 @@
+package route
+
+import (
+	"healthcare-app/internal/audit/controller"
+	"healthcare-app/internal/auth/constant"
+	"healthcare-app/pkg/middleware"
+
+	"github.com/gin-gonic/gin"
+)
+
+func AuditLogControllerRoute(c *controller.AuditLogController, r *gin.Engine, authMiddleware *middleware.AuthMiddleware) {
+	g := r.Group("/admin/audit-logs", authMiddleware.Authorization(), authMiddleware.ProtectedRoles(constant.ADMIN))
+	{
+		g.GET("", c.Search)
+		g.GET("/export", c.Export)
+	}
+}
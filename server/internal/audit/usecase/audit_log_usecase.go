@@ -0,0 +1,110 @@
+package usecase
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"strconv"
+
+	apperrorAudit "healthcare-app/internal/audit/apperror"
+	dtoAudit "healthcare-app/internal/audit/dto"
+	"healthcare-app/internal/audit/entity"
+	"healthcare-app/internal/audit/repository"
+	auditUtils "healthcare-app/internal/audit/utils"
+	authUtils "healthcare-app/internal/auth/utils"
+	apperrorPkg "healthcare-app/pkg/apperror"
+	dtoPkg "healthcare-app/pkg/dto"
+	"healthcare-app/pkg/utils/pageutils"
+)
+
+type AuditLogUseCase interface {
+	Record(ctx context.Context, entry *dtoAudit.AuditEntry) error
+	Search(ctx context.Context, request *dtoAudit.SearchAuditLogRequest) ([]*dtoAudit.AuditLogResponse, *dtoPkg.SeekPageMetaData, error)
+	Export(ctx context.Context, request *dtoAudit.ExportAuditLogRequest, w io.Writer) error
+}
+
+type auditLogUseCaseImpl struct {
+	auditLogRepository repository.AuditLogRepository
+}
+
+func NewAuditLogUseCase(auditLogRepository repository.AuditLogRepository) *auditLogUseCaseImpl {
+	return &auditLogUseCaseImpl{
+		auditLogRepository: auditLogRepository,
+	}
+}
+
+// Record appends the entry for the user behind the request. Called with the
+// transaction context of the action, the entry is only kept when the action
+// commits and a failure to write it rolls the action back.
+func (u *auditLogUseCaseImpl) Record(ctx context.Context, entry *dtoAudit.AuditEntry) error {
+	changes, err := auditUtils.DiffChanges(entry.Before, entry.After)
+	if err != nil {
+		return apperrorPkg.NewServerError(err)
+	}
+	b, err := json.Marshal(changes)
+	if err != nil {
+		return apperrorPkg.NewServerError(err)
+	}
+
+	auditLog := &entity.AuditLog{
+		ActorRole:  authUtils.SpecifyRole(authUtils.GetValueRoleUserFromToken(ctx)),
+		Action:     entry.Action,
+		EntityType: entry.EntityType,
+		EntityID:   entry.EntityID,
+		Changes:    b,
+		IPAddress:  authUtils.GetClientIPFromContext(ctx),
+		RequestID:  authUtils.GetRequestIDFromContext(ctx),
+	}
+	if actorID := authUtils.GetValueUserIdFromToken(ctx); actorID != 0 {
+		auditLog.ActorID = &actorID
+	}
+	if err := u.auditLogRepository.Save(ctx, auditLog); err != nil {
+		return apperrorPkg.NewServerError(err)
+	}
+	return nil
+}
+
+func (u *auditLogUseCaseImpl) Search(ctx context.Context, request *dtoAudit.SearchAuditLogRequest) ([]*dtoAudit.AuditLogResponse, *dtoPkg.SeekPageMetaData, error) {
+	if err := validateDateRange(&request.AuditLogFilter); err != nil {
+		return nil, nil, err
+	}
+
+	auditLogs, err := u.auditLogRepository.Search(ctx, request)
+	if err != nil {
+		return nil, nil, apperrorPkg.NewServerError(err)
+	}
+	itemLen := int64(len(auditLogs))
+	if itemLen >= request.Limit {
+		auditLogs = auditLogs[:request.Limit]
+	}
+
+	last := ""
+	if len(auditLogs) != 0 {
+		last = strconv.Itoa(int(auditLogs[len(auditLogs)-1].ID))
+	}
+
+	metaData := pageutils.CreateSeekMetaData(itemLen, request.Limit, last)
+	return dtoAudit.ConvertToAuditLogResponses(auditLogs), metaData, nil
+}
+
+// Export writes the newest entries matching the filter as csv, capped at
+// MAX_EXPORT_ROWS, a narrower date range reaches further back.
+func (u *auditLogUseCaseImpl) Export(ctx context.Context, request *dtoAudit.ExportAuditLogRequest, w io.Writer) error {
+	if err := validateDateRange(&request.AuditLogFilter); err != nil {
+		return err
+	}
+
+	auditLogs, err := u.auditLogRepository.FindAll(ctx, request)
+	if err != nil {
+		return apperrorPkg.NewServerError(err)
+	}
+	return auditUtils.WriteAuditLogs(w, auditLogs)
+}
+
+// the dates are validated as 2006-01-02 on binding, so they compare as strings
+func validateDateRange(request *dtoAudit.AuditLogFilter) error {
+	if request.StartDate != "" && request.EndDate != "" && request.StartDate > request.EndDate {
+		return apperrorAudit.NewInvalidAuditLogDateRangeError()
+	}
+	return nil
+}
@@ -0,0 +1,54 @@
+package utils
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"healthcare-app/internal/audit/constant"
+	"healthcare-app/internal/audit/entity"
+)
+
+func WriteAuditLogs(w io.Writer, auditLogs []*entity.AuditLog) error {
+	writer := csv.NewWriter(w)
+	if err := writer.Write(constant.AuditLogExportHeaders); err != nil {
+		return err
+	}
+	for _, auditLog := range auditLogs {
+		actorID := ""
+		if auditLog.ActorID != nil {
+			actorID = fmt.Sprint(*auditLog.ActorID)
+		}
+		if err := writer.Write([]string{
+			fmt.Sprint(auditLog.ID),
+			auditLog.CreatedAt.Format(time.RFC3339),
+			actorID,
+			auditLog.ActorRole,
+			auditLog.Action,
+			auditLog.EntityType,
+			fmt.Sprint(auditLog.EntityID),
+			string(auditLog.Changes),
+			auditLog.IPAddress,
+			escapeFormula(auditLog.RequestID),
+		}); err != nil {
+			return err
+		}
+	}
+	writer.Flush()
+	return writer.Error()
+}
+
+func AuditLogExportFile() (string, string) {
+	return "audit-logs.csv", "text/csv"
+}
+
+// escapeFormula keeps a spreadsheet from evaluating a value that came from a
+// user as a formula.
+func escapeFormula(value string) string {
+	if value != "" && strings.ContainsRune("=+-@\t\r", rune(value[0])) {
+		return "'" + value
+	}
+	return value
+}
@@ -0,0 +1,80 @@
+package utils
+
+import (
+	"encoding/json"
+	"reflect"
+	"strings"
+
+	"healthcare-app/internal/audit/constant"
+	"healthcare-app/internal/audit/dto"
+)
+
+// DiffChanges compares the json form of before and after field by field and
+// keeps the fields that differ, so a creation or a deletion keeps every field.
+// Sensitive fields are masked on both sides.
+func DiffChanges(before, after any) (map[string]*dto.Change, error) {
+	beforeFields, err := toFields(before)
+	if err != nil {
+		return nil, err
+	}
+	afterFields, err := toFields(after)
+	if err != nil {
+		return nil, err
+	}
+
+	changes := map[string]*dto.Change{}
+	for field, value := range beforeFields {
+		changes[field] = &dto.Change{Before: value, After: afterFields[field]}
+	}
+	for field, value := range afterFields {
+		if _, ok := changes[field]; !ok {
+			changes[field] = &dto.Change{After: value}
+		}
+	}
+
+	for field, change := range changes {
+		if reflect.DeepEqual(change.Before, change.After) {
+			delete(changes, field)
+			continue
+		}
+		if isRedacted(field) {
+			change.Before = redact(change.Before)
+			change.After = redact(change.After)
+		}
+	}
+	return changes, nil
+}
+
+func toFields(v any) (map[string]any, error) {
+	if v == nil {
+		return nil, nil
+	}
+	b, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+
+	// a nil pointer marshals to null, which leaves the map nil
+	fields := map[string]any{}
+	if err := json.Unmarshal(b, &fields); err != nil {
+		return nil, err
+	}
+	return fields, nil
+}
+
+func isRedacted(field string) bool {
+	field = strings.ToLower(field)
+	for _, redacted := range constant.RedactedFields {
+		if strings.Contains(field, redacted) {
+			return true
+		}
+	}
+	return false
+}
+
+func redact(value any) any {
+	if value == nil {
+		return nil
+	}
+	return constant.REDACTED_VALUE
+}
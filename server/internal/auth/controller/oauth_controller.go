@@ -6,6 +6,7 @@ import (
 	"healthcare-app/internal/auth/dto"
 	"healthcare-app/internal/auth/usecase"
 	"healthcare-app/internal/auth/utils"
+	"healthcare-app/pkg/config"
 	"healthcare-app/pkg/utils/ginutils"
 
 	"github.com/gin-gonic/gin"
@@ -13,15 +14,18 @@ import (
 )
 
 type OauthController struct {
+	appConfig           *config.AppConfig
 	oauthUseCase        usecase.OauthUseCase
 	refreshTokenUseCase usecase.RefreshTokenUseCase
 }
 
 func NewOauthController(
+	appConfig *config.AppConfig,
 	oauthUseCase usecase.OauthUseCase,
 	refreshTokenUseCase usecase.RefreshTokenUseCase,
 ) *OauthController {
 	return &OauthController{
+		appConfig:           appConfig,
 		oauthUseCase:        oauthUseCase,
 		refreshTokenUseCase: refreshTokenUseCase,
 	}
@@ -51,7 +55,7 @@ func (c *OauthController) Callback(ctx *gin.Context) {
 	}
 
 	ctx.SetCookie("access_token", res.AccessToken, 604800, "/", "", false, false)
-	ctx.Redirect(http.StatusFound, "http://localhost:5173/")
+	ctx.Redirect(http.StatusFound, c.appConfig.FrontendURL+"/")
 }
 
 func (c *OauthController) Logout(ctx *gin.Context) {
@@ -80,3 +80,13 @@ func ConvertToUserOrAdminResponse(user *entity.UserOrAdmin) *UserOrAdminResponse
 		IsVerified:     user.IsVerified,
 	}
 }
+
+func ConvertToResponsePharmacistAccount(pharmacist *entity.UserDetail) *ResponsePharmacistUpdateAccount {
+	return &ResponsePharmacistUpdateAccount{
+		PharmacistID:      *pharmacist.UserId,
+		Fullname:          *pharmacist.Fullname,
+		SipaNumber:        *pharmacist.SipaNumber,
+		WhatsappNumber:    *pharmacist.WhatsappNumber,
+		YearsOfExperience: *pharmacist.YearsOfExperience,
+	}
+}
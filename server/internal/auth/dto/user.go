@@ -22,6 +22,7 @@ type ResponseUser struct {
 	Email      string     `json:"email"`
 	IsVerified bool       `json:"is_verified"`
 	IsOauth    bool       `json:"is_oauth,omitempty"`
+	Locale     string     `json:"locale,omitempty"`
 	CreatedAt  time.Time  `json:"created_at"`
 	UpdatedAt  time.Time  `json:"updated_at"`
 	DeletedAt  *time.Time `json:"deleted_at"`
@@ -9,6 +9,7 @@ type User struct {
 	HashPassword string
 	IsVerified   bool
 	IsOauth      bool
+	Locale       string
 	CreatedAt    time.Time
 	UpdatedAt    time.Time
 	DeletedAt    *time.Time
@@ -59,7 +59,7 @@ func (r *userRepositoryImpl) FindByID(ctx context.Context, id int64) (*entity.Us
 
 func (r *userRepositoryImpl) FindByIDWithCompleteData(ctx context.Context, id int64) (*entity.User, error) {
 	query := `
-		select id, role, email, is_verified, is_oauth, created_at, updated_at, deleted_at
+		select id, role, email, is_verified, is_oauth, locale, created_at, updated_at, deleted_at
 		from users 
 		where id = $1 and deleted_at is null
 	`
@@ -68,9 +68,9 @@ func (r *userRepositoryImpl) FindByIDWithCompleteData(ctx context.Context, id in
 
 	var err error
 	if tx != nil {
-		err = tx.QueryRowContext(ctx, query, id).Scan(&user.ID, &user.Role, &user.Email, &user.IsVerified, &user.IsOauth, &user.CreatedAt, &user.UpdatedAt, &user.DeletedAt)
+		err = tx.QueryRowContext(ctx, query, id).Scan(&user.ID, &user.Role, &user.Email, &user.IsVerified, &user.IsOauth, &user.Locale, &user.CreatedAt, &user.UpdatedAt, &user.DeletedAt)
 	} else {
-		err = r.db.QueryRowContext(ctx, query, id).Scan(&user.ID, &user.Role, &user.Email, &user.IsVerified, &user.IsOauth, &user.CreatedAt, &user.UpdatedAt, &user.DeletedAt)
+		err = r.db.QueryRowContext(ctx, query, id).Scan(&user.ID, &user.Role, &user.Email, &user.IsVerified, &user.IsOauth, &user.Locale, &user.CreatedAt, &user.UpdatedAt, &user.DeletedAt)
 	}
 
 	if err != nil {
@@ -5,12 +5,18 @@ import (
 	"strconv"
 	"strings"
 
+	constantAudit "healthcare-app/internal/audit/constant"
+	dtoAudit "healthcare-app/internal/audit/dto"
+	usecaseAudit "healthcare-app/internal/audit/usecase"
 	apperrorAuth "healthcare-app/internal/auth/apperror"
 	"healthcare-app/internal/auth/constant"
 	dtoAuth "healthcare-app/internal/auth/dto"
 	"healthcare-app/internal/auth/entity"
 	"healthcare-app/internal/auth/repository"
 	"healthcare-app/internal/auth/utils"
+	constantNotification "healthcare-app/internal/notification/constant"
+	usecaseNotification "healthcare-app/internal/notification/usecase"
+	utilsNotification "healthcare-app/internal/notification/utils"
 	"healthcare-app/internal/queue/payload"
 	"healthcare-app/internal/queue/tasks"
 	apperrorPkg "healthcare-app/pkg/apperror"
@@ -33,29 +39,35 @@ type AdminUseCase interface {
 }
 
 type adminUseCaseImpl struct {
-	smtpUtil          smtputils.SMTPUtils
-	passwordEncryptor encryptutils.PasswordEncryptor
-	emailTask         tasks.EmailTask
-	adminRepo         repository.AdminRepository
-	transactor        transactor.Transactor
-	userRepo          repository.UserRepository
+	smtpUtil            smtputils.SMTPUtils
+	passwordEncryptor   encryptutils.PasswordEncryptor
+	emailTask           tasks.EmailTask
+	notificationUseCase usecaseNotification.NotificationUseCase
+	auditLogUseCase     usecaseAudit.AuditLogUseCase
+	adminRepo           repository.AdminRepository
+	transactor          transactor.Transactor
+	userRepo            repository.UserRepository
 }
 
 func NewAdminUseCase(
 	smtpUtil smtputils.SMTPUtils,
 	passwordEncryptor encryptutils.PasswordEncryptor,
 	emailTask tasks.EmailTask,
+	notificationUseCase usecaseNotification.NotificationUseCase,
+	auditLogUseCase usecaseAudit.AuditLogUseCase,
 	adminRepo repository.AdminRepository,
 	transactor transactor.Transactor,
 	userRepo repository.UserRepository,
 ) *adminUseCaseImpl {
 	return &adminUseCaseImpl{
-		smtpUtil:          smtpUtil,
-		passwordEncryptor: passwordEncryptor,
-		emailTask:         emailTask,
-		adminRepo:         adminRepo,
-		transactor:        transactor,
-		userRepo:          userRepo,
+		smtpUtil:            smtpUtil,
+		passwordEncryptor:   passwordEncryptor,
+		emailTask:           emailTask,
+		notificationUseCase: notificationUseCase,
+		auditLogUseCase:     auditLogUseCase,
+		adminRepo:           adminRepo,
+		transactor:          transactor,
+		userRepo:            userRepo,
 	}
 }
 
@@ -176,7 +188,15 @@ func (u *adminUseCaseImpl) CreateAccountPharmacist(ctx context.Context, reqBody
 		entityUserDetail.CreatedAt = userDetail.CreatedAt
 		entityUserDetail.UpdatedAt = userDetail.UpdatedAt
 		entityUserDetail.DeletedAt = userDetail.DeletedAt
-		return nil
+		if err := u.auditLogUseCase.Record(cForTx, &dtoAudit.AuditEntry{
+			Action:     constantAudit.ACTION_CREATE,
+			EntityType: constantAudit.ENTITY_PHARMACIST,
+			EntityID:   user.ID,
+			After:      dtoAuth.ConvertToResponsePharmacistAccount(entityUserDetail),
+		}); err != nil {
+			return err
+		}
+		return u.notificationUseCase.Notify(cForTx, utilsNotification.NewInviteNotification(user.ID, constantNotification.INVITE_ROLE_PHARMACIST))
 	})
 	if err != nil {
 		return err
@@ -253,6 +273,7 @@ func (u *adminUseCaseImpl) UpdateAccount(ctx context.Context, pharmacist *dtoAut
 		if pharmacistDb == nil {
 			return apperrorPkg.NewEntityNotFoundError("pharmacist ID")
 		}
+		before := dtoAuth.ConvertToResponsePharmacistAccount(pharmacistDb)
 
 		if pharmacist.WhatsappNumber != nil {
 			pharmacistDb.WhatsappNumber = pharmacist.WhatsappNumber
@@ -273,6 +294,15 @@ func (u *adminUseCaseImpl) UpdateAccount(ctx context.Context, pharmacist *dtoAut
 		if err != nil {
 			return apperrorPkg.NewServerError(err)
 		}
+		if err := u.auditLogUseCase.Record(ctx, &dtoAudit.AuditEntry{
+			Action:     constantAudit.ACTION_UPDATE,
+			EntityType: constantAudit.ENTITY_PHARMACIST,
+			EntityID:   *pharmacistDb.UserId,
+			Before:     before,
+			After:      dtoAuth.ConvertToResponsePharmacistAccount(pharmacistDb),
+		}); err != nil {
+			return err
+		}
 
 		updatedAccount = pharmacistDb
 
@@ -283,13 +313,7 @@ func (u *adminUseCaseImpl) UpdateAccount(ctx context.Context, pharmacist *dtoAut
 		return nil, err
 	}
 
-	return &dtoAuth.ResponsePharmacistUpdateAccount{
-		PharmacistID:      *updatedAccount.UserId,
-		Fullname:          *updatedAccount.Fullname,
-		SipaNumber:        *updatedAccount.SipaNumber,
-		WhatsappNumber:    *updatedAccount.WhatsappNumber,
-		YearsOfExperience: *updatedAccount.YearsOfExperience,
-	}, nil
+	return dtoAuth.ConvertToResponsePharmacistAccount(updatedAccount), nil
 }
 
 func (u *adminUseCaseImpl) DeleteAccount(ctx context.Context, pharmacist *dtoAuth.RequestPharmacistDeleteAccount) error {
@@ -317,7 +341,12 @@ func (u *adminUseCaseImpl) DeleteAccount(ctx context.Context, pharmacist *dtoAut
 			return apperrorPkg.NewServerError(err)
 		}
 
-		return nil
+		return u.auditLogUseCase.Record(ctx, &dtoAudit.AuditEntry{
+			Action:     constantAudit.ACTION_DELETE,
+			EntityType: constantAudit.ENTITY_PHARMACIST,
+			EntityID:   *pharmacistDb.UserId,
+			Before:     dtoAuth.ConvertToResponsePharmacistAccount(pharmacistDb),
+		})
 	})
 
 	if err != nil {
@@ -29,3 +29,19 @@ func GetJTIFromToken(c context.Context) string {
 	}
 	return ""
 }
+
+func GetRequestIDFromContext(c context.Context) string {
+	var key constant.RequestID = "request_id"
+	if requestID, ok := c.Value(key).(string); ok {
+		return requestID
+	}
+	return ""
+}
+
+func GetClientIPFromContext(c context.Context) string {
+	var key constant.ClientIP = "client_ip"
+	if clientIP, ok := c.Value(key).(string); ok {
+		return clientIP
+	}
+	return ""
+}
@@ -8,6 +8,8 @@ func SpecifyRole(roleId int) string {
 		return "pharmacist"
 	case 3:
 		return "admin"
+	case 4:
+		return "doctor"
 	default:
 		return "unknown"
 	}
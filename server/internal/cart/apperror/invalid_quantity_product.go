@@ -2,15 +2,20 @@ package apperror
 
 import (
 	"errors"
+	"fmt"
 
 	"healthcare-app/internal/cart/constant"
+	"healthcare-app/internal/cart/dto"
 	"healthcare-app/pkg/apperror"
 )
 
-func NewInsufficientStockError() *apperror.AppError {
+func NewInsufficientStockError(productID int64) *apperror.AppError {
 	msg := constant.InvalidInsufficientStock
 	err := errors.New(msg)
-	return apperror.NewAppError(err, apperror.DefaultClientErrorCode, msg)
+	return apperror.NewAppErrorWithData(err, apperror.DefaultClientErrorCode, msg, &dto.InsufficientStockResponse{
+		ProductID:    productID,
+		Alternatives: fmt.Sprintf(constant.ALTERNATIVES_PATH, productID),
+	})
 }
 
 func NewInsufficientStockOnCartError() *apperror.AppError {
@@ -0,0 +1,9 @@
+package constant
+
+const (
+	MAX_CART_BODY_SIZE = 64 * 1024 // 64 kb
+)
+
+const (
+	ALTERNATIVES_PATH = "/products/%v/alternatives"
+)
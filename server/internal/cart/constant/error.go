@@ -2,7 +2,7 @@ package constant
 
 const (
 	InactiveProductErrorMessage       = "can't add to cart on inactive product"
-	InvalidInsufficientStock          = "invalid! insufficient stock"
+	InvalidInsufficientStock          = "invalid! insufficient stock, check the alternatives for substitutes"
 	InvalidInsufficientStockOnCart    = "invalid! insufficient stock on your cart"
 	InvalidPharmacyProductsId         = "invalid pharmacy product id"
 	InvalidCartAlreadyExists          = "cart already exists"
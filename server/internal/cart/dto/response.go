@@ -3,7 +3,9 @@ package dto
 import (
 	"time"
 
+	dtoInteraction "healthcare-app/internal/interaction/dto"
 	dtoPharmacy "healthcare-app/internal/pharmacy/dto"
+	dtoProduct "healthcare-app/internal/product/dto"
 
 	"github.com/shopspring/decimal"
 )
@@ -20,11 +22,20 @@ type CartCountResponse struct {
 	Count int64 `json:"count"`
 }
 
+// InsufficientStockResponse is the data of the insufficient stock error, it
+// points the client to substitutes for the product.
+type InsufficientStockResponse struct {
+	ProductID    int64  `json:"product_id"`
+	Alternatives string `json:"alternatives"`
+}
+
 type ResponsePharmacyWithProduct struct {
-	SoldAmount       int64                        `json:"sold_amount"`
-	PricePerPharmacy decimal.Decimal              `json:"total_price_per_pharmacy"`
-	Pharmacy         ResponsePharmacy             `json:"pharmacy_info"`
-	Product          []ResponseProductAndQuantity `json:"products_info"`
+	SoldAmount       int64                                        `json:"sold_amount"`
+	PricePerPharmacy decimal.Decimal                              `json:"total_price_per_pharmacy"`
+	Pharmacy         ResponsePharmacy                             `json:"pharmacy_info"`
+	Product          []ResponseProductAndQuantity                 `json:"products_info"`
+	BoughtTogether   []*dtoProduct.ProductResponse                `json:"customers_also_bought"`
+	Warnings         []*dtoInteraction.InteractionWarningResponse `json:"warnings"`
 }
 
 type ResponseProductAndQuantity struct {
@@ -27,12 +27,13 @@ type CartWithData struct {
 }
 
 type CartWithProduct struct {
-	ID        int64
-	UserId    int64
-	Product   productEntity.ProductForCart
-	Quantity  int64
-	CreatedAt time.Time
-	UpdatedAt time.Time
+	ID                 int64
+	UserId             int64
+	Product            productEntity.ProductForCart
+	Quantity           int64
+	PrescriptionItemID *int64
+	CreatedAt          time.Time
+	UpdatedAt          time.Time
 }
 
 type CartWithPharmacyProductId struct {
@@ -119,7 +119,7 @@ func (c *cartRepositoryImpl) GetMyCart(ctx context.Context, userId int64, query
 }
 
 func (c *cartRepositoryImpl) FindPharmacyProductById(ctx context.Context, pharmacyProductId int64) (*entityPharmacy.PharmacyProduct, error) {
-	query := "SELECT id, stock_quantity, is_active FROM pharmacy_products WHERE id = $1"
+	query := "SELECT id, product_id, stock_quantity, is_active FROM pharmacy_products WHERE id = $1"
 	tx := transactor.ExtractTx(ctx)
 	var row *sql.Row
 	if tx != nil {
@@ -128,7 +128,7 @@ func (c *cartRepositoryImpl) FindPharmacyProductById(ctx context.Context, pharma
 		row = c.db.QueryRowContext(ctx, query, pharmacyProductId)
 	}
 	var product entityPharmacy.PharmacyProduct
-	err := row.Scan(&product.ID, &product.StockQuantity, &product.IsActive)
+	err := row.Scan(&product.ID, &product.ProductId, &product.StockQuantity, &product.IsActive)
 	if err == sql.ErrNoRows {
 		return nil, err
 	}
@@ -227,7 +227,7 @@ func (c *cartRepositoryImpl) GetCartItem(ctx context.Context, userId, pharmacyPr
 func (c *cartRepositoryImpl) GetCartItemWithPharmacyId(ctx context.Context, userId, pharmacyProductId int64, pharmacyId int64) (*entityCart.CartWithProduct, error) {
 	query := `
 		SELECT 
-			uc.id, uc.user_id, uc.quantity, uc.created_at, uc.updated_at,
+			uc.id, uc.user_id, uc.quantity, uc.prescription_item_id, uc.created_at, uc.updated_at,
 			p2.id, p2.manufacture_id, p2.product_classification_id, p2.product_form_id, p2.name, p2.generic_name, p2.description, p2.unit_in_pack, p2.selling_unit, p2.sold_amount, p2.weight, p2.height, p2.length, p2.width, p2.image_url, p2.is_active, p2.created_at, p2.updated_at, p2.deleted_at
 		FROM user_cart_items uc
 		INNER JOIN pharmacy_products pp ON pp.id = uc.pharmacy_product_id
@@ -240,12 +240,12 @@ func (c *cartRepositoryImpl) GetCartItemWithPharmacyId(ctx context.Context, user
 	var err error
 	if tx != nil {
 		err = tx.QueryRowContext(ctx, query, userId, pharmacyProductId, pharmacyId).Scan(
-			&cartItem.ID, &cartItem.UserId, &cartItem.Quantity, &cartItem.CreatedAt, &cartItem.UpdatedAt,
+			&cartItem.ID, &cartItem.UserId, &cartItem.Quantity, &cartItem.PrescriptionItemID, &cartItem.CreatedAt, &cartItem.UpdatedAt,
 			&product.ID, &product.ManufactureID, &product.ProductClassificationID, &product.ProductFormID, &product.Name, &product.GenericName, &product.Description, &product.UnitInPack, &product.SellingUnit, &product.SoldAmount, &product.Weight, &product.Height, &product.Length, &product.Width, &product.ImageURL, &product.IsActive, &product.CreatedAt, &product.UpdatedAt, &product.DeletedAt,
 		)
 	} else {
 		err = c.db.QueryRowContext(ctx, query, userId, pharmacyProductId, pharmacyId).Scan(
-			&cartItem.ID, &cartItem.UserId, &cartItem.Quantity, &cartItem.CreatedAt, &cartItem.UpdatedAt,
+			&cartItem.ID, &cartItem.UserId, &cartItem.Quantity, &cartItem.PrescriptionItemID, &cartItem.CreatedAt, &cartItem.UpdatedAt,
 			&product.ID, &product.ManufactureID, &product.ProductClassificationID, &product.ProductFormID, &product.Name, &product.GenericName, &product.Description, &product.UnitInPack, &product.SellingUnit, &product.SoldAmount, &product.Weight, &product.Height, &product.Length, &product.Width, &product.ImageURL, &product.IsActive, &product.CreatedAt, &product.UpdatedAt, &product.DeletedAt,
 		)
 	}
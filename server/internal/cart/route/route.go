@@ -2,17 +2,18 @@ package route
 
 import (
 	"healthcare-app/internal/auth/constant"
+	constantCart "healthcare-app/internal/cart/constant"
 	"healthcare-app/internal/cart/controller"
 	"healthcare-app/pkg/middleware"
 
 	"github.com/gin-gonic/gin"
 )
 
-func CartControllerRoute(c *controller.CartController, r *gin.Engine, authMiddleware *middleware.AuthMiddleware) {
+func CartControllerRoute(c *controller.CartController, r *gin.Engine, authMiddleware *middleware.AuthMiddleware, idempotencyMiddleware *middleware.IdempotencyMiddleware) {
 	g := r.Group("/users/me", authMiddleware.Authorization(), authMiddleware.ProtectedRoles(constant.USER))
 	{
 		g.GET("/cart", c.GetMyCart)
-		g.POST("/cart", c.CreateCart)
+		g.POST("/cart", idempotencyMiddleware.Idempotent(constantCart.MAX_CART_BODY_SIZE), c.CreateCart)
 		g.DELETE("/cart/:PharmacyProductId", c.DeleteCart)
 		g.PUT("/cart", c.PutQuantityCart)
 		g.GET("/cart/count", c.CountCart)
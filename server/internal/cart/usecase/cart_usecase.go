@@ -7,7 +7,14 @@ import (
 	appErrorCart "healthcare-app/internal/cart/apperror"
 	cartDto "healthcare-app/internal/cart/dto"
 	cartRepo "healthcare-app/internal/cart/repository"
+	entityInteraction "healthcare-app/internal/interaction/entity"
+	interactionRepo "healthcare-app/internal/interaction/repository"
+	interactionUtils "healthcare-app/internal/interaction/utils"
 	dtoPharmacy "healthcare-app/internal/pharmacy/dto"
+	productConstant "healthcare-app/internal/product/constant"
+	dtoProduct "healthcare-app/internal/product/dto"
+	productRepo "healthcare-app/internal/product/repository"
+	profileRepo "healthcare-app/internal/profile/repository"
 	appErrorPkg "healthcare-app/pkg/apperror"
 	"healthcare-app/pkg/database/transactor"
 
@@ -23,20 +30,29 @@ type CartUseCase interface {
 }
 
 type cartUseCaseImpl struct {
-	cartRepo   cartRepo.CartRepository
-	userRepo   authRepo.UserRepository
-	transactor transactor.Transactor
+	cartRepo        cartRepo.CartRepository
+	userRepo        authRepo.UserRepository
+	productRepo     productRepo.ProductRepository
+	interactionRepo interactionRepo.InteractionRepository
+	medicalRepo     profileRepo.MedicalProfileRepository
+	transactor      transactor.Transactor
 }
 
 func NewCartUseCase(
 	cartRepo cartRepo.CartRepository,
 	userRepo authRepo.UserRepository,
+	productRepo productRepo.ProductRepository,
+	interactionRepo interactionRepo.InteractionRepository,
+	medicalRepo profileRepo.MedicalProfileRepository,
 	transactor transactor.Transactor,
 ) *cartUseCaseImpl {
 	return &cartUseCaseImpl{
-		cartRepo:   cartRepo,
-		userRepo:   userRepo,
-		transactor: transactor,
+		cartRepo:        cartRepo,
+		userRepo:        userRepo,
+		productRepo:     productRepo,
+		interactionRepo: interactionRepo,
+		medicalRepo:     medicalRepo,
+		transactor:      transactor,
 	}
 }
 
@@ -65,6 +81,8 @@ func (c *cartUseCaseImpl) GetMyCart(ctx context.Context, userId int64, query str
 		}
 		pharmacyMap := make(map[int64]*cartDto.ResponsePharmacyWithProduct)
 		pharmacyKeys := make([]int64, 0)
+		pharmacyProductIDs := make(map[int64][]int64)
+		pharmacyInteractionProducts := make(map[int64][]*entityInteraction.InteractionProduct)
 		for _, cart := range cartDb {
 			pharmacyID := cart.PharmacyProduct.Pharmacy.ID
 			if pharmacyMap[pharmacyID] == nil {
@@ -101,6 +119,12 @@ func (c *cartUseCaseImpl) GetMyCart(ctx context.Context, userId int64, query str
 				}
 				pharmacyKeys = append(pharmacyKeys, pharmacyID)
 			}
+			pharmacyProductIDs[pharmacyID] = append(pharmacyProductIDs[pharmacyID], cart.PharmacyProduct.Product.ID)
+			pharmacyInteractionProducts[pharmacyID] = append(pharmacyInteractionProducts[pharmacyID], &entityInteraction.InteractionProduct{
+				ID:          cart.PharmacyProduct.Product.ID,
+				Name:        cart.PharmacyProduct.Product.Name,
+				GenericName: cart.PharmacyProduct.Product.GenericName,
+			})
 			productPrice := cart.PharmacyProduct.Price.Mul(decimal.NewFromInt(int64(cart.Quantity)))
 			pharmacyMap[pharmacyID].PricePerPharmacy = pharmacyMap[pharmacyID].PricePerPharmacy.Add(productPrice)
 			totalCartPrice = totalCartPrice.Add(productPrice)
@@ -132,9 +156,25 @@ func (c *cartUseCaseImpl) GetMyCart(ctx context.Context, userId int64, query str
 				},
 			})
 		}
+		allergies, err := c.medicalRepo.FindAllAllergiesByUserID(cForTx, userId)
+		if err != nil {
+			return appErrorPkg.NewServerError(err)
+		}
 		totalCount = int64(len(pharmacyKeys))
 		for _, pharmacyID := range pharmacyKeys {
 			pharmacyWithProducts := pharmacyMap[pharmacyID]
+			boughtTogether, err := c.productRepo.FindAllBoughtTogether(cForTx, pharmacyProductIDs[pharmacyID], pharmacyID, productConstant.BOUGHT_TOGETHER_LIMIT)
+			if err != nil {
+				return appErrorPkg.NewServerError(err)
+			}
+			pharmacyWithProducts.BoughtTogether = dtoProduct.ConvertToProductResponses(boughtTogether)
+			interactionProducts := pharmacyInteractionProducts[pharmacyID]
+			interactions, err := c.interactionRepo.FindAllByIngredients(cForTx, interactionUtils.CollectIngredients(interactionProducts))
+			if err != nil {
+				return appErrorPkg.NewServerError(err)
+			}
+			pharmacyWithProducts.Warnings = append(interactionUtils.BuildWarnings(interactionProducts, interactions), interactionUtils.BuildAllergyWarnings(interactionProducts, allergies)...)
+			interactionUtils.SortWarnings(pharmacyWithProducts.Warnings)
 			responseCarts = append(responseCarts, cartDto.ResponseCart{
 				UserId:             userId,
 				TotalPrice:         totalCartPrice,
@@ -181,7 +221,7 @@ func (c *cartUseCaseImpl) CreateCart(ctx context.Context, reqBody *cartDto.Reque
 				return appErrorPkg.NewServerError(err)
 			}
 			if pharmacyProduct.StockQuantity < reqBody.Quantity+cartExists.Quantity {
-				return appErrorCart.NewInsufficientStockError()
+				return appErrorCart.NewInsufficientStockError(pharmacyProduct.ProductId)
 			}
 			err = c.cartRepo.UpdateCartQuantity(ctxForTx, userId, reqBody.PharmacyProductId, (reqBody.Quantity + cartExists.Quantity))
 			if err != nil {
@@ -190,7 +230,7 @@ func (c *cartUseCaseImpl) CreateCart(ctx context.Context, reqBody *cartDto.Reque
 			return nil
 		}
 		if pharmacyProduct.StockQuantity < reqBody.Quantity {
-			return appErrorCart.NewInsufficientStockError()
+			return appErrorCart.NewInsufficientStockError(pharmacyProduct.ProductId)
 		}
 		err = c.cartRepo.CreateCart(ctxForTx, reqBody, userId)
 		if err != nil {
@@ -231,7 +271,7 @@ func (c *cartUseCaseImpl) PutQuantityCart(ctx context.Context, reqBody *cartDto.
 			return appErrorCart.NewCartItemNotFoundError()
 		}
 		if pharmacyProduct.StockQuantity < reqBody.Quantity {
-			return appErrorCart.NewInsufficientStockError()
+			return appErrorCart.NewInsufficientStockError(pharmacyProduct.ProductId)
 		}
 		err = c.cartRepo.UpdateCartQuantity(ctxForTx, userId, reqBody.PharmacyProductId, reqBody.Quantity)
 		if err != nil {
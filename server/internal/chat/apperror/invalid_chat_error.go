@@ -0,0 +1,32 @@
+package apperror
+
+import (
+	"errors"
+
+	"healthcare-app/internal/chat/constant"
+	"healthcare-app/pkg/apperror"
+)
+
+func NewInvalidChatTargetError() *apperror.AppError {
+	msg := constant.InvalidChatTarget
+	err := errors.New(msg)
+	return apperror.NewAppError(err, apperror.DefaultClientErrorCode, msg)
+}
+
+func NewInvalidChatPharmacistError() *apperror.AppError {
+	msg := constant.InvalidChatPharmacist
+	err := errors.New(msg)
+	return apperror.NewAppError(err, apperror.DefaultClientErrorCode, msg)
+}
+
+func NewInvalidChatMessageError() *apperror.AppError {
+	msg := constant.InvalidChatMessage
+	err := errors.New(msg)
+	return apperror.NewAppError(err, apperror.DefaultClientErrorCode, msg)
+}
+
+func NewInvalidChatEventError() *apperror.AppError {
+	msg := constant.InvalidChatEvent
+	err := errors.New(msg)
+	return apperror.NewAppError(err, apperror.DefaultClientErrorCode, msg)
+}
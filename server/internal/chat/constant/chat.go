@@ -0,0 +1,16 @@
+package constant
+
+import "time"
+
+const (
+	EVENT_MESSAGE = "message"
+	EVENT_READ    = "read"
+	EVENT_ERROR   = "error"
+)
+
+const (
+	SOCKET_WRITE_WAIT     = 10 * time.Second
+	SOCKET_PONG_WAIT      = 60 * time.Second
+	SOCKET_PING_PERIOD    = (SOCKET_PONG_WAIT * 9) / 10
+	SOCKET_MAX_FRAME_SIZE = 8 * 1024 // 8 kb
+)
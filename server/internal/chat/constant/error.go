@@ -0,0 +1,8 @@
+package constant
+
+const (
+	InvalidChatTarget     = "chat needs either an order or a pharmacy"
+	InvalidChatPharmacist = "pharmacy has no pharmacist to chat with"
+	InvalidChatMessage    = "message is required"
+	InvalidChatEvent      = "chat event is not supported"
+)
@@ -0,0 +1,170 @@
+package controller
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+
+	"healthcare-app/internal/auth/utils"
+	apperrorChat "healthcare-app/internal/chat/apperror"
+	"healthcare-app/internal/chat/constant"
+	dtoChat "healthcare-app/internal/chat/dto"
+	"healthcare-app/internal/chat/usecase"
+	"healthcare-app/pkg/apperror"
+	"healthcare-app/pkg/config"
+	"healthcare-app/pkg/utils/ginutils"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gorilla/websocket"
+)
+
+type ChatController struct {
+	chatUseCase usecase.ChatUseCase
+	upgrader    websocket.Upgrader
+}
+
+func NewChatController(appConfig *config.AppConfig, chatUseCase usecase.ChatUseCase) *ChatController {
+	return &ChatController{
+		chatUseCase: chatUseCase,
+		upgrader: websocket.Upgrader{
+			ReadBufferSize:  1024,
+			WriteBufferSize: 1024,
+			// the access token can come in the query string, so only the
+			// frontend may open the socket from a browser
+			CheckOrigin: func(r *http.Request) bool {
+				origin := r.Header.Get("Origin")
+				return origin == "" || strings.EqualFold(strings.TrimSuffix(origin, "/"), strings.TrimSuffix(appConfig.FrontendURL, "/"))
+			},
+		},
+	}
+}
+
+func (cc *ChatController) GetAllMyRooms(ctx *gin.Context) {
+	req := &dtoChat.GetChatRoomRequest{UserID: utils.GetValueUserIdFromToken(ctx), Role: utils.GetValueRoleUserFromToken(ctx)}
+	res, err := cc.chatUseCase.GetAllMyRooms(ctx, req)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+	ginutils.ResponseOK(ctx, res)
+}
+
+func (cc *ChatController) GetMyRoom(ctx *gin.Context) {
+	roomID, err := strconv.Atoi(ctx.Param("chatId"))
+	if err != nil {
+		ctx.Error(apperror.NewInvalidIdError())
+		return
+	}
+	res, err := cc.chatUseCase.GetMyRoom(ctx, int64(roomID), utils.GetValueUserIdFromToken(ctx))
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+	ginutils.ResponseOK(ctx, res)
+}
+
+func (cc *ChatController) PostNewRoom(ctx *gin.Context) {
+	req := &dtoChat.RequestChatRoom{UserID: utils.GetValueUserIdFromToken(ctx)}
+	if err := ctx.ShouldBindJSON(req); err != nil {
+		ctx.Error(err)
+		return
+	}
+	res, err := cc.chatUseCase.PostNewRoom(ctx, req)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+	ginutils.ResponseCreated(ctx, res)
+}
+
+func (cc *ChatController) GetAllMessages(ctx *gin.Context) {
+	roomID, err := strconv.Atoi(ctx.Param("chatId"))
+	if err != nil {
+		ctx.Error(apperror.NewInvalidIdError())
+		return
+	}
+	req := &dtoChat.GetChatMessageRequest{RoomID: int64(roomID), ParticipantID: utils.GetValueUserIdFromToken(ctx)}
+	if err := ctx.ShouldBindQuery(req); err != nil {
+		ctx.Error(err)
+		return
+	}
+	res, paging, err := cc.chatUseCase.GetAllMessages(ctx, req)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+	ginutils.ResponseOKSeekPagination(ctx, res, paging)
+}
+
+func (cc *ChatController) PostNewMessage(ctx *gin.Context) {
+	roomID, err := strconv.Atoi(ctx.Param("chatId"))
+	if err != nil {
+		ctx.Error(apperror.NewInvalidIdError())
+		return
+	}
+	req := &dtoChat.RequestChatMessage{RoomID: int64(roomID), SenderID: utils.GetValueUserIdFromToken(ctx)}
+	if err := ctx.ShouldBindJSON(req); err != nil {
+		ctx.Error(err)
+		return
+	}
+	res, err := cc.chatUseCase.PostNewMessage(ctx, req)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+	ginutils.ResponseCreated(ctx, res)
+}
+
+func (cc *ChatController) ReadMessages(ctx *gin.Context) {
+	roomID, err := strconv.Atoi(ctx.Param("chatId"))
+	if err != nil {
+		ctx.Error(apperror.NewInvalidIdError())
+		return
+	}
+	if err := cc.chatUseCase.ReadMessages(ctx, int64(roomID), utils.GetValueUserIdFromToken(ctx)); err != nil {
+		ctx.Error(err)
+		return
+	}
+	ginutils.ResponseOKPlain(ctx)
+}
+
+// Connect upgrades the request to a websocket once the participant is known
+// to belong to the room. Errors after the upgrade go back over the socket as
+// error events since the response is no longer plain http.
+func (cc *ChatController) Connect(ctx *gin.Context) {
+	roomID, err := strconv.Atoi(ctx.Param("chatId"))
+	if err != nil {
+		ctx.Error(apperror.NewInvalidIdError())
+		return
+	}
+	participantID := utils.GetValueUserIdFromToken(ctx)
+	subscriber, err := cc.chatUseCase.SubscribeRoom(ctx, int64(roomID), participantID)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+	defer cc.chatUseCase.UnsubscribeRoom(subscriber)
+
+	conn, err := cc.upgrader.Upgrade(ctx.Writer, ctx.Request, nil)
+	if err != nil {
+		return
+	}
+
+	socket := newChatSocket(conn, subscriber)
+	go socket.writePump()
+	socket.readPump(func(event *dtoChat.RequestChatEvent) error {
+		switch event.Type {
+		case constant.EVENT_MESSAGE:
+			_, err := cc.chatUseCase.PostNewMessage(ctx, &dtoChat.RequestChatMessage{
+				Message:  event.Message,
+				RoomID:   int64(roomID),
+				SenderID: participantID,
+			})
+			return err
+		case constant.EVENT_READ:
+			return cc.chatUseCase.ReadMessages(ctx, int64(roomID), participantID)
+		default:
+			return apperrorChat.NewInvalidChatEventError()
+		}
+	})
+}
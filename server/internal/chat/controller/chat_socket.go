@@ -0,0 +1,117 @@
+package controller
+
+import (
+	"encoding/json"
+	"errors"
+	"time"
+
+	apperrorChat "healthcare-app/internal/chat/apperror"
+	"healthcare-app/internal/chat/constant"
+	dtoChat "healthcare-app/internal/chat/dto"
+	"healthcare-app/pkg/apperror"
+	constantPkg "healthcare-app/pkg/constant"
+	"healthcare-app/pkg/utils/pubsubutils"
+
+	"github.com/gorilla/websocket"
+)
+
+// chatSocket pairs a websocket with a room subscription. Only writePump
+// writes to the connection, readPump hands its errors over through errs.
+type chatSocket struct {
+	conn       *websocket.Conn
+	subscriber *pubsubutils.Subscriber
+	errs       chan *dtoChat.ChatEvent
+	done       chan struct{}
+}
+
+func newChatSocket(conn *websocket.Conn, subscriber *pubsubutils.Subscriber) *chatSocket {
+	return &chatSocket{
+		conn:       conn,
+		subscriber: subscriber,
+		errs:       make(chan *dtoChat.ChatEvent, 1),
+		done:       make(chan struct{}),
+	}
+}
+
+func (s *chatSocket) readPump(handle func(event *dtoChat.RequestChatEvent) error) {
+	defer close(s.done)
+
+	s.conn.SetReadLimit(constant.SOCKET_MAX_FRAME_SIZE)
+	s.conn.SetReadDeadline(time.Now().Add(constant.SOCKET_PONG_WAIT))
+	s.conn.SetPongHandler(func(string) error {
+		return s.conn.SetReadDeadline(time.Now().Add(constant.SOCKET_PONG_WAIT))
+	})
+
+	for {
+		event := new(dtoChat.RequestChatEvent)
+		if err := s.conn.ReadJSON(event); err != nil {
+			var (
+				syntaxErr    *json.SyntaxError
+				unmarshalErr *json.UnmarshalTypeError
+			)
+			if errors.As(err, &syntaxErr) || errors.As(err, &unmarshalErr) {
+				s.sendError(apperrorChat.NewInvalidChatEventError())
+				continue
+			}
+			return
+		}
+		if err := handle(event); err != nil {
+			s.sendError(err)
+		}
+	}
+}
+
+func (s *chatSocket) writePump() {
+	ticker := time.NewTicker(constant.SOCKET_PING_PERIOD)
+	defer func() {
+		ticker.Stop()
+		s.conn.Close()
+	}()
+
+	for {
+		select {
+		case <-s.done:
+			s.write(websocket.CloseMessage, []byte{})
+			return
+		case data, ok := <-s.subscriber.Events:
+			if !ok {
+				return
+			}
+			if err := s.write(websocket.TextMessage, data); err != nil {
+				return
+			}
+		case event := <-s.errs:
+			data, err := json.Marshal(event)
+			if err != nil {
+				return
+			}
+			if err := s.write(websocket.TextMessage, data); err != nil {
+				return
+			}
+		case <-ticker.C:
+			if err := s.write(websocket.PingMessage, nil); err != nil {
+				return
+			}
+		}
+	}
+}
+
+func (s *chatSocket) write(messageType int, data []byte) error {
+	s.conn.SetWriteDeadline(time.Now().Add(constant.SOCKET_WRITE_WAIT))
+	return s.conn.WriteMessage(messageType, data)
+}
+
+// sendError drops the error when one is already waiting, a client flooding
+// bad events should not hold up the read loop.
+func (s *chatSocket) sendError(err error) {
+	msg := constantPkg.InternalServerErrorMessage
+	var appErr *apperror.AppError
+	if errors.As(err, &appErr) && appErr.GetCode() != apperror.DefaultServerErrorCode {
+		msg = appErr.DisplayMessage()
+	}
+
+	select {
+	case s.errs <- &dtoChat.ChatEvent{Type: constant.EVENT_ERROR, Error: msg}:
+	default:
+	}
+}
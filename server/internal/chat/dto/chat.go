@@ -0,0 +1,114 @@
+package dto
+
+import (
+	"time"
+
+	"healthcare-app/internal/chat/entity"
+)
+
+type ChatRoomResponse struct {
+	ID            int64      `json:"id"`
+	UserID        int64      `json:"user_id"`
+	UserName      string     `json:"user_name"`
+	PharmacyID    int64      `json:"pharmacy_id"`
+	PharmacyName  string     `json:"pharmacy_name"`
+	PharmacistID  *int64     `json:"pharmacist_id"`
+	OrderID       *int64     `json:"order_id"`
+	UnreadCount   int64      `json:"unread_count"`
+	LastMessageAt *time.Time `json:"last_message_at"`
+	CreatedAt     time.Time  `json:"created_at"`
+	UpdatedAt     time.Time  `json:"updated_at"`
+}
+
+type ChatMessageResponse struct {
+	ID        int64      `json:"id"`
+	RoomID    int64      `json:"room_id"`
+	SenderID  int64      `json:"sender_id"`
+	Message   string     `json:"message"`
+	ReadAt    *time.Time `json:"read_at"`
+	CreatedAt time.Time  `json:"created_at"`
+}
+
+// ChatEvent is what goes over the socket in both directions. Clients send
+// message and read events, the server sends those back to every participant
+// of the room along with error events meant only for the sender.
+type ChatEvent struct {
+	Type     string               `json:"type"`
+	RoomID   int64                `json:"room_id,omitempty"`
+	Message  *ChatMessageResponse `json:"message,omitempty"`
+	ReaderID int64                `json:"reader_id,omitempty"`
+	ReadAt   *time.Time           `json:"read_at,omitempty"`
+	Error    string               `json:"error,omitempty"`
+}
+
+type RequestChatEvent struct {
+	Type    string `json:"type"`
+	Message string `json:"message"`
+}
+
+type GetChatRoomRequest struct {
+	UserID int64 `form:"-"`
+	Role   int   `form:"-"`
+}
+
+type GetChatMessageRequest struct {
+	Last          string `form:"last" binding:"omitempty,numeric,gte=0"`
+	Limit         int64  `form:"limit" binding:"numeric,gte=1,lte=50"`
+	RoomID        int64  `form:"-"`
+	ParticipantID int64  `form:"-"`
+}
+
+type RequestChatRoom struct {
+	OrderID    *int64 `json:"order_id" binding:"omitempty,gte=1"`
+	PharmacyID *int64 `json:"pharmacy_id" binding:"omitempty,gte=1"`
+	UserID     int64  `json:"-"`
+}
+
+type RequestChatMessage struct {
+	Message  string `json:"message" binding:"required,max=2000"`
+	RoomID   int64  `json:"-"`
+	SenderID int64  `json:"-"`
+}
+
+func ConvertToChatRoomResponses(rooms []*entity.ChatRoom) []*ChatRoomResponse {
+	responses := []*ChatRoomResponse{}
+	for _, room := range rooms {
+		responses = append(responses, ConvertToChatRoomResponse(room))
+	}
+	return responses
+}
+
+func ConvertToChatRoomResponse(room *entity.ChatRoom) *ChatRoomResponse {
+	return &ChatRoomResponse{
+		ID:            room.ID,
+		UserID:        room.UserID,
+		UserName:      room.UserName,
+		PharmacyID:    room.PharmacyID,
+		PharmacyName:  room.PharmacyName,
+		PharmacistID:  room.PharmacistID,
+		OrderID:       room.OrderID,
+		UnreadCount:   room.UnreadCount,
+		LastMessageAt: room.LastMessageAt,
+		CreatedAt:     room.CreatedAt,
+		UpdatedAt:     room.UpdatedAt,
+	}
+}
+
+func ConvertToChatMessageResponses(messages []*entity.ChatMessage) []*ChatMessageResponse {
+	responses := []*ChatMessageResponse{}
+	for _, message := range messages {
+		responses = append(responses, ConvertToChatMessageResponse(message))
+	}
+	return responses
+}
+
+func ConvertToChatMessageResponse(message *entity.ChatMessage) *ChatMessageResponse {
+	return &ChatMessageResponse{
+		ID:        message.ID,
+		RoomID:    message.RoomID,
+		SenderID:  message.SenderID,
+		Message:   message.Message,
+		ReadAt:    message.ReadAt,
+		CreatedAt: message.CreatedAt,
+	}
+}
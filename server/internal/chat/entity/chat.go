@@ -0,0 +1,26 @@
+package entity
+
+import "time"
+
+type ChatRoom struct {
+	ID            int64
+	UserID        int64
+	UserName      string
+	PharmacyID    int64
+	PharmacyName  string
+	PharmacistID  *int64
+	OrderID       *int64
+	UnreadCount   int64
+	LastMessageAt *time.Time
+	CreatedAt     time.Time
+	UpdatedAt     time.Time
+}
+
+type ChatMessage struct {
+	ID        int64
+	RoomID    int64
+	SenderID  int64
+	Message   string
+	ReadAt    *time.Time
+	CreatedAt time.Time
+}
@@ -0,0 +1,126 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"strconv"
+	"time"
+
+	"healthcare-app/internal/chat/dto"
+	"healthcare-app/internal/chat/entity"
+	"healthcare-app/pkg/database/transactor"
+)
+
+type ChatMessageRepository interface {
+	FindAllByRoomID(ctx context.Context, request *dto.GetChatMessageRequest) ([]*entity.ChatMessage, error)
+	Save(ctx context.Context, message *entity.ChatMessage) error
+	MarkAsRead(ctx context.Context, roomID int64, readerID int64) (*time.Time, error)
+}
+
+type chatMessageRepositoryImpl struct {
+	db *sql.DB
+}
+
+func NewChatMessageRepository(db *sql.DB) *chatMessageRepositoryImpl {
+	return &chatMessageRepositoryImpl{
+		db: db,
+	}
+}
+
+// FindAllByRoomID pages backwards from the newest message, last is the
+// smallest id the client already has. One extra row is fetched to tell
+// whether there is an older page.
+func (r *chatMessageRepositoryImpl) FindAllByRoomID(ctx context.Context, request *dto.GetChatMessageRequest) ([]*entity.ChatMessage, error) {
+	query := `
+		select id, room_id, sender_id, message, read_at, created_at
+		from chat_messages
+		where room_id = $1 and ($2 = 0 or id < $2)
+		order by id desc
+		limit $3
+	`
+	tx := transactor.ExtractTx(ctx)
+
+	lastID, _ := strconv.Atoi(request.Last)
+	var (
+		err  error
+		rows *sql.Rows
+	)
+	if tx != nil {
+		rows, err = tx.QueryContext(ctx, query, request.RoomID, lastID, request.Limit+1)
+	} else {
+		rows, err = r.db.QueryContext(ctx, query, request.RoomID, lastID, request.Limit+1)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	messages := []*entity.ChatMessage{}
+	for rows.Next() {
+		message := new(entity.ChatMessage)
+		if err := rows.Scan(
+			&message.ID,
+			&message.RoomID,
+			&message.SenderID,
+			&message.Message,
+			&message.ReadAt,
+			&message.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		messages = append(messages, message)
+	}
+
+	err = rows.Err()
+	if err != nil {
+		return nil, err
+	}
+	return messages, nil
+}
+
+func (r *chatMessageRepositoryImpl) Save(ctx context.Context, message *entity.ChatMessage) error {
+	query := `
+		insert into chat_messages(room_id, sender_id, message)
+		values ($1, $2, $3) returning id, created_at
+	`
+	tx := transactor.ExtractTx(ctx)
+
+	var err error
+	if tx != nil {
+		err = tx.QueryRowContext(ctx, query, message.RoomID, message.SenderID, message.Message).Scan(&message.ID, &message.CreatedAt)
+	} else {
+		err = r.db.QueryRowContext(ctx, query, message.RoomID, message.SenderID, message.Message).Scan(&message.ID, &message.CreatedAt)
+	}
+
+	return err
+}
+
+// MarkAsRead marks everything the other participant sent as read and returns
+// the read time, or nil when there was nothing unread.
+func (r *chatMessageRepositoryImpl) MarkAsRead(ctx context.Context, roomID int64, readerID int64) (*time.Time, error) {
+	query := `
+		with updated as (
+			update chat_messages set read_at = now()
+			where room_id = $1 and sender_id <> $2 and read_at is null
+			returning read_at
+		)
+		select max(read_at) from updated
+	`
+	tx := transactor.ExtractTx(ctx)
+
+	var (
+		err    error
+		readAt *time.Time
+	)
+	if tx != nil {
+		err = tx.QueryRowContext(ctx, query, roomID, readerID).Scan(&readAt)
+	} else {
+		err = r.db.QueryRowContext(ctx, query, roomID, readerID).Scan(&readAt)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+	return readAt, nil
+}
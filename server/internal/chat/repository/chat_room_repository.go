@@ -0,0 +1,220 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"healthcare-app/internal/chat/entity"
+	apperrorPkg "healthcare-app/pkg/apperror"
+	"healthcare-app/pkg/database/transactor"
+)
+
+type ChatRoomRepository interface {
+	FindAllByUserID(ctx context.Context, userID int64) ([]*entity.ChatRoom, error)
+	FindAllByPharmacistID(ctx context.Context, pharmacistID int64) ([]*entity.ChatRoom, error)
+	FindByIDAndParticipantID(ctx context.Context, id int64, participantID int64) (*entity.ChatRoom, error)
+	FindByTarget(ctx context.Context, room *entity.ChatRoom) (*entity.ChatRoom, error)
+	FindOrderPharmacyID(ctx context.Context, orderID int64, userID int64) (int64, error)
+	Save(ctx context.Context, room *entity.ChatRoom) error
+	UpdateLastMessageAt(ctx context.Context, id int64) error
+}
+
+type chatRoomRepositoryImpl struct {
+	db *sql.DB
+}
+
+func NewChatRoomRepository(db *sql.DB) *chatRoomRepositoryImpl {
+	return &chatRoomRepositoryImpl{
+		db: db,
+	}
+}
+
+// chatRoomSelectQuery takes the viewer as $1, the unread count only covers
+// messages the other participant sent.
+const chatRoomSelectQuery = `
+	select cr.id, cr.user_id, coalesce(ud.full_name, u.email), cr.pharmacy_id, p.name, p.pharmacist_id, cr.order_id,
+		(select count(*) from chat_messages cm where cm.room_id = cr.id and cm.sender_id <> $1 and cm.read_at is null),
+		cr.last_message_at, cr.created_at, cr.updated_at
+	from chat_rooms cr
+	join users u on u.id = cr.user_id
+	left join user_details ud on ud.user_id = cr.user_id
+	join pharmacies p on p.id = cr.pharmacy_id
+`
+
+func (r *chatRoomRepositoryImpl) FindAllByUserID(ctx context.Context, userID int64) ([]*entity.ChatRoom, error) {
+	query := chatRoomSelectQuery + " where cr.user_id = $1 order by coalesce(cr.last_message_at, cr.created_at) desc"
+	return r.findAll(ctx, query, userID)
+}
+
+func (r *chatRoomRepositoryImpl) FindAllByPharmacistID(ctx context.Context, pharmacistID int64) ([]*entity.ChatRoom, error) {
+	query := chatRoomSelectQuery + " where p.pharmacist_id = $1 order by coalesce(cr.last_message_at, cr.created_at) desc"
+	return r.findAll(ctx, query, pharmacistID)
+}
+
+func (r *chatRoomRepositoryImpl) FindByIDAndParticipantID(ctx context.Context, id int64, participantID int64) (*entity.ChatRoom, error) {
+	query := chatRoomSelectQuery + " where cr.id = $2 and (cr.user_id = $1 or p.pharmacist_id = $1)"
+	return r.findOne(ctx, query, participantID, id)
+}
+
+func (r *chatRoomRepositoryImpl) FindByTarget(ctx context.Context, room *entity.ChatRoom) (*entity.ChatRoom, error) {
+	query := chatRoomSelectQuery + " where cr.user_id = $1 and cr.pharmacy_id = $2 and cr.order_id is not distinct from $3"
+	return r.findOne(ctx, query, room.UserID, room.PharmacyID, room.OrderID)
+}
+
+func (r *chatRoomRepositoryImpl) FindOrderPharmacyID(ctx context.Context, orderID int64, userID int64) (int64, error) {
+	query := `
+		select pp.pharmacy_id
+		from orders o
+		join order_products op on op.order_id = o.id
+		join pharmacy_products pp on pp.id = op.pharmacy_product_id
+		where o.id = $1 and o.user_id = $2
+		limit 1
+	`
+	tx := transactor.ExtractTx(ctx)
+
+	var (
+		err        error
+		pharmacyID int64
+	)
+	if tx != nil {
+		err = tx.QueryRowContext(ctx, query, orderID, userID).Scan(&pharmacyID)
+	} else {
+		err = r.db.QueryRowContext(ctx, query, orderID, userID).Scan(&pharmacyID)
+	}
+
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return 0, apperrorPkg.NewEntityNotFoundError("order")
+		}
+		return 0, err
+	}
+	return pharmacyID, nil
+}
+
+// Save does nothing when the user already has a room for the same order or
+// pharmacy, callers look the room up afterwards.
+func (r *chatRoomRepositoryImpl) Save(ctx context.Context, room *entity.ChatRoom) error {
+	query := `
+		insert into chat_rooms(user_id, pharmacy_id, order_id)
+		values ($1, $2, $3) on conflict do nothing
+	`
+	tx := transactor.ExtractTx(ctx)
+
+	var err error
+	if tx != nil {
+		_, err = tx.ExecContext(ctx, query, room.UserID, room.PharmacyID, room.OrderID)
+	} else {
+		_, err = r.db.ExecContext(ctx, query, room.UserID, room.PharmacyID, room.OrderID)
+	}
+
+	return err
+}
+
+func (r *chatRoomRepositoryImpl) UpdateLastMessageAt(ctx context.Context, id int64) error {
+	query := `
+		update chat_rooms set last_message_at = now(), updated_at = now() where id = $1
+	`
+	tx := transactor.ExtractTx(ctx)
+
+	var err error
+	if tx != nil {
+		_, err = tx.ExecContext(ctx, query, id)
+	} else {
+		_, err = r.db.ExecContext(ctx, query, id)
+	}
+
+	return err
+}
+
+func (r *chatRoomRepositoryImpl) findOne(ctx context.Context, query string, args ...any) (*entity.ChatRoom, error) {
+	tx := transactor.ExtractTx(ctx)
+
+	var (
+		err  error
+		room entity.ChatRoom
+	)
+	if tx != nil {
+		err = tx.QueryRowContext(ctx, query, args...).Scan(
+			&room.ID,
+			&room.UserID,
+			&room.UserName,
+			&room.PharmacyID,
+			&room.PharmacyName,
+			&room.PharmacistID,
+			&room.OrderID,
+			&room.UnreadCount,
+			&room.LastMessageAt,
+			&room.CreatedAt,
+			&room.UpdatedAt,
+		)
+	} else {
+		err = r.db.QueryRowContext(ctx, query, args...).Scan(
+			&room.ID,
+			&room.UserID,
+			&room.UserName,
+			&room.PharmacyID,
+			&room.PharmacyName,
+			&room.PharmacistID,
+			&room.OrderID,
+			&room.UnreadCount,
+			&room.LastMessageAt,
+			&room.CreatedAt,
+			&room.UpdatedAt,
+		)
+	}
+
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, apperrorPkg.NewEntityNotFoundError("chat")
+		}
+		return nil, err
+	}
+	return &room, nil
+}
+
+func (r *chatRoomRepositoryImpl) findAll(ctx context.Context, query string, args ...any) ([]*entity.ChatRoom, error) {
+	tx := transactor.ExtractTx(ctx)
+
+	var (
+		err  error
+		rows *sql.Rows
+	)
+	if tx != nil {
+		rows, err = tx.QueryContext(ctx, query, args...)
+	} else {
+		rows, err = r.db.QueryContext(ctx, query, args...)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	rooms := []*entity.ChatRoom{}
+	for rows.Next() {
+		room := new(entity.ChatRoom)
+		if err := rows.Scan(
+			&room.ID,
+			&room.UserID,
+			&room.UserName,
+			&room.PharmacyID,
+			&room.PharmacyName,
+			&room.PharmacistID,
+			&room.OrderID,
+			&room.UnreadCount,
+			&room.LastMessageAt,
+			&room.CreatedAt,
+			&room.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		rooms = append(rooms, room)
+	}
+
+	err = rows.Err()
+	if err != nil {
+		return nil, err
+	}
+	return rooms, nil
+}
@@ -0,0 +1,28 @@
+package route
+
+import (
+	"healthcare-app/internal/auth/constant"
+	"healthcare-app/internal/chat/controller"
+	"healthcare-app/pkg/middleware"
+
+	"github.com/gin-gonic/gin"
+)
+
+const chatId = "/:chatId"
+
+// ConnectPath is the websocket route, it is exempt from the request timeout.
+const ConnectPath = "/chats" + chatId + "/ws"
+
+func ChatControllerRoute(c *controller.ChatController, r *gin.Engine, authMiddleware *middleware.AuthMiddleware) {
+	g := r.Group("/chats", authMiddleware.Authorization(), authMiddleware.ProtectedRoles(constant.USER, constant.PHARMACIST))
+	{
+		g.GET("", c.GetAllMyRooms)
+		g.POST("", authMiddleware.ProtectedRoles(constant.USER), c.PostNewRoom)
+		g.GET(chatId, c.GetMyRoom)
+		g.GET(chatId+"/messages", c.GetAllMessages)
+		g.POST(chatId+"/messages", c.PostNewMessage)
+		g.PATCH(chatId+"/read", c.ReadMessages)
+	}
+
+	r.GET(ConnectPath, authMiddleware.WebSocketAuthorization(), authMiddleware.ProtectedRoles(constant.USER, constant.PHARMACIST), c.Connect)
+}
@@ -0,0 +1,209 @@
+package usecase
+
+import (
+	"context"
+	"strconv"
+	"strings"
+
+	constantAuth "healthcare-app/internal/auth/constant"
+	apperrorChat "healthcare-app/internal/chat/apperror"
+	"healthcare-app/internal/chat/constant"
+	dtoChat "healthcare-app/internal/chat/dto"
+	"healthcare-app/internal/chat/entity"
+	chatRepository "healthcare-app/internal/chat/repository"
+	"healthcare-app/internal/chat/utils"
+	pharmacyRepository "healthcare-app/internal/pharmacy/repository"
+	apperrorPkg "healthcare-app/pkg/apperror"
+	dtoPkg "healthcare-app/pkg/dto"
+	"healthcare-app/pkg/utils/pageutils"
+	"healthcare-app/pkg/utils/pubsubutils"
+)
+
+type ChatUseCase interface {
+	GetAllMyRooms(ctx context.Context, request *dtoChat.GetChatRoomRequest) ([]*dtoChat.ChatRoomResponse, error)
+	GetMyRoom(ctx context.Context, id int64, participantID int64) (*dtoChat.ChatRoomResponse, error)
+	PostNewRoom(ctx context.Context, reqBody *dtoChat.RequestChatRoom) (*dtoChat.ChatRoomResponse, error)
+	GetAllMessages(ctx context.Context, request *dtoChat.GetChatMessageRequest) ([]*dtoChat.ChatMessageResponse, *dtoPkg.SeekPageMetaData, error)
+	PostNewMessage(ctx context.Context, reqBody *dtoChat.RequestChatMessage) (*dtoChat.ChatMessageResponse, error)
+	ReadMessages(ctx context.Context, id int64, readerID int64) error
+	SubscribeRoom(ctx context.Context, id int64, participantID int64) (*pubsubutils.Subscriber, error)
+	UnsubscribeRoom(subscriber *pubsubutils.Subscriber)
+}
+
+type chatUseCaseImpl struct {
+	roomRepo     chatRepository.ChatRoomRepository
+	messageRepo  chatRepository.ChatMessageRepository
+	pharmacyRepo pharmacyRepository.PharmacyRepository
+	broker       pubsubutils.Broker
+}
+
+func NewChatUseCase(
+	roomRepo chatRepository.ChatRoomRepository,
+	messageRepo chatRepository.ChatMessageRepository,
+	pharmacyRepo pharmacyRepository.PharmacyRepository,
+	broker pubsubutils.Broker,
+) *chatUseCaseImpl {
+	return &chatUseCaseImpl{
+		roomRepo:     roomRepo,
+		messageRepo:  messageRepo,
+		pharmacyRepo: pharmacyRepo,
+		broker:       broker,
+	}
+}
+
+func (u *chatUseCaseImpl) GetAllMyRooms(ctx context.Context, request *dtoChat.GetChatRoomRequest) ([]*dtoChat.ChatRoomResponse, error) {
+	var (
+		rooms []*entity.ChatRoom
+		err   error
+	)
+	if request.Role == constantAuth.PHARMACIST {
+		rooms, err = u.roomRepo.FindAllByPharmacistID(ctx, request.UserID)
+	} else {
+		rooms, err = u.roomRepo.FindAllByUserID(ctx, request.UserID)
+	}
+	if err != nil {
+		return nil, apperrorPkg.NewServerError(err)
+	}
+	return dtoChat.ConvertToChatRoomResponses(rooms), nil
+}
+
+func (u *chatUseCaseImpl) GetMyRoom(ctx context.Context, id int64, participantID int64) (*dtoChat.ChatRoomResponse, error) {
+	room, err := u.roomRepo.FindByIDAndParticipantID(ctx, id, participantID)
+	if err != nil {
+		return nil, err
+	}
+	return dtoChat.ConvertToChatRoomResponse(room), nil
+}
+
+// PostNewRoom opens a conversation with the pharmacist of a pharmacy, or of
+// the pharmacy fulfilling one of the user's orders. Asking again for the same
+// order or pharmacy returns the existing room.
+func (u *chatUseCaseImpl) PostNewRoom(ctx context.Context, reqBody *dtoChat.RequestChatRoom) (*dtoChat.ChatRoomResponse, error) {
+	if (reqBody.OrderID == nil) == (reqBody.PharmacyID == nil) {
+		return nil, apperrorChat.NewInvalidChatTargetError()
+	}
+
+	room := &entity.ChatRoom{UserID: reqBody.UserID, OrderID: reqBody.OrderID}
+	if reqBody.OrderID != nil {
+		pharmacyID, err := u.roomRepo.FindOrderPharmacyID(ctx, *reqBody.OrderID, reqBody.UserID)
+		if err != nil {
+			return nil, err
+		}
+		room.PharmacyID = pharmacyID
+	} else {
+		room.PharmacyID = *reqBody.PharmacyID
+	}
+
+	pharmacy, err := u.pharmacyRepo.FindByID(ctx, room.PharmacyID)
+	if err != nil {
+		return nil, err
+	}
+	if pharmacy.PharmacistID == nil {
+		return nil, apperrorChat.NewInvalidChatPharmacistError()
+	}
+
+	if err := u.roomRepo.Save(ctx, room); err != nil {
+		return nil, apperrorPkg.NewServerError(err)
+	}
+	room, err = u.roomRepo.FindByTarget(ctx, room)
+	if err != nil {
+		return nil, err
+	}
+	return dtoChat.ConvertToChatRoomResponse(room), nil
+}
+
+func (u *chatUseCaseImpl) GetAllMessages(ctx context.Context, request *dtoChat.GetChatMessageRequest) ([]*dtoChat.ChatMessageResponse, *dtoPkg.SeekPageMetaData, error) {
+	if _, err := u.roomRepo.FindByIDAndParticipantID(ctx, request.RoomID, request.ParticipantID); err != nil {
+		return nil, nil, err
+	}
+
+	messages, err := u.messageRepo.FindAllByRoomID(ctx, request)
+	if err != nil {
+		return nil, nil, apperrorPkg.NewServerError(err)
+	}
+	itemLen := int64(len(messages))
+	if itemLen >= request.Limit {
+		messages = messages[:request.Limit]
+	}
+
+	last := ""
+	if len(messages) != 0 {
+		last = strconv.Itoa(int(messages[len(messages)-1].ID))
+	}
+
+	metaData := pageutils.CreateSeekMetaData(itemLen, request.Limit, last)
+	return dtoChat.ConvertToChatMessageResponses(messages), metaData, nil
+}
+
+// PostNewMessage stores the message before publishing it, so a participant
+// who misses the event still finds it in the history.
+func (u *chatUseCaseImpl) PostNewMessage(ctx context.Context, reqBody *dtoChat.RequestChatMessage) (*dtoChat.ChatMessageResponse, error) {
+	text := strings.TrimSpace(reqBody.Message)
+	if text == "" {
+		return nil, apperrorChat.NewInvalidChatMessageError()
+	}
+
+	room, err := u.roomRepo.FindByIDAndParticipantID(ctx, reqBody.RoomID, reqBody.SenderID)
+	if err != nil {
+		return nil, err
+	}
+
+	message := &entity.ChatMessage{
+		RoomID:   room.ID,
+		SenderID: reqBody.SenderID,
+		Message:  text,
+	}
+	if err := u.messageRepo.Save(ctx, message); err != nil {
+		return nil, apperrorPkg.NewServerError(err)
+	}
+	if err := u.roomRepo.UpdateLastMessageAt(ctx, room.ID); err != nil {
+		return nil, apperrorPkg.NewServerError(err)
+	}
+
+	res := dtoChat.ConvertToChatMessageResponse(message)
+	if err := u.broker.Publish(ctx, utils.GenerateRoomTopic(room.ID), &dtoChat.ChatEvent{
+		Type:    constant.EVENT_MESSAGE,
+		RoomID:  room.ID,
+		Message: res,
+	}); err != nil {
+		return nil, apperrorPkg.NewServerError(err)
+	}
+	return res, nil
+}
+
+func (u *chatUseCaseImpl) ReadMessages(ctx context.Context, id int64, readerID int64) error {
+	room, err := u.roomRepo.FindByIDAndParticipantID(ctx, id, readerID)
+	if err != nil {
+		return err
+	}
+
+	readAt, err := u.messageRepo.MarkAsRead(ctx, room.ID, readerID)
+	if err != nil {
+		return apperrorPkg.NewServerError(err)
+	}
+	if readAt == nil {
+		return nil
+	}
+
+	if err := u.broker.Publish(ctx, utils.GenerateRoomTopic(room.ID), &dtoChat.ChatEvent{
+		Type:     constant.EVENT_READ,
+		RoomID:   room.ID,
+		ReaderID: readerID,
+		ReadAt:   readAt,
+	}); err != nil {
+		return apperrorPkg.NewServerError(err)
+	}
+	return nil
+}
+
+func (u *chatUseCaseImpl) SubscribeRoom(ctx context.Context, id int64, participantID int64) (*pubsubutils.Subscriber, error) {
+	room, err := u.roomRepo.FindByIDAndParticipantID(ctx, id, participantID)
+	if err != nil {
+		return nil, err
+	}
+	return u.broker.Subscribe(utils.GenerateRoomTopic(room.ID)), nil
+}
+
+func (u *chatUseCaseImpl) UnsubscribeRoom(subscriber *pubsubutils.Subscriber) {
+	u.broker.Unsubscribe(subscriber)
+}
@@ -0,0 +1,7 @@
+package utils
+
+import "fmt"
+
+func GenerateRoomTopic(roomID int64) string {
+	return fmt.Sprintf("chat:room:%v", roomID)
+}
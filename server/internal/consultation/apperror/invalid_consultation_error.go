@@ -0,0 +1,40 @@
+package apperror
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"healthcare-app/internal/consultation/constant"
+	"healthcare-app/pkg/apperror"
+)
+
+func NewInvalidConsultationStatusError(status string) *apperror.AppError {
+	msg := fmt.Sprintf(constant.InvalidConsultationStatus, strings.ToLower(status))
+	err := errors.New(msg)
+	return apperror.NewAppError(err, apperror.DefaultClientErrorCode, msg)
+}
+
+func NewInvalidConsultationDoctorError() *apperror.AppError {
+	msg := constant.InvalidConsultationDoctor
+	err := errors.New(msg)
+	return apperror.NewAppError(err, apperror.DefaultClientErrorCode, msg)
+}
+
+func NewInvalidConsultationActiveError() *apperror.AppError {
+	msg := constant.InvalidConsultationActive
+	err := errors.New(msg)
+	return apperror.NewAppError(err, apperror.DefaultClientErrorCode, msg)
+}
+
+func NewInvalidConsultationMessageError() *apperror.AppError {
+	msg := constant.InvalidConsultationMessage
+	err := errors.New(msg)
+	return apperror.NewAppError(err, apperror.DefaultClientErrorCode, msg)
+}
+
+func NewInvalidConsultationAttachmentError() *apperror.AppError {
+	msg := constant.InvalidConsultationAttachment
+	err := errors.New(msg)
+	return apperror.NewAppError(err, apperror.DefaultClientErrorCode, msg)
+}
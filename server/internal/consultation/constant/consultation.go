@@ -0,0 +1,20 @@
+package constant
+
+const (
+	STATUS_WAITING   = "WAITING"
+	STATUS_ONGOING   = "ONGOING"
+	STATUS_COMPLETED = "COMPLETED"
+	STATUS_REJECTED  = "REJECTED"
+	STATUS_CANCELLED = "CANCELLED"
+)
+
+const (
+	MAX_ATTACHMENT_SIZE = 5 * 1024 * 1024 // 5 mb
+)
+
+var AllowedAttachmentTypes = map[string]struct{}{
+	"image/jpeg":      {},
+	"image/png":       {},
+	"image/webp":      {},
+	"application/pdf": {},
+}
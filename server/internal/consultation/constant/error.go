@@ -0,0 +1,9 @@
+package constant
+
+const (
+	InvalidConsultationStatus     = "consultation is not %s"
+	InvalidConsultationDoctor     = "doctor is not available for consultation"
+	InvalidConsultationActive     = "you already have an active consultation with this doctor"
+	InvalidConsultationMessage    = "message or attachment is required"
+	InvalidConsultationAttachment = "attachment must be an image or a pdf of at most 5 mb"
+)
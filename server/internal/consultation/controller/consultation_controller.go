@@ -0,0 +1,168 @@
+package controller
+
+import (
+	"strconv"
+
+	"healthcare-app/internal/auth/utils"
+	dtoConsultation "healthcare-app/internal/consultation/dto"
+	"healthcare-app/internal/consultation/usecase"
+	"healthcare-app/pkg/apperror"
+	"healthcare-app/pkg/utils/ginutils"
+
+	"github.com/gin-gonic/gin"
+)
+
+type ConsultationController struct {
+	consultationUseCase usecase.ConsultationUseCase
+}
+
+func NewConsultationController(consultationUseCase usecase.ConsultationUseCase) *ConsultationController {
+	return &ConsultationController{
+		consultationUseCase: consultationUseCase,
+	}
+}
+
+func (cc *ConsultationController) GetAllMyConsultations(ctx *gin.Context) {
+	req := &dtoConsultation.GetConsultationRequest{UserID: utils.GetValueUserIdFromToken(ctx), Role: utils.GetValueRoleUserFromToken(ctx)}
+	if err := ctx.ShouldBindQuery(req); err != nil {
+		ctx.Error(err)
+		return
+	}
+	res, err := cc.consultationUseCase.GetAllMyConsultations(ctx, req)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+	ginutils.ResponseOK(ctx, res)
+}
+
+func (cc *ConsultationController) GetMyConsultation(ctx *gin.Context) {
+	consultationID, err := strconv.Atoi(ctx.Param("consultationId"))
+	if err != nil {
+		ctx.Error(apperror.NewInvalidIdError())
+		return
+	}
+	res, err := cc.consultationUseCase.GetMyConsultation(ctx, int64(consultationID), utils.GetValueUserIdFromToken(ctx))
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+	ginutils.ResponseOK(ctx, res)
+}
+
+func (cc *ConsultationController) PostNewConsultation(ctx *gin.Context) {
+	req := &dtoConsultation.RequestConsultation{UserID: utils.GetValueUserIdFromToken(ctx)}
+	if err := ctx.ShouldBindJSON(req); err != nil {
+		ctx.Error(err)
+		return
+	}
+	res, err := cc.consultationUseCase.PostNewConsultation(ctx, req)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+	ginutils.ResponseCreated(ctx, res)
+}
+
+func (cc *ConsultationController) CancelMyConsultation(ctx *gin.Context) {
+	consultationID, err := strconv.Atoi(ctx.Param("consultationId"))
+	if err != nil {
+		ctx.Error(apperror.NewInvalidIdError())
+		return
+	}
+	res, err := cc.consultationUseCase.CancelMyConsultation(ctx, int64(consultationID), utils.GetValueUserIdFromToken(ctx))
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+	ginutils.ResponseOK(ctx, res)
+}
+
+func (cc *ConsultationController) AcceptConsultation(ctx *gin.Context) {
+	consultationID, err := strconv.Atoi(ctx.Param("consultationId"))
+	if err != nil {
+		ctx.Error(apperror.NewInvalidIdError())
+		return
+	}
+	res, err := cc.consultationUseCase.AcceptConsultation(ctx, int64(consultationID), utils.GetValueUserIdFromToken(ctx))
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+	ginutils.ResponseOK(ctx, res)
+}
+
+func (cc *ConsultationController) RejectConsultation(ctx *gin.Context) {
+	consultationID, err := strconv.Atoi(ctx.Param("consultationId"))
+	if err != nil {
+		ctx.Error(apperror.NewInvalidIdError())
+		return
+	}
+	res, err := cc.consultationUseCase.RejectConsultation(ctx, int64(consultationID), utils.GetValueUserIdFromToken(ctx))
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+	ginutils.ResponseOK(ctx, res)
+}
+
+func (cc *ConsultationController) EndConsultation(ctx *gin.Context) {
+	consultationID, err := strconv.Atoi(ctx.Param("consultationId"))
+	if err != nil {
+		ctx.Error(apperror.NewInvalidIdError())
+		return
+	}
+	req := &dtoConsultation.RequestConsultationNote{ID: int64(consultationID), DoctorID: utils.GetValueUserIdFromToken(ctx)}
+	if err := ctx.ShouldBindJSON(req); err != nil {
+		ctx.Error(err)
+		return
+	}
+	res, err := cc.consultationUseCase.EndConsultation(ctx, req)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+	ginutils.ResponseOK(ctx, res)
+}
+
+func (cc *ConsultationController) GetAllMessages(ctx *gin.Context) {
+	consultationID, err := strconv.Atoi(ctx.Param("consultationId"))
+	if err != nil {
+		ctx.Error(apperror.NewInvalidIdError())
+		return
+	}
+	res, err := cc.consultationUseCase.GetAllMessages(ctx, int64(consultationID), utils.GetValueUserIdFromToken(ctx))
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+	ginutils.ResponseOK(ctx, res)
+}
+
+func (cc *ConsultationController) PostNewMessage(ctx *gin.Context) {
+	consultationID, err := strconv.Atoi(ctx.Param("consultationId"))
+	if err != nil {
+		ctx.Error(apperror.NewInvalidIdError())
+		return
+	}
+	req := &dtoConsultation.RequestConsultationMessage{ConsultationID: int64(consultationID), SenderID: utils.GetValueUserIdFromToken(ctx)}
+	if err := ctx.ShouldBind(req); err != nil {
+		ctx.Error(err)
+		return
+	}
+	res, err := cc.consultationUseCase.PostNewMessage(ctx, req)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+	ginutils.ResponseCreated(ctx, res)
+}
+
+func (cc *ConsultationController) GetAllMyNotes(ctx *gin.Context) {
+	res, err := cc.consultationUseCase.GetAllMyNotes(ctx, utils.GetValueUserIdFromToken(ctx))
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+	ginutils.ResponseOK(ctx, res)
+}
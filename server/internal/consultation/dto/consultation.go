@@ -0,0 +1,167 @@
+package dto
+
+import (
+	"mime/multipart"
+	"time"
+
+	"healthcare-app/internal/consultation/constant"
+	"healthcare-app/internal/consultation/entity"
+
+	"github.com/shopspring/decimal"
+)
+
+type ConsultationResponse struct {
+	ID              int64                     `json:"id"`
+	UserID          int64                     `json:"user_id"`
+	UserName        string                    `json:"user_name"`
+	Doctor          *ConsultationDoctor       `json:"doctor"`
+	DependentID     *int64                    `json:"dependent_id"`
+	Complaint       string                    `json:"complaint"`
+	ConsultationFee decimal.Decimal           `json:"consultation_fee"`
+	Status          string                    `json:"status"`
+	AcceptedAt      *time.Time                `json:"accepted_at"`
+	EndedAt         *time.Time                `json:"ended_at"`
+	Note            *ConsultationNoteResponse `json:"note,omitempty"`
+	CreatedAt       time.Time                 `json:"created_at"`
+	UpdatedAt       time.Time                 `json:"updated_at"`
+}
+
+type ConsultationDoctor struct {
+	ID             int64  `json:"id"`
+	Name           string `json:"name"`
+	Specialization string `json:"specialization"`
+}
+
+type ConsultationMessageResponse struct {
+	ID             int64     `json:"id"`
+	ConsultationID int64     `json:"consultation_id"`
+	SenderID       int64     `json:"sender_id"`
+	Message        *string   `json:"message"`
+	AttachmentURL  *string   `json:"attachment_url"`
+	AttachmentType *string   `json:"attachment_type"`
+	CreatedAt      time.Time `json:"created_at"`
+}
+
+type ConsultationNoteResponse struct {
+	ID             int64     `json:"id"`
+	ConsultationID int64     `json:"consultation_id"`
+	DoctorID       int64     `json:"doctor_id"`
+	DoctorName     string    `json:"doctor_name"`
+	DependentID    *int64    `json:"dependent_id"`
+	Diagnosis      string    `json:"diagnosis"`
+	Advice         string    `json:"advice"`
+	CreatedAt      time.Time `json:"created_at"`
+	UpdatedAt      time.Time `json:"updated_at"`
+}
+
+type GetConsultationRequest struct {
+	Status string `form:"status" binding:"omitempty,oneof=WAITING ONGOING COMPLETED REJECTED CANCELLED"`
+	UserID int64  `form:"-"`
+	Role   int    `form:"-"`
+}
+
+type RequestConsultation struct {
+	DoctorID    int64  `json:"doctor_id" binding:"required,gte=1"`
+	DependentID *int64 `json:"dependent_id" binding:"omitempty,gte=1"`
+	Complaint   string `json:"complaint" binding:"required,max=2000"`
+	UserID      int64  `json:"-"`
+}
+
+type RequestConsultationMessage struct {
+	Message        *string               `form:"message" binding:"omitempty,max=2000"`
+	Attachment     *multipart.FileHeader `form:"attachment"`
+	ConsultationID int64                 `form:"-"`
+	SenderID       int64                 `form:"-"`
+}
+
+type RequestConsultationNote struct {
+	Diagnosis string `json:"diagnosis" binding:"required,max=2000"`
+	Advice    string `json:"advice" binding:"required,max=5000"`
+	ID        int64  `json:"-"`
+	DoctorID  int64  `json:"-"`
+}
+
+func ConvertToConsultationResponses(consultations []*entity.Consultation) []*ConsultationResponse {
+	responses := []*ConsultationResponse{}
+	for _, consultation := range consultations {
+		responses = append(responses, ConvertToConsultationResponse(consultation))
+	}
+	return responses
+}
+
+func ConvertToConsultationResponse(consultation *entity.Consultation) *ConsultationResponse {
+	res := &ConsultationResponse{
+		ID:       consultation.ID,
+		UserID:   consultation.UserID,
+		UserName: consultation.UserName,
+		Doctor: &ConsultationDoctor{
+			ID:             consultation.DoctorID,
+			Name:           consultation.DoctorName,
+			Specialization: consultation.DoctorSpecialization,
+		},
+		DependentID:     consultation.DependentID,
+		Complaint:       consultation.Complaint,
+		ConsultationFee: consultation.ConsultationFee,
+		Status:          consultation.Status,
+		AcceptedAt:      consultation.AcceptedAt,
+		EndedAt:         consultation.EndedAt,
+		CreatedAt:       consultation.CreatedAt,
+		UpdatedAt:       consultation.UpdatedAt,
+	}
+	if consultation.Note != nil {
+		res.Note = ConvertToConsultationNoteResponse(consultation.Note)
+	}
+	return res
+}
+
+func ConvertToConsultationMessageResponses(messages []*entity.ConsultationMessage) []*ConsultationMessageResponse {
+	responses := []*ConsultationMessageResponse{}
+	for _, message := range messages {
+		responses = append(responses, ConvertToConsultationMessageResponse(message))
+	}
+	return responses
+}
+
+func ConvertToConsultationMessageResponse(message *entity.ConsultationMessage) *ConsultationMessageResponse {
+	return &ConsultationMessageResponse{
+		ID:             message.ID,
+		ConsultationID: message.ConsultationID,
+		SenderID:       message.SenderID,
+		Message:        message.Message,
+		AttachmentURL:  message.AttachmentURL,
+		AttachmentType: message.AttachmentType,
+		CreatedAt:      message.CreatedAt,
+	}
+}
+
+func ConvertToConsultationNoteResponses(notes []*entity.ConsultationNote) []*ConsultationNoteResponse {
+	responses := []*ConsultationNoteResponse{}
+	for _, note := range notes {
+		responses = append(responses, ConvertToConsultationNoteResponse(note))
+	}
+	return responses
+}
+
+func ConvertToConsultationNoteResponse(note *entity.ConsultationNote) *ConsultationNoteResponse {
+	return &ConsultationNoteResponse{
+		ID:             note.ID,
+		ConsultationID: note.ConsultationID,
+		DoctorID:       note.DoctorID,
+		DoctorName:     note.DoctorName,
+		DependentID:    note.DependentID,
+		Diagnosis:      note.Diagnosis,
+		Advice:         note.Advice,
+		CreatedAt:      note.CreatedAt,
+		UpdatedAt:      note.UpdatedAt,
+	}
+}
+
+func ConsultationRequestToEntity(request *RequestConsultation) *entity.Consultation {
+	return &entity.Consultation{
+		UserID:      request.UserID,
+		DoctorID:    request.DoctorID,
+		DependentID: request.DependentID,
+		Complaint:   request.Complaint,
+		Status:      constant.STATUS_WAITING,
+	}
+}
@@ -0,0 +1,48 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+type Consultation struct {
+	ID                   int64
+	UserID               int64
+	UserName             string
+	DoctorID             int64
+	DoctorName           string
+	DoctorSpecialization string
+	DependentID          *int64
+	Complaint            string
+	ConsultationFee      decimal.Decimal
+	Status               string
+	AcceptedAt           *time.Time
+	EndedAt              *time.Time
+	Note                 *ConsultationNote
+	CreatedAt            time.Time
+	UpdatedAt            time.Time
+}
+
+type ConsultationMessage struct {
+	ID             int64
+	ConsultationID int64
+	SenderID       int64
+	Message        *string
+	AttachmentURL  *string
+	AttachmentType *string
+	CreatedAt      time.Time
+}
+
+type ConsultationNote struct {
+	ID             int64
+	ConsultationID int64
+	UserID         int64
+	DoctorID       int64
+	DoctorName     string
+	DependentID    *int64
+	Diagnosis      string
+	Advice         string
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+}
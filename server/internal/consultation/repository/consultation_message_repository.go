@@ -0,0 +1,89 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+
+	"healthcare-app/internal/consultation/entity"
+	"healthcare-app/pkg/database/transactor"
+)
+
+type ConsultationMessageRepository interface {
+	FindAllByConsultationID(ctx context.Context, consultationID int64) ([]*entity.ConsultationMessage, error)
+	Save(ctx context.Context, message *entity.ConsultationMessage) error
+}
+
+type consultationMessageRepositoryImpl struct {
+	db *sql.DB
+}
+
+func NewConsultationMessageRepository(db *sql.DB) *consultationMessageRepositoryImpl {
+	return &consultationMessageRepositoryImpl{
+		db: db,
+	}
+}
+
+func (r *consultationMessageRepositoryImpl) FindAllByConsultationID(ctx context.Context, consultationID int64) ([]*entity.ConsultationMessage, error) {
+	query := `
+		select id, consultation_id, sender_id, message, attachment_url, attachment_type, created_at
+		from consultation_messages
+		where consultation_id = $1
+		order by created_at, id
+	`
+	tx := transactor.ExtractTx(ctx)
+
+	var (
+		err  error
+		rows *sql.Rows
+	)
+	if tx != nil {
+		rows, err = tx.QueryContext(ctx, query, consultationID)
+	} else {
+		rows, err = r.db.QueryContext(ctx, query, consultationID)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	messages := []*entity.ConsultationMessage{}
+	for rows.Next() {
+		message := new(entity.ConsultationMessage)
+		if err := rows.Scan(
+			&message.ID,
+			&message.ConsultationID,
+			&message.SenderID,
+			&message.Message,
+			&message.AttachmentURL,
+			&message.AttachmentType,
+			&message.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		messages = append(messages, message)
+	}
+
+	err = rows.Err()
+	if err != nil {
+		return nil, err
+	}
+	return messages, nil
+}
+
+func (r *consultationMessageRepositoryImpl) Save(ctx context.Context, message *entity.ConsultationMessage) error {
+	query := `
+		insert into consultation_messages(consultation_id, sender_id, message, attachment_url, attachment_type)
+		values ($1, $2, $3, $4, $5) returning id, created_at
+	`
+	tx := transactor.ExtractTx(ctx)
+
+	var err error
+	if tx != nil {
+		err = tx.QueryRowContext(ctx, query, message.ConsultationID, message.SenderID, message.Message, message.AttachmentURL, message.AttachmentType).Scan(&message.ID, &message.CreatedAt)
+	} else {
+		err = r.db.QueryRowContext(ctx, query, message.ConsultationID, message.SenderID, message.Message, message.AttachmentURL, message.AttachmentType).Scan(&message.ID, &message.CreatedAt)
+	}
+
+	return err
+}
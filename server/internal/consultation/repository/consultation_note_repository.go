@@ -0,0 +1,141 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"healthcare-app/internal/consultation/entity"
+	"healthcare-app/pkg/database/transactor"
+)
+
+type ConsultationNoteRepository interface {
+	FindAllByUserID(ctx context.Context, userID int64) ([]*entity.ConsultationNote, error)
+	FindByConsultationID(ctx context.Context, consultationID int64) (*entity.ConsultationNote, error)
+	Save(ctx context.Context, note *entity.ConsultationNote) error
+}
+
+type consultationNoteRepositoryImpl struct {
+	db *sql.DB
+}
+
+func NewConsultationNoteRepository(db *sql.DB) *consultationNoteRepositoryImpl {
+	return &consultationNoteRepositoryImpl{
+		db: db,
+	}
+}
+
+const consultationNoteSelectQuery = `
+	select cn.id, cn.consultation_id, cn.user_id, cn.doctor_id, ud.full_name, cn.dependent_id, cn.diagnosis, cn.advice, cn.created_at, cn.updated_at
+	from consultation_notes cn
+	join user_details ud on ud.user_id = cn.doctor_id
+`
+
+func (r *consultationNoteRepositoryImpl) FindAllByUserID(ctx context.Context, userID int64) ([]*entity.ConsultationNote, error) {
+	query := consultationNoteSelectQuery + " where cn.user_id = $1 order by cn.created_at desc"
+	tx := transactor.ExtractTx(ctx)
+
+	var (
+		err  error
+		rows *sql.Rows
+	)
+	if tx != nil {
+		rows, err = tx.QueryContext(ctx, query, userID)
+	} else {
+		rows, err = r.db.QueryContext(ctx, query, userID)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	notes := []*entity.ConsultationNote{}
+	for rows.Next() {
+		note := new(entity.ConsultationNote)
+		if err := rows.Scan(
+			&note.ID,
+			&note.ConsultationID,
+			&note.UserID,
+			&note.DoctorID,
+			&note.DoctorName,
+			&note.DependentID,
+			&note.Diagnosis,
+			&note.Advice,
+			&note.CreatedAt,
+			&note.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		notes = append(notes, note)
+	}
+
+	err = rows.Err()
+	if err != nil {
+		return nil, err
+	}
+	return notes, nil
+}
+
+// FindByConsultationID returns nil when the doctor has not issued a note yet.
+func (r *consultationNoteRepositoryImpl) FindByConsultationID(ctx context.Context, consultationID int64) (*entity.ConsultationNote, error) {
+	query := consultationNoteSelectQuery + " where cn.consultation_id = $1"
+	tx := transactor.ExtractTx(ctx)
+
+	var (
+		err  error
+		note entity.ConsultationNote
+	)
+	if tx != nil {
+		err = tx.QueryRowContext(ctx, query, consultationID).Scan(
+			&note.ID,
+			&note.ConsultationID,
+			&note.UserID,
+			&note.DoctorID,
+			&note.DoctorName,
+			&note.DependentID,
+			&note.Diagnosis,
+			&note.Advice,
+			&note.CreatedAt,
+			&note.UpdatedAt,
+		)
+	} else {
+		err = r.db.QueryRowContext(ctx, query, consultationID).Scan(
+			&note.ID,
+			&note.ConsultationID,
+			&note.UserID,
+			&note.DoctorID,
+			&note.DoctorName,
+			&note.DependentID,
+			&note.Diagnosis,
+			&note.Advice,
+			&note.CreatedAt,
+			&note.UpdatedAt,
+		)
+	}
+
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &note, nil
+}
+
+func (r *consultationNoteRepositoryImpl) Save(ctx context.Context, note *entity.ConsultationNote) error {
+	query := `
+		insert into consultation_notes(consultation_id, user_id, doctor_id, dependent_id, diagnosis, advice)
+		values ($1, $2, $3, $4, $5, $6) returning id, created_at, updated_at
+	`
+	tx := transactor.ExtractTx(ctx)
+
+	var err error
+	if tx != nil {
+		err = tx.QueryRowContext(ctx, query, note.ConsultationID, note.UserID, note.DoctorID, note.DependentID, note.Diagnosis, note.Advice).Scan(&note.ID, &note.CreatedAt, &note.UpdatedAt)
+	} else {
+		err = r.db.QueryRowContext(ctx, query, note.ConsultationID, note.UserID, note.DoctorID, note.DependentID, note.Diagnosis, note.Advice).Scan(&note.ID, &note.CreatedAt, &note.UpdatedAt)
+	}
+
+	return err
+}
@@ -0,0 +1,247 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"healthcare-app/internal/consultation/constant"
+	"healthcare-app/internal/consultation/entity"
+	apperrorPkg "healthcare-app/pkg/apperror"
+	"healthcare-app/pkg/database/transactor"
+)
+
+type ConsultationRepository interface {
+	FindAllByUserID(ctx context.Context, userID int64, status string) ([]*entity.Consultation, error)
+	FindAllByDoctorID(ctx context.Context, doctorID int64, status string) ([]*entity.Consultation, error)
+	FindByIDAndParticipantID(ctx context.Context, id int64, participantID int64) (*entity.Consultation, error)
+	IsActiveExists(ctx context.Context, userID int64, doctorID int64) (bool, error)
+	Save(ctx context.Context, consultation *entity.Consultation) error
+	UpdateStatus(ctx context.Context, consultation *entity.Consultation, fromStatus string) error
+}
+
+type consultationRepositoryImpl struct {
+	db *sql.DB
+}
+
+func NewConsultationRepository(db *sql.DB) *consultationRepositoryImpl {
+	return &consultationRepositoryImpl{
+		db: db,
+	}
+}
+
+const consultationSelectQuery = `
+	select c.id, c.user_id, coalesce(uud.full_name, u.email), c.doctor_id, dud.full_name, dd.specialization, c.dependent_id, c.complaint, c.consultation_fee, c.status, c.accepted_at, c.ended_at, c.created_at, c.updated_at
+	from consultations c
+	join users u on u.id = c.user_id
+	left join user_details uud on uud.user_id = c.user_id
+	join user_details dud on dud.user_id = c.doctor_id
+	join doctor_details dd on dd.user_id = c.doctor_id
+`
+
+func (r *consultationRepositoryImpl) FindAllByUserID(ctx context.Context, userID int64, status string) ([]*entity.Consultation, error) {
+	query := consultationSelectQuery + " where c.user_id = $1 and ($2 = '' or c.status = $2) order by c.created_at desc"
+	return r.findAll(ctx, query, userID, status)
+}
+
+func (r *consultationRepositoryImpl) FindAllByDoctorID(ctx context.Context, doctorID int64, status string) ([]*entity.Consultation, error) {
+	query := consultationSelectQuery + " where c.doctor_id = $1 and ($2 = '' or c.status = $2) order by c.created_at desc"
+	return r.findAll(ctx, query, doctorID, status)
+}
+
+func (r *consultationRepositoryImpl) FindByIDAndParticipantID(ctx context.Context, id int64, participantID int64) (*entity.Consultation, error) {
+	query := consultationSelectQuery + " where c.id = $1 and (c.user_id = $2 or c.doctor_id = $2)"
+	tx := transactor.ExtractTx(ctx)
+
+	var (
+		err          error
+		consultation entity.Consultation
+	)
+	if tx != nil {
+		err = tx.QueryRowContext(ctx, query, id, participantID).Scan(
+			&consultation.ID,
+			&consultation.UserID,
+			&consultation.UserName,
+			&consultation.DoctorID,
+			&consultation.DoctorName,
+			&consultation.DoctorSpecialization,
+			&consultation.DependentID,
+			&consultation.Complaint,
+			&consultation.ConsultationFee,
+			&consultation.Status,
+			&consultation.AcceptedAt,
+			&consultation.EndedAt,
+			&consultation.CreatedAt,
+			&consultation.UpdatedAt,
+		)
+	} else {
+		err = r.db.QueryRowContext(ctx, query, id, participantID).Scan(
+			&consultation.ID,
+			&consultation.UserID,
+			&consultation.UserName,
+			&consultation.DoctorID,
+			&consultation.DoctorName,
+			&consultation.DoctorSpecialization,
+			&consultation.DependentID,
+			&consultation.Complaint,
+			&consultation.ConsultationFee,
+			&consultation.Status,
+			&consultation.AcceptedAt,
+			&consultation.EndedAt,
+			&consultation.CreatedAt,
+			&consultation.UpdatedAt,
+		)
+	}
+
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, apperrorPkg.NewEntityNotFoundError("consultation")
+		}
+		return nil, err
+	}
+	return &consultation, nil
+}
+
+func (r *consultationRepositoryImpl) IsActiveExists(ctx context.Context, userID int64, doctorID int64) (bool, error) {
+	query := `
+		select exists(select 1 from consultations where user_id = $1 and doctor_id = $2 and status in ($3, $4))
+	`
+	tx := transactor.ExtractTx(ctx)
+
+	var (
+		err    error
+		exists bool
+	)
+	if tx != nil {
+		err = tx.QueryRowContext(ctx, query, userID, doctorID, constant.STATUS_WAITING, constant.STATUS_ONGOING).Scan(&exists)
+	} else {
+		err = r.db.QueryRowContext(ctx, query, userID, doctorID, constant.STATUS_WAITING, constant.STATUS_ONGOING).Scan(&exists)
+	}
+
+	if err != nil {
+		return false, err
+	}
+	return exists, nil
+}
+
+func (r *consultationRepositoryImpl) Save(ctx context.Context, consultation *entity.Consultation) error {
+	query := `
+		insert into consultations(user_id, doctor_id, dependent_id, complaint, consultation_fee, status)
+		values ($1, $2, $3, $4, $5, $6) returning id, created_at, updated_at
+	`
+	tx := transactor.ExtractTx(ctx)
+
+	var err error
+	if tx != nil {
+		err = tx.QueryRowContext(
+			ctx,
+			query,
+			consultation.UserID,
+			consultation.DoctorID,
+			consultation.DependentID,
+			consultation.Complaint,
+			consultation.ConsultationFee,
+			consultation.Status,
+		).Scan(&consultation.ID, &consultation.CreatedAt, &consultation.UpdatedAt)
+	} else {
+		err = r.db.QueryRowContext(
+			ctx,
+			query,
+			consultation.UserID,
+			consultation.DoctorID,
+			consultation.DependentID,
+			consultation.Complaint,
+			consultation.ConsultationFee,
+			consultation.Status,
+		).Scan(&consultation.ID, &consultation.CreatedAt, &consultation.UpdatedAt)
+	}
+
+	return err
+}
+
+// UpdateStatus only moves the consultation when it is still in fromStatus, so a
+// doctor accepting and a user cancelling at the same time cannot both succeed.
+func (r *consultationRepositoryImpl) UpdateStatus(ctx context.Context, consultation *entity.Consultation, fromStatus string) error {
+	query := `
+		update consultations
+		set status = $3,
+			accepted_at = case when $3 = $4 then now() else accepted_at end,
+			ended_at = case when $3 = $4 then ended_at else now() end,
+			updated_at = now()
+		where id = $1 and status = $2
+		returning accepted_at, ended_at, updated_at
+	`
+	tx := transactor.ExtractTx(ctx)
+
+	var err error
+	if tx != nil {
+		err = tx.QueryRowContext(ctx, query, consultation.ID, fromStatus, consultation.Status, constant.STATUS_ONGOING).Scan(
+			&consultation.AcceptedAt,
+			&consultation.EndedAt,
+			&consultation.UpdatedAt,
+		)
+	} else {
+		err = r.db.QueryRowContext(ctx, query, consultation.ID, fromStatus, consultation.Status, constant.STATUS_ONGOING).Scan(
+			&consultation.AcceptedAt,
+			&consultation.EndedAt,
+			&consultation.UpdatedAt,
+		)
+	}
+
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return apperrorPkg.NewEntityNotFoundError("consultation")
+		}
+		return err
+	}
+	return nil
+}
+
+func (r *consultationRepositoryImpl) findAll(ctx context.Context, query string, args ...any) ([]*entity.Consultation, error) {
+	tx := transactor.ExtractTx(ctx)
+
+	var (
+		err  error
+		rows *sql.Rows
+	)
+	if tx != nil {
+		rows, err = tx.QueryContext(ctx, query, args...)
+	} else {
+		rows, err = r.db.QueryContext(ctx, query, args...)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	consultations := []*entity.Consultation{}
+	for rows.Next() {
+		consultation := new(entity.Consultation)
+		if err := rows.Scan(
+			&consultation.ID,
+			&consultation.UserID,
+			&consultation.UserName,
+			&consultation.DoctorID,
+			&consultation.DoctorName,
+			&consultation.DoctorSpecialization,
+			&consultation.DependentID,
+			&consultation.Complaint,
+			&consultation.ConsultationFee,
+			&consultation.Status,
+			&consultation.AcceptedAt,
+			&consultation.EndedAt,
+			&consultation.CreatedAt,
+			&consultation.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		consultations = append(consultations, consultation)
+	}
+
+	err = rows.Err()
+	if err != nil {
+		return nil, err
+	}
+	return consultations, nil
+}
@@ -0,0 +1,28 @@
+package route
+
+import (
+	"healthcare-app/internal/auth/constant"
+	"healthcare-app/internal/consultation/controller"
+	"healthcare-app/pkg/middleware"
+
+	"github.com/gin-gonic/gin"
+)
+
+const consultationId = "/:consultationId"
+
+func ConsultationControllerRoute(c *controller.ConsultationController, r *gin.Engine, authMiddleware *middleware.AuthMiddleware) {
+	g := r.Group("/consultations", authMiddleware.Authorization(), authMiddleware.ProtectedRoles(constant.USER, constant.DOCTOR))
+	{
+		g.GET("", c.GetAllMyConsultations)
+		g.POST("", authMiddleware.ProtectedRoles(constant.USER), c.PostNewConsultation)
+		g.GET(consultationId, c.GetMyConsultation)
+		g.PATCH(consultationId+"/cancel", authMiddleware.ProtectedRoles(constant.USER), c.CancelMyConsultation)
+		g.PATCH(consultationId+"/accept", authMiddleware.ProtectedRoles(constant.DOCTOR), c.AcceptConsultation)
+		g.PATCH(consultationId+"/reject", authMiddleware.ProtectedRoles(constant.DOCTOR), c.RejectConsultation)
+		g.PATCH(consultationId+"/end", authMiddleware.ProtectedRoles(constant.DOCTOR), c.EndConsultation)
+		g.GET(consultationId+"/messages", c.GetAllMessages)
+		g.POST(consultationId+"/messages", c.PostNewMessage)
+	}
+
+	r.GET("/users/me/consultation-notes", authMiddleware.Authorization(), authMiddleware.ProtectedRoles(constant.USER), c.GetAllMyNotes)
+}
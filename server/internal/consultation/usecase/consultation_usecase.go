@@ -0,0 +1,314 @@
+package usecase
+
+import (
+	"context"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"strings"
+
+	constantAuth "healthcare-app/internal/auth/constant"
+	apperrorConsultation "healthcare-app/internal/consultation/apperror"
+	"healthcare-app/internal/consultation/constant"
+	dtoConsultation "healthcare-app/internal/consultation/dto"
+	"healthcare-app/internal/consultation/entity"
+	consultationRepository "healthcare-app/internal/consultation/repository"
+	"healthcare-app/internal/consultation/utils"
+	doctorRepository "healthcare-app/internal/doctor/repository"
+	profileRepository "healthcare-app/internal/profile/repository"
+	apperrorPkg "healthcare-app/pkg/apperror"
+	"healthcare-app/pkg/database/transactor"
+	"healthcare-app/pkg/utils/storageutils"
+)
+
+type ConsultationUseCase interface {
+	GetAllMyConsultations(ctx context.Context, request *dtoConsultation.GetConsultationRequest) ([]*dtoConsultation.ConsultationResponse, error)
+	GetMyConsultation(ctx context.Context, id int64, participantID int64) (*dtoConsultation.ConsultationResponse, error)
+	PostNewConsultation(ctx context.Context, reqBody *dtoConsultation.RequestConsultation) (*dtoConsultation.ConsultationResponse, error)
+	CancelMyConsultation(ctx context.Context, id int64, userID int64) (*dtoConsultation.ConsultationResponse, error)
+	AcceptConsultation(ctx context.Context, id int64, doctorID int64) (*dtoConsultation.ConsultationResponse, error)
+	RejectConsultation(ctx context.Context, id int64, doctorID int64) (*dtoConsultation.ConsultationResponse, error)
+	EndConsultation(ctx context.Context, reqBody *dtoConsultation.RequestConsultationNote) (*dtoConsultation.ConsultationResponse, error)
+	GetAllMessages(ctx context.Context, id int64, participantID int64) ([]*dtoConsultation.ConsultationMessageResponse, error)
+	PostNewMessage(ctx context.Context, reqBody *dtoConsultation.RequestConsultationMessage) (*dtoConsultation.ConsultationMessageResponse, error)
+	GetAllMyNotes(ctx context.Context, userID int64) ([]*dtoConsultation.ConsultationNoteResponse, error)
+}
+
+type consultationUseCaseImpl struct {
+	consultationRepo consultationRepository.ConsultationRepository
+	messageRepo      consultationRepository.ConsultationMessageRepository
+	noteRepo         consultationRepository.ConsultationNoteRepository
+	doctorRepo       doctorRepository.DoctorRepository
+	dependentRepo    profileRepository.DependentRepository
+	objectStorage    storageutils.ObjectStorage
+	transactor       transactor.Transactor
+}
+
+func NewConsultationUseCase(
+	consultationRepo consultationRepository.ConsultationRepository,
+	messageRepo consultationRepository.ConsultationMessageRepository,
+	noteRepo consultationRepository.ConsultationNoteRepository,
+	doctorRepo doctorRepository.DoctorRepository,
+	dependentRepo profileRepository.DependentRepository,
+	objectStorage storageutils.ObjectStorage,
+	transactor transactor.Transactor,
+) *consultationUseCaseImpl {
+	return &consultationUseCaseImpl{
+		consultationRepo: consultationRepo,
+		messageRepo:      messageRepo,
+		noteRepo:         noteRepo,
+		doctorRepo:       doctorRepo,
+		dependentRepo:    dependentRepo,
+		objectStorage:    objectStorage,
+		transactor:       transactor,
+	}
+}
+
+func (u *consultationUseCaseImpl) GetAllMyConsultations(ctx context.Context, request *dtoConsultation.GetConsultationRequest) ([]*dtoConsultation.ConsultationResponse, error) {
+	var (
+		consultations []*entity.Consultation
+		err           error
+	)
+	if request.Role == constantAuth.DOCTOR {
+		consultations, err = u.consultationRepo.FindAllByDoctorID(ctx, request.UserID, request.Status)
+	} else {
+		consultations, err = u.consultationRepo.FindAllByUserID(ctx, request.UserID, request.Status)
+	}
+	if err != nil {
+		return nil, apperrorPkg.NewServerError(err)
+	}
+	return dtoConsultation.ConvertToConsultationResponses(consultations), nil
+}
+
+func (u *consultationUseCaseImpl) GetMyConsultation(ctx context.Context, id int64, participantID int64) (*dtoConsultation.ConsultationResponse, error) {
+	consultation, err := u.consultationRepo.FindByIDAndParticipantID(ctx, id, participantID)
+	if err != nil {
+		return nil, err
+	}
+
+	consultation.Note, err = u.noteRepo.FindByConsultationID(ctx, consultation.ID)
+	if err != nil {
+		return nil, apperrorPkg.NewServerError(err)
+	}
+	return dtoConsultation.ConvertToConsultationResponse(consultation), nil
+}
+
+func (u *consultationUseCaseImpl) PostNewConsultation(ctx context.Context, reqBody *dtoConsultation.RequestConsultation) (*dtoConsultation.ConsultationResponse, error) {
+	consultation := dtoConsultation.ConsultationRequestToEntity(reqBody)
+	err := u.transactor.Atomic(ctx, func(cForTx context.Context) error {
+		doctor, err := u.doctorRepo.FindByID(cForTx, consultation.DoctorID)
+		if err != nil {
+			return err
+		}
+		if !doctor.IsOnline {
+			return apperrorConsultation.NewInvalidConsultationDoctorError()
+		}
+		if consultation.DependentID != nil {
+			if _, err := u.dependentRepo.FindByIDAndUserID(cForTx, *consultation.DependentID, consultation.UserID); err != nil {
+				return err
+			}
+		}
+
+		isActive, err := u.consultationRepo.IsActiveExists(cForTx, consultation.UserID, consultation.DoctorID)
+		if err != nil {
+			return apperrorPkg.NewServerError(err)
+		}
+		if isActive {
+			return apperrorConsultation.NewInvalidConsultationActiveError()
+		}
+
+		consultation.ConsultationFee = doctor.ConsultationFee
+		if err := u.consultationRepo.Save(cForTx, consultation); err != nil {
+			return apperrorPkg.NewServerError(err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return u.GetMyConsultation(ctx, consultation.ID, consultation.UserID)
+}
+
+func (u *consultationUseCaseImpl) CancelMyConsultation(ctx context.Context, id int64, userID int64) (*dtoConsultation.ConsultationResponse, error) {
+	return u.moveStatus(ctx, id, userID, constantAuth.USER, constant.STATUS_WAITING, constant.STATUS_CANCELLED)
+}
+
+func (u *consultationUseCaseImpl) AcceptConsultation(ctx context.Context, id int64, doctorID int64) (*dtoConsultation.ConsultationResponse, error) {
+	return u.moveStatus(ctx, id, doctorID, constantAuth.DOCTOR, constant.STATUS_WAITING, constant.STATUS_ONGOING)
+}
+
+func (u *consultationUseCaseImpl) RejectConsultation(ctx context.Context, id int64, doctorID int64) (*dtoConsultation.ConsultationResponse, error) {
+	return u.moveStatus(ctx, id, doctorID, constantAuth.DOCTOR, constant.STATUS_WAITING, constant.STATUS_REJECTED)
+}
+
+// EndConsultation closes an ongoing session with the doctor's note, the note is
+// kept against the user so it stays available after the chat is gone.
+func (u *consultationUseCaseImpl) EndConsultation(ctx context.Context, reqBody *dtoConsultation.RequestConsultationNote) (*dtoConsultation.ConsultationResponse, error) {
+	err := u.transactor.Atomic(ctx, func(cForTx context.Context) error {
+		consultation, err := u.findConsultation(cForTx, reqBody.ID, reqBody.DoctorID, constantAuth.DOCTOR)
+		if err != nil {
+			return err
+		}
+		if consultation.Status != constant.STATUS_ONGOING {
+			return apperrorConsultation.NewInvalidConsultationStatusError(constant.STATUS_ONGOING)
+		}
+
+		consultation.Status = constant.STATUS_COMPLETED
+		if err := u.consultationRepo.UpdateStatus(cForTx, consultation, constant.STATUS_ONGOING); err != nil {
+			return err
+		}
+
+		note := &entity.ConsultationNote{
+			ConsultationID: consultation.ID,
+			UserID:         consultation.UserID,
+			DoctorID:       consultation.DoctorID,
+			DependentID:    consultation.DependentID,
+			Diagnosis:      reqBody.Diagnosis,
+			Advice:         reqBody.Advice,
+		}
+		if err := u.noteRepo.Save(cForTx, note); err != nil {
+			return apperrorPkg.NewServerError(err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return u.GetMyConsultation(ctx, reqBody.ID, reqBody.DoctorID)
+}
+
+func (u *consultationUseCaseImpl) GetAllMessages(ctx context.Context, id int64, participantID int64) ([]*dtoConsultation.ConsultationMessageResponse, error) {
+	consultation, err := u.consultationRepo.FindByIDAndParticipantID(ctx, id, participantID)
+	if err != nil {
+		return nil, err
+	}
+
+	messages, err := u.messageRepo.FindAllByConsultationID(ctx, consultation.ID)
+	if err != nil {
+		return nil, apperrorPkg.NewServerError(err)
+	}
+	for _, message := range messages {
+		if err := u.signAttachment(ctx, message); err != nil {
+			return nil, apperrorPkg.NewServerError(err)
+		}
+	}
+	return dtoConsultation.ConvertToConsultationMessageResponses(messages), nil
+}
+
+func (u *consultationUseCaseImpl) PostNewMessage(ctx context.Context, reqBody *dtoConsultation.RequestConsultationMessage) (*dtoConsultation.ConsultationMessageResponse, error) {
+	if reqBody.Message != nil {
+		trimmed := strings.TrimSpace(*reqBody.Message)
+		reqBody.Message = &trimmed
+		if trimmed == "" {
+			reqBody.Message = nil
+		}
+	}
+	if reqBody.Message == nil && reqBody.Attachment == nil {
+		return nil, apperrorConsultation.NewInvalidConsultationMessageError()
+	}
+
+	consultation, err := u.consultationRepo.FindByIDAndParticipantID(ctx, reqBody.ConsultationID, reqBody.SenderID)
+	if err != nil {
+		return nil, err
+	}
+	if consultation.Status != constant.STATUS_ONGOING {
+		return nil, apperrorConsultation.NewInvalidConsultationStatusError(constant.STATUS_ONGOING)
+	}
+
+	message := &entity.ConsultationMessage{
+		ConsultationID: consultation.ID,
+		SenderID:       reqBody.SenderID,
+		Message:        reqBody.Message,
+	}
+	if reqBody.Attachment != nil {
+		message.AttachmentURL, message.AttachmentType, err = u.uploadAttachment(ctx, reqBody.Attachment, utils.GenerateAttachmentTitle(consultation.ID, reqBody.SenderID))
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if err := u.messageRepo.Save(ctx, message); err != nil {
+		return nil, apperrorPkg.NewServerError(err)
+	}
+	if err := u.signAttachment(ctx, message); err != nil {
+		return nil, apperrorPkg.NewServerError(err)
+	}
+	return dtoConsultation.ConvertToConsultationMessageResponse(message), nil
+}
+
+func (u *consultationUseCaseImpl) GetAllMyNotes(ctx context.Context, userID int64) ([]*dtoConsultation.ConsultationNoteResponse, error) {
+	notes, err := u.noteRepo.FindAllByUserID(ctx, userID)
+	if err != nil {
+		return nil, apperrorPkg.NewServerError(err)
+	}
+	return dtoConsultation.ConvertToConsultationNoteResponses(notes), nil
+}
+
+func (u *consultationUseCaseImpl) moveStatus(ctx context.Context, id int64, participantID int64, role int, fromStatus string, toStatus string) (*dtoConsultation.ConsultationResponse, error) {
+	consultation, err := u.findConsultation(ctx, id, participantID, role)
+	if err != nil {
+		return nil, err
+	}
+	if consultation.Status != fromStatus {
+		return nil, apperrorConsultation.NewInvalidConsultationStatusError(fromStatus)
+	}
+
+	consultation.Status = toStatus
+	if err := u.consultationRepo.UpdateStatus(ctx, consultation, fromStatus); err != nil {
+		return nil, err
+	}
+	return dtoConsultation.ConvertToConsultationResponse(consultation), nil
+}
+
+// findConsultation makes sure the participant is on the expected side of the
+// consultation, a user cannot accept and a doctor cannot cancel.
+func (u *consultationUseCaseImpl) findConsultation(ctx context.Context, id int64, participantID int64, role int) (*entity.Consultation, error) {
+	consultation, err := u.consultationRepo.FindByIDAndParticipantID(ctx, id, participantID)
+	if err != nil {
+		return nil, err
+	}
+	if (role == constantAuth.DOCTOR && consultation.DoctorID != participantID) || (role == constantAuth.USER && consultation.UserID != participantID) {
+		return nil, apperrorPkg.NewEntityNotFoundError("consultation")
+	}
+	return consultation, nil
+}
+
+func (u *consultationUseCaseImpl) uploadAttachment(ctx context.Context, header *multipart.FileHeader, key string) (*string, *string, error) {
+	if header.Size > constant.MAX_ATTACHMENT_SIZE {
+		return nil, nil, apperrorConsultation.NewInvalidConsultationAttachmentError()
+	}
+
+	f, err := header.Open()
+	if err != nil {
+		return nil, nil, apperrorConsultation.NewInvalidConsultationAttachmentError()
+	}
+	defer f.Close()
+
+	data, err := io.ReadAll(f)
+	if err != nil {
+		return nil, nil, apperrorPkg.NewServerError(err)
+	}
+	contentType := http.DetectContentType(data)
+	if _, ok := constant.AllowedAttachmentTypes[contentType]; !ok {
+		return nil, nil, apperrorConsultation.NewInvalidConsultationAttachmentError()
+	}
+
+	attachmentKey, err := u.objectStorage.Upload(ctx, data, storageutils.UploadParams{Key: key, Private: true})
+	if err != nil {
+		return nil, nil, apperrorPkg.NewServerError(err)
+	}
+	return &attachmentKey, &contentType, nil
+}
+
+func (u *consultationUseCaseImpl) signAttachment(ctx context.Context, message *entity.ConsultationMessage) error {
+	if message.AttachmentURL == nil {
+		return nil
+	}
+
+	signedURL, err := u.objectStorage.SignedURL(ctx, *message.AttachmentURL)
+	if err != nil {
+		return err
+	}
+	message.AttachmentURL = &signedURL
+	return nil
+}
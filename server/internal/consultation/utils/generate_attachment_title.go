@@ -0,0 +1,10 @@
+package utils
+
+import (
+	"fmt"
+	"time"
+)
+
+func GenerateAttachmentTitle(consultationID, senderID int64) string {
+	return fmt.Sprintf("consultation-%v-%v-%v", consultationID, senderID, time.Now().UnixNano())
+}
@@ -0,0 +1,20 @@
+package apperror
+
+import (
+	"errors"
+
+	"healthcare-app/internal/doctor/constant"
+	"healthcare-app/pkg/apperror"
+)
+
+func NewInvalidLicenseNumberAlreadyExistsError() *apperror.AppError {
+	msg := constant.InvalidLicenseNumberAlreadyExists
+	err := errors.New(msg)
+	return apperror.NewAppError(err, apperror.DefaultClientErrorCode, msg)
+}
+
+func NewInvalidDoctorHasConsultationError() *apperror.AppError {
+	msg := constant.InvalidDoctorHasConsultation
+	err := errors.New(msg)
+	return apperror.NewAppError(err, apperror.DefaultClientErrorCode, msg)
+}
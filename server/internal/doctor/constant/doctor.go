@@ -0,0 +1,19 @@
+package constant
+
+var (
+	DoctorAllowedSorts = map[string]string{
+		"date":       "u.created_at",
+		"name":       "ud.full_name",
+		"fee":        "dd.consultation_fee",
+		"experience": "ud.years_of_experience",
+	}
+	AllowedOrderDir = map[string]struct{}{
+		"asc":  {},
+		"desc": {},
+	}
+)
+
+const (
+	DEFAULT_IMAGE_URL = "https://www.kindpng.com/picc/m/24-248253_user-profile-default-image-png-clipart-png-download.png"
+	PASSWORD_LENGTH   = 12
+)
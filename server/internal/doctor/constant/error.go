@@ -0,0 +1,6 @@
+package constant
+
+const (
+	InvalidLicenseNumberAlreadyExists = "license number already exists"
+	InvalidDoctorHasConsultation      = "doctor still has waiting or ongoing consultations"
+)
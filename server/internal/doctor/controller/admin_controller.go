@@ -0,0 +1,99 @@
+package controller
+
+import (
+	"strconv"
+
+	dtoDoctor "healthcare-app/internal/doctor/dto"
+	"healthcare-app/internal/doctor/usecase"
+	"healthcare-app/pkg/apperror"
+	"healthcare-app/pkg/utils/ginutils"
+	"healthcare-app/pkg/utils/pageutils"
+
+	"github.com/gin-gonic/gin"
+)
+
+type AdminDoctorController struct {
+	doctorUseCase usecase.DoctorUseCase
+}
+
+func NewAdminDoctorController(doctorUseCase usecase.DoctorUseCase) *AdminDoctorController {
+	return &AdminDoctorController{
+		doctorUseCase: doctorUseCase,
+	}
+}
+
+func (c *AdminDoctorController) SearchDoctor(ctx *gin.Context) {
+	req := new(dtoDoctor.SearchDoctorRequest)
+	if err := ctx.ShouldBindQuery(req); err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	res, paging, err := c.doctorUseCase.Search(ctx, req)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+	paging.Links = pageutils.CreateLinks(ctx.Request, int(paging.Page), int(paging.Size), int(paging.TotalItem), int(paging.TotalPage))
+	ginutils.ResponseOKPagination(ctx, res, paging)
+}
+
+func (c *AdminDoctorController) GetDoctorByID(ctx *gin.Context) {
+	doctorID, err := strconv.Atoi(ctx.Param("doctorId"))
+	if err != nil {
+		ctx.Error(apperror.NewInvalidIdError())
+		return
+	}
+	res, err := c.doctorUseCase.GetDoctorByID(ctx, int64(doctorID))
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+	ginutils.ResponseOK(ctx, res)
+}
+
+func (c *AdminDoctorController) CreateAccount(ctx *gin.Context) {
+	req := new(dtoDoctor.RequestDoctorCreateAccount)
+	if err := ctx.ShouldBindJSON(req); err != nil {
+		ctx.Error(err)
+		return
+	}
+	res, err := c.doctorUseCase.CreateAccountDoctor(ctx, req)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+	ginutils.ResponseCreated(ctx, res)
+}
+
+func (c *AdminDoctorController) UpdateAccount(ctx *gin.Context) {
+	doctorID, err := strconv.Atoi(ctx.Param("doctorId"))
+	if err != nil {
+		ctx.Error(apperror.NewInvalidIdError())
+		return
+	}
+	req := &dtoDoctor.RequestDoctorUpdateAccount{DoctorID: int64(doctorID)}
+	if err := ctx.ShouldBindJSON(req); err != nil {
+		ctx.Error(err)
+		return
+	}
+	res, err := c.doctorUseCase.UpdateAccount(ctx, req)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+	ginutils.ResponseOK(ctx, res)
+}
+
+func (c *AdminDoctorController) DeleteAccount(ctx *gin.Context) {
+	doctorID, err := strconv.Atoi(ctx.Param("doctorId"))
+	if err != nil {
+		ctx.Error(apperror.NewInvalidIdError())
+		return
+	}
+	if err := c.doctorUseCase.DeleteAccount(ctx, int64(doctorID)); err != nil {
+		ctx.Error(err)
+		return
+	}
+	ginutils.ResponseOKPlain(ctx)
+}
@@ -0,0 +1,77 @@
+package controller
+
+import (
+	"strconv"
+
+	"healthcare-app/internal/auth/utils"
+	dtoDoctor "healthcare-app/internal/doctor/dto"
+	"healthcare-app/internal/doctor/usecase"
+	"healthcare-app/pkg/apperror"
+	"healthcare-app/pkg/utils/ginutils"
+	"healthcare-app/pkg/utils/pageutils"
+
+	"github.com/gin-gonic/gin"
+)
+
+type DoctorController struct {
+	doctorUseCase usecase.DoctorUseCase
+}
+
+func NewDoctorController(doctorUseCase usecase.DoctorUseCase) *DoctorController {
+	return &DoctorController{
+		doctorUseCase: doctorUseCase,
+	}
+}
+
+func (c *DoctorController) SearchDoctor(ctx *gin.Context) {
+	req := new(dtoDoctor.SearchDoctorRequest)
+	if err := ctx.ShouldBindQuery(req); err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	res, paging, err := c.doctorUseCase.SearchProfile(ctx, req)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+	paging.Links = pageutils.CreateLinks(ctx.Request, int(paging.Page), int(paging.Size), int(paging.TotalItem), int(paging.TotalPage))
+	ginutils.ResponseOKPagination(ctx, res, paging)
+}
+
+func (c *DoctorController) GetDoctorByID(ctx *gin.Context) {
+	doctorID, err := strconv.Atoi(ctx.Param("doctorId"))
+	if err != nil {
+		ctx.Error(apperror.NewInvalidIdError())
+		return
+	}
+	res, err := c.doctorUseCase.GetDoctorProfileByID(ctx, int64(doctorID))
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+	ginutils.ResponseOK(ctx, res)
+}
+
+func (c *DoctorController) GetMyDoctorProfile(ctx *gin.Context) {
+	res, err := c.doctorUseCase.GetDoctorByID(ctx, utils.GetValueUserIdFromToken(ctx))
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+	ginutils.ResponseOK(ctx, res)
+}
+
+func (c *DoctorController) PatchMyStatus(ctx *gin.Context) {
+	req := new(dtoDoctor.RequestDoctorStatus)
+	if err := ctx.ShouldBindJSON(req); err != nil {
+		ctx.Error(err)
+		return
+	}
+	res, err := c.doctorUseCase.PatchMyStatus(ctx, req, utils.GetValueUserIdFromToken(ctx))
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+	ginutils.ResponseOK(ctx, res)
+}
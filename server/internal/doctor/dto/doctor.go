@@ -0,0 +1,111 @@
+package dto
+
+import (
+	"time"
+
+	"healthcare-app/internal/doctor/entity"
+
+	"github.com/shopspring/decimal"
+)
+
+type DoctorResponse struct {
+	ID                int64           `json:"id"`
+	Name              string          `json:"name"`
+	Email             string          `json:"email"`
+	WhatsappNumber    string          `json:"whatsapp_number"`
+	YearsOfExperience int             `json:"years_of_experience"`
+	ImageUrl          string          `json:"image_url"`
+	LicenseNumber     string          `json:"license_number"`
+	Specialization    string          `json:"specialization"`
+	ConsultationFee   decimal.Decimal `json:"consultation_fee"`
+	IsOnline          bool            `json:"is_online"`
+	CreatedAt         time.Time       `json:"created_at"`
+}
+
+type DoctorProfileResponse struct {
+	ID                int64           `json:"id"`
+	Name              string          `json:"name"`
+	YearsOfExperience int             `json:"years_of_experience"`
+	ImageUrl          string          `json:"image_url"`
+	LicenseNumber     string          `json:"license_number"`
+	Specialization    string          `json:"specialization"`
+	ConsultationFee   decimal.Decimal `json:"consultation_fee"`
+	IsOnline          bool            `json:"is_online"`
+}
+
+type SearchDoctorRequest struct {
+	SortBy         []string `form:"sort-by"`
+	Sort           []string `form:"sort"`
+	Name           string   `form:"name"`
+	Specialization string   `form:"specialization"`
+	IsOnline       *bool    `form:"is-online"`
+	Limit          int64    `form:"limit" binding:"numeric,gte=1,lte=25"`
+	Page           int64    `form:"page" binding:"numeric,gte=1"`
+}
+
+type RequestDoctorCreateAccount struct {
+	Email             string          `json:"email" binding:"required,email"`
+	Fullname          string          `json:"full_name" binding:"required"`
+	LicenseNumber     string          `json:"license_number" binding:"required"`
+	Specialization    string          `json:"specialization" binding:"required,max=255"`
+	ConsultationFee   decimal.Decimal `json:"consultation_fee" binding:"required,dgte=1"`
+	WhatsappNumber    string          `json:"whatsapp_number" binding:"required,phone_number"`
+	YearsOfExperience int             `json:"years_of_experience" binding:"required,max=70,gte=0"`
+}
+
+type RequestDoctorUpdateAccount struct {
+	DoctorID          int64            `json:"-"`
+	WhatsappNumber    *string          `json:"whatsapp_number" binding:"omitempty,phone_number"`
+	YearsOfExperience *int             `json:"years_of_experience" binding:"omitempty,max=70,gte=0"`
+	Specialization    *string          `json:"specialization" binding:"omitempty,max=255"`
+	ConsultationFee   *decimal.Decimal `json:"consultation_fee" binding:"omitempty,dgte=1"`
+}
+
+type RequestDoctorStatus struct {
+	IsOnline *bool `json:"is_online" binding:"required"`
+}
+
+func ConvertToDoctorResponses(doctors []*entity.Doctor) []*DoctorResponse {
+	responses := []*DoctorResponse{}
+	for _, doctor := range doctors {
+		responses = append(responses, ConvertToDoctorResponse(doctor))
+	}
+	return responses
+}
+
+func ConvertToDoctorResponse(doctor *entity.Doctor) *DoctorResponse {
+	return &DoctorResponse{
+		ID:                doctor.ID,
+		Name:              doctor.Fullname,
+		Email:             doctor.Email,
+		WhatsappNumber:    doctor.WhatsappNumber,
+		YearsOfExperience: doctor.YearsOfExperience,
+		ImageUrl:          doctor.ImageUrl,
+		LicenseNumber:     doctor.LicenseNumber,
+		Specialization:    doctor.Specialization,
+		ConsultationFee:   doctor.ConsultationFee,
+		IsOnline:          doctor.IsOnline,
+		CreatedAt:         doctor.CreatedAt,
+	}
+}
+
+func ConvertToDoctorProfileResponses(doctors []*entity.Doctor) []*DoctorProfileResponse {
+	responses := []*DoctorProfileResponse{}
+	for _, doctor := range doctors {
+		responses = append(responses, ConvertToDoctorProfileResponse(doctor))
+	}
+	return responses
+}
+
+func ConvertToDoctorProfileResponse(doctor *entity.Doctor) *DoctorProfileResponse {
+	return &DoctorProfileResponse{
+		ID:                doctor.ID,
+		Name:              doctor.Fullname,
+		YearsOfExperience: doctor.YearsOfExperience,
+		ImageUrl:          doctor.ImageUrl,
+		LicenseNumber:     doctor.LicenseNumber,
+		Specialization:    doctor.Specialization,
+		ConsultationFee:   doctor.ConsultationFee,
+		IsOnline:          doctor.IsOnline,
+	}
+}
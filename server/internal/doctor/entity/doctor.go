@@ -0,0 +1,21 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+type Doctor struct {
+	ID                int64
+	Email             string
+	Fullname          string
+	WhatsappNumber    string
+	YearsOfExperience int
+	ImageUrl          string
+	LicenseNumber     string
+	Specialization    string
+	ConsultationFee   decimal.Decimal
+	IsOnline          bool
+	CreatedAt         time.Time
+}
@@ -0,0 +1,355 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+
+	"healthcare-app/internal/auth/constant"
+	"healthcare-app/internal/doctor/dto"
+	"healthcare-app/internal/doctor/entity"
+	apperrorPkg "healthcare-app/pkg/apperror"
+	"healthcare-app/pkg/database/transactor"
+)
+
+const iLike = "%%%v%%"
+
+type DoctorRepository interface {
+	Search(ctx context.Context, request *dto.SearchDoctorRequest) ([]*entity.Doctor, error)
+	FindByID(ctx context.Context, id int64) (*entity.Doctor, error)
+	IsLicenseNumberExists(ctx context.Context, licenseNumber string) (bool, error)
+	HasActiveConsultation(ctx context.Context, id int64) (bool, error)
+	SaveUser(ctx context.Context, doctor *entity.Doctor, hashPassword string) error
+	SaveDetail(ctx context.Context, doctor *entity.Doctor) error
+	Update(ctx context.Context, doctor *entity.Doctor) error
+	UpdateStatus(ctx context.Context, id int64, isOnline bool) error
+	DeleteByID(ctx context.Context, id int64) error
+}
+
+type doctorRepositoryImpl struct {
+	db *sql.DB
+}
+
+func NewDoctorRepository(db *sql.DB) *doctorRepositoryImpl {
+	return &doctorRepositoryImpl{
+		db: db,
+	}
+}
+
+const doctorSelectQuery = `
+	select u.id, u.email, ud.full_name, ud.whatsapp_number, ud.years_of_experience, ud.image_url, dd.license_number, dd.specialization, dd.consultation_fee, dd.is_online, u.created_at
+	from users u
+	join user_details ud on ud.user_id = u.id
+	join doctor_details dd on dd.user_id = u.id
+	where u.deleted_at is null and dd.deleted_at is null and u.role = $1
+`
+
+func (r *doctorRepositoryImpl) Search(ctx context.Context, request *dto.SearchDoctorRequest) ([]*entity.Doctor, error) {
+	args := []any{constant.DOCTOR}
+	queryBuilder := strings.Builder{}
+	queryBuilder.WriteString(doctorSelectQuery)
+
+	if request.Name != "" {
+		queryBuilder.WriteString(fmt.Sprintf(" and ud.full_name ilike $%v", len(args)+1))
+		args = append(args, fmt.Sprintf(iLike, request.Name))
+	}
+	if request.Specialization != "" {
+		queryBuilder.WriteString(fmt.Sprintf(" and dd.specialization ilike $%v", len(args)+1))
+		args = append(args, fmt.Sprintf(iLike, request.Specialization))
+	}
+	if request.IsOnline != nil {
+		queryBuilder.WriteString(fmt.Sprintf(" and dd.is_online = $%v", len(args)+1))
+		args = append(args, *request.IsOnline)
+	}
+
+	if len(request.SortBy) > 0 {
+		queryBuilder.WriteString(" order by ")
+		for i, ord := range request.SortBy {
+			if i > 0 {
+				queryBuilder.WriteString(", ")
+			}
+			queryBuilder.WriteString(fmt.Sprintf("%s %s", ord, request.Sort[i]))
+		}
+	}
+
+	tx := transactor.ExtractTx(ctx)
+
+	var (
+		err  error
+		rows *sql.Rows
+	)
+	if tx != nil {
+		rows, err = tx.QueryContext(ctx, queryBuilder.String(), args...)
+	} else {
+		rows, err = r.db.QueryContext(ctx, queryBuilder.String(), args...)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	doctors := []*entity.Doctor{}
+	for rows.Next() {
+		doctor := new(entity.Doctor)
+		if err := rows.Scan(
+			&doctor.ID,
+			&doctor.Email,
+			&doctor.Fullname,
+			&doctor.WhatsappNumber,
+			&doctor.YearsOfExperience,
+			&doctor.ImageUrl,
+			&doctor.LicenseNumber,
+			&doctor.Specialization,
+			&doctor.ConsultationFee,
+			&doctor.IsOnline,
+			&doctor.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		doctors = append(doctors, doctor)
+	}
+
+	err = rows.Err()
+	if err != nil {
+		return nil, err
+	}
+	return doctors, nil
+}
+
+func (r *doctorRepositoryImpl) FindByID(ctx context.Context, id int64) (*entity.Doctor, error) {
+	query := doctorSelectQuery + " and u.id = $2"
+	tx := transactor.ExtractTx(ctx)
+
+	var (
+		err    error
+		doctor entity.Doctor
+	)
+	if tx != nil {
+		err = tx.QueryRowContext(ctx, query, constant.DOCTOR, id).Scan(
+			&doctor.ID,
+			&doctor.Email,
+			&doctor.Fullname,
+			&doctor.WhatsappNumber,
+			&doctor.YearsOfExperience,
+			&doctor.ImageUrl,
+			&doctor.LicenseNumber,
+			&doctor.Specialization,
+			&doctor.ConsultationFee,
+			&doctor.IsOnline,
+			&doctor.CreatedAt,
+		)
+	} else {
+		err = r.db.QueryRowContext(ctx, query, constant.DOCTOR, id).Scan(
+			&doctor.ID,
+			&doctor.Email,
+			&doctor.Fullname,
+			&doctor.WhatsappNumber,
+			&doctor.YearsOfExperience,
+			&doctor.ImageUrl,
+			&doctor.LicenseNumber,
+			&doctor.Specialization,
+			&doctor.ConsultationFee,
+			&doctor.IsOnline,
+			&doctor.CreatedAt,
+		)
+	}
+
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, apperrorPkg.NewEntityNotFoundError("doctor")
+		}
+		return nil, err
+	}
+	return &doctor, nil
+}
+
+func (r *doctorRepositoryImpl) IsLicenseNumberExists(ctx context.Context, licenseNumber string) (bool, error) {
+	query := `
+		select exists(select 1 from doctor_details where license_number = $1)
+	`
+	tx := transactor.ExtractTx(ctx)
+
+	var (
+		err    error
+		exists bool
+	)
+	if tx != nil {
+		err = tx.QueryRowContext(ctx, query, licenseNumber).Scan(&exists)
+	} else {
+		err = r.db.QueryRowContext(ctx, query, licenseNumber).Scan(&exists)
+	}
+
+	if err != nil {
+		return false, err
+	}
+	return exists, nil
+}
+
+func (r *doctorRepositoryImpl) HasActiveConsultation(ctx context.Context, id int64) (bool, error) {
+	query := `
+		select exists(select 1 from consultations where doctor_id = $1 and status in ('WAITING', 'ONGOING'))
+	`
+	tx := transactor.ExtractTx(ctx)
+
+	var (
+		err    error
+		exists bool
+	)
+	if tx != nil {
+		err = tx.QueryRowContext(ctx, query, id).Scan(&exists)
+	} else {
+		err = r.db.QueryRowContext(ctx, query, id).Scan(&exists)
+	}
+
+	if err != nil {
+		return false, err
+	}
+	return exists, nil
+}
+
+// SaveUser creates the login account of the doctor, accounts made by the admin
+// are verified right away like the pharmacist ones.
+func (r *doctorRepositoryImpl) SaveUser(ctx context.Context, doctor *entity.Doctor, hashPassword string) error {
+	query := `
+		insert into users(role, email, hash_password, is_verified)
+		values ($1, $2, $3, true) returning id, created_at
+	`
+	tx := transactor.ExtractTx(ctx)
+
+	var err error
+	if tx != nil {
+		err = tx.QueryRowContext(ctx, query, constant.DOCTOR, doctor.Email, hashPassword).Scan(&doctor.ID, &doctor.CreatedAt)
+	} else {
+		err = r.db.QueryRowContext(ctx, query, constant.DOCTOR, doctor.Email, hashPassword).Scan(&doctor.ID, &doctor.CreatedAt)
+	}
+
+	return err
+}
+
+func (r *doctorRepositoryImpl) SaveDetail(ctx context.Context, doctor *entity.Doctor) error {
+	query := `
+		with detail as (
+			insert into user_details(user_id, full_name, whatsapp_number, years_of_experience, image_url)
+			values ($1, $2, $3, $4, $5)
+		)
+		insert into doctor_details(user_id, license_number, specialization, consultation_fee)
+		values ($1, $6, $7, $8)
+	`
+	tx := transactor.ExtractTx(ctx)
+
+	var err error
+	if tx != nil {
+		_, err = tx.ExecContext(
+			ctx,
+			query,
+			doctor.ID,
+			doctor.Fullname,
+			doctor.WhatsappNumber,
+			doctor.YearsOfExperience,
+			doctor.ImageUrl,
+			doctor.LicenseNumber,
+			doctor.Specialization,
+			doctor.ConsultationFee,
+		)
+	} else {
+		_, err = r.db.ExecContext(
+			ctx,
+			query,
+			doctor.ID,
+			doctor.Fullname,
+			doctor.WhatsappNumber,
+			doctor.YearsOfExperience,
+			doctor.ImageUrl,
+			doctor.LicenseNumber,
+			doctor.Specialization,
+			doctor.ConsultationFee,
+		)
+	}
+
+	return err
+}
+
+func (r *doctorRepositoryImpl) Update(ctx context.Context, doctor *entity.Doctor) error {
+	query := `
+		with detail as (
+			update user_details set whatsapp_number = $2, years_of_experience = $3, updated_at = now()
+			where user_id = $1
+		)
+		update doctor_details set specialization = $4, consultation_fee = $5, updated_at = now()
+		where user_id = $1 and deleted_at is null
+	`
+	tx := transactor.ExtractTx(ctx)
+
+	var err error
+	if tx != nil {
+		_, err = tx.ExecContext(ctx, query, doctor.ID, doctor.WhatsappNumber, doctor.YearsOfExperience, doctor.Specialization, doctor.ConsultationFee)
+	} else {
+		_, err = r.db.ExecContext(ctx, query, doctor.ID, doctor.WhatsappNumber, doctor.YearsOfExperience, doctor.Specialization, doctor.ConsultationFee)
+	}
+
+	return err
+}
+
+func (r *doctorRepositoryImpl) UpdateStatus(ctx context.Context, id int64, isOnline bool) error {
+	query := `
+		update doctor_details set is_online = $2, updated_at = now() where user_id = $1 and deleted_at is null
+	`
+	tx := transactor.ExtractTx(ctx)
+
+	var (
+		err error
+		res sql.Result
+	)
+	if tx != nil {
+		res, err = tx.ExecContext(ctx, query, id, isOnline)
+	} else {
+		res, err = r.db.ExecContext(ctx, query, id, isOnline)
+	}
+	if err != nil {
+		return err
+	}
+
+	rowsAffected, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rowsAffected == 0 {
+		return apperrorPkg.NewEntityNotFoundError("doctor")
+	}
+	return nil
+}
+
+func (r *doctorRepositoryImpl) DeleteByID(ctx context.Context, id int64) error {
+	query := `
+		with account as (
+			update users set deleted_at = now() where id = $1
+		)
+		update doctor_details set is_online = false, deleted_at = now() where user_id = $1 and deleted_at is null
+	`
+	tx := transactor.ExtractTx(ctx)
+
+	var (
+		err error
+		res sql.Result
+	)
+	if tx != nil {
+		res, err = tx.ExecContext(ctx, query, id)
+	} else {
+		res, err = r.db.ExecContext(ctx, query, id)
+	}
+	if err != nil {
+		return err
+	}
+
+	rowsAffected, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rowsAffected == 0 {
+		return apperrorPkg.NewEntityNotFoundError("doctor")
+	}
+	return nil
+}
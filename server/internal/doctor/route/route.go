@@ -0,0 +1,36 @@
+package route
+
+import (
+	"healthcare-app/internal/auth/constant"
+	"healthcare-app/internal/doctor/controller"
+	"healthcare-app/pkg/middleware"
+
+	"github.com/gin-gonic/gin"
+)
+
+const doctorId = "/:doctorId"
+
+func AdminDoctorControllerRoute(c *controller.AdminDoctorController, r *gin.Engine, authMiddleware *middleware.AuthMiddleware) {
+	g := r.Group("/admin/doctors", authMiddleware.Authorization(), authMiddleware.ProtectedRoles(constant.ADMIN))
+	{
+		g.GET("", c.SearchDoctor)
+		g.POST("", c.CreateAccount)
+		g.GET(doctorId, c.GetDoctorByID)
+		g.PUT(doctorId, c.UpdateAccount)
+		g.DELETE(doctorId, c.DeleteAccount)
+	}
+}
+
+func DoctorControllerRoute(c *controller.DoctorController, r *gin.Engine, authMiddleware *middleware.AuthMiddleware) {
+	g := r.Group("/doctors")
+	{
+		g.GET("", c.SearchDoctor)
+		g.GET(doctorId, c.GetDoctorByID)
+	}
+
+	me := r.Group("/doctors/me", authMiddleware.Authorization(), authMiddleware.ProtectedRoles(constant.DOCTOR))
+	{
+		me.GET("", c.GetMyDoctorProfile)
+		me.PATCH("/status", c.PatchMyStatus)
+	}
+}
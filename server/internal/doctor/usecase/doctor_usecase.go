@@ -0,0 +1,267 @@
+package usecase
+
+import (
+	"context"
+	"strings"
+
+	apperrorAuth "healthcare-app/internal/auth/apperror"
+	authRepository "healthcare-app/internal/auth/repository"
+	apperrorDoctor "healthcare-app/internal/doctor/apperror"
+	"healthcare-app/internal/doctor/constant"
+	dtoDoctor "healthcare-app/internal/doctor/dto"
+	"healthcare-app/internal/doctor/entity"
+	"healthcare-app/internal/doctor/repository"
+	constantNotification "healthcare-app/internal/notification/constant"
+	usecaseNotification "healthcare-app/internal/notification/usecase"
+	utilsNotification "healthcare-app/internal/notification/utils"
+	"healthcare-app/internal/queue/payload"
+	"healthcare-app/internal/queue/tasks"
+	apperrorPkg "healthcare-app/pkg/apperror"
+	"healthcare-app/pkg/database/transactor"
+	dtoPkg "healthcare-app/pkg/dto"
+	"healthcare-app/pkg/utils/encryptutils"
+	"healthcare-app/pkg/utils/pageutils"
+	"healthcare-app/pkg/utils/randutils"
+)
+
+type DoctorUseCase interface {
+	Search(ctx context.Context, request *dtoDoctor.SearchDoctorRequest) ([]*dtoDoctor.DoctorResponse, *dtoPkg.PageMetaData, error)
+	SearchProfile(ctx context.Context, request *dtoDoctor.SearchDoctorRequest) ([]*dtoDoctor.DoctorProfileResponse, *dtoPkg.PageMetaData, error)
+	GetDoctorByID(ctx context.Context, id int64) (*dtoDoctor.DoctorResponse, error)
+	GetDoctorProfileByID(ctx context.Context, id int64) (*dtoDoctor.DoctorProfileResponse, error)
+	CreateAccountDoctor(ctx context.Context, reqBody *dtoDoctor.RequestDoctorCreateAccount) (*dtoDoctor.DoctorResponse, error)
+	UpdateAccount(ctx context.Context, reqBody *dtoDoctor.RequestDoctorUpdateAccount) (*dtoDoctor.DoctorResponse, error)
+	DeleteAccount(ctx context.Context, id int64) error
+	PatchMyStatus(ctx context.Context, reqBody *dtoDoctor.RequestDoctorStatus, doctorID int64) (*dtoDoctor.DoctorResponse, error)
+}
+
+type doctorUseCaseImpl struct {
+	passwordEncryptor   encryptutils.PasswordEncryptor
+	emailTask           tasks.EmailTask
+	notificationUseCase usecaseNotification.NotificationUseCase
+	doctorRepo          repository.DoctorRepository
+	userRepo            authRepository.UserRepository
+	transactor          transactor.Transactor
+}
+
+func NewDoctorUseCase(
+	passwordEncryptor encryptutils.PasswordEncryptor,
+	emailTask tasks.EmailTask,
+	notificationUseCase usecaseNotification.NotificationUseCase,
+	doctorRepo repository.DoctorRepository,
+	userRepo authRepository.UserRepository,
+	transactor transactor.Transactor,
+) *doctorUseCaseImpl {
+	return &doctorUseCaseImpl{
+		passwordEncryptor:   passwordEncryptor,
+		emailTask:           emailTask,
+		notificationUseCase: notificationUseCase,
+		doctorRepo:          doctorRepo,
+		userRepo:            userRepo,
+		transactor:          transactor,
+	}
+}
+
+func (u *doctorUseCaseImpl) Search(ctx context.Context, request *dtoDoctor.SearchDoctorRequest) ([]*dtoDoctor.DoctorResponse, *dtoPkg.PageMetaData, error) {
+	doctors, metaData, err := u.search(ctx, request)
+	if err != nil {
+		return nil, nil, err
+	}
+	return dtoDoctor.ConvertToDoctorResponses(doctors), metaData, nil
+}
+
+func (u *doctorUseCaseImpl) SearchProfile(ctx context.Context, request *dtoDoctor.SearchDoctorRequest) ([]*dtoDoctor.DoctorProfileResponse, *dtoPkg.PageMetaData, error) {
+	doctors, metaData, err := u.search(ctx, request)
+	if err != nil {
+		return nil, nil, err
+	}
+	return dtoDoctor.ConvertToDoctorProfileResponses(doctors), metaData, nil
+}
+
+func (u *doctorUseCaseImpl) GetDoctorByID(ctx context.Context, id int64) (*dtoDoctor.DoctorResponse, error) {
+	doctor, err := u.doctorRepo.FindByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return dtoDoctor.ConvertToDoctorResponse(doctor), nil
+}
+
+func (u *doctorUseCaseImpl) GetDoctorProfileByID(ctx context.Context, id int64) (*dtoDoctor.DoctorProfileResponse, error) {
+	doctor, err := u.doctorRepo.FindByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return dtoDoctor.ConvertToDoctorProfileResponse(doctor), nil
+}
+
+func (u *doctorUseCaseImpl) CreateAccountDoctor(ctx context.Context, reqBody *dtoDoctor.RequestDoctorCreateAccount) (*dtoDoctor.DoctorResponse, error) {
+	doctor := &entity.Doctor{
+		Email:             reqBody.Email,
+		Fullname:          reqBody.Fullname,
+		WhatsappNumber:    reqBody.WhatsappNumber,
+		YearsOfExperience: reqBody.YearsOfExperience,
+		ImageUrl:          constant.DEFAULT_IMAGE_URL,
+		LicenseNumber:     reqBody.LicenseNumber,
+		Specialization:    reqBody.Specialization,
+		ConsultationFee:   reqBody.ConsultationFee,
+	}
+
+	password := randutils.GenerateRandomString(constant.PASSWORD_LENGTH)
+	err := u.transactor.Atomic(ctx, func(cForTx context.Context) error {
+		checkEmail, err := u.userRepo.FindByEmail(cForTx, reqBody.Email)
+		if err != nil {
+			return apperrorPkg.NewServerError(err)
+		}
+		if checkEmail != nil {
+			return apperrorAuth.NewInvalidEmailAlreadyExists(nil)
+		}
+		checkLicense, err := u.doctorRepo.IsLicenseNumberExists(cForTx, reqBody.LicenseNumber)
+		if err != nil {
+			return apperrorPkg.NewServerError(err)
+		}
+		if checkLicense {
+			return apperrorDoctor.NewInvalidLicenseNumberAlreadyExistsError()
+		}
+		checkWaNumber, err := u.userRepo.FindByWhatsappNumber(cForTx, reqBody.WhatsappNumber)
+		if err != nil {
+			return apperrorPkg.NewServerError(err)
+		}
+		if checkWaNumber != 0 {
+			return apperrorAuth.NewInvalidWaNumberAlreadyExists()
+		}
+
+		hashPassword, err := u.passwordEncryptor.Hash(password)
+		if err != nil {
+			return apperrorPkg.NewServerError(err)
+		}
+		if err := u.doctorRepo.SaveUser(cForTx, doctor, hashPassword); err != nil {
+			return apperrorPkg.NewServerError(err)
+		}
+		if err := u.doctorRepo.SaveDetail(cForTx, doctor); err != nil {
+			return apperrorPkg.NewServerError(err)
+		}
+		if err := u.notificationUseCase.Notify(cForTx, utilsNotification.NewInviteNotification(doctor.ID, constantNotification.INVITE_ROLE_DOCTOR)); err != nil {
+			return err
+		}
+
+		return u.emailTask.QueueDoctorAccountEmail(cForTx, &payload.DoctorAccountEmailPayload{
+			Email:          doctor.Email,
+			Name:           doctor.Fullname,
+			License:        doctor.LicenseNumber,
+			Specialization: doctor.Specialization,
+			Whatsapp:       doctor.WhatsappNumber,
+			Password:       password,
+			Yoe:            doctor.YearsOfExperience,
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+	return dtoDoctor.ConvertToDoctorResponse(doctor), nil
+}
+
+func (u *doctorUseCaseImpl) UpdateAccount(ctx context.Context, reqBody *dtoDoctor.RequestDoctorUpdateAccount) (*dtoDoctor.DoctorResponse, error) {
+	var doctor *entity.Doctor
+	err := u.transactor.Atomic(ctx, func(cForTx context.Context) error {
+		var err error
+		doctor, err = u.doctorRepo.FindByID(cForTx, reqBody.DoctorID)
+		if err != nil {
+			return err
+		}
+
+		if reqBody.WhatsappNumber != nil && *reqBody.WhatsappNumber != doctor.WhatsappNumber {
+			checkWaNumber, err := u.userRepo.FindByWhatsappNumber(cForTx, *reqBody.WhatsappNumber)
+			if err != nil {
+				return apperrorPkg.NewServerError(err)
+			}
+			if checkWaNumber != 0 {
+				return apperrorAuth.NewInvalidWaNumberAlreadyExists()
+			}
+			doctor.WhatsappNumber = *reqBody.WhatsappNumber
+		}
+		if reqBody.YearsOfExperience != nil {
+			doctor.YearsOfExperience = *reqBody.YearsOfExperience
+		}
+		if reqBody.Specialization != nil {
+			doctor.Specialization = *reqBody.Specialization
+		}
+		if reqBody.ConsultationFee != nil {
+			doctor.ConsultationFee = *reqBody.ConsultationFee
+		}
+
+		if err := u.doctorRepo.Update(cForTx, doctor); err != nil {
+			return apperrorPkg.NewServerError(err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return dtoDoctor.ConvertToDoctorResponse(doctor), nil
+}
+
+func (u *doctorUseCaseImpl) DeleteAccount(ctx context.Context, id int64) error {
+	return u.transactor.Atomic(ctx, func(cForTx context.Context) error {
+		if _, err := u.doctorRepo.FindByID(cForTx, id); err != nil {
+			return err
+		}
+
+		hasConsultation, err := u.doctorRepo.HasActiveConsultation(cForTx, id)
+		if err != nil {
+			return apperrorPkg.NewServerError(err)
+		}
+		if hasConsultation {
+			return apperrorDoctor.NewInvalidDoctorHasConsultationError()
+		}
+
+		return u.doctorRepo.DeleteByID(cForTx, id)
+	})
+}
+
+func (u *doctorUseCaseImpl) PatchMyStatus(ctx context.Context, reqBody *dtoDoctor.RequestDoctorStatus, doctorID int64) (*dtoDoctor.DoctorResponse, error) {
+	if err := u.doctorRepo.UpdateStatus(ctx, doctorID, *reqBody.IsOnline); err != nil {
+		return nil, err
+	}
+
+	doctor, err := u.doctorRepo.FindByID(ctx, doctorID)
+	if err != nil {
+		return nil, err
+	}
+	return dtoDoctor.ConvertToDoctorResponse(doctor), nil
+}
+
+func (u *doctorUseCaseImpl) search(ctx context.Context, request *dtoDoctor.SearchDoctorRequest) ([]*entity.Doctor, *dtoPkg.PageMetaData, error) {
+	allowedSort := []string{}
+	allowedDir := []string{}
+	for i, s := range request.SortBy {
+		ord, ok := constant.DoctorAllowedSorts[strings.ToLower(s)]
+		if !ok {
+			continue
+		}
+
+		dir := ""
+		if len(request.Sort) > i {
+			dir = request.Sort[i]
+		}
+		_, ok = constant.AllowedOrderDir[strings.ToLower(dir)]
+		if !ok {
+			dir = "asc"
+		}
+		allowedSort = append(allowedSort, ord)
+		allowedDir = append(allowedDir, dir)
+	}
+	if len(request.SortBy) == 0 {
+		allowedSort = append(allowedSort, "dd.is_online", "u.created_at")
+		allowedDir = append(allowedDir, "desc", "desc")
+	}
+	request.Sort = allowedDir
+	request.SortBy = allowedSort
+
+	doctors, err := u.doctorRepo.Search(ctx, request)
+	if err != nil {
+		return nil, nil, apperrorPkg.NewServerError(err)
+	}
+
+	res, metaData := pageutils.CreateMetaData(doctors, request.Page, request.Limit)
+	return res, metaData, nil
+}
@@ -0,0 +1,22 @@
+package apperror
+
+import (
+	"errors"
+	"fmt"
+
+	"healthcare-app/internal/email/constant"
+	"healthcare-app/pkg/apperror"
+)
+
+func NewInvalidEmailTemplateCodeError() *apperror.AppError {
+	msg := constant.InvalidEmailTemplateCode
+	err := errors.New(msg)
+	return apperror.NewAppError(err, apperror.DefaultClientErrorCode, msg)
+}
+
+// NewInvalidEmailTemplateError keeps the parser's message, it tells the admin
+// which line of the template is broken.
+func NewInvalidEmailTemplateError(err error) *apperror.AppError {
+	msg := fmt.Sprintf("%v: %v", constant.InvalidEmailTemplate, err)
+	return apperror.NewAppError(err, apperror.DefaultClientErrorCode, msg)
+}
@@ -0,0 +1,64 @@
+package constant
+
+import "healthcare-app/pkg/utils/smtputils"
+
+const (
+	LOCALE_EN      = "en"
+	LOCALE_ID      = "id"
+	DEFAULT_LOCALE = LOCALE_EN
+)
+
+var (
+	// SampleData fills each template when an admin previews or tests it. The
+	// keys follow what the queue processors pass for the same template, Link
+	// is added from the frontend url.
+	SampleData = map[smtputils.EmailTemplate]map[string]any{
+		smtputils.ResetPasswordTemplate: {},
+		smtputils.VerificationTemplate:  {},
+		smtputils.PharmacistTemplate: {
+			"Name":     "Jane Doe",
+			"Sipa":     "SIPA-0001",
+			"Whatsapp": "+6281234567890",
+			"Yoe":      5,
+			"Email":    "jane.doe@example.com",
+			"Password": "Secret123!",
+		},
+		smtputils.DoctorTemplate: {
+			"Name":           "John Doe",
+			"License":        "STR-0001",
+			"Specialization": "General Practitioner",
+			"Whatsapp":       "+6281234567890",
+			"Yoe":            8,
+			"Email":          "john.doe@example.com",
+			"Password":       "Secret123!",
+		},
+		smtputils.RefillReminderTemplate: {
+			"RefillDate": "1 January 2025",
+			"Products":   []string{"Paracetamol 500 mg x 2", "Amoxicillin 250 mg x 1"},
+		},
+		smtputils.RefillShortageTemplate: {
+			"Products": []string{"Paracetamol 500 mg"},
+		},
+		smtputils.NotificationTemplate: {
+			"Title": "It's time to take your medicine",
+			"Body":  "Please take 1 tablet of Paracetamol scheduled at 08:00.",
+		},
+		smtputils.OrderReceiptTemplate:   sampleOrder,
+		smtputils.OrderPaidTemplate:      sampleOrder,
+		smtputils.OrderShippedTemplate:   sampleOrder,
+		smtputils.OrderCancelledTemplate: sampleOrder,
+		smtputils.OrderConfirmedTemplate: sampleOrder,
+	}
+
+	sampleOrder = map[string]any{
+		"Invoice":      "INV/20250101/0001",
+		"PharmacyName": "Favipiravir Pharmacy",
+		"Products": []map[string]any{
+			{"Name": "Paracetamol 500 mg", "Quantity": 2, "Price": "Rp.10000", "Subtotal": "Rp.20000"},
+		},
+		"TotalProductPrice": "Rp.20000",
+		"ShipCost":          "Rp.9000",
+		"TotalPayment":      "Rp.29000",
+		"Reason":            "The product is out of stock.",
+	}
+)
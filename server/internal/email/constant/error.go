@@ -0,0 +1,6 @@
+package constant
+
+const (
+	InvalidEmailTemplateCode = "email template is not supported"
+	InvalidEmailTemplate     = "email template can not be rendered"
+)
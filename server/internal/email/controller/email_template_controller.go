@@ -0,0 +1,139 @@
+package controller
+
+import (
+	"strconv"
+
+	"healthcare-app/internal/auth/utils"
+	"healthcare-app/internal/email/dto"
+	"healthcare-app/internal/email/usecase"
+	"healthcare-app/pkg/apperror"
+	"healthcare-app/pkg/utils/ginutils"
+	"healthcare-app/pkg/utils/pageutils"
+
+	"github.com/gin-gonic/gin"
+)
+
+type EmailTemplateController struct {
+	emailTemplateUseCase usecase.EmailTemplateUseCase
+}
+
+func NewEmailTemplateController(emailTemplateUseCase usecase.EmailTemplateUseCase) *EmailTemplateController {
+	return &EmailTemplateController{
+		emailTemplateUseCase: emailTemplateUseCase,
+	}
+}
+
+func (c *EmailTemplateController) Search(ctx *gin.Context) {
+	req := new(dto.SearchEmailTemplateRequest)
+	if err := ctx.ShouldBindQuery(req); err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	res, paging, err := c.emailTemplateUseCase.Search(ctx, req)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+	paging.Links = pageutils.CreateLinks(ctx.Request, int(paging.Page), int(paging.Size), int(paging.TotalItem), int(paging.TotalPage))
+	ginutils.ResponseOKPagination(ctx, res, paging)
+}
+
+func (c *EmailTemplateController) Get(ctx *gin.Context) {
+	templateID, err := strconv.Atoi(ctx.Param("templateId"))
+	if err != nil {
+		ctx.Error(apperror.NewInvalidIdError())
+		return
+	}
+
+	res, err := c.emailTemplateUseCase.Get(ctx, int64(templateID))
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+	ginutils.ResponseOK(ctx, res)
+}
+
+func (c *EmailTemplateController) Create(ctx *gin.Context) {
+	req := new(dto.EmailTemplateRequest)
+	if err := ctx.ShouldBindJSON(req); err != nil {
+		ctx.Error(err)
+		return
+	}
+	req.CreatedBy = utils.GetValueUserIdFromToken(ctx)
+
+	res, err := c.emailTemplateUseCase.Create(ctx, req)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+	ginutils.ResponseCreated(ctx, res)
+}
+
+func (c *EmailTemplateController) Activate(ctx *gin.Context) {
+	templateID, err := strconv.Atoi(ctx.Param("templateId"))
+	if err != nil {
+		ctx.Error(apperror.NewInvalidIdError())
+		return
+	}
+
+	res, err := c.emailTemplateUseCase.Activate(ctx, int64(templateID))
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+	ginutils.ResponseOK(ctx, res)
+}
+
+func (c *EmailTemplateController) Preview(ctx *gin.Context) {
+	req := new(dto.PreviewEmailTemplateRequest)
+	if err := ctx.ShouldBindJSON(req); err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	res, err := c.emailTemplateUseCase.Preview(ctx, req)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+	ginutils.ResponseOK(ctx, res)
+}
+
+func (c *EmailTemplateController) PreviewByID(ctx *gin.Context) {
+	templateID, err := strconv.Atoi(ctx.Param("templateId"))
+	if err != nil {
+		ctx.Error(apperror.NewInvalidIdError())
+		return
+	}
+
+	res, err := c.emailTemplateUseCase.PreviewByID(ctx, int64(templateID))
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+	ginutils.ResponseOK(ctx, res)
+}
+
+func (c *EmailTemplateController) SendTest(ctx *gin.Context) {
+	templateID, err := strconv.Atoi(ctx.Param("templateId"))
+	if err != nil {
+		ctx.Error(apperror.NewInvalidIdError())
+		return
+	}
+
+	req := &dto.TestEmailTemplateRequest{ID: int64(templateID)}
+	if ctx.Request.ContentLength > 0 {
+		if err := ctx.ShouldBindJSON(req); err != nil {
+			ctx.Error(err)
+			return
+		}
+	}
+	req.AdminID = utils.GetValueUserIdFromToken(ctx)
+
+	if err := c.emailTemplateUseCase.SendTest(ctx, req); err != nil {
+		ctx.Error(err)
+		return
+	}
+	ginutils.ResponseOKPlain(ctx)
+}
@@ -0,0 +1,86 @@
+package dto
+
+import (
+	"time"
+
+	"healthcare-app/internal/email/entity"
+)
+
+type EmailTemplateResponse struct {
+	ID        int64     `json:"id"`
+	Code      string    `json:"code"`
+	Locale    string    `json:"locale"`
+	Version   int       `json:"version"`
+	Subject   string    `json:"subject"`
+	Body      string    `json:"body"`
+	IsActive  bool      `json:"is_active"`
+	CreatedBy *int64    `json:"created_by"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+type EmailTemplatePreviewResponse struct {
+	Subject string `json:"subject"`
+	Body    string `json:"body"`
+}
+
+type SearchEmailTemplateRequest struct {
+	Code   string `form:"code"`
+	Locale string `form:"locale" binding:"omitempty,oneof=en id"`
+	Limit  int64  `form:"limit" binding:"numeric,gte=1,lte=25"`
+	Page   int64  `form:"page" binding:"numeric,gte=1"`
+}
+
+type EmailTemplateRequest struct {
+	Code      string `json:"code" binding:"required"`
+	Locale    string `json:"locale" binding:"required,oneof=en id"`
+	Subject   string `json:"subject" binding:"required,max=255"`
+	Body      string `json:"body" binding:"required"`
+	Activate  bool   `json:"activate"`
+	CreatedBy int64  `json:"-"`
+}
+
+type PreviewEmailTemplateRequest struct {
+	Code    string `json:"code" binding:"required"`
+	Subject string `json:"subject" binding:"required,max=255"`
+	Body    string `json:"body" binding:"required"`
+}
+
+type TestEmailTemplateRequest struct {
+	Email   string `json:"email" binding:"omitempty,email"`
+	ID      int64  `json:"-"`
+	AdminID int64  `json:"-"`
+}
+
+func ConvertToEmailTemplateResponses(emailTemplates []*entity.EmailTemplate) []*EmailTemplateResponse {
+	res := []*EmailTemplateResponse{}
+	for _, emailTemplate := range emailTemplates {
+		res = append(res, ConvertToEmailTemplateResponse(emailTemplate))
+	}
+	return res
+}
+
+func ConvertToEmailTemplateResponse(emailTemplate *entity.EmailTemplate) *EmailTemplateResponse {
+	return &EmailTemplateResponse{
+		ID:        emailTemplate.ID,
+		Code:      emailTemplate.Code,
+		Locale:    emailTemplate.Locale,
+		Version:   emailTemplate.Version,
+		Subject:   emailTemplate.Subject,
+		Body:      emailTemplate.Body,
+		IsActive:  emailTemplate.IsActive,
+		CreatedBy: emailTemplate.CreatedBy,
+		CreatedAt: emailTemplate.CreatedAt,
+		UpdatedAt: emailTemplate.UpdatedAt,
+	}
+}
+
+func EmailTemplateRequestToEntity(request *EmailTemplateRequest) *entity.EmailTemplate {
+	return &entity.EmailTemplate{
+		Code:      request.Code,
+		Locale:    request.Locale,
+		Subject:   request.Subject,
+		Body:      request.Body,
+		CreatedBy: &request.CreatedBy,
+	}
+}
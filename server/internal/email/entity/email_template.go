@@ -0,0 +1,16 @@
+package entity
+
+import "time"
+
+type EmailTemplate struct {
+	ID        int64
+	Code      string
+	Locale    string
+	Version   int
+	Subject   string
+	Body      string
+	IsActive  bool
+	CreatedBy *int64
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
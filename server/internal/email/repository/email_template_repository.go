@@ -0,0 +1,251 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+
+	"healthcare-app/internal/email/dto"
+	"healthcare-app/internal/email/entity"
+	apperrorPkg "healthcare-app/pkg/apperror"
+	"healthcare-app/pkg/database/transactor"
+)
+
+type EmailTemplateRepository interface {
+	Search(ctx context.Context, request *dto.SearchEmailTemplateRequest) ([]*entity.EmailTemplate, error)
+	FindByID(ctx context.Context, id int64) (*entity.EmailTemplate, error)
+	FindActive(ctx context.Context, code string, locale string) (*entity.EmailTemplate, error)
+	Save(ctx context.Context, emailTemplate *entity.EmailTemplate) error
+	Activate(ctx context.Context, emailTemplate *entity.EmailTemplate) error
+	FindLocaleByEmail(ctx context.Context, email string) (string, error)
+	FindEmailByUserID(ctx context.Context, userID int64) (string, error)
+}
+
+type emailTemplateRepositoryImpl struct {
+	db *sql.DB
+}
+
+func NewEmailTemplateRepository(db *sql.DB) *emailTemplateRepositoryImpl {
+	return &emailTemplateRepositoryImpl{
+		db: db,
+	}
+}
+
+const emailTemplateSelectQuery = `
+	select id, code, locale, version, subject, body, is_active, created_by, created_at, updated_at
+	from email_templates
+	where true
+`
+
+func (r *emailTemplateRepositoryImpl) Search(ctx context.Context, request *dto.SearchEmailTemplateRequest) ([]*entity.EmailTemplate, error) {
+	args := []any{}
+	queryBuilder := strings.Builder{}
+	queryBuilder.WriteString(emailTemplateSelectQuery)
+
+	if request.Code != "" {
+		queryBuilder.WriteString(fmt.Sprintf(" and code = $%v", len(args)+1))
+		args = append(args, request.Code)
+	}
+	if request.Locale != "" {
+		queryBuilder.WriteString(fmt.Sprintf(" and locale = $%v", len(args)+1))
+		args = append(args, request.Locale)
+	}
+	queryBuilder.WriteString(" order by code, locale, version desc")
+
+	return r.findAll(ctx, queryBuilder.String(), args...)
+}
+
+func (r *emailTemplateRepositoryImpl) FindByID(ctx context.Context, id int64) (*entity.EmailTemplate, error) {
+	emailTemplate, err := r.findOne(ctx, emailTemplateSelectQuery+" and id = $1", id)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, apperrorPkg.NewEntityNotFoundError("email template")
+	}
+	return emailTemplate, err
+}
+
+// FindActive returns nil when no version of the template is active for the
+// locale, the caller falls back to the embedded template.
+func (r *emailTemplateRepositoryImpl) FindActive(ctx context.Context, code string, locale string) (*entity.EmailTemplate, error) {
+	emailTemplate, err := r.findOne(ctx, emailTemplateSelectQuery+" and code = $1 and locale = $2 and is_active", code, locale)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	return emailTemplate, err
+}
+
+// Save stores the template as the next version of its code and locale.
+func (r *emailTemplateRepositoryImpl) Save(ctx context.Context, emailTemplate *entity.EmailTemplate) error {
+	query := `
+		insert into email_templates(code, locale, version, subject, body, created_by)
+		select $1, $2, coalesce(max(version), 0) + 1, $3, $4, $5
+		from email_templates
+		where code = $1 and locale = $2
+		returning id, version, is_active, created_at, updated_at
+	`
+	tx := transactor.ExtractTx(ctx)
+
+	var err error
+	if tx != nil {
+		err = tx.QueryRowContext(ctx, query, emailTemplate.Code, emailTemplate.Locale, emailTemplate.Subject, emailTemplate.Body, emailTemplate.CreatedBy).
+			Scan(&emailTemplate.ID, &emailTemplate.Version, &emailTemplate.IsActive, &emailTemplate.CreatedAt, &emailTemplate.UpdatedAt)
+	} else {
+		err = r.db.QueryRowContext(ctx, query, emailTemplate.Code, emailTemplate.Locale, emailTemplate.Subject, emailTemplate.Body, emailTemplate.CreatedBy).
+			Scan(&emailTemplate.ID, &emailTemplate.Version, &emailTemplate.IsActive, &emailTemplate.CreatedAt, &emailTemplate.UpdatedAt)
+	}
+
+	return err
+}
+
+// Activate makes the template the only active version of its code and
+// locale. It has to run in a transaction, the other versions are turned off
+// first to keep the unique index satisfied.
+func (r *emailTemplateRepositoryImpl) Activate(ctx context.Context, emailTemplate *entity.EmailTemplate) error {
+	deactivateQuery := `
+		update email_templates set is_active = false, updated_at = now()
+		where code = $1 and locale = $2 and is_active and id <> $3
+	`
+	activateQuery := `
+		update email_templates set is_active = true, updated_at = now()
+		where id = $1
+		returning updated_at
+	`
+	tx := transactor.ExtractTx(ctx)
+
+	var err error
+	if tx != nil {
+		if _, err = tx.ExecContext(ctx, deactivateQuery, emailTemplate.Code, emailTemplate.Locale, emailTemplate.ID); err != nil {
+			return err
+		}
+		err = tx.QueryRowContext(ctx, activateQuery, emailTemplate.ID).Scan(&emailTemplate.UpdatedAt)
+	} else {
+		if _, err = r.db.ExecContext(ctx, deactivateQuery, emailTemplate.Code, emailTemplate.Locale, emailTemplate.ID); err != nil {
+			return err
+		}
+		err = r.db.QueryRowContext(ctx, activateQuery, emailTemplate.ID).Scan(&emailTemplate.UpdatedAt)
+	}
+	if err != nil {
+		return err
+	}
+
+	emailTemplate.IsActive = true
+	return nil
+}
+
+// FindLocaleByEmail returns an empty locale when the address does not belong
+// to a user.
+func (r *emailTemplateRepositoryImpl) FindLocaleByEmail(ctx context.Context, email string) (string, error) {
+	query := `
+		select locale from users where email = $1 and deleted_at is null
+	`
+	tx := transactor.ExtractTx(ctx)
+
+	var (
+		err    error
+		locale string
+	)
+	if tx != nil {
+		err = tx.QueryRowContext(ctx, query, email).Scan(&locale)
+	} else {
+		err = r.db.QueryRowContext(ctx, query, email).Scan(&locale)
+	}
+	if errors.Is(err, sql.ErrNoRows) {
+		return "", nil
+	}
+	return locale, err
+}
+
+func (r *emailTemplateRepositoryImpl) FindEmailByUserID(ctx context.Context, userID int64) (string, error) {
+	query := `
+		select email from users where id = $1 and deleted_at is null
+	`
+	tx := transactor.ExtractTx(ctx)
+
+	var (
+		err   error
+		email string
+	)
+	if tx != nil {
+		err = tx.QueryRowContext(ctx, query, userID).Scan(&email)
+	} else {
+		err = r.db.QueryRowContext(ctx, query, userID).Scan(&email)
+	}
+	if errors.Is(err, sql.ErrNoRows) {
+		return "", apperrorPkg.NewEntityNotFoundError("user")
+	}
+	return email, err
+}
+
+func (r *emailTemplateRepositoryImpl) findOne(ctx context.Context, query string, args ...any) (*entity.EmailTemplate, error) {
+	tx := transactor.ExtractTx(ctx)
+
+	var row *sql.Row
+	if tx != nil {
+		row = tx.QueryRowContext(ctx, query, args...)
+	} else {
+		row = r.db.QueryRowContext(ctx, query, args...)
+	}
+
+	emailTemplate := new(entity.EmailTemplate)
+	if err := row.Scan(
+		&emailTemplate.ID,
+		&emailTemplate.Code,
+		&emailTemplate.Locale,
+		&emailTemplate.Version,
+		&emailTemplate.Subject,
+		&emailTemplate.Body,
+		&emailTemplate.IsActive,
+		&emailTemplate.CreatedBy,
+		&emailTemplate.CreatedAt,
+		&emailTemplate.UpdatedAt,
+	); err != nil {
+		return nil, err
+	}
+	return emailTemplate, nil
+}
+
+func (r *emailTemplateRepositoryImpl) findAll(ctx context.Context, query string, args ...any) ([]*entity.EmailTemplate, error) {
+	tx := transactor.ExtractTx(ctx)
+
+	var (
+		err  error
+		rows *sql.Rows
+	)
+	if tx != nil {
+		rows, err = tx.QueryContext(ctx, query, args...)
+	} else {
+		rows, err = r.db.QueryContext(ctx, query, args...)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	emailTemplates := []*entity.EmailTemplate{}
+	for rows.Next() {
+		emailTemplate := new(entity.EmailTemplate)
+		if err := rows.Scan(
+			&emailTemplate.ID,
+			&emailTemplate.Code,
+			&emailTemplate.Locale,
+			&emailTemplate.Version,
+			&emailTemplate.Subject,
+			&emailTemplate.Body,
+			&emailTemplate.IsActive,
+			&emailTemplate.CreatedBy,
+			&emailTemplate.CreatedAt,
+			&emailTemplate.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		emailTemplates = append(emailTemplates, emailTemplate)
+	}
+
+	err = rows.Err()
+	if err != nil {
+		return nil, err
+	}
+	return emailTemplates, nil
+}
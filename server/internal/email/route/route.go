@@ -0,0 +1,24 @@
+package route
+
+import (
+	"healthcare-app/internal/auth/constant"
+	"healthcare-app/internal/email/controller"
+	"healthcare-app/pkg/middleware"
+
+	"github.com/gin-gonic/gin"
+)
+
+const templateId = "/:templateId"
+
+func EmailTemplateControllerRoute(c *controller.EmailTemplateController, r *gin.Engine, authMiddleware *middleware.AuthMiddleware) {
+	g := r.Group("/admin/email-templates", authMiddleware.Authorization(), authMiddleware.ProtectedRoles(constant.ADMIN))
+	{
+		g.GET("", c.Search)
+		g.GET(templateId, c.Get)
+		g.POST("", c.Create)
+		g.POST("/preview", c.Preview)
+		g.PATCH(templateId+"/activate", c.Activate)
+		g.GET(templateId+"/preview", c.PreviewByID)
+		g.POST(templateId+"/test", c.SendTest)
+	}
+}
@@ -0,0 +1,68 @@
+package usecase
+
+import (
+	"context"
+
+	"healthcare-app/internal/email/constant"
+	"healthcare-app/internal/email/repository"
+	"healthcare-app/internal/email/utils"
+	"healthcare-app/pkg/logger"
+	"healthcare-app/pkg/utils/smtputils"
+)
+
+// emailSender sends the version of a template that admins activated for the
+// recipient's locale. Templates nobody stored yet, or stored ones that fail
+// to render, go out with the embedded english template and the caller's
+// subject.
+type emailSender struct {
+	smtputils.SMTPUtils
+	emailTemplateRepository repository.EmailTemplateRepository
+}
+
+func NewEmailSender(smtpUtil smtputils.SMTPUtils, emailTemplateRepository repository.EmailTemplateRepository) *emailSender {
+	return &emailSender{
+		SMTPUtils:               smtpUtil,
+		emailTemplateRepository: emailTemplateRepository,
+	}
+}
+
+func (s *emailSender) SendMailHTML(to, subject string, emailTemplate smtputils.EmailTemplate, data map[string]any) error {
+	return s.SendMailHTMLContext(context.Background(), to, subject, emailTemplate, data)
+}
+
+func (s *emailSender) SendMailHTMLContext(ctx context.Context, to, subject string, emailTemplate smtputils.EmailTemplate, data map[string]any) error {
+	renderedSubject, renderedBody, ok := s.render(ctx, to, emailTemplate, data)
+	if !ok {
+		return s.SMTPUtils.SendMailHTMLContext(ctx, to, subject, emailTemplate, data)
+	}
+	return s.SMTPUtils.SendMailHTMLBodyContext(ctx, to, renderedSubject, renderedBody)
+}
+
+func (s *emailSender) render(ctx context.Context, to string, emailTemplate smtputils.EmailTemplate, data map[string]any) (string, string, bool) {
+	locale, err := s.emailTemplateRepository.FindLocaleByEmail(ctx, to)
+	if err != nil {
+		logger.Log.Warn("failed to find email locale:", err)
+	}
+	if locale == "" {
+		locale = constant.DEFAULT_LOCALE
+	}
+
+	stored, err := s.emailTemplateRepository.FindActive(ctx, emailTemplate.Code(), locale)
+	if err == nil && stored == nil && locale != constant.DEFAULT_LOCALE {
+		stored, err = s.emailTemplateRepository.FindActive(ctx, emailTemplate.Code(), constant.DEFAULT_LOCALE)
+	}
+	if err != nil {
+		logger.Log.Warn("failed to find email template:", err)
+		return "", "", false
+	}
+	if stored == nil {
+		return "", "", false
+	}
+
+	subject, body, err := utils.RenderEmailTemplate(stored.Subject, stored.Body, data)
+	if err != nil {
+		logger.Log.WithFields(map[string]any{"email_template_id": stored.ID}).Warn("failed to render email template:", err)
+		return "", "", false
+	}
+	return subject, body, true
+}
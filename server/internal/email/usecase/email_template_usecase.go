@@ -0,0 +1,168 @@
+package usecase
+
+import (
+	"context"
+	"maps"
+
+	apperrorEmail "healthcare-app/internal/email/apperror"
+	"healthcare-app/internal/email/constant"
+	dtoEmail "healthcare-app/internal/email/dto"
+	"healthcare-app/internal/email/entity"
+	"healthcare-app/internal/email/repository"
+	"healthcare-app/internal/email/utils"
+	apperrorPkg "healthcare-app/pkg/apperror"
+	"healthcare-app/pkg/config"
+	"healthcare-app/pkg/database/transactor"
+	dtoPkg "healthcare-app/pkg/dto"
+	"healthcare-app/pkg/utils/pageutils"
+	"healthcare-app/pkg/utils/smtputils"
+)
+
+type EmailTemplateUseCase interface {
+	Search(ctx context.Context, request *dtoEmail.SearchEmailTemplateRequest) ([]*dtoEmail.EmailTemplateResponse, *dtoPkg.PageMetaData, error)
+	Get(ctx context.Context, id int64) (*dtoEmail.EmailTemplateResponse, error)
+	Create(ctx context.Context, request *dtoEmail.EmailTemplateRequest) (*dtoEmail.EmailTemplateResponse, error)
+	Activate(ctx context.Context, id int64) (*dtoEmail.EmailTemplateResponse, error)
+	Preview(ctx context.Context, request *dtoEmail.PreviewEmailTemplateRequest) (*dtoEmail.EmailTemplatePreviewResponse, error)
+	PreviewByID(ctx context.Context, id int64) (*dtoEmail.EmailTemplatePreviewResponse, error)
+	SendTest(ctx context.Context, request *dtoEmail.TestEmailTemplateRequest) error
+}
+
+type emailTemplateUseCaseImpl struct {
+	appConfig               *config.AppConfig
+	smtpUtil                smtputils.SMTPUtils
+	emailTemplateRepository repository.EmailTemplateRepository
+	transactor              transactor.Transactor
+}
+
+func NewEmailTemplateUseCase(
+	appConfig *config.AppConfig,
+	smtpUtil smtputils.SMTPUtils,
+	emailTemplateRepository repository.EmailTemplateRepository,
+	transactor transactor.Transactor,
+) *emailTemplateUseCaseImpl {
+	return &emailTemplateUseCaseImpl{
+		appConfig:               appConfig,
+		smtpUtil:                smtpUtil,
+		emailTemplateRepository: emailTemplateRepository,
+		transactor:              transactor,
+	}
+}
+
+func (u *emailTemplateUseCaseImpl) Search(ctx context.Context, request *dtoEmail.SearchEmailTemplateRequest) ([]*dtoEmail.EmailTemplateResponse, *dtoPkg.PageMetaData, error) {
+	emailTemplates, err := u.emailTemplateRepository.Search(ctx, request)
+	if err != nil {
+		return nil, nil, apperrorPkg.NewServerError(err)
+	}
+
+	res, metaData := pageutils.CreateMetaData(emailTemplates, request.Page, request.Limit)
+	return dtoEmail.ConvertToEmailTemplateResponses(res), metaData, nil
+}
+
+func (u *emailTemplateUseCaseImpl) Get(ctx context.Context, id int64) (*dtoEmail.EmailTemplateResponse, error) {
+	emailTemplate, err := u.emailTemplateRepository.FindByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return dtoEmail.ConvertToEmailTemplateResponse(emailTemplate), nil
+}
+
+// Create stores the template as a new version, the previous versions are kept
+// so an admin can go back to one of them.
+func (u *emailTemplateUseCaseImpl) Create(ctx context.Context, request *dtoEmail.EmailTemplateRequest) (*dtoEmail.EmailTemplateResponse, error) {
+	if _, err := u.render(request.Code, request.Subject, request.Body); err != nil {
+		return nil, err
+	}
+
+	emailTemplate := dtoEmail.EmailTemplateRequestToEntity(request)
+	err := u.transactor.Atomic(ctx, func(txCtx context.Context) error {
+		if err := u.emailTemplateRepository.Save(txCtx, emailTemplate); err != nil {
+			return apperrorPkg.NewServerError(err)
+		}
+		if !request.Activate {
+			return nil
+		}
+		if err := u.emailTemplateRepository.Activate(txCtx, emailTemplate); err != nil {
+			return apperrorPkg.NewServerError(err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return dtoEmail.ConvertToEmailTemplateResponse(emailTemplate), nil
+}
+
+func (u *emailTemplateUseCaseImpl) Activate(ctx context.Context, id int64) (*dtoEmail.EmailTemplateResponse, error) {
+	var emailTemplate *entity.EmailTemplate
+	err := u.transactor.Atomic(ctx, func(txCtx context.Context) error {
+		var err error
+		emailTemplate, err = u.emailTemplateRepository.FindByID(txCtx, id)
+		if err != nil {
+			return err
+		}
+		if err := u.emailTemplateRepository.Activate(txCtx, emailTemplate); err != nil {
+			return apperrorPkg.NewServerError(err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return dtoEmail.ConvertToEmailTemplateResponse(emailTemplate), nil
+}
+
+func (u *emailTemplateUseCaseImpl) Preview(ctx context.Context, request *dtoEmail.PreviewEmailTemplateRequest) (*dtoEmail.EmailTemplatePreviewResponse, error) {
+	return u.render(request.Code, request.Subject, request.Body)
+}
+
+func (u *emailTemplateUseCaseImpl) PreviewByID(ctx context.Context, id int64) (*dtoEmail.EmailTemplatePreviewResponse, error) {
+	emailTemplate, err := u.emailTemplateRepository.FindByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return u.render(emailTemplate.Code, emailTemplate.Subject, emailTemplate.Body)
+}
+
+// SendTest renders the template with the sample data and sends it to the
+// given address, or to the admin when there is none.
+func (u *emailTemplateUseCaseImpl) SendTest(ctx context.Context, request *dtoEmail.TestEmailTemplateRequest) error {
+	emailTemplate, err := u.emailTemplateRepository.FindByID(ctx, request.ID)
+	if err != nil {
+		return err
+	}
+	preview, err := u.render(emailTemplate.Code, emailTemplate.Subject, emailTemplate.Body)
+	if err != nil {
+		return err
+	}
+
+	to := request.Email
+	if to == "" {
+		if to, err = u.emailTemplateRepository.FindEmailByUserID(ctx, request.AdminID); err != nil {
+			return err
+		}
+	}
+	if err := u.smtpUtil.SendMailHTMLBodyContext(ctx, to, preview.Subject, preview.Body); err != nil {
+		return apperrorPkg.NewServerError(err)
+	}
+	return nil
+}
+
+func (u *emailTemplateUseCaseImpl) render(code, subject, body string) (*dtoEmail.EmailTemplatePreviewResponse, error) {
+	emailTemplate, ok := smtputils.ParseEmailTemplate(code)
+	if !ok {
+		return nil, apperrorEmail.NewInvalidEmailTemplateCodeError()
+	}
+
+	data := maps.Clone(constant.SampleData[emailTemplate])
+	data["Link"] = u.appConfig.FrontendURL
+
+	renderedSubject, renderedBody, err := utils.RenderEmailTemplate(subject, body, data)
+	if err != nil {
+		return nil, apperrorEmail.NewInvalidEmailTemplateError(err)
+	}
+	return &dtoEmail.EmailTemplatePreviewResponse{
+		Subject: renderedSubject,
+		Body:    renderedBody,
+	}, nil
+}
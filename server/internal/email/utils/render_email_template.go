@@ -0,0 +1,35 @@
+package utils
+
+import (
+	"bytes"
+	htmlTemplate "html/template"
+	textTemplate "text/template"
+
+	"healthcare-app/pkg/utils/smtputils"
+)
+
+// RenderEmailTemplate executes a stored template, the subject as plain text
+// and the body as html so the data is escaped. The body can use the shared
+// layouts of the embedded templates.
+func RenderEmailTemplate(subject, body string, data map[string]any) (string, string, error) {
+	subjectTmpl, err := textTemplate.New("subject").Parse(subject)
+	if err != nil {
+		return "", "", err
+	}
+	bodyTmpl, err := smtputils.ParseLayouts(htmlTemplate.New("body"))
+	if err != nil {
+		return "", "", err
+	}
+	if bodyTmpl, err = bodyTmpl.Parse(body); err != nil {
+		return "", "", err
+	}
+
+	var renderedSubject, renderedBody bytes.Buffer
+	if err := subjectTmpl.Execute(&renderedSubject, data); err != nil {
+		return "", "", err
+	}
+	if err := bodyTmpl.Execute(&renderedBody, data); err != nil {
+		return "", "", err
+	}
+	return renderedSubject.String(), renderedBody.String(), nil
+}
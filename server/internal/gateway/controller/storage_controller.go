@@ -0,0 +1,43 @@
+package controller
+
+import (
+	"errors"
+	"net/http"
+
+	"healthcare-app/pkg/dto"
+	"healthcare-app/pkg/utils/storageutils"
+
+	"github.com/gin-gonic/gin"
+)
+
+type StorageController struct {
+	localStorage storageutils.LocalObjectStorage
+}
+
+func NewStorageController(localStorage storageutils.LocalObjectStorage) *StorageController {
+	return &StorageController{
+		localStorage: localStorage,
+	}
+}
+
+func (c *StorageController) Route(r *gin.Engine) {
+	r.GET(storageutils.LocalRoutePrefix+"/*key", c.Serve)
+}
+
+func (c *StorageController) Serve(ctx *gin.Context) {
+	filePath, err := c.localStorage.Resolve(ctx.Param("key"), ctx.Query("expires"), ctx.Query("signature"))
+	if errors.Is(err, storageutils.ErrInvalidSignature) {
+		ctx.JSON(http.StatusForbidden, dto.WebResponse[any]{
+			Message: err.Error(),
+		})
+		return
+	}
+	if err != nil {
+		ctx.JSON(http.StatusNotFound, dto.WebResponse[any]{
+			Message: storageutils.ErrObjectNotFound.Error(),
+		})
+		return
+	}
+
+	ctx.File(filePath)
+}
@@ -0,0 +1,42 @@
+package provider
+
+import (
+	"healthcare-app/internal/audit/controller"
+	"healthcare-app/internal/audit/repository"
+	"healthcare-app/internal/audit/route"
+	"healthcare-app/internal/audit/usecase"
+
+	"github.com/gin-gonic/gin"
+)
+
+var (
+	auditLogRepository repository.AuditLogRepository
+)
+
+var (
+	auditLogUseCase usecase.AuditLogUseCase
+)
+
+var (
+	auditLogController *controller.AuditLogController
+)
+
+func ProvideAuditModule(router *gin.Engine) {
+	injectAuditModuleRepository()
+	injectAuditModuleUseCase()
+	injectAuditModuleController()
+
+	route.AuditLogControllerRoute(auditLogController, router, authMiddleware)
+}
+
+func injectAuditModuleRepository() {
+	auditLogRepository = repository.NewAuditLogRepository(db)
+}
+
+func injectAuditModuleUseCase() {
+	auditLogUseCase = usecase.NewAuditLogUseCase(auditLogRepository)
+}
+
+func injectAuditModuleController() {
+	auditLogController = controller.NewAuditLogController(auditLogUseCase)
+}
@@ -9,6 +9,7 @@ import (
 	repositoryProfile "healthcare-app/internal/profile/repository"
 	routeProfile "healthcare-app/internal/profile/route"
 	usecaseProfile "healthcare-app/internal/profile/usecase"
+	"healthcare-app/pkg/config"
 
 	"github.com/gin-gonic/gin"
 )
@@ -21,31 +22,37 @@ var (
 	addressRepository               repositoryProfile.AddressRepository
 	clusterRepository               repositoryProfile.ClusterRepository
 	profileRepository               repositoryProfile.ProfileRepository
+	medicalProfileRepository        repositoryProfile.MedicalProfileRepository
+	dependentRepository             repositoryProfile.DependentRepository
 )
 
 var (
-	authUserUseCase  usecaseAuth.UserUseCase
-	authAdminUseCase usecaseAuth.AdminUseCase
-	oauthUseCase     usecaseAuth.OauthUseCase
-	clusterUseCase   usecaseProfile.ClusterUseCase
-	addressUseCase   usecaseProfile.AddressUseCase
-	profileUseCase   usecaseProfile.ProfileUseCase
+	authUserUseCase       usecaseAuth.UserUseCase
+	authAdminUseCase      usecaseAuth.AdminUseCase
+	oauthUseCase          usecaseAuth.OauthUseCase
+	clusterUseCase        usecaseProfile.ClusterUseCase
+	addressUseCase        usecaseProfile.AddressUseCase
+	profileUseCase        usecaseProfile.ProfileUseCase
+	medicalProfileUseCase usecaseProfile.MedicalProfileUseCase
+	dependentUseCase      usecaseProfile.DependentUseCase
 )
 
 var (
-	authUserController     *controllerAuth.UserController
-	authAdminController    *controllerAuth.AdminController
-	oauthController        *controllerAuth.OauthController
-	refreshTokenController *controllerAuth.RefreshTokenController
-	addressController      *controllerProfile.AddressController
-	profileController      *controllerProfile.ProfileController
-	clusterController      *controllerProfile.ClusterController
+	authUserController       *controllerAuth.UserController
+	authAdminController      *controllerAuth.AdminController
+	oauthController          *controllerAuth.OauthController
+	refreshTokenController   *controllerAuth.RefreshTokenController
+	addressController        *controllerProfile.AddressController
+	profileController        *controllerProfile.ProfileController
+	clusterController        *controllerProfile.ClusterController
+	medicalProfileController *controllerProfile.MedicalProfileController
+	dependentController      *controllerProfile.DependentController
 )
 
-func ProvideAuthModule(router *gin.Engine) {
+func ProvideAuthModule(cfg *config.Config, router *gin.Engine) {
 	injectAuthModuleRepository()
 	injectAuthModuleUseCase()
-	injectAuthModuleController()
+	injectAuthModuleController(cfg)
 
 	routeAuth.UserControllerRoute(authUserController, router)
 	routeAuth.AdminControllerRoute(authAdminController, router, authMiddleware)
@@ -54,6 +61,8 @@ func ProvideAuthModule(router *gin.Engine) {
 	routeProfile.AddressControllerRoute(addressController, router, authMiddleware)
 	routeProfile.ProfileControllerRoute(profileController, router, authMiddleware)
 	routeProfile.ClusterControllerRoute(clusterController, router)
+	routeProfile.MedicalProfileControllerRoute(medicalProfileController, router, authMiddleware)
+	routeProfile.DependentControllerRoute(dependentController, router, authMiddleware)
 }
 
 func injectAuthModuleRepository() {
@@ -64,6 +73,8 @@ func injectAuthModuleRepository() {
 	addressRepository = repositoryProfile.NewAddressRepository(db)
 	clusterRepository = repositoryProfile.NewClusterRepository(db)
 	profileRepository = repositoryProfile.NewProfileRepository(db)
+	medicalProfileRepository = repositoryProfile.NewMedicalProfileRepository(db)
+	dependentRepository = repositoryProfile.NewDependentRepository(db)
 }
 
 func injectAuthModuleUseCase() {
@@ -83,6 +94,8 @@ func injectAuthModuleUseCase() {
 		smtpUtil,
 		passwordEncryptor,
 		emailTask,
+		notificationUseCase,
+		auditLogUseCase,
 		authAdminRepository,
 		store,
 		authUserRepository,
@@ -90,15 +103,19 @@ func injectAuthModuleUseCase() {
 	oauthUseCase = usecaseAuth.NewOauthUseCase(jwtUtil, authUserRepository, refreshTokenRepository, store)
 	clusterUseCase = usecaseProfile.NewClusterUseCase(clusterRepository)
 	addressUseCase = usecaseProfile.NewAddressUseCase(addressRepository, authUserRepository, store)
-	profileUseCase = usecaseProfile.NewProfileUseCase(profileRepository, addressRepository, authUserRepository, store, cloudinaryUtil)
+	profileUseCase = usecaseProfile.NewProfileUseCase(profileRepository, addressRepository, authUserRepository, store, objectStorage)
+	medicalProfileUseCase = usecaseProfile.NewMedicalProfileUseCase(medicalProfileRepository, store)
+	dependentUseCase = usecaseProfile.NewDependentUseCase(dependentRepository, store)
 }
 
-func injectAuthModuleController() {
+func injectAuthModuleController(cfg *config.Config) {
 	authUserController = controllerAuth.NewUserController(authUserUseCase)
 	authAdminController = controllerAuth.NewAdminController(authAdminUseCase)
-	oauthController = controllerAuth.NewOauthController(oauthUseCase, refreshTokenUseCase)
+	oauthController = controllerAuth.NewOauthController(cfg.App, oauthUseCase, refreshTokenUseCase)
 	refreshTokenController = controllerAuth.NewRefreshTokenController(refreshTokenUseCase)
 	clusterController = controllerProfile.NewClusterController(clusterUseCase)
 	addressController = controllerProfile.NewAddressController(addressUseCase)
 	profileController = controllerProfile.NewProfileController(profileUseCase)
+	medicalProfileController = controllerProfile.NewMedicalProfileController(medicalProfileUseCase)
+	dependentController = controllerProfile.NewDependentController(dependentUseCase)
 }
@@ -26,7 +26,7 @@ func ProvideCartModule(router *gin.Engine) {
 	injectCartModuleUseCase()
 	injectCartModuleController()
 
-	route.CartControllerRoute(cartController, router, authMiddleware)
+	route.CartControllerRoute(cartController, router, authMiddleware, idempotencyMiddleware)
 }
 
 func injectCartModuleRepository() {
@@ -34,7 +34,7 @@ func injectCartModuleRepository() {
 }
 
 func injectCartModuleUseCase() {
-	cartUseCase = usecase.NewCartUseCase(cartRepository, authUserRepository, store)
+	cartUseCase = usecase.NewCartUseCase(cartRepository, authUserRepository, productRepository, interactionRepository, medicalProfileRepository, store)
 }
 
 func injectCartModuleController() {
@@ -0,0 +1,45 @@
+package provider
+
+import (
+	"healthcare-app/internal/chat/controller"
+	"healthcare-app/internal/chat/repository"
+	"healthcare-app/internal/chat/route"
+	"healthcare-app/internal/chat/usecase"
+	"healthcare-app/pkg/config"
+
+	"github.com/gin-gonic/gin"
+)
+
+var (
+	chatRoomRepository    repository.ChatRoomRepository
+	chatMessageRepository repository.ChatMessageRepository
+)
+
+var (
+	chatUseCase usecase.ChatUseCase
+)
+
+var (
+	chatController *controller.ChatController
+)
+
+func ProvideChatModule(cfg *config.Config, router *gin.Engine) {
+	injectChatModuleRepository()
+	injectChatModuleUseCase()
+	injectChatModuleController(cfg)
+
+	route.ChatControllerRoute(chatController, router, authMiddleware)
+}
+
+func injectChatModuleRepository() {
+	chatRoomRepository = repository.NewChatRoomRepository(db)
+	chatMessageRepository = repository.NewChatMessageRepository(db)
+}
+
+func injectChatModuleUseCase() {
+	chatUseCase = usecase.NewChatUseCase(chatRoomRepository, chatMessageRepository, pharmacyRepository, broker)
+}
+
+func injectChatModuleController(cfg *config.Config) {
+	chatController = controller.NewChatController(cfg.App, chatUseCase)
+}
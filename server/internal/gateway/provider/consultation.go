@@ -0,0 +1,54 @@
+package provider
+
+import (
+	"healthcare-app/internal/consultation/controller"
+	"healthcare-app/internal/consultation/repository"
+	"healthcare-app/internal/consultation/route"
+	"healthcare-app/internal/consultation/usecase"
+
+	"github.com/gin-gonic/gin"
+)
+
+var (
+	consultationRepository        repository.ConsultationRepository
+	consultationMessageRepository repository.ConsultationMessageRepository
+	consultationNoteRepository    repository.ConsultationNoteRepository
+)
+
+var (
+	consultationUseCase usecase.ConsultationUseCase
+)
+
+var (
+	consultationController *controller.ConsultationController
+)
+
+func ProvideConsultationModule(router *gin.Engine) {
+	injectConsultationModuleRepository()
+	injectConsultationModuleUseCase()
+	injectConsultationModuleController()
+
+	route.ConsultationControllerRoute(consultationController, router, authMiddleware)
+}
+
+func injectConsultationModuleRepository() {
+	consultationRepository = repository.NewConsultationRepository(db)
+	consultationMessageRepository = repository.NewConsultationMessageRepository(db)
+	consultationNoteRepository = repository.NewConsultationNoteRepository(db)
+}
+
+func injectConsultationModuleUseCase() {
+	consultationUseCase = usecase.NewConsultationUseCase(
+		consultationRepository,
+		consultationMessageRepository,
+		consultationNoteRepository,
+		doctorRepository,
+		dependentRepository,
+		objectStorage,
+		store,
+	)
+}
+
+func injectConsultationModuleController() {
+	consultationController = controller.NewConsultationController(consultationUseCase)
+}
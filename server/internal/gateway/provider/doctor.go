@@ -0,0 +1,45 @@
+package provider
+
+import (
+	"healthcare-app/internal/doctor/controller"
+	"healthcare-app/internal/doctor/repository"
+	"healthcare-app/internal/doctor/route"
+	"healthcare-app/internal/doctor/usecase"
+
+	"github.com/gin-gonic/gin"
+)
+
+var (
+	doctorRepository repository.DoctorRepository
+)
+
+var (
+	doctorUseCase usecase.DoctorUseCase
+)
+
+var (
+	adminDoctorController *controller.AdminDoctorController
+	doctorController      *controller.DoctorController
+)
+
+func ProvideDoctorModule(router *gin.Engine) {
+	injectDoctorModuleRepository()
+	injectDoctorModuleUseCase()
+	injectDoctorModuleController()
+
+	route.AdminDoctorControllerRoute(adminDoctorController, router, authMiddleware)
+	route.DoctorControllerRoute(doctorController, router, authMiddleware)
+}
+
+func injectDoctorModuleRepository() {
+	doctorRepository = repository.NewDoctorRepository(db)
+}
+
+func injectDoctorModuleUseCase() {
+	doctorUseCase = usecase.NewDoctorUseCase(passwordEncryptor, emailTask, notificationUseCase, doctorRepository, authUserRepository, store)
+}
+
+func injectDoctorModuleController() {
+	adminDoctorController = controller.NewAdminDoctorController(doctorUseCase)
+	doctorController = controller.NewDoctorController(doctorUseCase)
+}
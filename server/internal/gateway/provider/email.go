@@ -0,0 +1,43 @@
+package provider
+
+import (
+	"healthcare-app/internal/email/controller"
+	"healthcare-app/internal/email/repository"
+	"healthcare-app/internal/email/route"
+	"healthcare-app/internal/email/usecase"
+	"healthcare-app/pkg/config"
+
+	"github.com/gin-gonic/gin"
+)
+
+var (
+	emailTemplateRepository repository.EmailTemplateRepository
+)
+
+var (
+	emailTemplateUseCase usecase.EmailTemplateUseCase
+)
+
+var (
+	emailTemplateController *controller.EmailTemplateController
+)
+
+func ProvideEmailModule(cfg *config.Config, router *gin.Engine) {
+	injectEmailModuleRepository()
+	injectEmailModuleUseCase(cfg)
+	injectEmailModuleController()
+
+	route.EmailTemplateControllerRoute(emailTemplateController, router, authMiddleware)
+}
+
+func injectEmailModuleRepository() {
+	emailTemplateRepository = repository.NewEmailTemplateRepository(db)
+}
+
+func injectEmailModuleUseCase(cfg *config.Config) {
+	emailTemplateUseCase = usecase.NewEmailTemplateUseCase(cfg.App, smtpUtil, emailTemplateRepository, store)
+}
+
+func injectEmailModuleController() {
+	emailTemplateController = controller.NewEmailTemplateController(emailTemplateUseCase)
+}
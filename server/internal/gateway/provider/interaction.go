@@ -0,0 +1,42 @@
+package provider
+
+import (
+	"healthcare-app/internal/interaction/controller"
+	"healthcare-app/internal/interaction/repository"
+	"healthcare-app/internal/interaction/route"
+	"healthcare-app/internal/interaction/usecase"
+
+	"github.com/gin-gonic/gin"
+)
+
+var (
+	interactionRepository repository.InteractionRepository
+)
+
+var (
+	interactionUseCase usecase.InteractionUseCase
+)
+
+var (
+	interactionController *controller.InteractionController
+)
+
+func ProvideInteractionModule(router *gin.Engine) {
+	injectInteractionModuleRepository()
+	injectInteractionModuleUseCase()
+	injectInteractionModuleController()
+
+	route.InteractionControllerRoute(interactionController, router, authMiddleware)
+}
+
+func injectInteractionModuleRepository() {
+	interactionRepository = repository.NewInteractionRepository(db)
+}
+
+func injectInteractionModuleUseCase() {
+	interactionUseCase = usecase.NewInteractionUseCase(interactionRepository)
+}
+
+func injectInteractionModuleController() {
+	interactionController = controller.NewInteractionController(interactionUseCase)
+}
@@ -0,0 +1,75 @@
+package provider
+
+import (
+	"context"
+
+	"healthcare-app/internal/medication/controller"
+	"healthcare-app/internal/medication/repository"
+	"healthcare-app/internal/medication/route"
+	"healthcare-app/internal/medication/usecase"
+	"healthcare-app/pkg/logger"
+
+	"github.com/gin-gonic/gin"
+)
+
+var (
+	medicationScheduleRepository repository.MedicationScheduleRepository
+	medicationDoseRepository     repository.MedicationDoseRepository
+)
+
+var (
+	userMedicationUseCase       usecase.UserMedicationUseCase
+	pharmacistMedicationUseCase usecase.PharmacistMedicationUseCase
+)
+
+var (
+	userMedicationController       *controller.UserMedicationController
+	pharmacistMedicationController *controller.PharmacistMedicationController
+)
+
+func ProvideMedicationModule(router *gin.Engine) {
+	injectMedicationModuleRepository()
+	injectMedicationModuleUseCase()
+	injectMedicationModuleController()
+
+	route.UserMedicationControllerRoute(userMedicationController, router, authMiddleware)
+	route.PharmacistMedicationControllerRoute(pharmacistMedicationController, router, authMiddleware)
+
+	cronJob.AddFunc("*/5 * * * *", func() {
+		err := userMedicationUseCase.SendDoseReminders(context.Background())
+		if err != nil {
+			logger.Log.Error("error sending dose reminders:", err)
+		}
+	})
+
+	cronJob.AddFunc("@midnight", func() {
+		err := userMedicationUseCase.CompleteFinishedSchedules(context.Background())
+		if err != nil {
+			logger.Log.Error("error completing medication schedules:", err)
+		}
+	})
+}
+
+func injectMedicationModuleRepository() {
+	medicationScheduleRepository = repository.NewMedicationScheduleRepository(db)
+	medicationDoseRepository = repository.NewMedicationDoseRepository(db)
+}
+
+func injectMedicationModuleUseCase() {
+	userMedicationUseCase = usecase.NewUserMedicationUseCase(
+		medicationScheduleRepository,
+		medicationDoseRepository,
+		orderUserRepository,
+		reminderTask,
+		store,
+	)
+	pharmacistMedicationUseCase = usecase.NewPharmacistMedicationUseCase(
+		medicationScheduleRepository,
+		orderPharmacistRepository,
+	)
+}
+
+func injectMedicationModuleController() {
+	userMedicationController = controller.NewUserMedicationController(userMedicationUseCase)
+	pharmacistMedicationController = controller.NewPharmacistMedicationController(pharmacistMedicationUseCase)
+}
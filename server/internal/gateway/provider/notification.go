@@ -0,0 +1,51 @@
+package provider
+
+import (
+	"healthcare-app/internal/notification/controller"
+	"healthcare-app/internal/notification/repository"
+	"healthcare-app/internal/notification/route"
+	"healthcare-app/internal/notification/usecase"
+	"healthcare-app/pkg/config"
+
+	"github.com/gin-gonic/gin"
+)
+
+var (
+	notificationRepository           repository.NotificationRepository
+	notificationPreferenceRepository repository.NotificationPreferenceRepository
+)
+
+var (
+	notificationDispatcher        usecase.NotificationDispatcher
+	notificationUseCase           usecase.NotificationUseCase
+	notificationPreferenceUseCase usecase.NotificationPreferenceUseCase
+)
+
+var (
+	notificationController           *controller.NotificationController
+	notificationPreferenceController *controller.NotificationPreferenceController
+)
+
+func ProvideNotificationModule(cfg *config.Config, router *gin.Engine) {
+	injectNotificationModuleRepository()
+	injectNotificationModuleUseCase(cfg)
+	injectNotificationModuleController()
+
+	route.NotificationControllerRoute(notificationController, notificationPreferenceController, router, authMiddleware)
+}
+
+func injectNotificationModuleRepository() {
+	notificationRepository = repository.NewNotificationRepository(db)
+	notificationPreferenceRepository = repository.NewNotificationPreferenceRepository(db)
+}
+
+func injectNotificationModuleUseCase(cfg *config.Config) {
+	notificationDispatcher = usecase.NewNotificationDispatcher(cfg.App, smtpUtil, notificationTask, notificationRepository, notificationPreferenceRepository)
+	notificationUseCase = usecase.NewNotificationUseCase(notificationRepository, notificationDispatcher)
+	notificationPreferenceUseCase = usecase.NewNotificationPreferenceUseCase(notificationPreferenceRepository, store)
+}
+
+func injectNotificationModuleController() {
+	notificationController = controller.NewNotificationController(notificationUseCase)
+	notificationPreferenceController = controller.NewNotificationPreferenceController(notificationPreferenceUseCase)
+}
@@ -19,12 +19,14 @@ var (
 	orderAdminUseCase      usecase.AdminOrderUseCase
 	orderPharmacistUseCase usecase.PharmacistOrderUseCase
 	orderUserUseCase       usecase.UserOrderUseCase
+	orderEventUseCase      usecase.OrderEventUseCase
 )
 
 var (
 	orderAdminController      *controller.AdminOrderController
 	orderPharmacistController *controller.PharmacistOrderController
 	orderUserController       *controller.UserOrderController
+	orderEventController      *controller.OrderEventController
 )
 
 func ProvideOrderModule(router *gin.Engine) {
@@ -34,7 +36,8 @@ func ProvideOrderModule(router *gin.Engine) {
 
 	route.AdminOrderControllerRoute(orderAdminController, router, authMiddleware)
 	route.PharmacistOrderControllerRoute(orderPharmacistController, router, authMiddleware)
-	route.UserOrderControllerRoute(orderUserController, router, authMiddleware)
+	route.UserOrderControllerRoute(orderUserController, router, authMiddleware, idempotencyMiddleware)
+	route.OrderEventControllerRoute(orderEventController, router, authMiddleware)
 }
 
 func injectOrderModuleRepository() {
@@ -44,19 +47,29 @@ func injectOrderModuleRepository() {
 }
 
 func injectOrderModuleUseCase() {
-	orderAdminUseCase = usecase.NewAdminOrderUseCase(orderRepository)
-	orderPharmacistUseCase = usecase.NewPharmacistOrderUseCase(orderTask, productRepository, pharmacyProductRepository, orderPharmacistRepository, store)
+	orderEventUseCase = usecase.NewOrderEventUseCase(orderPharmacistRepository, broker, webhookDeliveryUseCase)
+	orderAdminUseCase = usecase.NewAdminOrderUseCase(objectStorage, orderRepository)
+	orderPharmacistUseCase = usecase.NewPharmacistOrderUseCase(objectStorage, orderTask, emailTask, notificationUseCase, orderEventUseCase, auditLogUseCase, productRepository, pharmacyProductRepository, orderPharmacistRepository, medicalProfileRepository, dependentRepository, store)
 	orderUserUseCase = usecase.NewUserOrderUseCase(
 		orderUserRepository,
+		orderRepository,
 		cartRepository,
 		addressRepository,
+		medicalProfileRepository,
+		dependentRepository,
 		productRepository,
 		pharmacyProductRepository,
 		pharmacyRepository,
 		logisticRepository,
+		interactionRepository,
 		base64Encryptor,
+		objectStorage,
 		store,
 		orderTask,
+		emailTask,
+		notificationUseCase,
+		orderEventUseCase,
+		webhookDeliveryUseCase,
 	)
 }
 
@@ -64,4 +77,5 @@ func injectOrderModuleController() {
 	orderAdminController = controller.NewAdminOrderController(orderAdminUseCase)
 	orderPharmacistController = controller.NewPharmacistOrderController(orderPharmacistUseCase)
 	orderUserController = controller.NewUserOrderController(orderUserUseCase)
+	orderEventController = controller.NewOrderEventController(orderEventUseCase)
 }
@@ -20,6 +20,7 @@ var (
 	pharmacyRepository           repository.PharmacyRepository
 	partnerChangeRepository      repository.PartnerChangeRepository
 	pharmacistPharmacyRepository repository.PharmacistPharmacyRepository
+	stockTransferRepository      repository.StockTransferRepository
 )
 
 var (
@@ -29,6 +30,7 @@ var (
 	pharmacyPharmacistUseCase usecase.PharmacistUseCase
 	pharmacyUseCase           usecase.PharmacyUseCase
 	pharmacyUserUseCase       usecase.UserUseCase
+	stockTransferUseCase      usecase.StockTransferUseCase
 )
 
 var (
@@ -36,6 +38,7 @@ var (
 	pharmacyAdminController      *controller.AdminController
 	pharmacyPharmacistController *controller.PharmacistController
 	pharmacyUserController       *controller.UserController
+	stockTransferController      *controller.StockTransferController
 )
 
 func ProvidePharmacyModule(cfg *config.Config, router *gin.Engine) {
@@ -47,6 +50,7 @@ func ProvidePharmacyModule(cfg *config.Config, router *gin.Engine) {
 	route.UserControllerRoute(pharmacyUserController, router, authMiddleware)
 	route.AdminControllerRoute(pharmacyAdminController, router, authMiddleware)
 	route.PharmacistControllerRoute(pharmacyPharmacistController, router, authMiddleware)
+	route.StockTransferControllerRoute(stockTransferController, router, authMiddleware)
 
 	cronJob.AddFunc("@midnight", func() {
 		err := partnerChangeUseCase.ApplyChanges(context.Background())
@@ -64,15 +68,17 @@ func injectPharmacyModuleRepository(db *sql.DB) {
 	pharmacyRepository = repository.NewPharmacyRepository(db)
 	partnerChangeRepository = repository.NewPartnerChangeRepository(db)
 	pharmacistPharmacyRepository = repository.NewPharmacistPharmacyRepository(db)
+	stockTransferRepository = repository.NewStockTransferRepository(db)
 }
 
 func injectPharmacyModuleUseCase(cfg *config.Config) {
 	logisticUseCase = usecase.NewLogisticUseCase(logisticRepository, store)
 	partnerChangeUseCase = usecase.NewPartnerChangeUseCase(partnerChangeRepository, partnerRepository, store)
-	partnerUseCase = usecase.NewPartnerUseCase(cloudinaryUtil, partnerChangeRepository, partnerRepository, store)
+	partnerUseCase = usecase.NewPartnerUseCase(objectStorage, auditLogUseCase, partnerChangeRepository, partnerRepository, store)
 	pharmacyPharmacistUseCase = usecase.NewPharmacistUseCase(pharmacyRepository, pharmacistPharmacyRepository, store)
-	pharmacyUseCase = usecase.NewPharmacyUseCase(pharmacyRepository, store)
+	pharmacyUseCase = usecase.NewPharmacyUseCase(auditLogUseCase, pharmacyRepository, store)
 	pharmacyUserUseCase = usecase.NewUserUseCase(cfg.RajaOngkir, addressRepository, logisticRepository, pharmacyRepository, store)
+	stockTransferUseCase = usecase.NewStockTransferUseCase(auditLogUseCase, pharmacyRepository, stockTransferRepository, store)
 }
 
 func injectPharmacyModuleController() {
@@ -80,4 +86,5 @@ func injectPharmacyModuleController() {
 	pharmacyAdminController = controller.NewAdminController(partnerUseCase, pharmacyUseCase)
 	pharmacyPharmacistController = controller.NewPharmacistController(pharmacyPharmacistUseCase)
 	pharmacyUserController = controller.NewUserController(pharmacyUserUseCase)
+	stockTransferController = controller.NewStockTransferController(stockTransferUseCase)
 }
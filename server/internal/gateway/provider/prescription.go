@@ -0,0 +1,53 @@
+package provider
+
+import (
+	"healthcare-app/internal/prescription/controller"
+	"healthcare-app/internal/prescription/repository"
+	"healthcare-app/internal/prescription/route"
+	"healthcare-app/internal/prescription/usecase"
+
+	"github.com/gin-gonic/gin"
+)
+
+var (
+	prescriptionRepository           repository.PrescriptionRepository
+	prescriptionItemRepository       repository.PrescriptionItemRepository
+	prescriptionRedemptionRepository repository.PrescriptionRedemptionRepository
+)
+
+var (
+	prescriptionUseCase usecase.PrescriptionUseCase
+)
+
+var (
+	prescriptionController *controller.PrescriptionController
+)
+
+func ProvidePrescriptionModule(router *gin.Engine) {
+	injectPrescriptionModuleRepository()
+	injectPrescriptionModuleUseCase()
+	injectPrescriptionModuleController()
+
+	route.PrescriptionControllerRoute(prescriptionController, router, authMiddleware)
+}
+
+func injectPrescriptionModuleRepository() {
+	prescriptionRepository = repository.NewPrescriptionRepository(db)
+	prescriptionItemRepository = repository.NewPrescriptionItemRepository(db)
+	prescriptionRedemptionRepository = repository.NewPrescriptionRedemptionRepository(db)
+}
+
+func injectPrescriptionModuleUseCase() {
+	prescriptionUseCase = usecase.NewPrescriptionUseCase(
+		prescriptionRepository,
+		prescriptionItemRepository,
+		prescriptionRedemptionRepository,
+		consultationRepository,
+		pharmacyRepository,
+		store,
+	)
+}
+
+func injectPrescriptionModuleController() {
+	prescriptionController = controller.NewPrescriptionController(prescriptionUseCase)
+}
@@ -22,6 +22,8 @@ var (
 	productCategoryRepository       repository.ProductCategoryRepository
 	pharmacyProductRepository       repository.PharmacyProductRepository
 	productUserRepository           repository.UserProductRepository
+	productImportRepository         repository.ProductImportRepository
+	pharmacyProductImportRepository repository.PharmacyProductImportRepository
 )
 
 var (
@@ -60,6 +62,12 @@ func ProvideProductModule(router *gin.Engine) {
 			logger.Log.Error("error refreshing most bought views:", err)
 		}
 	})
+	cronJob.AddFunc("@hourly", func() {
+		err := productUserUseCase.RefreshCoPurchaseView(context.Background())
+		if err != nil {
+			logger.Log.Error("error refreshing co-purchase view:", err)
+		}
+	})
 }
 
 func injectProductModuleRepository() {
@@ -70,16 +78,18 @@ func injectProductModuleRepository() {
 	productCategoryRepository = repository.NewProductCategoryRepository(db)
 	pharmacyProductRepository = repository.NewPharmacyProductRepository(db)
 	productUserRepository = repository.NewUserProductRepository(db)
+	productImportRepository = repository.NewProductImportRepository(db)
+	pharmacyProductImportRepository = repository.NewPharmacyProductImportRepository(db)
 }
 
 func injectProductModuleUseCase() {
 	redisUtilsLRU := redisutils.NewRedisUtilsLRU(rdb, 1000, 5*time.Minute)
 
-	productAdminUseCase = usecase.NewAdminProductUseCase(base64Encryptor, cloudinaryUtil, productTask, manufactureRepository, productClassificationRepository, productFormRepository, productRepository, store)
+	productAdminUseCase = usecase.NewAdminProductUseCase(base64Encryptor, objectStorage, productTask, auditLogUseCase, manufactureRepository, productClassificationRepository, productFormRepository, productRepository, productImportRepository, store)
 	productCategoryUseCase = usecase.NewProductCategoryUseCase(productCategoryRepository, store)
 	manufactureUseCase = usecase.NewManufactureUseCase(manufactureRepository)
 	productFormUseCase = usecase.NewProductFormUseCase(productFormRepository)
-	productPharmacistUseCase = usecase.NewPharmacistProductUseCase(productRepository, pharmacyProductRepository, store)
+	productPharmacistUseCase = usecase.NewPharmacistProductUseCase(base64Encryptor, productTask, auditLogUseCase, productRepository, pharmacyProductRepository, pharmacyProductImportRepository, store)
 	productUserUseCase = usecase.NewUserProductUseCase(redisUtilsLRU, addressRepository, productRepository, productUserRepository)
 }
 
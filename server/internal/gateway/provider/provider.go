@@ -1,6 +1,7 @@
 package provider
 
 import (
+	"context"
 	"database/sql"
 
 	gatewayController "healthcare-app/internal/gateway/controller"
@@ -8,6 +9,8 @@ import (
 	"healthcare-app/pkg/config"
 	"healthcare-app/pkg/database/postgres"
 	"healthcare-app/pkg/database/redis"
+	"healthcare-app/pkg/logger"
+	"healthcare-app/pkg/utils/storageutils"
 
 	"github.com/RediSearch/redisearch-go/redisearch"
 	"github.com/gin-gonic/gin"
@@ -30,20 +33,50 @@ func InitProvider(cfg *config.Config) {
 
 func ProvideHttpDependency(cfg *config.Config, router *gin.Engine) {
 	ProvideGatewayModule(router)
-	ProvideAuthModule(router)
+	ProvideNotificationModule(cfg, router)
+	ProvideEmailModule(cfg, router)
+	ProvideAuditModule(router)
+	ProvideAuthModule(cfg, router)
 	ProvidePharmacyModule(cfg, router)
+	ProvideWebhookModule(router)
 	ProvideProductModule(router)
+	ProvideInteractionModule(router)
 	ProvideCartModule(router)
 	ProvideOrderModule(router)
+	ProvideSubscriptionModule(router)
+	ProvideMedicationModule(router)
+	ProvideDoctorModule(router)
+	ProvideConsultationModule(router)
+	ProvidePrescriptionModule(router)
+	ProvideChatModule(cfg, router)
 	ProvideReportModule(router)
+	ProvideReviewModule(router)
 	cronJob.Start()
+	go runBroker()
 }
 
-func ProvideQueueDependency(client *asynq.Client, mux *asynq.ServeMux) {
-	ProvideQueueModule(client, mux)
+func runBroker() {
+	if err := broker.Run(context.Background()); err != nil {
+		logger.Log.Error("broker stopped:", err)
+	}
+}
+
+// ShutdownHttpDependency releases what keeps connections open on their own,
+// the server can not finish shutting down while an event stream is running.
+func ShutdownHttpDependency() {
+	broker.Close()
+}
+
+func ProvideQueueDependency(cfg *config.Config, client *asynq.Client, mux *asynq.ServeMux, scheduler *asynq.Scheduler) {
+	ProvideQueueModule(cfg, client, mux, scheduler)
 }
 
 func ProvideGatewayModule(router *gin.Engine) {
 	appController := gatewayController.NewAppController()
 	appController.Route(router)
+
+	if localStorage, ok := objectStorage.(storageutils.LocalObjectStorage); ok {
+		storageController := gatewayController.NewStorageController(localStorage)
+		storageController.Route(router)
+	}
 }
@@ -1,48 +1,152 @@
 package provider
 
 import (
+	repositoryAudit "healthcare-app/internal/audit/repository"
+	usecaseAudit "healthcare-app/internal/audit/usecase"
+	repositoryAuth "healthcare-app/internal/auth/repository"
+	repositoryCart "healthcare-app/internal/cart/repository"
+	repositoryInteraction "healthcare-app/internal/interaction/repository"
+	repositoryNotification "healthcare-app/internal/notification/repository"
+	usecaseNotification "healthcare-app/internal/notification/usecase"
 	repositoryOrder "healthcare-app/internal/order/repository"
+	usecaseOrder "healthcare-app/internal/order/usecase"
+	repositoryPharmacy "healthcare-app/internal/pharmacy/repository"
 	repositoryProduct "healthcare-app/internal/product/repository"
+	repositoryProfile "healthcare-app/internal/profile/repository"
 	"healthcare-app/internal/queue/processor"
 	"healthcare-app/internal/queue/route"
 	"healthcare-app/internal/queue/tasks"
+	repositorySubscription "healthcare-app/internal/subscription/repository"
+	usecaseSubscription "healthcare-app/internal/subscription/usecase"
+	repositoryWebhook "healthcare-app/internal/webhook/repository"
+	usecaseWebhook "healthcare-app/internal/webhook/usecase"
+	"healthcare-app/pkg/config"
+	"healthcare-app/pkg/logger"
 
 	"github.com/hibiken/asynq"
 )
 
 var (
-	emailTask   tasks.EmailTask
-	productTask tasks.ProductTask
-	orderTask   tasks.OrderTask
+	emailTask        tasks.EmailTask
+	productTask      tasks.ProductTask
+	orderTask        tasks.OrderTask
+	reminderTask     tasks.ReminderTask
+	webhookTask      tasks.WebhookTask
+	notificationTask tasks.NotificationTask
 )
 
 var (
-	emailTaskProcessor   *processor.EmailTaskProcessor
-	productTaskProcessor *processor.ProductTaskProcessor
-	orderTaskProcessor   *processor.OrderTaskProcessor
+	emailTaskProcessor        *processor.EmailTaskProcessor
+	productTaskProcessor      *processor.ProductTaskProcessor
+	orderTaskProcessor        *processor.OrderTaskProcessor
+	reminderTaskProcessor     *processor.ReminderTaskProcessor
+	webhookTaskProcessor      *processor.WebhookTaskProcessor
+	notificationTaskProcessor *processor.NotificationTaskProcessor
+	subscriptionTaskProcessor *processor.SubscriptionTaskProcessor
 )
 
-func ProvideQueueModule(client *asynq.Client, mux *asynq.ServeMux) {
+func ProvideQueueModule(cfg *config.Config, client *asynq.Client, mux *asynq.ServeMux, scheduler *asynq.Scheduler) {
 	injectQueueModuleTask(client)
-	injectQueueModuleProcessor()
+	injectQueueModuleProcessor(cfg)
 
 	route.EmailTaskRoute(mux, emailTaskProcessor)
 	route.ProductTaskRoute(mux, productTaskProcessor)
 	route.OrderTaskRoute(mux, orderTaskProcessor)
+	route.ReminderTaskRoute(mux, reminderTaskProcessor)
+	route.WebhookTaskRoute(mux, webhookTaskProcessor)
+	route.NotificationTaskRoute(mux, notificationTaskProcessor)
+	route.SubscriptionTaskRoute(mux, subscriptionTaskProcessor)
+
+	if _, err := scheduler.Register("@hourly", tasks.NewSubscriptionRefillTask()); err != nil {
+		logger.Log.Fatal("failed to schedule subscription refills:", err)
+	}
 }
 
 func injectQueueModuleTask(client *asynq.Client) {
 	emailTask = tasks.NewEmailTask(client)
 	productTask = tasks.NewProductTask(client)
 	orderTask = tasks.NewOrderTask(client)
+	reminderTask = tasks.NewReminderTask(client)
+	webhookTask = tasks.NewWebhookTask(client)
+	notificationTask = tasks.NewNotificationTask(client)
 }
 
-func injectQueueModuleProcessor() {
+func injectQueueModuleProcessor(cfg *config.Config) {
+	manufactureRepository := repositoryProduct.NewManufactureRepository(db)
+	productClassificationRepository := repositoryProduct.NewProductClassificationRepository(db)
+	productFormRepository := repositoryProduct.NewProductFormRepository(db)
+	productCategoryRepository := repositoryProduct.NewProductCategoryRepository(db)
+	productImportRepository := repositoryProduct.NewProductImportRepository(db)
 	productRepository := repositoryProduct.NewProductRepository(db)
+	pharmacyProductRepository := repositoryProduct.NewPharmacyProductRepository(db)
+	pharmacyProductImportRepository := repositoryProduct.NewPharmacyProductImportRepository(db)
+	orderRepository := repositoryOrder.NewOrderRepository(db)
 	userOrderRepository := repositoryOrder.NewUserOrderRepository(db)
 	pharmacistOrderRepository := repositoryOrder.NewPharmacistOrderRepository(db)
+	pharmacyRepository := repositoryPharmacy.NewPharmacyRepository(db)
+	notificationRepository := repositoryNotification.NewNotificationRepository(db)
+	notificationPreferenceRepository := repositoryNotification.NewNotificationPreferenceRepository(db)
+	notificationDispatcher := usecaseNotification.NewNotificationDispatcher(cfg.App, smtpUtil, notificationTask, notificationRepository, notificationPreferenceRepository)
+	notificationUseCase := usecaseNotification.NewNotificationUseCase(notificationRepository, notificationDispatcher)
+	webhookEndpointRepository := repositoryWebhook.NewWebhookEndpointRepository(db)
+	webhookDeliveryRepository := repositoryWebhook.NewWebhookDeliveryRepository(db)
+	webhookDeliveryUseCase := usecaseWebhook.NewWebhookDeliveryUseCase(webhookTask, webhookEndpointRepository, webhookDeliveryRepository)
+	orderEventUseCase := usecaseOrder.NewOrderEventUseCase(pharmacistOrderRepository, broker, webhookDeliveryUseCase)
+	auditLogUseCase := usecaseAudit.NewAuditLogUseCase(repositoryAudit.NewAuditLogRepository(db))
+	addressRepository := repositoryProfile.NewAddressRepository(db)
+	dependentRepository := repositoryProfile.NewDependentRepository(db)
+	logisticRepository := repositoryPharmacy.NewLogisticRepository(db)
+	userOrderUseCase := usecaseOrder.NewUserOrderUseCase(
+		userOrderRepository,
+		orderRepository,
+		repositoryCart.NewCartRepository(db),
+		addressRepository,
+		repositoryProfile.NewMedicalProfileRepository(db),
+		dependentRepository,
+		productRepository,
+		pharmacyProductRepository,
+		pharmacyRepository,
+		logisticRepository,
+		repositoryInteraction.NewInteractionRepository(db),
+		base64Encryptor,
+		objectStorage,
+		store,
+		orderTask,
+		emailTask,
+		notificationUseCase,
+		orderEventUseCase,
+		webhookDeliveryUseCase,
+	)
+	subscriptionUseCase := usecaseSubscription.NewSubscriptionUseCase(
+		repositorySubscription.NewSubscriptionRepository(db),
+		repositoryAuth.NewUserRepository(db),
+		addressRepository,
+		dependentRepository,
+		pharmacyRepository,
+		logisticRepository,
+		userOrderUseCase,
+		emailTask,
+		store,
+	)
 
-	emailTaskProcessor = processor.NewEmailTaskProcessor(base64Encryptor, smtpUtil)
-	productTaskProcessor = processor.NewProductTaskProcessor(cloudinaryUtil, productRepository, store)
-	orderTaskProcessor = processor.NewOrderTaskProcessor(cloudinaryUtil, userOrderRepository, pharmacistOrderRepository, store)
+	emailTaskProcessor = processor.NewEmailTaskProcessor(cfg.App, base64Encryptor, smtpUtil, notificationDispatcher)
+	productTaskProcessor = processor.NewProductTaskProcessor(
+		base64Encryptor,
+		objectStorage,
+		auditLogUseCase,
+		manufactureRepository,
+		productClassificationRepository,
+		productFormRepository,
+		productCategoryRepository,
+		productImportRepository,
+		productRepository,
+		pharmacyProductRepository,
+		pharmacyProductImportRepository,
+		store,
+	)
+	orderTaskProcessor = processor.NewOrderTaskProcessor(objectStorage, emailTask, orderRepository, userOrderRepository, pharmacistOrderRepository, pharmacyRepository, notificationUseCase, orderEventUseCase, store)
+	reminderTaskProcessor = processor.NewReminderTaskProcessor(cfg.App, notificationDispatcher)
+	webhookTaskProcessor = processor.NewWebhookTaskProcessor(webhookDeliveryUseCase)
+	notificationTaskProcessor = processor.NewNotificationTaskProcessor(whatsappChannel, pushChannel)
+	subscriptionTaskProcessor = processor.NewSubscriptionTaskProcessor(subscriptionUseCase)
 }
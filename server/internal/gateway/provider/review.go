@@ -0,0 +1,42 @@
+package provider
+
+import (
+	"healthcare-app/internal/review/controller"
+	"healthcare-app/internal/review/repository"
+	"healthcare-app/internal/review/route"
+	"healthcare-app/internal/review/usecase"
+
+	"github.com/gin-gonic/gin"
+)
+
+var (
+	reviewRepository repository.ReviewRepository
+)
+
+var (
+	reviewUseCase usecase.ReviewUseCase
+)
+
+var (
+	reviewController *controller.ReviewController
+)
+
+func ProvideReviewModule(router *gin.Engine) {
+	injectReviewModuleRepository()
+	injectReviewModuleUseCase()
+	injectReviewModuleController()
+
+	route.ReviewControllerRoute(reviewController, router, authMiddleware)
+}
+
+func injectReviewModuleRepository() {
+	reviewRepository = repository.NewReviewRepository(db)
+}
+
+func injectReviewModuleUseCase() {
+	reviewUseCase = usecase.NewReviewUseCase(reviewRepository, objectStorage, store)
+}
+
+func injectReviewModuleController() {
+	reviewController = controller.NewReviewController(reviewUseCase)
+}
@@ -0,0 +1,52 @@
+package provider
+
+import (
+	"healthcare-app/internal/subscription/controller"
+	"healthcare-app/internal/subscription/repository"
+	"healthcare-app/internal/subscription/route"
+	"healthcare-app/internal/subscription/usecase"
+
+	"github.com/gin-gonic/gin"
+)
+
+var (
+	subscriptionRepository repository.SubscriptionRepository
+)
+
+var (
+	subscriptionUseCase usecase.SubscriptionUseCase
+)
+
+var (
+	subscriptionController *controller.SubscriptionController
+)
+
+func ProvideSubscriptionModule(router *gin.Engine) {
+	injectSubscriptionModuleRepository()
+	injectSubscriptionModuleUseCase()
+	injectSubscriptionModuleController()
+
+	route.SubscriptionControllerRoute(subscriptionController, router, authMiddleware)
+}
+
+func injectSubscriptionModuleRepository() {
+	subscriptionRepository = repository.NewSubscriptionRepository(db)
+}
+
+func injectSubscriptionModuleUseCase() {
+	subscriptionUseCase = usecase.NewSubscriptionUseCase(
+		subscriptionRepository,
+		authUserRepository,
+		addressRepository,
+		dependentRepository,
+		pharmacyRepository,
+		logisticRepository,
+		orderUserUseCase,
+		emailTask,
+		store,
+	)
+}
+
+func injectSubscriptionModuleController() {
+	subscriptionController = controller.NewSubscriptionController(subscriptionUseCase)
+}
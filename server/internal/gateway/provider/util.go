@@ -7,14 +7,18 @@ import (
 
 	"healthcare-app/internal/auth/repository"
 	"healthcare-app/internal/auth/usecase"
+	repositoryEmail "healthcare-app/internal/email/repository"
+	usecaseEmail "healthcare-app/internal/email/usecase"
 	"healthcare-app/pkg/config"
 	"healthcare-app/pkg/database/transactor"
 	"healthcare-app/pkg/middleware"
-	"healthcare-app/pkg/utils/cloudinaryutils"
 	"healthcare-app/pkg/utils/encryptutils"
 	"healthcare-app/pkg/utils/jwtutils"
+	"healthcare-app/pkg/utils/notificationutils"
+	"healthcare-app/pkg/utils/pubsubutils"
 	"healthcare-app/pkg/utils/redisutils"
 	"healthcare-app/pkg/utils/smtputils"
+	"healthcare-app/pkg/utils/storageutils"
 
 	"github.com/redis/go-redis/v9"
 	"github.com/robfig/cron/v3"
@@ -29,29 +33,41 @@ var (
 )
 
 var (
-	cloudinaryUtil    cloudinaryutils.CloudinaryUtil
-	jwtUtil           jwtutils.JwtUtil
-	smtpUtil          smtputils.SMTPUtils
-	redisUtil         redisutils.RedisUtil
-	passwordEncryptor encryptutils.PasswordEncryptor
-	base64Encryptor   encryptutils.Base64Encryptor
-	store             transactor.Transactor
-	authMiddleware    *middleware.AuthMiddleware
-	cronJob           *cron.Cron
+	objectStorage         storageutils.ObjectStorage
+	jwtUtil               jwtutils.JwtUtil
+	smtpUtil              smtputils.SMTPUtils
+	whatsappChannel       notificationutils.Channel
+	pushChannel           notificationutils.Channel
+	redisUtil             redisutils.RedisUtil
+	broker                pubsubutils.Broker
+	passwordEncryptor     encryptutils.PasswordEncryptor
+	base64Encryptor       encryptutils.Base64Encryptor
+	store                 transactor.Transactor
+	authMiddleware        *middleware.AuthMiddleware
+	idempotencyMiddleware *middleware.IdempotencyMiddleware
+	cronJob               *cron.Cron
 )
 
 func ProvideUtils(cfg *config.Config, db *sql.DB, rdb *redis.Client) {
-	cloudinaryUtil = cloudinaryutils.NewCloudinaryUtil()
+	var err error
+	objectStorage, err = storageutils.NewObjectStorage(cfg.Storage)
+	if err != nil {
+		log.Fatalf("Failed to create object storage: %v", err)
+	}
 	jwtUtil = jwtutils.NewJwtUtil(cfg.Jwt)
-	smtpUtil = smtputils.NewSMTPUtils(cfg.SMTP)
+	smtpUtil = usecaseEmail.NewEmailSender(smtputils.NewSMTPUtils(cfg.SMTP), repositoryEmail.NewEmailTemplateRepository(db))
+	whatsappChannel = notificationutils.NewWhatsappChannel(notificationutils.NewLocalProvider())
+	pushChannel = notificationutils.NewPushChannel(notificationutils.NewLocalProvider())
 	passwordEncryptor = encryptutils.NewBcryptPasswordEncryptor(cfg.App.BCryptCost)
 	base64Encryptor = encryptutils.NewBase64Encryptor()
 	redisUtil = redisutils.NewRedisUtils(cfg.Redis, rdb)
+	broker = pubsubutils.NewRedisBroker(rdb)
 	store = transactor.NewTransactor(db)
 
 	refreshTokenRepository = repository.NewRefreshTokenRepository(db)
 	refreshTokenUseCase = usecase.NewRefreshTokenUseCase(cfg.Jwt, redisUtil, jwtUtil, refreshTokenRepository, store)
 	authMiddleware = middleware.NewAuthMiddleware(jwtUtil, refreshTokenUseCase)
+	idempotencyMiddleware = middleware.NewIdempotencyMiddleware(cfg.HttpServer, redisUtil)
 
 	wib, err := time.LoadLocation("Asia/Jakarta")
 	if err != nil {
@@ -0,0 +1,46 @@
+package provider
+
+import (
+	"healthcare-app/internal/webhook/controller"
+	"healthcare-app/internal/webhook/repository"
+	"healthcare-app/internal/webhook/route"
+	"healthcare-app/internal/webhook/usecase"
+
+	"github.com/gin-gonic/gin"
+)
+
+var (
+	webhookEndpointRepository repository.WebhookEndpointRepository
+	webhookDeliveryRepository repository.WebhookDeliveryRepository
+)
+
+var (
+	webhookEndpointUseCase usecase.WebhookEndpointUseCase
+	webhookDeliveryUseCase usecase.WebhookDeliveryUseCase
+)
+
+var (
+	webhookController *controller.WebhookController
+)
+
+func ProvideWebhookModule(router *gin.Engine) {
+	injectWebhookModuleRepository()
+	injectWebhookModuleUseCase()
+	injectWebhookModuleController()
+
+	route.WebhookControllerRoute(webhookController, router, authMiddleware)
+}
+
+func injectWebhookModuleRepository() {
+	webhookEndpointRepository = repository.NewWebhookEndpointRepository(db)
+	webhookDeliveryRepository = repository.NewWebhookDeliveryRepository(db)
+}
+
+func injectWebhookModuleUseCase() {
+	webhookEndpointUseCase = usecase.NewWebhookEndpointUseCase(auditLogUseCase, webhookEndpointRepository, partnerRepository, store)
+	webhookDeliveryUseCase = usecase.NewWebhookDeliveryUseCase(webhookTask, webhookEndpointRepository, webhookDeliveryRepository)
+}
+
+func injectWebhookModuleController() {
+	webhookController = controller.NewWebhookController(webhookEndpointUseCase, webhookDeliveryUseCase)
+}
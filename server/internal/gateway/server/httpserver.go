@@ -7,7 +7,9 @@ import (
 	"net/http"
 	"time"
 
+	routeChat "healthcare-app/internal/chat/route"
 	"healthcare-app/internal/gateway/provider"
+	routeOrder "healthcare-app/internal/order/route"
 	"healthcare-app/pkg/config"
 	"healthcare-app/pkg/logger"
 	"healthcare-app/pkg/middleware"
@@ -50,18 +52,24 @@ func NewHttpServer(cfg *config.Config) *HttpServer {
 	router := gin.New()
 	router.ContextWithFallback = true
 	router.HandleMethodNotAllowed = true
+	if err := router.SetTrustedProxies(cfg.HttpServer.TrustedProxies); err != nil {
+		logger.Log.Fatal("Error while setting trusted proxies:", err)
+	}
 
 	RegisterValidators()
 	RegisterMiddleware(router, cfg)
 
 	provider.ProvideHttpDependency(cfg, router)
 
+	server := &http.Server{
+		Addr:    fmt.Sprintf("%s:%d", cfg.HttpServer.Host, cfg.HttpServer.Port),
+		Handler: router,
+	}
+	server.RegisterOnShutdown(provider.ShutdownHttpDependency)
+
 	return &HttpServer{
-		cfg: cfg,
-		server: &http.Server{
-			Addr:    fmt.Sprintf("%s:%d", cfg.HttpServer.Host, cfg.HttpServer.Port),
-			Handler: router,
-		},
+		cfg:    cfg,
+		server: server,
 	}
 }
 
@@ -107,15 +115,16 @@ func RegisterMiddleware(router *gin.Engine, cfg *config.Config) {
 	limiter := rate.NewLimiter(rate.Limit(cfg.HttpServer.MaxRequestPerSecond), cfg.HttpServer.MaxRequestPerSecond)
 
 	middlewares := []gin.HandlerFunc{
+		middleware.RequestID(),
 		middleware.Logger(),
 		middleware.Metrics(),
 		middleware.ErrorHandler(),
 		middleware.RateLimiter(limiter),
-		middleware.RequestTimeout(cfg),
+		middleware.RequestTimeout(cfg, routeOrder.MyOrderEventsPath, routeOrder.PharmacyOrderEventsPath, routeChat.ConnectPath),
 		cors.New(cors.Config{
 			AllowMethods:     []string{"*"},
 			AllowHeaders:     []string{"*", "Authorization", "Content-Type"},
-			AllowOrigins:     []string{"http://localhost:3000", "http://localhost:3001", "http://localhost:5173"},
+			AllowOrigins:     []string{"http://localhost:3000", "http://localhost:3001", cfg.App.FrontendURL},
 			AllowCredentials: true,
 		}),
 		gin.Recovery(),
@@ -13,9 +13,10 @@ import (
 )
 
 type QueueServer struct {
-	cfg    *config.Config
-	mux    *asynq.ServeMux
-	server *asynq.Server
+	cfg       *config.Config
+	mux       *asynq.ServeMux
+	server    *asynq.Server
+	scheduler *asynq.Scheduler
 }
 
 func NewQueueServer(cfg *config.Config) *QueueServer {
@@ -25,11 +26,13 @@ func NewQueueServer(cfg *config.Config) *QueueServer {
 
 	client := asynq.NewClient(redisOpt)
 	mux := asynq.NewServeMux()
-	provider.ProvideQueueDependency(client, mux)
+	scheduler := asynq.NewScheduler(redisOpt, nil)
+	provider.ProvideQueueDependency(cfg, client, mux, scheduler)
 
 	return &QueueServer{
-		cfg: cfg,
-		mux: mux,
+		cfg:       cfg,
+		mux:       mux,
+		scheduler: scheduler,
 		server: asynq.NewServer(
 			redisOpt,
 			asynq.Config{
@@ -47,6 +50,9 @@ func NewQueueServer(cfg *config.Config) *QueueServer {
 
 func (s *QueueServer) Start() {
 	logger.Log.Info("Running queue server...")
+	if err := s.scheduler.Start(); err != nil {
+		logger.Log.Fatal("Error while starting the queue scheduler:", err)
+	}
 	if err := s.server.Run(s.mux); err != nil && !errors.Is(err, asynq.ErrServerClosed) {
 		logger.Log.Fatal("Error while queue server listening:", err)
 	}
@@ -55,6 +61,7 @@ func (s *QueueServer) Start() {
 
 func (s *QueueServer) Shutdown() {
 	logger.Log.Info("Attempting to shut down the queue server...")
+	s.scheduler.Shutdown()
 	s.server.Shutdown()
 	logger.Log.Info("Queue server shut down gracefully")
 }
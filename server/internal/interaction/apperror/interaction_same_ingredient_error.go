@@ -0,0 +1,16 @@
+package apperror
+
+import (
+	"errors"
+
+	"healthcare-app/internal/interaction/constant"
+	"healthcare-app/pkg/apperror"
+)
+
+func NewInteractionSameIngredientError() *apperror.AppError {
+	msg := constant.InteractionSameIngredientErrorMessage
+
+	err := errors.New(msg)
+
+	return apperror.NewAppError(err, apperror.DefaultClientErrorCode, msg)
+}
@@ -0,0 +1,6 @@
+package constant
+
+const (
+	InteractionAlreadyExistsErrorMessage  = "interaction between the two ingredients already exists"
+	InteractionSameIngredientErrorMessage = "interaction must be between two different ingredients"
+)
@@ -0,0 +1,17 @@
+package constant
+
+const (
+	SEVERITY_MINOR    = "MINOR"
+	SEVERITY_MODERATE = "MODERATE"
+	SEVERITY_SEVERE   = "SEVERE"
+)
+
+const (
+	WARNING_TYPE_INTERACTION       = "INTERACTION"
+	WARNING_TYPE_DUPLICATE_THERAPY = "DUPLICATE_THERAPY"
+	WARNING_TYPE_ALLERGY           = "ALLERGY"
+)
+
+const (
+	INGREDIENT_SEPARATORS = ",+/;&"
+)
@@ -0,0 +1,104 @@
+package controller
+
+import (
+	"strconv"
+
+	"healthcare-app/internal/interaction/dto"
+	"healthcare-app/internal/interaction/usecase"
+	"healthcare-app/pkg/apperror"
+	"healthcare-app/pkg/utils/ginutils"
+	"healthcare-app/pkg/utils/pageutils"
+
+	"github.com/gin-gonic/gin"
+)
+
+type InteractionController struct {
+	interactionUseCase usecase.InteractionUseCase
+}
+
+func NewInteractionController(interactionUseCase usecase.InteractionUseCase) *InteractionController {
+	return &InteractionController{
+		interactionUseCase: interactionUseCase,
+	}
+}
+
+func (c *InteractionController) Search(ctx *gin.Context) {
+	req := new(dto.SearchDrugInteractionRequest)
+	if err := ctx.ShouldBindQuery(req); err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	res, paging, err := c.interactionUseCase.Search(ctx, req)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+	paging.Links = pageutils.CreateLinks(ctx.Request, int(paging.Page), int(paging.Size), int(paging.TotalItem), int(paging.TotalPage))
+	ginutils.ResponseOKPagination(ctx, res, paging)
+}
+
+func (c *InteractionController) Get(ctx *gin.Context) {
+	interactionID, err := strconv.Atoi(ctx.Param("interactionId"))
+	if err != nil {
+		ctx.Error(apperror.NewInvalidIdError())
+		return
+	}
+
+	res, err := c.interactionUseCase.Get(ctx, int64(interactionID))
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+	ginutils.ResponseOK(ctx, res)
+}
+
+func (c *InteractionController) Create(ctx *gin.Context) {
+	req := new(dto.DrugInteractionRequest)
+	if err := ctx.ShouldBindJSON(req); err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	res, err := c.interactionUseCase.Create(ctx, req)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+	ginutils.ResponseCreated(ctx, res)
+}
+
+func (c *InteractionController) Update(ctx *gin.Context) {
+	interactionID, err := strconv.Atoi(ctx.Param("interactionId"))
+	if err != nil {
+		ctx.Error(apperror.NewInvalidIdError())
+		return
+	}
+
+	req := &dto.DrugInteractionRequest{ID: int64(interactionID)}
+	if err := ctx.ShouldBindJSON(req); err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	res, err := c.interactionUseCase.Update(ctx, req)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+	ginutils.ResponseOK(ctx, res)
+}
+
+func (c *InteractionController) Delete(ctx *gin.Context) {
+	interactionID, err := strconv.Atoi(ctx.Param("interactionId"))
+	if err != nil {
+		ctx.Error(apperror.NewInvalidIdError())
+		return
+	}
+
+	if err := c.interactionUseCase.Delete(ctx, int64(interactionID)); err != nil {
+		ctx.Error(err)
+		return
+	}
+	ginutils.ResponseOKPlain(ctx)
+}
@@ -0,0 +1,75 @@
+package dto
+
+import (
+	"time"
+
+	"healthcare-app/internal/interaction/entity"
+)
+
+type DrugInteractionResponse struct {
+	ID          int64     `json:"id"`
+	IngredientA string    `json:"ingredient_a"`
+	IngredientB string    `json:"ingredient_b"`
+	Severity    string    `json:"severity"`
+	Description *string   `json:"description"`
+	CreatedAt   time.Time `json:"created_at"`
+	UpdatedAt   time.Time `json:"updated_at"`
+}
+
+type InteractionWarningResponse struct {
+	Type        string                       `json:"type"`
+	Severity    string                       `json:"severity"`
+	Ingredients []string                     `json:"ingredients"`
+	Products    []InteractionProductResponse `json:"products"`
+	Description *string                      `json:"description"`
+}
+
+type InteractionProductResponse struct {
+	ID   int64  `json:"id"`
+	Name string `json:"name"`
+}
+
+type DrugInteractionRequest struct {
+	IngredientA string  `json:"ingredient_a" binding:"required,max=255"`
+	IngredientB string  `json:"ingredient_b" binding:"required,max=255"`
+	Severity    string  `json:"severity" binding:"required,oneof=MINOR MODERATE SEVERE"`
+	Description *string `json:"description" binding:"omitempty,max=1000"`
+	ID          int64   `json:"-"`
+}
+
+type SearchDrugInteractionRequest struct {
+	Ingredient string `form:"ingredient"`
+	Severity   string `form:"severity" binding:"omitempty,oneof=MINOR MODERATE SEVERE"`
+	Limit      int64  `form:"limit" binding:"numeric,gte=1,lte=25"`
+	Page       int64  `form:"page" binding:"numeric,gte=1"`
+}
+
+func ConvertToDrugInteractionResponses(interactions []*entity.DrugInteraction) []*DrugInteractionResponse {
+	res := []*DrugInteractionResponse{}
+	for _, interaction := range interactions {
+		res = append(res, ConvertToDrugInteractionResponse(interaction))
+	}
+	return res
+}
+
+func ConvertToDrugInteractionResponse(interaction *entity.DrugInteraction) *DrugInteractionResponse {
+	return &DrugInteractionResponse{
+		ID:          interaction.ID,
+		IngredientA: interaction.IngredientA,
+		IngredientB: interaction.IngredientB,
+		Severity:    interaction.Severity,
+		Description: interaction.Description,
+		CreatedAt:   interaction.CreatedAt,
+		UpdatedAt:   interaction.UpdatedAt,
+	}
+}
+
+func DrugInteractionRequestToEntity(request *DrugInteractionRequest) *entity.DrugInteraction {
+	return &entity.DrugInteraction{
+		ID:          request.ID,
+		IngredientA: request.IngredientA,
+		IngredientB: request.IngredientB,
+		Severity:    request.Severity,
+		Description: request.Description,
+	}
+}
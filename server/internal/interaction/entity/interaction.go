@@ -0,0 +1,19 @@
+package entity
+
+import "time"
+
+type DrugInteraction struct {
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+	Description *string
+	IngredientA string
+	IngredientB string
+	Severity    string
+	ID          int64
+}
+
+type InteractionProduct struct {
+	Name        string
+	GenericName string
+	ID          int64
+}
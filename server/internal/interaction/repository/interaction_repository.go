@@ -0,0 +1,233 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+
+	apperrorInteraction "healthcare-app/internal/interaction/apperror"
+	"healthcare-app/internal/interaction/dto"
+	"healthcare-app/internal/interaction/entity"
+	apperrorPkg "healthcare-app/pkg/apperror"
+	"healthcare-app/pkg/database/transactor"
+
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+type InteractionRepository interface {
+	Search(ctx context.Context, request *dto.SearchDrugInteractionRequest) ([]*entity.DrugInteraction, error)
+	FindAllByIngredients(ctx context.Context, ingredients []string) ([]*entity.DrugInteraction, error)
+	FindByID(ctx context.Context, id int64) (*entity.DrugInteraction, error)
+	Save(ctx context.Context, interaction *entity.DrugInteraction) error
+	Update(ctx context.Context, interaction *entity.DrugInteraction) error
+	DeleteByID(ctx context.Context, id int64) error
+}
+
+type interactionRepositoryImpl struct {
+	db *sql.DB
+}
+
+func NewInteractionRepository(db *sql.DB) *interactionRepositoryImpl {
+	return &interactionRepositoryImpl{
+		db: db,
+	}
+}
+
+const interactionSelectQuery = `
+	select id, ingredient_a, ingredient_b, severity, description, created_at, updated_at
+	from drug_interactions
+	where deleted_at is null
+`
+
+func (r *interactionRepositoryImpl) Search(ctx context.Context, request *dto.SearchDrugInteractionRequest) ([]*entity.DrugInteraction, error) {
+	args := []any{}
+	queryBuilder := strings.Builder{}
+	queryBuilder.WriteString(interactionSelectQuery)
+
+	if request.Ingredient != "" {
+		queryBuilder.WriteString(fmt.Sprintf(" and (ingredient_a ilike $%v or ingredient_b ilike $%v)", len(args)+1, len(args)+1))
+		args = append(args, "%"+request.Ingredient+"%")
+	}
+	if request.Severity != "" {
+		queryBuilder.WriteString(fmt.Sprintf(" and severity = $%v", len(args)+1))
+		args = append(args, request.Severity)
+	}
+	queryBuilder.WriteString(" order by lower(ingredient_a), lower(ingredient_b)")
+
+	return r.findAll(ctx, queryBuilder.String(), args...)
+}
+
+func (r *interactionRepositoryImpl) FindAllByIngredients(ctx context.Context, ingredients []string) ([]*entity.DrugInteraction, error) {
+	if len(ingredients) < 2 {
+		return []*entity.DrugInteraction{}, nil
+	}
+	query := interactionSelectQuery + `
+		and exists(select 1 from unnest($1::text[]) i where strpos(' ' || i || ' ', ' ' || lower(ingredient_a) || ' ') > 0)
+		and exists(select 1 from unnest($1::text[]) i where strpos(' ' || i || ' ', ' ' || lower(ingredient_b) || ' ') > 0)
+	`
+
+	return r.findAll(ctx, query, ingredients)
+}
+
+func (r *interactionRepositoryImpl) FindByID(ctx context.Context, id int64) (*entity.DrugInteraction, error) {
+	query := interactionSelectQuery + " and id = $1"
+	tx := transactor.ExtractTx(ctx)
+
+	var (
+		err         error
+		interaction = new(entity.DrugInteraction)
+	)
+	if tx != nil {
+		err = tx.QueryRowContext(ctx, query, id).Scan(
+			&interaction.ID,
+			&interaction.IngredientA,
+			&interaction.IngredientB,
+			&interaction.Severity,
+			&interaction.Description,
+			&interaction.CreatedAt,
+			&interaction.UpdatedAt,
+		)
+	} else {
+		err = r.db.QueryRowContext(ctx, query, id).Scan(
+			&interaction.ID,
+			&interaction.IngredientA,
+			&interaction.IngredientB,
+			&interaction.Severity,
+			&interaction.Description,
+			&interaction.CreatedAt,
+			&interaction.UpdatedAt,
+		)
+	}
+
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, apperrorPkg.NewEntityNotFoundError("drug interaction")
+		}
+		return nil, err
+	}
+	return interaction, nil
+}
+
+func (r *interactionRepositoryImpl) Save(ctx context.Context, interaction *entity.DrugInteraction) error {
+	query := `
+		insert into drug_interactions(ingredient_a, ingredient_b, severity, description)
+		values ($1, $2, $3, $4) returning id, created_at, updated_at
+	`
+	tx := transactor.ExtractTx(ctx)
+
+	var err error
+	if tx != nil {
+		err = tx.QueryRowContext(ctx, query, interaction.IngredientA, interaction.IngredientB, interaction.Severity, interaction.Description).
+			Scan(&interaction.ID, &interaction.CreatedAt, &interaction.UpdatedAt)
+	} else {
+		err = r.db.QueryRowContext(ctx, query, interaction.IngredientA, interaction.IngredientB, interaction.Severity, interaction.Description).
+			Scan(&interaction.ID, &interaction.CreatedAt, &interaction.UpdatedAt)
+	}
+
+	if err, ok := err.(*pgconn.PgError); ok {
+		if err.SQLState() == "23505" {
+			return apperrorInteraction.NewInteractionAlreadyExistsError()
+		}
+	}
+
+	return err
+}
+
+func (r *interactionRepositoryImpl) Update(ctx context.Context, interaction *entity.DrugInteraction) error {
+	query := `
+		update drug_interactions set ingredient_a = $2, ingredient_b = $3, severity = $4, description = $5, updated_at = now()
+		where id = $1 and deleted_at is null returning created_at, updated_at
+	`
+	tx := transactor.ExtractTx(ctx)
+
+	var err error
+	if tx != nil {
+		err = tx.QueryRowContext(ctx, query, interaction.ID, interaction.IngredientA, interaction.IngredientB, interaction.Severity, interaction.Description).
+			Scan(&interaction.CreatedAt, &interaction.UpdatedAt)
+	} else {
+		err = r.db.QueryRowContext(ctx, query, interaction.ID, interaction.IngredientA, interaction.IngredientB, interaction.Severity, interaction.Description).
+			Scan(&interaction.CreatedAt, &interaction.UpdatedAt)
+	}
+
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return apperrorPkg.NewEntityNotFoundError("drug interaction")
+		}
+		if err, ok := err.(*pgconn.PgError); ok && err.SQLState() == "23505" {
+			return apperrorInteraction.NewInteractionAlreadyExistsError()
+		}
+		return err
+	}
+	return nil
+}
+
+func (r *interactionRepositoryImpl) DeleteByID(ctx context.Context, id int64) error {
+	query := `
+		update drug_interactions set deleted_at = now() where id = $1 and deleted_at is null
+	`
+	tx := transactor.ExtractTx(ctx)
+
+	var (
+		err error
+		res sql.Result
+	)
+	if tx != nil {
+		res, err = tx.ExecContext(ctx, query, id)
+	} else {
+		res, err = r.db.ExecContext(ctx, query, id)
+	}
+
+	if err != nil {
+		return err
+	}
+
+	rowsAffected, _ := res.RowsAffected()
+	if rowsAffected == 0 {
+		return apperrorPkg.NewEntityNotFoundError("drug interaction")
+	}
+	return nil
+}
+
+func (r *interactionRepositoryImpl) findAll(ctx context.Context, query string, args ...any) ([]*entity.DrugInteraction, error) {
+	tx := transactor.ExtractTx(ctx)
+
+	var (
+		err  error
+		rows *sql.Rows
+	)
+	if tx != nil {
+		rows, err = tx.QueryContext(ctx, query, args...)
+	} else {
+		rows, err = r.db.QueryContext(ctx, query, args...)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	interactions := []*entity.DrugInteraction{}
+	for rows.Next() {
+		interaction := new(entity.DrugInteraction)
+		if err := rows.Scan(
+			&interaction.ID,
+			&interaction.IngredientA,
+			&interaction.IngredientB,
+			&interaction.Severity,
+			&interaction.Description,
+			&interaction.CreatedAt,
+			&interaction.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		interactions = append(interactions, interaction)
+	}
+
+	err = rows.Err()
+	if err != nil {
+		return nil, err
+	}
+	return interactions, nil
+}
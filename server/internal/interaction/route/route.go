@@ -0,0 +1,22 @@
+package route
+
+import (
+	"healthcare-app/internal/auth/constant"
+	"healthcare-app/internal/interaction/controller"
+	"healthcare-app/pkg/middleware"
+
+	"github.com/gin-gonic/gin"
+)
+
+const interactionId = "/:interactionId"
+
+func InteractionControllerRoute(c *controller.InteractionController, r *gin.Engine, authMiddleware *middleware.AuthMiddleware) {
+	g := r.Group("/admin/drug-interactions", authMiddleware.Authorization(), authMiddleware.ProtectedRoles(constant.ADMIN))
+	{
+		g.GET("", c.Search)
+		g.GET(interactionId, c.Get)
+		g.POST("", c.Create)
+		g.PUT(interactionId, c.Update)
+		g.DELETE(interactionId, c.Delete)
+	}
+}
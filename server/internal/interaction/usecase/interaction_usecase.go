@@ -0,0 +1,86 @@
+package usecase
+
+import (
+	"context"
+	"strings"
+
+	apperrorInteraction "healthcare-app/internal/interaction/apperror"
+	dtoInteraction "healthcare-app/internal/interaction/dto"
+	"healthcare-app/internal/interaction/repository"
+	apperrorPkg "healthcare-app/pkg/apperror"
+	dtoPkg "healthcare-app/pkg/dto"
+	"healthcare-app/pkg/utils/pageutils"
+)
+
+type InteractionUseCase interface {
+	Search(ctx context.Context, request *dtoInteraction.SearchDrugInteractionRequest) ([]*dtoInteraction.DrugInteractionResponse, *dtoPkg.PageMetaData, error)
+	Get(ctx context.Context, id int64) (*dtoInteraction.DrugInteractionResponse, error)
+	Create(ctx context.Context, request *dtoInteraction.DrugInteractionRequest) (*dtoInteraction.DrugInteractionResponse, error)
+	Update(ctx context.Context, request *dtoInteraction.DrugInteractionRequest) (*dtoInteraction.DrugInteractionResponse, error)
+	Delete(ctx context.Context, id int64) error
+}
+
+type interactionUseCaseImpl struct {
+	interactionRepo repository.InteractionRepository
+}
+
+func NewInteractionUseCase(interactionRepo repository.InteractionRepository) *interactionUseCaseImpl {
+	return &interactionUseCaseImpl{
+		interactionRepo: interactionRepo,
+	}
+}
+
+func (u *interactionUseCaseImpl) Search(ctx context.Context, request *dtoInteraction.SearchDrugInteractionRequest) ([]*dtoInteraction.DrugInteractionResponse, *dtoPkg.PageMetaData, error) {
+	interactions, err := u.interactionRepo.Search(ctx, request)
+	if err != nil {
+		return nil, nil, apperrorPkg.NewServerError(err)
+	}
+
+	res, metaData := pageutils.CreateMetaData(interactions, request.Page, request.Limit)
+	return dtoInteraction.ConvertToDrugInteractionResponses(res), metaData, nil
+}
+
+func (u *interactionUseCaseImpl) Get(ctx context.Context, id int64) (*dtoInteraction.DrugInteractionResponse, error) {
+	interaction, err := u.interactionRepo.FindByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return dtoInteraction.ConvertToDrugInteractionResponse(interaction), nil
+}
+
+func (u *interactionUseCaseImpl) Create(ctx context.Context, request *dtoInteraction.DrugInteractionRequest) (*dtoInteraction.DrugInteractionResponse, error) {
+	if err := validateIngredients(request); err != nil {
+		return nil, err
+	}
+
+	interaction := dtoInteraction.DrugInteractionRequestToEntity(request)
+	if err := u.interactionRepo.Save(ctx, interaction); err != nil {
+		return nil, err
+	}
+	return dtoInteraction.ConvertToDrugInteractionResponse(interaction), nil
+}
+
+func (u *interactionUseCaseImpl) Update(ctx context.Context, request *dtoInteraction.DrugInteractionRequest) (*dtoInteraction.DrugInteractionResponse, error) {
+	if err := validateIngredients(request); err != nil {
+		return nil, err
+	}
+
+	interaction := dtoInteraction.DrugInteractionRequestToEntity(request)
+	if err := u.interactionRepo.Update(ctx, interaction); err != nil {
+		return nil, err
+	}
+	return dtoInteraction.ConvertToDrugInteractionResponse(interaction), nil
+}
+
+func (u *interactionUseCaseImpl) Delete(ctx context.Context, id int64) error {
+	return u.interactionRepo.DeleteByID(ctx, id)
+}
+
+func validateIngredients(request *dtoInteraction.DrugInteractionRequest) error {
+	request.IngredientA = strings.TrimSpace(request.IngredientA)
+	request.IngredientB = strings.TrimSpace(request.IngredientB)
+	if strings.EqualFold(request.IngredientA, request.IngredientB) {
+		return apperrorInteraction.NewInteractionSameIngredientError()
+	}
+	return nil
+}
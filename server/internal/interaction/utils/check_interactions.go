@@ -0,0 +1,164 @@
+package utils
+
+import (
+	"sort"
+	"strings"
+
+	"healthcare-app/internal/interaction/constant"
+	"healthcare-app/internal/interaction/dto"
+	"healthcare-app/internal/interaction/entity"
+)
+
+var severityRanks = map[string]int{
+	constant.SEVERITY_SEVERE:   0,
+	constant.SEVERITY_MODERATE: 1,
+	constant.SEVERITY_MINOR:    2,
+}
+
+func ParseIngredients(genericName string) []string {
+	ingredients := []string{}
+	fields := strings.FieldsFunc(strings.ToLower(genericName), func(r rune) bool {
+		return strings.ContainsRune(constant.INGREDIENT_SEPARATORS, r)
+	})
+	for _, field := range fields {
+		if ingredient := strings.TrimSpace(field); ingredient != "" {
+			ingredients = append(ingredients, ingredient)
+		}
+	}
+	return ingredients
+}
+
+func CollectIngredients(products []*entity.InteractionProduct) []string {
+	seen := map[string]bool{}
+	ingredients := []string{}
+	for _, product := range products {
+		for _, ingredient := range ParseIngredients(product.GenericName) {
+			if !seen[ingredient] {
+				seen[ingredient] = true
+				ingredients = append(ingredients, ingredient)
+			}
+		}
+	}
+	return ingredients
+}
+
+// BuildWarnings matches an interaction rule against whole words of each
+// ingredient the same way allergies are matched, so an "amoxicillin" rule
+// covers "amoxicillin trihydrate".
+func BuildWarnings(products []*entity.InteractionProduct, interactions []*entity.DrugInteraction) []*dto.InteractionWarningResponse {
+	warnings := []*dto.InteractionWarningResponse{}
+	productIngredients := make([]map[string]bool, len(products))
+	for i, product := range products {
+		productIngredients[i] = map[string]bool{}
+		for _, ingredient := range ParseIngredients(product.GenericName) {
+			productIngredients[i][ingredient] = true
+		}
+	}
+
+	for i := 0; i < len(products); i++ {
+		for j := i + 1; j < len(products); j++ {
+			if products[i].ID == products[j].ID {
+				continue
+			}
+			pair := []dto.InteractionProductResponse{
+				{ID: products[i].ID, Name: products[i].Name},
+				{ID: products[j].ID, Name: products[j].Name},
+			}
+
+			shared := []string{}
+			for ingredient := range productIngredients[i] {
+				if productIngredients[j][ingredient] {
+					shared = append(shared, ingredient)
+				}
+			}
+			if len(shared) > 0 {
+				sort.Strings(shared)
+				warnings = append(warnings, &dto.InteractionWarningResponse{
+					Type:        constant.WARNING_TYPE_DUPLICATE_THERAPY,
+					Severity:    constant.SEVERITY_MODERATE,
+					Ingredients: shared,
+					Products:    pair,
+				})
+			}
+
+			for _, interaction := range interactions {
+				a, b := interaction.IngredientA, interaction.IngredientB
+				if (hasIngredient(productIngredients[i], a) && hasIngredient(productIngredients[j], b)) ||
+					(hasIngredient(productIngredients[i], b) && hasIngredient(productIngredients[j], a)) {
+					warnings = append(warnings, &dto.InteractionWarningResponse{
+						Type:        constant.WARNING_TYPE_INTERACTION,
+						Severity:    interaction.Severity,
+						Ingredients: []string{interaction.IngredientA, interaction.IngredientB},
+						Products:    pair,
+						Description: interaction.Description,
+					})
+				}
+			}
+		}
+	}
+	SortWarnings(warnings)
+	return warnings
+}
+
+// BuildAllergyWarnings matches an allergy against whole words of each
+// ingredient, so "amoxicillin" flags "amoxicillin trihydrate" but "pen" does
+// not flag "penicillin".
+func BuildAllergyWarnings(products []*entity.InteractionProduct, allergies []string) []*dto.InteractionWarningResponse {
+	warnings := []*dto.InteractionWarningResponse{}
+	for _, product := range products {
+		matched := []string{}
+		for _, ingredient := range ParseIngredients(product.GenericName) {
+			for _, allergy := range allergies {
+				if containsWords(ingredient, allergy) {
+					matched = append(matched, ingredient)
+					break
+				}
+			}
+		}
+		if len(matched) > 0 {
+			warnings = append(warnings, &dto.InteractionWarningResponse{
+				Type:        constant.WARNING_TYPE_ALLERGY,
+				Severity:    constant.SEVERITY_SEVERE,
+				Ingredients: matched,
+				Products:    []dto.InteractionProductResponse{{ID: product.ID, Name: product.Name}},
+			})
+		}
+	}
+	return warnings
+}
+
+// HasSevereInteraction decides whether the order is held for a pharmacist,
+// severe allergy matches hold it the same as severe interactions.
+func HasSevereInteraction(warnings []*dto.InteractionWarningResponse) bool {
+	for _, warning := range warnings {
+		if warning.Severity == constant.SEVERITY_SEVERE {
+			return true
+		}
+	}
+	return false
+}
+
+// SortWarnings puts the most severe warnings first and keeps the order they
+// were found in otherwise.
+func SortWarnings(warnings []*dto.InteractionWarningResponse) {
+	sort.SliceStable(warnings, func(i, j int) bool {
+		return severityRanks[warnings[i].Severity] < severityRanks[warnings[j].Severity]
+	})
+}
+
+func hasIngredient(ingredients map[string]bool, term string) bool {
+	for ingredient := range ingredients {
+		if containsWords(ingredient, term) {
+			return true
+		}
+	}
+	return false
+}
+
+func containsWords(ingredient, term string) bool {
+	words := strings.Fields(strings.ToLower(term))
+	if len(words) == 0 {
+		return false
+	}
+	return strings.Contains(" "+strings.Join(strings.Fields(ingredient), " ")+" ", " "+strings.Join(words, " ")+" ")
+}
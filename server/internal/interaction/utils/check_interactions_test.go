@@ -0,0 +1,273 @@
+package utils
+
+import (
+	"testing"
+
+	"healthcare-app/internal/interaction/constant"
+	"healthcare-app/internal/interaction/dto"
+	"healthcare-app/internal/interaction/entity"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type warningSummary struct {
+	Type        string
+	Severity    string
+	Ingredients []string
+	ProductIDs  []int64
+}
+
+func summarizeWarnings(warnings []*dto.InteractionWarningResponse) []warningSummary {
+	summaries := []warningSummary{}
+	for _, warning := range warnings {
+		productIDs := []int64{}
+		for _, product := range warning.Products {
+			productIDs = append(productIDs, product.ID)
+		}
+		summaries = append(summaries, warningSummary{
+			Type:        warning.Type,
+			Severity:    warning.Severity,
+			Ingredients: warning.Ingredients,
+			ProductIDs:  productIDs,
+		})
+	}
+	return summaries
+}
+
+func TestParseIngredients(t *testing.T) {
+	tests := []struct {
+		name        string
+		genericName string
+		want        []string
+	}{
+		{
+			name:        "single ingredient is lowercased",
+			genericName: "Paracetamol",
+			want:        []string{"paracetamol"},
+		},
+		{
+			name:        "every separator splits",
+			genericName: "Paracetamol, Caffeine + Ibuprofen/Codeine; Aspirin & Guaifenesin",
+			want:        []string{"paracetamol", "caffeine", "ibuprofen", "codeine", "aspirin", "guaifenesin"},
+		},
+		{
+			name:        "spaces inside an ingredient are kept",
+			genericName: "Amoxicillin Trihydrate + Clavulanic Acid",
+			want:        []string{"amoxicillin trihydrate", "clavulanic acid"},
+		},
+		{
+			name:        "empty fields are dropped",
+			genericName: " , Paracetamol,, ; ",
+			want:        []string{"paracetamol"},
+		},
+		{
+			name:        "blank name has no ingredients",
+			genericName: "",
+			want:        []string{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, ParseIngredients(tt.genericName))
+		})
+	}
+}
+
+func TestCollectIngredients(t *testing.T) {
+	tests := []struct {
+		name     string
+		products []*entity.InteractionProduct
+		want     []string
+	}{
+		{
+			name: "ingredients keep the order they are found in",
+			products: []*entity.InteractionProduct{
+				{ID: 1, GenericName: "Paracetamol + Caffeine"},
+				{ID: 2, GenericName: "Ibuprofen"},
+			},
+			want: []string{"paracetamol", "caffeine", "ibuprofen"},
+		},
+		{
+			name: "duplicate ingredients across products are collected once",
+			products: []*entity.InteractionProduct{
+				{ID: 1, GenericName: "Paracetamol"},
+				{ID: 2, GenericName: "PARACETAMOL / Caffeine"},
+				{ID: 3, GenericName: "caffeine"},
+			},
+			want: []string{"paracetamol", "caffeine"},
+		},
+		{
+			name:     "no products",
+			products: nil,
+			want:     []string{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, CollectIngredients(tt.products))
+		})
+	}
+}
+
+func TestBuildWarnings(t *testing.T) {
+	tests := []struct {
+		name         string
+		products     []*entity.InteractionProduct
+		interactions []*entity.DrugInteraction
+		want         []warningSummary
+	}{
+		{
+			name: "shared ingredients are a duplicate therapy",
+			products: []*entity.InteractionProduct{
+				{ID: 1, GenericName: "Paracetamol + Caffeine"},
+				{ID: 2, GenericName: "Caffeine; Paracetamol"},
+			},
+			want: []warningSummary{
+				{Type: constant.WARNING_TYPE_DUPLICATE_THERAPY, Severity: constant.SEVERITY_MODERATE, Ingredients: []string{"caffeine", "paracetamol"}, ProductIDs: []int64{1, 2}},
+			},
+		},
+		{
+			name: "same product twice is not a duplicate therapy",
+			products: []*entity.InteractionProduct{
+				{ID: 1, GenericName: "Paracetamol"},
+				{ID: 1, GenericName: "Paracetamol"},
+			},
+			want: []warningSummary{},
+		},
+		{
+			name: "interaction matches in either direction and ignores case",
+			products: []*entity.InteractionProduct{
+				{ID: 1, GenericName: "Warfarin"},
+				{ID: 2, GenericName: "Aspirin & Caffeine"},
+			},
+			interactions: []*entity.DrugInteraction{
+				{IngredientA: "ASPIRIN", IngredientB: "warfarin", Severity: constant.SEVERITY_SEVERE},
+			},
+			want: []warningSummary{
+				{Type: constant.WARNING_TYPE_INTERACTION, Severity: constant.SEVERITY_SEVERE, Ingredients: []string{"ASPIRIN", "warfarin"}, ProductIDs: []int64{1, 2}},
+			},
+		},
+		{
+			name: "interaction matches whole words of an ingredient",
+			products: []*entity.InteractionProduct{
+				{ID: 1, GenericName: "Amoxicillin Trihydrate + Clavulanic Acid"},
+				{ID: 2, GenericName: "Methotrexate"},
+				{ID: 3, GenericName: "Penicillin"},
+			},
+			interactions: []*entity.DrugInteraction{
+				{IngredientA: "amoxicillin", IngredientB: "methotrexate", Severity: constant.SEVERITY_SEVERE},
+				{IngredientA: "pen", IngredientB: "methotrexate", Severity: constant.SEVERITY_MINOR},
+			},
+			want: []warningSummary{
+				{Type: constant.WARNING_TYPE_INTERACTION, Severity: constant.SEVERITY_SEVERE, Ingredients: []string{"amoxicillin", "methotrexate"}, ProductIDs: []int64{1, 2}},
+			},
+		},
+		{
+			name: "interaction inside one product is not reported",
+			products: []*entity.InteractionProduct{
+				{ID: 1, GenericName: "Aspirin + Warfarin"},
+				{ID: 2, GenericName: "Ibuprofen"},
+			},
+			interactions: []*entity.DrugInteraction{
+				{IngredientA: "aspirin", IngredientB: "warfarin", Severity: constant.SEVERITY_SEVERE},
+			},
+			want: []warningSummary{},
+		},
+		{
+			name: "most severe warnings come first",
+			products: []*entity.InteractionProduct{
+				{ID: 1, GenericName: "Ibuprofen, Caffeine"},
+				{ID: 2, GenericName: "Caffeine"},
+				{ID: 3, GenericName: "Warfarin"},
+			},
+			interactions: []*entity.DrugInteraction{
+				{IngredientA: "caffeine", IngredientB: "warfarin", Severity: constant.SEVERITY_MINOR},
+				{IngredientA: "ibuprofen", IngredientB: "warfarin", Severity: constant.SEVERITY_SEVERE},
+			},
+			want: []warningSummary{
+				{Type: constant.WARNING_TYPE_INTERACTION, Severity: constant.SEVERITY_SEVERE, Ingredients: []string{"ibuprofen", "warfarin"}, ProductIDs: []int64{1, 3}},
+				{Type: constant.WARNING_TYPE_DUPLICATE_THERAPY, Severity: constant.SEVERITY_MODERATE, Ingredients: []string{"caffeine"}, ProductIDs: []int64{1, 2}},
+				{Type: constant.WARNING_TYPE_INTERACTION, Severity: constant.SEVERITY_MINOR, Ingredients: []string{"caffeine", "warfarin"}, ProductIDs: []int64{1, 3}},
+				{Type: constant.WARNING_TYPE_INTERACTION, Severity: constant.SEVERITY_MINOR, Ingredients: []string{"caffeine", "warfarin"}, ProductIDs: []int64{2, 3}},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, summarizeWarnings(BuildWarnings(tt.products, tt.interactions)))
+		})
+	}
+}
+
+func TestBuildAllergyWarnings(t *testing.T) {
+	tests := []struct {
+		name      string
+		products  []*entity.InteractionProduct
+		allergies []string
+		want      []warningSummary
+	}{
+		{
+			name: "allergy matches whole words of an ingredient",
+			products: []*entity.InteractionProduct{
+				{ID: 1, GenericName: "Amoxicillin Trihydrate + Clavulanic Acid"},
+			},
+			allergies: []string{"AMOXICILLIN"},
+			want: []warningSummary{
+				{Type: constant.WARNING_TYPE_ALLERGY, Severity: constant.SEVERITY_SEVERE, Ingredients: []string{"amoxicillin trihydrate"}, ProductIDs: []int64{1}},
+			},
+		},
+		{
+			name: "part of a word does not match",
+			products: []*entity.InteractionProduct{
+				{ID: 1, GenericName: "Penicillin"},
+			},
+			allergies: []string{"pen"},
+			want:      []warningSummary{},
+		},
+		{
+			name: "blank allergy does not match",
+			products: []*entity.InteractionProduct{
+				{ID: 1, GenericName: "Penicillin"},
+			},
+			allergies: []string{" "},
+			want:      []warningSummary{},
+		},
+		{
+			name: "ingredient matching several allergies is listed once",
+			products: []*entity.InteractionProduct{
+				{ID: 1, GenericName: "Clavulanic Acid"},
+			},
+			allergies: []string{"clavulanic", "clavulanic acid"},
+			want: []warningSummary{
+				{Type: constant.WARNING_TYPE_ALLERGY, Severity: constant.SEVERITY_SEVERE, Ingredients: []string{"clavulanic acid"}, ProductIDs: []int64{1}},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, summarizeWarnings(BuildAllergyWarnings(tt.products, tt.allergies)))
+		})
+	}
+}
+
+func TestSortWarnings(t *testing.T) {
+	warnings := []*dto.InteractionWarningResponse{
+		{Type: constant.WARNING_TYPE_INTERACTION, Severity: constant.SEVERITY_MINOR},
+		{Type: constant.WARNING_TYPE_DUPLICATE_THERAPY, Severity: constant.SEVERITY_MODERATE},
+		{Type: constant.WARNING_TYPE_INTERACTION, Severity: constant.SEVERITY_SEVERE},
+		{Type: constant.WARNING_TYPE_ALLERGY, Severity: constant.SEVERITY_SEVERE},
+	}
+
+	SortWarnings(warnings)
+
+	assert.Equal(t, []warningSummary{
+		{Type: constant.WARNING_TYPE_INTERACTION, Severity: constant.SEVERITY_SEVERE, ProductIDs: []int64{}},
+		{Type: constant.WARNING_TYPE_ALLERGY, Severity: constant.SEVERITY_SEVERE, ProductIDs: []int64{}},
+		{Type: constant.WARNING_TYPE_DUPLICATE_THERAPY, Severity: constant.SEVERITY_MODERATE, ProductIDs: []int64{}},
+		{Type: constant.WARNING_TYPE_INTERACTION, Severity: constant.SEVERITY_MINOR, ProductIDs: []int64{}},
+	}, summarizeWarnings(warnings))
+}
@@ -0,0 +1,50 @@
+package apperror
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"healthcare-app/internal/medication/constant"
+	"healthcare-app/pkg/apperror"
+)
+
+func NewInvalidScheduleStartDateError() *apperror.AppError {
+	msg := constant.InvalidScheduleStartDate
+
+	err := errors.New(msg)
+
+	return apperror.NewAppError(err, apperror.DefaultClientErrorCode, msg)
+}
+
+func NewInvalidScheduleStatusError(status string) *apperror.AppError {
+	msg := fmt.Sprintf(constant.InvalidScheduleStatus, strings.ToLower(status))
+
+	err := errors.New(msg)
+
+	return apperror.NewAppError(err, apperror.DefaultClientErrorCode, msg)
+}
+
+func NewInvalidScheduleOrderError() *apperror.AppError {
+	msg := constant.InvalidScheduleOrder
+
+	err := errors.New(msg)
+
+	return apperror.NewAppError(err, apperror.DefaultClientErrorCode, msg)
+}
+
+func NewInvalidScheduleProductError() *apperror.AppError {
+	msg := constant.InvalidScheduleProduct
+
+	err := errors.New(msg)
+
+	return apperror.NewAppError(err, apperror.DefaultClientErrorCode, msg)
+}
+
+func NewInvalidDoseNotDueError() *apperror.AppError {
+	msg := constant.InvalidDoseNotDue
+
+	err := errors.New(msg)
+
+	return apperror.NewAppError(err, apperror.DefaultClientErrorCode, msg)
+}
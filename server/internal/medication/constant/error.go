@@ -0,0 +1,9 @@
+package constant
+
+const (
+	InvalidScheduleStartDate = "start date cannot be in the past"
+	InvalidScheduleStatus    = "medication schedule must be %s to do this action"
+	InvalidScheduleOrder     = "medication schedule can only be proposed for processed, sent or confirmed orders"
+	InvalidScheduleProduct   = "product is not part of the order"
+	InvalidDoseNotDue        = "dose cannot be logged before it is due"
+)
@@ -0,0 +1,31 @@
+package constant
+
+import "time"
+
+const (
+	SCHEDULE_STATUS_PROPOSED  = "PROPOSED"
+	SCHEDULE_STATUS_ACTIVE    = "ACTIVE"
+	SCHEDULE_STATUS_COMPLETED = "COMPLETED"
+	SCHEDULE_STATUS_CANCELLED = "CANCELLED"
+)
+
+const (
+	DOSE_STATUS_PENDING = "PENDING"
+	DOSE_STATUS_TAKEN   = "TAKEN"
+	DOSE_STATUS_SKIPPED = "SKIPPED"
+)
+
+const (
+	FIRST_DOSE_HOUR = 8
+	LAST_DOSE_HOUR  = 20
+	DATE_LAYOUT     = "2006-01-02"
+	DATETIME_LAYOUT = "2006-01-02 15:04"
+	TIMEZONE        = "Asia/Jakarta"
+)
+
+const (
+	// Doses can be logged slightly ahead of time and are no longer reminded
+	// once they are this late.
+	DOSE_LOG_EARLY_PERIOD      = time.Hour
+	DOSE_REMINDER_GRACE_PERIOD = time.Hour
+)
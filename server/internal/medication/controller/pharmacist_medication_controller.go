@@ -0,0 +1,68 @@
+package controller
+
+import (
+	"strconv"
+
+	"healthcare-app/internal/auth/utils"
+	dtoMedication "healthcare-app/internal/medication/dto"
+	"healthcare-app/internal/medication/usecase"
+	dtoOrder "healthcare-app/internal/order/dto"
+	"healthcare-app/pkg/apperror"
+	"healthcare-app/pkg/utils/ginutils"
+
+	"github.com/gin-gonic/gin"
+)
+
+type PharmacistMedicationController struct {
+	pharmacistMedicationUseCase usecase.PharmacistMedicationUseCase
+}
+
+func NewPharmacistMedicationController(pharmacistMedicationUseCase usecase.PharmacistMedicationUseCase) *PharmacistMedicationController {
+	return &PharmacistMedicationController{
+		pharmacistMedicationUseCase: pharmacistMedicationUseCase,
+	}
+}
+
+func (mc *PharmacistMedicationController) GetOrderSchedules(ctx *gin.Context) {
+	pharmacyID, err := strconv.Atoi(ctx.Param("pharmacyId"))
+	if err != nil {
+		ctx.Error(apperror.NewInvalidIdError())
+		return
+	}
+	orderID, err := strconv.Atoi(ctx.Param("orderId"))
+	if err != nil {
+		ctx.Error(apperror.NewInvalidIdError())
+		return
+	}
+	req := &dtoOrder.GetOrderRequest{ID: int64(orderID), PharmacyID: int64(pharmacyID), PharmacistID: utils.GetValueUserIdFromToken(ctx)}
+	res, err := mc.pharmacistMedicationUseCase.GetOrderSchedules(ctx, req)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+	ginutils.ResponseOK(ctx, res)
+}
+
+func (mc *PharmacistMedicationController) ProposeSchedule(ctx *gin.Context) {
+	pharmacyID, err := strconv.Atoi(ctx.Param("pharmacyId"))
+	if err != nil {
+		ctx.Error(apperror.NewInvalidIdError())
+		return
+	}
+	orderID, err := strconv.Atoi(ctx.Param("orderId"))
+	if err != nil {
+		ctx.Error(apperror.NewInvalidIdError())
+		return
+	}
+	req := &dtoMedication.RequestProposeMedicationSchedule{OrderID: int64(orderID), PharmacyID: int64(pharmacyID), PharmacistID: utils.GetValueUserIdFromToken(ctx)}
+	if err := ctx.ShouldBindJSON(req); err != nil {
+		ctx.Error(err)
+		return
+	}
+	res, err := mc.pharmacistMedicationUseCase.ProposeSchedule(ctx, req)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+	ginutils.ResponseCreated(ctx, res)
+}
@@ -0,0 +1,116 @@
+package controller
+
+import (
+	"strconv"
+
+	"healthcare-app/internal/auth/utils"
+	dtoMedication "healthcare-app/internal/medication/dto"
+	"healthcare-app/internal/medication/usecase"
+	"healthcare-app/pkg/apperror"
+	"healthcare-app/pkg/utils/ginutils"
+
+	"github.com/gin-gonic/gin"
+)
+
+type UserMedicationController struct {
+	userMedicationUseCase usecase.UserMedicationUseCase
+}
+
+func NewUserMedicationController(userMedicationUseCase usecase.UserMedicationUseCase) *UserMedicationController {
+	return &UserMedicationController{
+		userMedicationUseCase: userMedicationUseCase,
+	}
+}
+
+func (mc *UserMedicationController) GetAllMySchedules(ctx *gin.Context) {
+	res, err := mc.userMedicationUseCase.GetAllMySchedules(ctx, utils.GetValueUserIdFromToken(ctx))
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+	ginutils.ResponseOK(ctx, res)
+}
+
+func (mc *UserMedicationController) GetMySchedule(ctx *gin.Context) {
+	scheduleID, err := strconv.Atoi(ctx.Param("scheduleId"))
+	if err != nil {
+		ctx.Error(apperror.NewInvalidIdError())
+		return
+	}
+	res, err := mc.userMedicationUseCase.GetMySchedule(ctx, int64(scheduleID), utils.GetValueUserIdFromToken(ctx))
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+	ginutils.ResponseOK(ctx, res)
+}
+
+func (mc *UserMedicationController) PostNewSchedule(ctx *gin.Context) {
+	req := &dtoMedication.RequestMedicationSchedule{UserID: utils.GetValueUserIdFromToken(ctx)}
+	if err := ctx.ShouldBindJSON(req); err != nil {
+		ctx.Error(err)
+		return
+	}
+	res, err := mc.userMedicationUseCase.PostNewSchedule(ctx, req)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+	ginutils.ResponseCreated(ctx, res)
+}
+
+func (mc *UserMedicationController) AcceptMySchedule(ctx *gin.Context) {
+	scheduleID, err := strconv.Atoi(ctx.Param("scheduleId"))
+	if err != nil {
+		ctx.Error(apperror.NewInvalidIdError())
+		return
+	}
+	req := &dtoMedication.RequestAcceptMedicationSchedule{ID: int64(scheduleID), UserID: utils.GetValueUserIdFromToken(ctx)}
+	if err := ctx.ShouldBindJSON(req); err != nil {
+		ctx.Error(err)
+		return
+	}
+	res, err := mc.userMedicationUseCase.AcceptMySchedule(ctx, req)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+	ginutils.ResponseOK(ctx, res)
+}
+
+func (mc *UserMedicationController) DeleteMySchedule(ctx *gin.Context) {
+	scheduleID, err := strconv.Atoi(ctx.Param("scheduleId"))
+	if err != nil {
+		ctx.Error(apperror.NewInvalidIdError())
+		return
+	}
+	if err := mc.userMedicationUseCase.DeleteMySchedule(ctx, int64(scheduleID), utils.GetValueUserIdFromToken(ctx)); err != nil {
+		ctx.Error(err)
+		return
+	}
+	ginutils.ResponseOKPlain(ctx)
+}
+
+func (mc *UserMedicationController) LogMyDose(ctx *gin.Context) {
+	scheduleID, err := strconv.Atoi(ctx.Param("scheduleId"))
+	if err != nil {
+		ctx.Error(apperror.NewInvalidIdError())
+		return
+	}
+	doseID, err := strconv.Atoi(ctx.Param("doseId"))
+	if err != nil {
+		ctx.Error(apperror.NewInvalidIdError())
+		return
+	}
+	req := &dtoMedication.RequestLogDose{ID: int64(doseID), ScheduleID: int64(scheduleID), UserID: utils.GetValueUserIdFromToken(ctx)}
+	if err := ctx.ShouldBindJSON(req); err != nil {
+		ctx.Error(err)
+		return
+	}
+	res, err := mc.userMedicationUseCase.LogMyDose(ctx, req)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+	ginutils.ResponseOK(ctx, res)
+}
@@ -0,0 +1,155 @@
+package dto
+
+import (
+	"math"
+	"time"
+
+	"healthcare-app/internal/medication/constant"
+	"healthcare-app/internal/medication/entity"
+)
+
+type MedicationScheduleResponse struct {
+	ID                int64                     `json:"id"`
+	OrderID           *int64                    `json:"order_id"`
+	PharmacyProductID *int64                    `json:"pharmacy_product_id"`
+	MedicineName      string                    `json:"medicine_name"`
+	Dosage            string                    `json:"dosage"`
+	TimesPerDay       int                       `json:"times_per_day"`
+	DurationDays      int                       `json:"duration_days"`
+	StartDate         *string                   `json:"start_date"`
+	Notes             *string                   `json:"notes"`
+	Status            string                    `json:"status"`
+	Proposed          bool                      `json:"proposed"`
+	Adherence         *AdherenceResponse        `json:"adherence"`
+	Doses             []*MedicationDoseResponse `json:"doses,omitempty"`
+	CreatedAt         time.Time                 `json:"created_at"`
+	UpdatedAt         time.Time                 `json:"updated_at"`
+}
+
+type AdherenceResponse struct {
+	Total   int     `json:"total"`
+	Taken   int     `json:"taken"`
+	Skipped int     `json:"skipped"`
+	Missed  int     `json:"missed"`
+	Rate    float64 `json:"rate"`
+}
+
+type MedicationDoseResponse struct {
+	ID          int64      `json:"id"`
+	ScheduledAt time.Time  `json:"scheduled_at"`
+	Status      string     `json:"status"`
+	LoggedAt    *time.Time `json:"logged_at"`
+}
+
+type RequestMedicationSchedule struct {
+	OrderID           *int64  `json:"order_id" binding:"omitempty,gte=1"`
+	PharmacyProductID *int64  `json:"pharmacy_product_id" binding:"omitempty,gte=1"`
+	MedicineName      string  `json:"medicine_name" binding:"required,max=255"`
+	Dosage            string  `json:"dosage" binding:"required,max=100"`
+	TimesPerDay       int     `json:"times_per_day" binding:"required,gte=1,lte=6"`
+	DurationDays      int     `json:"duration_days" binding:"required,gte=1,lte=90"`
+	StartDate         string  `json:"start_date" binding:"required,time_format=2006-01-02"`
+	Notes             *string `json:"notes" binding:"omitempty,max=1000"`
+	UserID            int64   `json:"-"`
+}
+
+type RequestProposeMedicationSchedule struct {
+	PharmacyProductID int64   `json:"pharmacy_product_id" binding:"required,gte=1"`
+	Dosage            string  `json:"dosage" binding:"required,max=100"`
+	TimesPerDay       int     `json:"times_per_day" binding:"required,gte=1,lte=6"`
+	DurationDays      int     `json:"duration_days" binding:"required,gte=1,lte=90"`
+	Notes             *string `json:"notes" binding:"omitempty,max=1000"`
+	OrderID           int64   `json:"-"`
+	PharmacyID        int64   `json:"-"`
+	PharmacistID      int64   `json:"-"`
+}
+
+type RequestAcceptMedicationSchedule struct {
+	StartDate string `json:"start_date" binding:"required,time_format=2006-01-02"`
+	ID        int64  `json:"-"`
+	UserID    int64  `json:"-"`
+}
+
+type RequestLogDose struct {
+	Status     string `json:"status" binding:"required,oneof=TAKEN SKIPPED"`
+	ID         int64  `json:"-"`
+	ScheduleID int64  `json:"-"`
+	UserID     int64  `json:"-"`
+}
+
+func ConvertToMedicationScheduleResponses(schedules []*entity.MedicationSchedule) []*MedicationScheduleResponse {
+	res := []*MedicationScheduleResponse{}
+	for _, schedule := range schedules {
+		res = append(res, ConvertToMedicationScheduleResponse(schedule))
+	}
+	return res
+}
+
+func ConvertToMedicationScheduleResponse(schedule *entity.MedicationSchedule) *MedicationScheduleResponse {
+	var startDate *string
+	if schedule.StartDate != nil {
+		formatted := schedule.StartDate.Format(constant.DATE_LAYOUT)
+		startDate = &formatted
+	}
+
+	var doses []*MedicationDoseResponse
+	for _, dose := range schedule.Doses {
+		doses = append(doses, &MedicationDoseResponse{
+			ID:          dose.ID,
+			ScheduledAt: dose.ScheduledAt,
+			Status:      dose.Status,
+			LoggedAt:    dose.LoggedAt,
+		})
+	}
+
+	return &MedicationScheduleResponse{
+		ID:                schedule.ID,
+		OrderID:           schedule.OrderID,
+		PharmacyProductID: schedule.PharmacyProductID,
+		MedicineName:      schedule.MedicineName,
+		Dosage:            schedule.Dosage,
+		TimesPerDay:       schedule.TimesPerDay,
+		DurationDays:      schedule.DurationDays,
+		StartDate:         startDate,
+		Notes:             schedule.Notes,
+		Status:            schedule.Status,
+		Proposed:          schedule.CreatedBy != schedule.UserID,
+		Adherence:         convertToAdherenceResponse(schedule),
+		Doses:             doses,
+		CreatedAt:         schedule.CreatedAt,
+		UpdatedAt:         schedule.UpdatedAt,
+	}
+}
+
+func MedicationScheduleRequestToEntity(request *RequestMedicationSchedule) *entity.MedicationSchedule {
+	startDate, _ := time.Parse(constant.DATE_LAYOUT, request.StartDate)
+	return &entity.MedicationSchedule{
+		UserID:            request.UserID,
+		OrderID:           request.OrderID,
+		PharmacyProductID: request.PharmacyProductID,
+		MedicineName:      request.MedicineName,
+		Dosage:            request.Dosage,
+		TimesPerDay:       request.TimesPerDay,
+		DurationDays:      request.DurationDays,
+		StartDate:         &startDate,
+		Notes:             request.Notes,
+		Status:            constant.SCHEDULE_STATUS_ACTIVE,
+		CreatedBy:         request.UserID,
+	}
+}
+
+// convertToAdherenceResponse rates adherence against the doses that are
+// already due, pending doses in the future are not counted as missed.
+func convertToAdherenceResponse(schedule *entity.MedicationSchedule) *AdherenceResponse {
+	res := &AdherenceResponse{
+		Total:   schedule.TotalDoses,
+		Taken:   schedule.TakenDoses,
+		Skipped: schedule.SkippedDoses,
+		Missed:  schedule.MissedDoses,
+	}
+	due := res.Taken + res.Skipped + res.Missed
+	if due > 0 {
+		res.Rate = math.Round(float64(res.Taken)/float64(due)*10000) / 100
+	}
+	return res
+}
@@ -0,0 +1,44 @@
+package entity
+
+import "time"
+
+type MedicationSchedule struct {
+	ID                int64
+	UserID            int64
+	OrderID           *int64
+	PharmacyProductID *int64
+	MedicineName      string
+	Dosage            string
+	TimesPerDay       int
+	DurationDays      int
+	StartDate         *time.Time
+	Notes             *string
+	Status            string
+	CreatedBy         int64
+	TotalDoses        int
+	TakenDoses        int
+	SkippedDoses      int
+	MissedDoses       int
+	Doses             []*MedicationDose
+	CreatedAt         time.Time
+	UpdatedAt         time.Time
+}
+
+type MedicationDose struct {
+	ID          int64
+	ScheduleID  int64
+	ScheduledAt time.Time
+	Status      string
+	RemindedAt  *time.Time
+	LoggedAt    *time.Time
+}
+
+type MedicationReminder struct {
+	DoseID       int64
+	ScheduleID   int64
+	UserID       int64
+	Email        string
+	MedicineName string
+	Dosage       string
+	ScheduledAt  time.Time
+}
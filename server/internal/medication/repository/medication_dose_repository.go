@@ -0,0 +1,223 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"healthcare-app/internal/medication/constant"
+	"healthcare-app/internal/medication/entity"
+	apperrorPkg "healthcare-app/pkg/apperror"
+	"healthcare-app/pkg/database/transactor"
+)
+
+type MedicationDoseRepository interface {
+	FindAllByScheduleID(ctx context.Context, scheduleID int64) ([]*entity.MedicationDose, error)
+	FindByIDAndScheduleID(ctx context.Context, id int64, scheduleID int64) (*entity.MedicationDose, error)
+	FindAllDueForReminder(ctx context.Context, from time.Time, to time.Time) ([]*entity.MedicationReminder, error)
+	SaveAll(ctx context.Context, scheduleID int64, scheduledAts []time.Time) error
+	UpdateStatus(ctx context.Context, dose *entity.MedicationDose) error
+	MarkReminded(ctx context.Context, ids []int64) error
+}
+
+type medicationDoseRepositoryImpl struct {
+	db *sql.DB
+}
+
+func NewMedicationDoseRepository(db *sql.DB) *medicationDoseRepositoryImpl {
+	return &medicationDoseRepositoryImpl{
+		db: db,
+	}
+}
+
+func (r *medicationDoseRepositoryImpl) FindAllByScheduleID(ctx context.Context, scheduleID int64) ([]*entity.MedicationDose, error) {
+	query := `
+		select id, schedule_id, scheduled_at, status, reminded_at, logged_at
+		from medication_doses where schedule_id = $1 order by scheduled_at
+	`
+	tx := transactor.ExtractTx(ctx)
+
+	var (
+		err  error
+		rows *sql.Rows
+	)
+	if tx != nil {
+		rows, err = tx.QueryContext(ctx, query, scheduleID)
+	} else {
+		rows, err = r.db.QueryContext(ctx, query, scheduleID)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	doses := []*entity.MedicationDose{}
+	for rows.Next() {
+		dose := new(entity.MedicationDose)
+		if err := rows.Scan(
+			&dose.ID,
+			&dose.ScheduleID,
+			&dose.ScheduledAt,
+			&dose.Status,
+			&dose.RemindedAt,
+			&dose.LoggedAt,
+		); err != nil {
+			return nil, err
+		}
+		doses = append(doses, dose)
+	}
+
+	err = rows.Err()
+	if err != nil {
+		return nil, err
+	}
+	return doses, nil
+}
+
+func (r *medicationDoseRepositoryImpl) FindByIDAndScheduleID(ctx context.Context, id int64, scheduleID int64) (*entity.MedicationDose, error) {
+	query := `
+		select id, schedule_id, scheduled_at, status, reminded_at, logged_at
+		from medication_doses where id = $1 and schedule_id = $2
+	`
+	tx := transactor.ExtractTx(ctx)
+
+	var (
+		err  error
+		dose entity.MedicationDose
+	)
+	if tx != nil {
+		err = tx.QueryRowContext(ctx, query, id, scheduleID).Scan(
+			&dose.ID,
+			&dose.ScheduleID,
+			&dose.ScheduledAt,
+			&dose.Status,
+			&dose.RemindedAt,
+			&dose.LoggedAt,
+		)
+	} else {
+		err = r.db.QueryRowContext(ctx, query, id, scheduleID).Scan(
+			&dose.ID,
+			&dose.ScheduleID,
+			&dose.ScheduledAt,
+			&dose.Status,
+			&dose.RemindedAt,
+			&dose.LoggedAt,
+		)
+	}
+
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, apperrorPkg.NewEntityNotFoundError("dose")
+		}
+		return nil, err
+	}
+	return &dose, nil
+}
+
+func (r *medicationDoseRepositoryImpl) FindAllDueForReminder(ctx context.Context, from time.Time, to time.Time) ([]*entity.MedicationReminder, error) {
+	query := `
+		select md.id, ms.id, u.id, u.email, ms.medicine_name, ms.dosage, md.scheduled_at
+		from medication_doses md
+		join medication_schedules ms on ms.id = md.schedule_id
+		join users u on u.id = ms.user_id
+		where md.status = $1 and md.reminded_at is null and md.scheduled_at between $2 and $3
+			and ms.status = $4 and ms.deleted_at is null
+		order by md.scheduled_at
+	`
+	tx := transactor.ExtractTx(ctx)
+
+	var (
+		err  error
+		rows *sql.Rows
+	)
+	if tx != nil {
+		rows, err = tx.QueryContext(ctx, query, constant.DOSE_STATUS_PENDING, from, to, constant.SCHEDULE_STATUS_ACTIVE)
+	} else {
+		rows, err = r.db.QueryContext(ctx, query, constant.DOSE_STATUS_PENDING, from, to, constant.SCHEDULE_STATUS_ACTIVE)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	reminders := []*entity.MedicationReminder{}
+	for rows.Next() {
+		reminder := new(entity.MedicationReminder)
+		if err := rows.Scan(
+			&reminder.DoseID,
+			&reminder.ScheduleID,
+			&reminder.UserID,
+			&reminder.Email,
+			&reminder.MedicineName,
+			&reminder.Dosage,
+			&reminder.ScheduledAt,
+		); err != nil {
+			return nil, err
+		}
+		reminders = append(reminders, reminder)
+	}
+
+	err = rows.Err()
+	if err != nil {
+		return nil, err
+	}
+	return reminders, nil
+}
+
+func (r *medicationDoseRepositoryImpl) SaveAll(ctx context.Context, scheduleID int64, scheduledAts []time.Time) error {
+	query := `
+		insert into medication_doses(schedule_id, scheduled_at) select $1, unnest($2::timestamp[])
+	`
+	tx := transactor.ExtractTx(ctx)
+
+	var err error
+	if tx != nil {
+		_, err = tx.ExecContext(ctx, query, scheduleID, scheduledAts)
+	} else {
+		_, err = r.db.ExecContext(ctx, query, scheduleID, scheduledAts)
+	}
+
+	return err
+}
+
+func (r *medicationDoseRepositoryImpl) UpdateStatus(ctx context.Context, dose *entity.MedicationDose) error {
+	query := `
+		update medication_doses set status = $3, logged_at = now(), updated_at = now()
+		where id = $1 and schedule_id = $2 returning logged_at
+	`
+	tx := transactor.ExtractTx(ctx)
+
+	var err error
+	if tx != nil {
+		err = tx.QueryRowContext(ctx, query, dose.ID, dose.ScheduleID, dose.Status).Scan(&dose.LoggedAt)
+	} else {
+		err = r.db.QueryRowContext(ctx, query, dose.ID, dose.ScheduleID, dose.Status).Scan(&dose.LoggedAt)
+	}
+
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return apperrorPkg.NewEntityNotFoundError("dose")
+		}
+		return err
+	}
+	return nil
+}
+
+func (r *medicationDoseRepositoryImpl) MarkReminded(ctx context.Context, ids []int64) error {
+	query := `
+		update medication_doses set reminded_at = now(), updated_at = now() where id = any($1)
+	`
+	tx := transactor.ExtractTx(ctx)
+
+	var err error
+	if tx != nil {
+		_, err = tx.ExecContext(ctx, query, ids)
+	} else {
+		_, err = r.db.ExecContext(ctx, query, ids)
+	}
+
+	return err
+}
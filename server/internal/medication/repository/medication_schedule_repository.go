@@ -0,0 +1,235 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"healthcare-app/internal/medication/constant"
+	"healthcare-app/internal/medication/entity"
+	apperrorPkg "healthcare-app/pkg/apperror"
+	"healthcare-app/pkg/database/transactor"
+)
+
+type MedicationScheduleRepository interface {
+	FindAllByUserID(ctx context.Context, userID int64, now time.Time) ([]*entity.MedicationSchedule, error)
+	FindAllByOrderID(ctx context.Context, orderID int64, now time.Time) ([]*entity.MedicationSchedule, error)
+	FindByIDAndUserID(ctx context.Context, id int64, userID int64, now time.Time) (*entity.MedicationSchedule, error)
+	Save(ctx context.Context, schedule *entity.MedicationSchedule) error
+	UpdateStatus(ctx context.Context, schedule *entity.MedicationSchedule) error
+	CompleteFinished(ctx context.Context, date time.Time) error
+	DeleteByIDAndUserID(ctx context.Context, id int64, userID int64) error
+}
+
+type medicationScheduleRepositoryImpl struct {
+	db *sql.DB
+}
+
+func NewMedicationScheduleRepository(db *sql.DB) *medicationScheduleRepositoryImpl {
+	return &medicationScheduleRepositoryImpl{
+		db: db,
+	}
+}
+
+// medicationScheduleSelectQuery counts the doses per status, pending doses
+// scheduled before $1 are counted as missed.
+const medicationScheduleSelectQuery = `
+	select
+		ms.id, ms.user_id, ms.order_id, ms.pharmacy_product_id, ms.medicine_name, ms.dosage, ms.times_per_day, ms.duration_days, ms.start_date, ms.notes, ms.status, ms.created_by, ms.created_at, ms.updated_at,
+		count(md.id),
+		count(md.id) filter (where md.status = 'TAKEN'),
+		count(md.id) filter (where md.status = 'SKIPPED'),
+		count(md.id) filter (where md.status = 'PENDING' and md.scheduled_at < $1)
+	from medication_schedules ms
+	left join medication_doses md on md.schedule_id = ms.id
+`
+
+func (r *medicationScheduleRepositoryImpl) FindAllByUserID(ctx context.Context, userID int64, now time.Time) ([]*entity.MedicationSchedule, error) {
+	query := medicationScheduleSelectQuery + " where ms.user_id = $2 and ms.deleted_at is null group by ms.id order by ms.created_at desc"
+	return r.findAll(ctx, query, now, userID)
+}
+
+func (r *medicationScheduleRepositoryImpl) FindAllByOrderID(ctx context.Context, orderID int64, now time.Time) ([]*entity.MedicationSchedule, error) {
+	query := medicationScheduleSelectQuery + " where ms.order_id = $2 and ms.deleted_at is null group by ms.id order by ms.created_at desc"
+	return r.findAll(ctx, query, now, orderID)
+}
+
+func (r *medicationScheduleRepositoryImpl) FindByIDAndUserID(ctx context.Context, id int64, userID int64, now time.Time) (*entity.MedicationSchedule, error) {
+	query := medicationScheduleSelectQuery + " where ms.id = $2 and ms.user_id = $3 and ms.deleted_at is null group by ms.id"
+	schedules, err := r.findAll(ctx, query, now, id, userID)
+	if err != nil {
+		return nil, err
+	}
+	if len(schedules) == 0 {
+		return nil, apperrorPkg.NewEntityNotFoundError("medication schedule")
+	}
+	return schedules[0], nil
+}
+
+func (r *medicationScheduleRepositoryImpl) Save(ctx context.Context, schedule *entity.MedicationSchedule) error {
+	query := `
+		insert into medication_schedules(user_id, order_id, pharmacy_product_id, medicine_name, dosage, times_per_day, duration_days, start_date, notes, status, created_by)
+		values ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11) returning id, created_at, updated_at
+	`
+	tx := transactor.ExtractTx(ctx)
+
+	var err error
+	if tx != nil {
+		err = tx.QueryRowContext(
+			ctx,
+			query,
+			schedule.UserID,
+			schedule.OrderID,
+			schedule.PharmacyProductID,
+			schedule.MedicineName,
+			schedule.Dosage,
+			schedule.TimesPerDay,
+			schedule.DurationDays,
+			schedule.StartDate,
+			schedule.Notes,
+			schedule.Status,
+			schedule.CreatedBy,
+		).Scan(&schedule.ID, &schedule.CreatedAt, &schedule.UpdatedAt)
+	} else {
+		err = r.db.QueryRowContext(
+			ctx,
+			query,
+			schedule.UserID,
+			schedule.OrderID,
+			schedule.PharmacyProductID,
+			schedule.MedicineName,
+			schedule.Dosage,
+			schedule.TimesPerDay,
+			schedule.DurationDays,
+			schedule.StartDate,
+			schedule.Notes,
+			schedule.Status,
+			schedule.CreatedBy,
+		).Scan(&schedule.ID, &schedule.CreatedAt, &schedule.UpdatedAt)
+	}
+
+	return err
+}
+
+func (r *medicationScheduleRepositoryImpl) UpdateStatus(ctx context.Context, schedule *entity.MedicationSchedule) error {
+	query := `
+		update medication_schedules set status = $2, start_date = $3, updated_at = now()
+		where id = $1 and deleted_at is null returning updated_at
+	`
+	tx := transactor.ExtractTx(ctx)
+
+	var err error
+	if tx != nil {
+		err = tx.QueryRowContext(ctx, query, schedule.ID, schedule.Status, schedule.StartDate).Scan(&schedule.UpdatedAt)
+	} else {
+		err = r.db.QueryRowContext(ctx, query, schedule.ID, schedule.Status, schedule.StartDate).Scan(&schedule.UpdatedAt)
+	}
+
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return apperrorPkg.NewEntityNotFoundError("medication schedule")
+		}
+		return err
+	}
+	return nil
+}
+
+func (r *medicationScheduleRepositoryImpl) CompleteFinished(ctx context.Context, date time.Time) error {
+	query := `
+		update medication_schedules set status = $1, updated_at = now()
+		where status = $2 and start_date + duration_days <= $3 and deleted_at is null
+	`
+	tx := transactor.ExtractTx(ctx)
+
+	var err error
+	if tx != nil {
+		_, err = tx.ExecContext(ctx, query, constant.SCHEDULE_STATUS_COMPLETED, constant.SCHEDULE_STATUS_ACTIVE, date)
+	} else {
+		_, err = r.db.ExecContext(ctx, query, constant.SCHEDULE_STATUS_COMPLETED, constant.SCHEDULE_STATUS_ACTIVE, date)
+	}
+
+	return err
+}
+
+func (r *medicationScheduleRepositoryImpl) DeleteByIDAndUserID(ctx context.Context, id int64, userID int64) error {
+	query := `
+		update medication_schedules set status = $3, deleted_at = now() where id = $1 and user_id = $2 and deleted_at is null
+	`
+	tx := transactor.ExtractTx(ctx)
+
+	var (
+		err error
+		res sql.Result
+	)
+	if tx != nil {
+		res, err = tx.ExecContext(ctx, query, id, userID, constant.SCHEDULE_STATUS_CANCELLED)
+	} else {
+		res, err = r.db.ExecContext(ctx, query, id, userID, constant.SCHEDULE_STATUS_CANCELLED)
+	}
+	if err != nil {
+		return err
+	}
+
+	rowsAffected, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rowsAffected == 0 {
+		return apperrorPkg.NewEntityNotFoundError("medication schedule")
+	}
+	return nil
+}
+
+func (r *medicationScheduleRepositoryImpl) findAll(ctx context.Context, query string, args ...any) ([]*entity.MedicationSchedule, error) {
+	tx := transactor.ExtractTx(ctx)
+
+	var (
+		err  error
+		rows *sql.Rows
+	)
+	if tx != nil {
+		rows, err = tx.QueryContext(ctx, query, args...)
+	} else {
+		rows, err = r.db.QueryContext(ctx, query, args...)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	schedules := []*entity.MedicationSchedule{}
+	for rows.Next() {
+		schedule := new(entity.MedicationSchedule)
+		if err := rows.Scan(
+			&schedule.ID,
+			&schedule.UserID,
+			&schedule.OrderID,
+			&schedule.PharmacyProductID,
+			&schedule.MedicineName,
+			&schedule.Dosage,
+			&schedule.TimesPerDay,
+			&schedule.DurationDays,
+			&schedule.StartDate,
+			&schedule.Notes,
+			&schedule.Status,
+			&schedule.CreatedBy,
+			&schedule.CreatedAt,
+			&schedule.UpdatedAt,
+			&schedule.TotalDoses,
+			&schedule.TakenDoses,
+			&schedule.SkippedDoses,
+			&schedule.MissedDoses,
+		); err != nil {
+			return nil, err
+		}
+		schedules = append(schedules, schedule)
+	}
+
+	err = rows.Err()
+	if err != nil {
+		return nil, err
+	}
+	return schedules, nil
+}
@@ -0,0 +1,31 @@
+package route
+
+import (
+	"healthcare-app/internal/auth/constant"
+	"healthcare-app/internal/medication/controller"
+	"healthcare-app/pkg/middleware"
+
+	"github.com/gin-gonic/gin"
+)
+
+const scheduleId = "/:scheduleId"
+
+func UserMedicationControllerRoute(c *controller.UserMedicationController, r *gin.Engine, authMiddleware *middleware.AuthMiddleware) {
+	g := r.Group("/users/me/medication-schedules", authMiddleware.Authorization(), authMiddleware.ProtectedRoles(constant.USER))
+	{
+		g.GET("", c.GetAllMySchedules)
+		g.POST("", c.PostNewSchedule)
+		g.GET(scheduleId, c.GetMySchedule)
+		g.DELETE(scheduleId, c.DeleteMySchedule)
+		g.PATCH(scheduleId+"/accept", c.AcceptMySchedule)
+		g.PATCH(scheduleId+"/doses/:doseId", c.LogMyDose)
+	}
+}
+
+func PharmacistMedicationControllerRoute(c *controller.PharmacistMedicationController, r *gin.Engine, authMiddleware *middleware.AuthMiddleware) {
+	g := r.Group("/pharmacists/pharmacies/:pharmacyId/orders/:orderId/medication-schedules", authMiddleware.Authorization(), authMiddleware.ProtectedRoles(constant.PHARMACIST))
+	{
+		g.GET("", c.GetOrderSchedules)
+		g.POST("", c.ProposeSchedule)
+	}
+}
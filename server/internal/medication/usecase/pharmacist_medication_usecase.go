@@ -0,0 +1,115 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	appErrorMedication "healthcare-app/internal/medication/apperror"
+	"healthcare-app/internal/medication/constant"
+	dtoMedication "healthcare-app/internal/medication/dto"
+	"healthcare-app/internal/medication/entity"
+	medicationRepository "healthcare-app/internal/medication/repository"
+	constantOrder "healthcare-app/internal/order/constant"
+	dtoOrder "healthcare-app/internal/order/dto"
+	entityOrder "healthcare-app/internal/order/entity"
+	orderRepository "healthcare-app/internal/order/repository"
+	appErrorPkg "healthcare-app/pkg/apperror"
+)
+
+type PharmacistMedicationUseCase interface {
+	GetOrderSchedules(ctx context.Context, order *dtoOrder.GetOrderRequest) ([]*dtoMedication.MedicationScheduleResponse, error)
+	ProposeSchedule(ctx context.Context, reqBody *dtoMedication.RequestProposeMedicationSchedule) (*dtoMedication.MedicationScheduleResponse, error)
+}
+
+type pharmacistMedicationUseCaseImpl struct {
+	scheduleRepo        medicationRepository.MedicationScheduleRepository
+	pharmacistOrderRepo orderRepository.PharmacistOrderRepository
+}
+
+func NewPharmacistMedicationUseCase(
+	scheduleRepo medicationRepository.MedicationScheduleRepository,
+	pharmacistOrderRepo orderRepository.PharmacistOrderRepository,
+) *pharmacistMedicationUseCaseImpl {
+	return &pharmacistMedicationUseCaseImpl{
+		scheduleRepo:        scheduleRepo,
+		pharmacistOrderRepo: pharmacistOrderRepo,
+	}
+}
+
+func (u *pharmacistMedicationUseCaseImpl) GetOrderSchedules(ctx context.Context, order *dtoOrder.GetOrderRequest) ([]*dtoMedication.MedicationScheduleResponse, error) {
+	if _, err := u.findOrder(ctx, order); err != nil {
+		return nil, err
+	}
+
+	schedules, err := u.scheduleRepo.FindAllByOrderID(ctx, order.ID, time.Now().UTC())
+	if err != nil {
+		return nil, appErrorPkg.NewServerError(err)
+	}
+	return dtoMedication.ConvertToMedicationScheduleResponses(schedules), nil
+}
+
+// ProposeSchedule attaches a schedule to one of the order's products. The
+// schedule only starts producing doses once the user accepts it.
+func (u *pharmacistMedicationUseCaseImpl) ProposeSchedule(ctx context.Context, reqBody *dtoMedication.RequestProposeMedicationSchedule) (*dtoMedication.MedicationScheduleResponse, error) {
+	orders, err := u.findOrder(ctx, &dtoOrder.GetOrderRequest{
+		ID:           reqBody.OrderID,
+		PharmacyID:   reqBody.PharmacyID,
+		PharmacistID: reqBody.PharmacistID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	switch orders[0].OrderStatus {
+	case constantOrder.STATUS_PROCESSED, constantOrder.STATUS_SENT, constantOrder.STATUS_CONFIRMED:
+	default:
+		return nil, appErrorMedication.NewInvalidScheduleOrderError()
+	}
+
+	var schedule *entity.MedicationSchedule
+	for _, order := range orders {
+		if order.OrderProduct.ProductID == reqBody.PharmacyProductID {
+			schedule = &entity.MedicationSchedule{
+				UserID:            order.UserID,
+				OrderID:           &order.ID,
+				PharmacyProductID: &reqBody.PharmacyProductID,
+				MedicineName:      order.OrderProduct.ProductName,
+				Dosage:            reqBody.Dosage,
+				TimesPerDay:       reqBody.TimesPerDay,
+				DurationDays:      reqBody.DurationDays,
+				Notes:             reqBody.Notes,
+				Status:            constant.SCHEDULE_STATUS_PROPOSED,
+				CreatedBy:         reqBody.PharmacistID,
+			}
+			break
+		}
+	}
+	if schedule == nil {
+		return nil, appErrorMedication.NewInvalidScheduleProductError()
+	}
+
+	if err := u.scheduleRepo.Save(ctx, schedule); err != nil {
+		return nil, appErrorPkg.NewServerError(err)
+	}
+	return dtoMedication.ConvertToMedicationScheduleResponse(schedule), nil
+}
+
+func (u *pharmacistMedicationUseCaseImpl) findOrder(ctx context.Context, order *dtoOrder.GetOrderRequest) ([]*entityOrder.Order, error) {
+	ok, err := u.pharmacistOrderRepo.IsPharmacistAssign(ctx, order.PharmacyID, order.PharmacistID)
+	if err != nil {
+		return nil, appErrorPkg.NewServerError(err)
+	}
+
+	if !ok {
+		return nil, appErrorPkg.NewForbiddenAccessError()
+	}
+
+	orders, err := u.pharmacistOrderRepo.FindByID(ctx, order)
+	if err != nil {
+		return nil, err
+	}
+	if len(orders) == 0 {
+		return nil, appErrorPkg.NewEntityNotFoundError("order")
+	}
+	return orders, nil
+}
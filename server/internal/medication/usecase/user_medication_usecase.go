@@ -0,0 +1,211 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	appErrorMedication "healthcare-app/internal/medication/apperror"
+	"healthcare-app/internal/medication/constant"
+	dtoMedication "healthcare-app/internal/medication/dto"
+	"healthcare-app/internal/medication/entity"
+	medicationRepository "healthcare-app/internal/medication/repository"
+	"healthcare-app/internal/medication/utils"
+	orderRepository "healthcare-app/internal/order/repository"
+	"healthcare-app/internal/queue/payload"
+	"healthcare-app/internal/queue/tasks"
+	appErrorPkg "healthcare-app/pkg/apperror"
+	"healthcare-app/pkg/database/transactor"
+)
+
+type UserMedicationUseCase interface {
+	GetAllMySchedules(ctx context.Context, userID int64) ([]*dtoMedication.MedicationScheduleResponse, error)
+	GetMySchedule(ctx context.Context, id int64, userID int64) (*dtoMedication.MedicationScheduleResponse, error)
+	PostNewSchedule(ctx context.Context, reqBody *dtoMedication.RequestMedicationSchedule) (*dtoMedication.MedicationScheduleResponse, error)
+	AcceptMySchedule(ctx context.Context, reqBody *dtoMedication.RequestAcceptMedicationSchedule) (*dtoMedication.MedicationScheduleResponse, error)
+	DeleteMySchedule(ctx context.Context, id int64, userID int64) error
+	LogMyDose(ctx context.Context, reqBody *dtoMedication.RequestLogDose) (*dtoMedication.MedicationScheduleResponse, error)
+	SendDoseReminders(ctx context.Context) error
+	CompleteFinishedSchedules(ctx context.Context) error
+}
+
+type userMedicationUseCaseImpl struct {
+	scheduleRepo  medicationRepository.MedicationScheduleRepository
+	doseRepo      medicationRepository.MedicationDoseRepository
+	userOrderRepo orderRepository.UserOrderRepository
+	reminderTask  tasks.ReminderTask
+	transactor    transactor.Transactor
+}
+
+func NewUserMedicationUseCase(
+	scheduleRepo medicationRepository.MedicationScheduleRepository,
+	doseRepo medicationRepository.MedicationDoseRepository,
+	userOrderRepo orderRepository.UserOrderRepository,
+	reminderTask tasks.ReminderTask,
+	transactor transactor.Transactor,
+) *userMedicationUseCaseImpl {
+	return &userMedicationUseCaseImpl{
+		scheduleRepo:  scheduleRepo,
+		doseRepo:      doseRepo,
+		userOrderRepo: userOrderRepo,
+		reminderTask:  reminderTask,
+		transactor:    transactor,
+	}
+}
+
+func (u *userMedicationUseCaseImpl) GetAllMySchedules(ctx context.Context, userID int64) ([]*dtoMedication.MedicationScheduleResponse, error) {
+	schedules, err := u.scheduleRepo.FindAllByUserID(ctx, userID, time.Now().UTC())
+	if err != nil {
+		return nil, appErrorPkg.NewServerError(err)
+	}
+	return dtoMedication.ConvertToMedicationScheduleResponses(schedules), nil
+}
+
+func (u *userMedicationUseCaseImpl) GetMySchedule(ctx context.Context, id int64, userID int64) (*dtoMedication.MedicationScheduleResponse, error) {
+	schedule, err := u.findMySchedule(ctx, id, userID)
+	if err != nil {
+		return nil, err
+	}
+	return dtoMedication.ConvertToMedicationScheduleResponse(schedule), nil
+}
+
+func (u *userMedicationUseCaseImpl) PostNewSchedule(ctx context.Context, reqBody *dtoMedication.RequestMedicationSchedule) (*dtoMedication.MedicationScheduleResponse, error) {
+	schedule := dtoMedication.MedicationScheduleRequestToEntity(reqBody)
+	if err := validateStartDate(*schedule.StartDate); err != nil {
+		return nil, err
+	}
+
+	err := u.transactor.Atomic(ctx, func(cForTx context.Context) error {
+		if schedule.OrderID != nil {
+			order, err := u.userOrderRepo.GetOrderByIDWithSingleData(cForTx, *schedule.OrderID, schedule.UserID)
+			if err != nil {
+				return appErrorPkg.NewServerError(err)
+			}
+			if order == nil {
+				return appErrorPkg.NewEntityNotFoundError("order")
+			}
+		}
+		if err := u.scheduleRepo.Save(cForTx, schedule); err != nil {
+			return appErrorPkg.NewServerError(err)
+		}
+		if err := u.doseRepo.SaveAll(cForTx, schedule.ID, utils.GenerateDoseTimes(*schedule.StartDate, schedule.TimesPerDay, schedule.DurationDays)); err != nil {
+			return appErrorPkg.NewServerError(err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return u.GetMySchedule(ctx, schedule.ID, schedule.UserID)
+}
+
+func (u *userMedicationUseCaseImpl) AcceptMySchedule(ctx context.Context, reqBody *dtoMedication.RequestAcceptMedicationSchedule) (*dtoMedication.MedicationScheduleResponse, error) {
+	startDate, _ := time.Parse(constant.DATE_LAYOUT, reqBody.StartDate)
+	if err := validateStartDate(startDate); err != nil {
+		return nil, err
+	}
+
+	err := u.transactor.Atomic(ctx, func(cForTx context.Context) error {
+		schedule, err := u.scheduleRepo.FindByIDAndUserID(cForTx, reqBody.ID, reqBody.UserID, time.Now().UTC())
+		if err != nil {
+			return err
+		}
+		if schedule.Status != constant.SCHEDULE_STATUS_PROPOSED {
+			return appErrorMedication.NewInvalidScheduleStatusError(constant.SCHEDULE_STATUS_PROPOSED)
+		}
+
+		schedule.Status = constant.SCHEDULE_STATUS_ACTIVE
+		schedule.StartDate = &startDate
+		if err := u.scheduleRepo.UpdateStatus(cForTx, schedule); err != nil {
+			return err
+		}
+		if err := u.doseRepo.SaveAll(cForTx, schedule.ID, utils.GenerateDoseTimes(startDate, schedule.TimesPerDay, schedule.DurationDays)); err != nil {
+			return appErrorPkg.NewServerError(err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return u.GetMySchedule(ctx, reqBody.ID, reqBody.UserID)
+}
+
+func (u *userMedicationUseCaseImpl) DeleteMySchedule(ctx context.Context, id int64, userID int64) error {
+	return u.scheduleRepo.DeleteByIDAndUserID(ctx, id, userID)
+}
+
+func (u *userMedicationUseCaseImpl) LogMyDose(ctx context.Context, reqBody *dtoMedication.RequestLogDose) (*dtoMedication.MedicationScheduleResponse, error) {
+	schedule, err := u.scheduleRepo.FindByIDAndUserID(ctx, reqBody.ScheduleID, reqBody.UserID, time.Now().UTC())
+	if err != nil {
+		return nil, err
+	}
+	if schedule.Status != constant.SCHEDULE_STATUS_ACTIVE {
+		return nil, appErrorMedication.NewInvalidScheduleStatusError(constant.SCHEDULE_STATUS_ACTIVE)
+	}
+
+	dose, err := u.doseRepo.FindByIDAndScheduleID(ctx, reqBody.ID, schedule.ID)
+	if err != nil {
+		return nil, err
+	}
+	if dose.ScheduledAt.After(time.Now().Add(constant.DOSE_LOG_EARLY_PERIOD)) {
+		return nil, appErrorMedication.NewInvalidDoseNotDueError()
+	}
+
+	dose.Status = reqBody.Status
+	if err := u.doseRepo.UpdateStatus(ctx, dose); err != nil {
+		return nil, err
+	}
+	return u.GetMySchedule(ctx, schedule.ID, schedule.UserID)
+}
+
+func (u *userMedicationUseCaseImpl) SendDoseReminders(ctx context.Context) error {
+	now := time.Now().UTC()
+	reminders, err := u.doseRepo.FindAllDueForReminder(ctx, now.Add(-constant.DOSE_REMINDER_GRACE_PERIOD), now)
+	if err != nil {
+		return err
+	}
+
+	ids := []int64{}
+	for _, reminder := range reminders {
+		err := u.reminderTask.QueueMedicationReminder(ctx, &payload.MedicationReminderPayload{
+			UserID:       reminder.UserID,
+			Email:        reminder.Email,
+			ScheduleID:   reminder.ScheduleID,
+			MedicineName: reminder.MedicineName,
+			Dosage:       reminder.Dosage,
+			ScheduledAt:  reminder.ScheduledAt.In(utils.Location()).Format(constant.DATETIME_LAYOUT),
+		})
+		if err != nil {
+			return err
+		}
+		ids = append(ids, reminder.DoseID)
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	return u.doseRepo.MarkReminded(ctx, ids)
+}
+
+func (u *userMedicationUseCaseImpl) CompleteFinishedSchedules(ctx context.Context) error {
+	year, month, day := time.Now().In(utils.Location()).Date()
+	return u.scheduleRepo.CompleteFinished(ctx, time.Date(year, month, day, 0, 0, 0, 0, time.UTC))
+}
+
+func (u *userMedicationUseCaseImpl) findMySchedule(ctx context.Context, id int64, userID int64) (*entity.MedicationSchedule, error) {
+	schedule, err := u.scheduleRepo.FindByIDAndUserID(ctx, id, userID, time.Now().UTC())
+	if err != nil {
+		return nil, err
+	}
+	schedule.Doses, err = u.doseRepo.FindAllByScheduleID(ctx, schedule.ID)
+	if err != nil {
+		return nil, appErrorPkg.NewServerError(err)
+	}
+	return schedule, nil
+}
+
+func validateStartDate(startDate time.Time) error {
+	year, month, day := time.Now().In(utils.Location()).Date()
+	if startDate.Before(time.Date(year, month, day, 0, 0, 0, 0, time.UTC)) {
+		return appErrorMedication.NewInvalidScheduleStartDateError()
+	}
+	return nil
+}
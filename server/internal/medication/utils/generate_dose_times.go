@@ -0,0 +1,37 @@
+package utils
+
+import (
+	"time"
+
+	"healthcare-app/internal/medication/constant"
+)
+
+// Location returns the timezone dose hours are expressed in. Doses are stored
+// in UTC and converted back with it when shown to the user.
+func Location() *time.Location {
+	loc, err := time.LoadLocation(constant.TIMEZONE)
+	if err != nil {
+		return time.Local
+	}
+	return loc
+}
+
+// GenerateDoseTimes spreads the daily doses evenly between the first and the
+// last dose hour, e.g. 2x daily is 08:00 and 20:00, 3x daily adds 14:00.
+func GenerateDoseTimes(startDate time.Time, timesPerDay int, durationDays int) []time.Time {
+	interval := time.Duration(0)
+	if timesPerDay > 1 {
+		interval = time.Duration(constant.LAST_DOSE_HOUR-constant.FIRST_DOSE_HOUR) * time.Hour / time.Duration(timesPerDay-1)
+	}
+
+	loc := Location()
+	year, month, day := startDate.Date()
+	doseTimes := []time.Time{}
+	for d := 0; d < durationDays; d++ {
+		firstDose := time.Date(year, month, day+d, constant.FIRST_DOSE_HOUR, 0, 0, 0, loc)
+		for t := 0; t < timesPerDay; t++ {
+			doseTimes = append(doseTimes, firstDose.Add(time.Duration(t)*interval).UTC())
+		}
+	}
+	return doseTimes
+}
@@ -0,0 +1,26 @@
+package apperror
+
+import (
+	"errors"
+
+	"healthcare-app/internal/notification/constant"
+	"healthcare-app/pkg/apperror"
+)
+
+func NewInvalidNotificationTypeError() *apperror.AppError {
+	msg := constant.InvalidNotificationType
+	err := errors.New(msg)
+	return apperror.NewAppError(err, apperror.DefaultClientErrorCode, msg)
+}
+
+func NewInvalidNotificationChannelError() *apperror.AppError {
+	msg := constant.InvalidNotificationChannel
+	err := errors.New(msg)
+	return apperror.NewAppError(err, apperror.DefaultClientErrorCode, msg)
+}
+
+func NewInvalidQuietHoursError() *apperror.AppError {
+	msg := constant.InvalidQuietHours
+	err := errors.New(msg)
+	return apperror.NewAppError(err, apperror.DefaultClientErrorCode, msg)
+}
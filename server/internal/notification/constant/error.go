@@ -0,0 +1,7 @@
+package constant
+
+const (
+	InvalidNotificationType    = "notification type can not be changed"
+	InvalidNotificationChannel = "notification channel is not supported"
+	InvalidQuietHours          = "quiet hours must be in HH:MM and can not start and end at the same time"
+)
@@ -0,0 +1,108 @@
+package constant
+
+import (
+	constantAuth "healthcare-app/internal/auth/constant"
+	"healthcare-app/pkg/utils/notificationutils"
+)
+
+const (
+	TYPE_ORDER_RECEIPT    = "order_receipt"
+	TYPE_ORDER_PROCESSED  = "order_processed"
+	TYPE_ORDER_SENT       = "order_sent"
+	TYPE_ORDER_CANCELLED  = "order_cancelled"
+	TYPE_ORDER_CONFIRMED  = "order_confirmed"
+	TYPE_PAYMENT_REJECTED = "payment_rejected"
+	TYPE_NEW_ORDER        = "new_order"
+	TYPE_LOW_STOCK        = "low_stock"
+	TYPE_INVITE           = "invite"
+	TYPE_REFILL_REMINDER  = "refill_reminder"
+	TYPE_REFILL_SHORTAGE  = "refill_shortage"
+	TYPE_DOSE_REMINDER    = "dose_reminder"
+)
+
+const (
+	TITLE_ORDER_PROCESSED  = "Payment received"
+	TITLE_ORDER_SENT       = "Order on the way"
+	TITLE_ORDER_CANCELLED  = "Order cancelled"
+	TITLE_PAYMENT_REJECTED = "Payment rejected"
+	TITLE_NEW_ORDER        = "New order to send"
+	TITLE_LOW_STOCK        = "Stock running low"
+	TITLE_INVITE           = "Welcome aboard"
+)
+
+const (
+	BODY_ORDER_PROCESSED  = "We received the payment for order %s, the pharmacy is preparing it now."
+	BODY_ORDER_SENT       = "Order %s has been sent by the pharmacy."
+	BODY_ORDER_CANCELLED  = "Order %s was cancelled by the pharmacy."
+	BODY_PAYMENT_REJECTED = "The payment for order %s was rejected by the pharmacy and the order was cancelled."
+	BODY_NEW_ORDER        = "Order %s at %s is paid and waiting to be sent."
+	BODY_LOW_STOCK        = "%s at %s has %d left in stock."
+	BODY_INVITE           = "Your %s account is ready, check your email for the sign in details."
+)
+
+const (
+	INVITE_ROLE_PHARMACIST = "pharmacist"
+	INVITE_ROLE_DOCTOR     = "doctor"
+)
+
+const (
+	LINK_USER_ORDER       = "/orders/%d"
+	LINK_PHARMACY_ORDER   = "/pharmacist/pharmacies/%d/orders"
+	LINK_PHARMACY_PRODUCT = "/pharmacist/pharmacies/%d/products"
+	LINK_PROFILE          = "/profile"
+)
+
+const (
+	QUIET_HOURS_TIMEZONE = "Asia/Jakarta"
+	QUIET_HOURS_LAYOUT   = "15:04"
+)
+
+var (
+	// Channels is every channel a preference can be set for, in the order
+	// they are listed back to the client.
+	Channels = []string{
+		notificationutils.ChannelEmail,
+		notificationutils.ChannelInApp,
+		notificationutils.ChannelWhatsapp,
+		notificationutils.ChannelPush,
+	}
+	// DeliveredChannels are sent by the worker once the caller commits. Email
+	// is not among them, rich emails go out through SendMail instead.
+	DeliveredChannels = []string{
+		notificationutils.ChannelWhatsapp,
+		notificationutils.ChannelPush,
+	}
+	// DefaultChannels is what an event goes out on until the user says
+	// otherwise, whatsapp and push are opt in.
+	DefaultChannels = map[string]bool{
+		notificationutils.ChannelEmail:    true,
+		notificationutils.ChannelInApp:    true,
+		notificationutils.ChannelWhatsapp: false,
+		notificationutils.ChannelPush:     false,
+	}
+	// RoleEventTypes is what each role can tune, events that are not listed
+	// always go out on the default channels.
+	RoleEventTypes = map[int][]string{
+		constantAuth.USER: {
+			TYPE_ORDER_RECEIPT,
+			TYPE_ORDER_PROCESSED,
+			TYPE_ORDER_SENT,
+			TYPE_ORDER_CANCELLED,
+			TYPE_ORDER_CONFIRMED,
+			TYPE_PAYMENT_REJECTED,
+			TYPE_REFILL_REMINDER,
+			TYPE_REFILL_SHORTAGE,
+			TYPE_DOSE_REMINDER,
+		},
+		constantAuth.PHARMACIST: {
+			TYPE_NEW_ORDER,
+			TYPE_LOW_STOCK,
+		},
+	}
+	// QuietHoursTypes are the pharmacist alerts that only reach the in-app
+	// inbox during quiet hours.
+	QuietHoursTypes = map[string]struct{}{
+		TYPE_NEW_ORDER: {},
+		TYPE_LOW_STOCK: {},
+	}
+)
@@ -0,0 +1,66 @@
+package controller
+
+import (
+	"strconv"
+
+	"healthcare-app/internal/auth/utils"
+	dtoNotification "healthcare-app/internal/notification/dto"
+	"healthcare-app/internal/notification/usecase"
+	"healthcare-app/pkg/apperror"
+	"healthcare-app/pkg/utils/ginutils"
+
+	"github.com/gin-gonic/gin"
+)
+
+type NotificationController struct {
+	notificationUseCase usecase.NotificationUseCase
+}
+
+func NewNotificationController(notificationUseCase usecase.NotificationUseCase) *NotificationController {
+	return &NotificationController{
+		notificationUseCase: notificationUseCase,
+	}
+}
+
+func (c *NotificationController) GetAllMyNotifications(ctx *gin.Context) {
+	req := &dtoNotification.GetNotificationRequest{UserID: utils.GetValueUserIdFromToken(ctx)}
+	if err := ctx.ShouldBindQuery(req); err != nil {
+		ctx.Error(err)
+		return
+	}
+	res, paging, err := c.notificationUseCase.GetAllMyNotifications(ctx, req)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+	ginutils.ResponseOKSeekPagination(ctx, res, paging)
+}
+
+func (c *NotificationController) ReadNotification(ctx *gin.Context) {
+	notificationID, err := strconv.Atoi(ctx.Param("notificationId"))
+	if err != nil {
+		ctx.Error(apperror.NewInvalidIdError())
+		return
+	}
+	res, err := c.notificationUseCase.ReadNotification(ctx, int64(notificationID), utils.GetValueUserIdFromToken(ctx))
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+	ginutils.ResponseOK(ctx, res)
+}
+
+func (c *NotificationController) ReadNotifications(ctx *gin.Context) {
+	req := &dtoNotification.RequestReadNotification{UserID: utils.GetValueUserIdFromToken(ctx)}
+	if ctx.Request.ContentLength != 0 {
+		if err := ctx.ShouldBindJSON(req); err != nil {
+			ctx.Error(err)
+			return
+		}
+	}
+	if err := c.notificationUseCase.ReadNotifications(ctx, req); err != nil {
+		ctx.Error(err)
+		return
+	}
+	ginutils.ResponseOKPlain(ctx)
+}
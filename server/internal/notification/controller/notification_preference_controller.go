@@ -0,0 +1,68 @@
+package controller
+
+import (
+	"healthcare-app/internal/auth/utils"
+	dtoNotification "healthcare-app/internal/notification/dto"
+	"healthcare-app/internal/notification/usecase"
+	"healthcare-app/pkg/utils/ginutils"
+
+	"github.com/gin-gonic/gin"
+)
+
+type NotificationPreferenceController struct {
+	notificationPreferenceUseCase usecase.NotificationPreferenceUseCase
+}
+
+func NewNotificationPreferenceController(notificationPreferenceUseCase usecase.NotificationPreferenceUseCase) *NotificationPreferenceController {
+	return &NotificationPreferenceController{
+		notificationPreferenceUseCase: notificationPreferenceUseCase,
+	}
+}
+
+func (c *NotificationPreferenceController) GetMyPreferences(ctx *gin.Context) {
+	res, err := c.notificationPreferenceUseCase.GetMyPreferences(ctx, utils.GetValueUserIdFromToken(ctx), utils.GetValueRoleUserFromToken(ctx))
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+	ginutils.ResponseOK(ctx, res)
+}
+
+func (c *NotificationPreferenceController) UpdateMyPreferences(ctx *gin.Context) {
+	req := &dtoNotification.RequestNotificationPreference{
+		UserID: utils.GetValueUserIdFromToken(ctx),
+		Role:   utils.GetValueRoleUserFromToken(ctx),
+	}
+	if err := ctx.ShouldBindJSON(req); err != nil {
+		ctx.Error(err)
+		return
+	}
+	res, err := c.notificationPreferenceUseCase.UpdateMyPreferences(ctx, req)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+	ginutils.ResponseOK(ctx, res)
+}
+
+func (c *NotificationPreferenceController) UpdateMyQuietHours(ctx *gin.Context) {
+	req := &dtoNotification.RequestNotificationQuietHours{UserID: utils.GetValueUserIdFromToken(ctx)}
+	if err := ctx.ShouldBindJSON(req); err != nil {
+		ctx.Error(err)
+		return
+	}
+	res, err := c.notificationPreferenceUseCase.UpdateMyQuietHours(ctx, req)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+	ginutils.ResponseOK(ctx, res)
+}
+
+func (c *NotificationPreferenceController) DeleteMyQuietHours(ctx *gin.Context) {
+	if err := c.notificationPreferenceUseCase.DeleteMyQuietHours(ctx, utils.GetValueUserIdFromToken(ctx)); err != nil {
+		ctx.Error(err)
+		return
+	}
+	ginutils.ResponseOKPlain(ctx)
+}
@@ -0,0 +1,56 @@
+package dto
+
+import (
+	"time"
+
+	"healthcare-app/internal/notification/entity"
+)
+
+type NotificationResponse struct {
+	ID        int64      `json:"id"`
+	Type      string     `json:"type"`
+	Title     string     `json:"title"`
+	Body      string     `json:"body"`
+	Link      *string    `json:"link"`
+	ReadAt    *time.Time `json:"read_at"`
+	CreatedAt time.Time  `json:"created_at"`
+}
+
+type NotificationListResponse struct {
+	UnreadCount   int64                   `json:"unread_count"`
+	Notifications []*NotificationResponse `json:"notifications"`
+}
+
+type GetNotificationRequest struct {
+	Last   string `form:"last" binding:"omitempty,numeric,gte=0"`
+	Limit  int64  `form:"limit" binding:"numeric,gte=1,lte=50"`
+	Unread string `form:"unread" binding:"omitempty,boolean"`
+	UserID int64  `form:"-"`
+}
+
+// RequestReadNotification marks the given notifications as read, or every
+// unread notification of the user when no id is given.
+type RequestReadNotification struct {
+	IDs    []int64 `json:"ids" binding:"omitempty,max=100,dive,gte=1"`
+	UserID int64   `json:"-"`
+}
+
+func ConvertToNotificationResponses(notifications []*entity.Notification) []*NotificationResponse {
+	responses := []*NotificationResponse{}
+	for _, notification := range notifications {
+		responses = append(responses, ConvertToNotificationResponse(notification))
+	}
+	return responses
+}
+
+func ConvertToNotificationResponse(notification *entity.Notification) *NotificationResponse {
+	return &NotificationResponse{
+		ID:        notification.ID,
+		Type:      notification.Type,
+		Title:     notification.Title,
+		Body:      notification.Body,
+		Link:      notification.Link,
+		ReadAt:    notification.ReadAt,
+		CreatedAt: notification.CreatedAt,
+	}
+}
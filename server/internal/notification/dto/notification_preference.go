@@ -0,0 +1,60 @@
+package dto
+
+import (
+	"healthcare-app/internal/notification/entity"
+	"healthcare-app/pkg/utils/smtputils"
+)
+
+type NotificationPreferenceResponse struct {
+	Preferences []*EventPreferenceResponse      `json:"preferences"`
+	QuietHours  *NotificationQuietHoursResponse `json:"quiet_hours"`
+}
+
+type EventPreferenceResponse struct {
+	Type     string          `json:"type"`
+	Channels map[string]bool `json:"channels"`
+}
+
+type NotificationQuietHoursResponse struct {
+	StartTime string `json:"start_time"`
+	EndTime   string `json:"end_time"`
+}
+
+type RequestNotificationPreference struct {
+	Preferences []RequestEventPreference `json:"preferences" binding:"required,min=1,max=50,dive"`
+	UserID      int64                    `json:"-"`
+	Role        int                      `json:"-"`
+}
+
+type RequestEventPreference struct {
+	Type    string `json:"type" binding:"required"`
+	Channel string `json:"channel" binding:"required"`
+	Enabled *bool  `json:"enabled" binding:"required"`
+}
+
+type RequestNotificationQuietHours struct {
+	StartTime string `json:"start_time" binding:"required,datetime=15:04"`
+	EndTime   string `json:"end_time" binding:"required,datetime=15:04"`
+	UserID    int64  `json:"-"`
+}
+
+// DispatchMail is an email that only goes out when the user kept email on for
+// the event type.
+type DispatchMail struct {
+	UserID   int64
+	Type     string
+	To       string
+	Subject  string
+	Template smtputils.EmailTemplate
+	Data     map[string]any
+}
+
+func ConvertToNotificationQuietHoursResponse(quietHours *entity.NotificationQuietHours) *NotificationQuietHoursResponse {
+	if quietHours == nil {
+		return nil
+	}
+	return &NotificationQuietHoursResponse{
+		StartTime: quietHours.StartTime,
+		EndTime:   quietHours.EndTime,
+	}
+}
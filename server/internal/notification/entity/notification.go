@@ -0,0 +1,14 @@
+package entity
+
+import "time"
+
+type Notification struct {
+	ID        int64
+	UserID    int64
+	Type      string
+	Title     string
+	Body      string
+	Link      *string
+	ReadAt    *time.Time
+	CreatedAt time.Time
+}
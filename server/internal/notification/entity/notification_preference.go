@@ -0,0 +1,29 @@
+package entity
+
+import "time"
+
+type NotificationPreference struct {
+	ID        int64
+	UserID    int64
+	Type      string
+	Channel   string
+	Enabled   bool
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// NotificationQuietHours are kept as HH:MM in the quiet hours timezone, the
+// end may be earlier than the start when the window spans midnight.
+type NotificationQuietHours struct {
+	UserID    int64
+	StartTime string
+	EndTime   string
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+type NotificationRecipient struct {
+	UserID         int64
+	Email          string
+	WhatsappNumber *string
+}
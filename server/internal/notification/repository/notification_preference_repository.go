@@ -0,0 +1,202 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"healthcare-app/internal/notification/entity"
+	apperrorPkg "healthcare-app/pkg/apperror"
+	"healthcare-app/pkg/database/transactor"
+)
+
+type NotificationPreferenceRepository interface {
+	FindAllByUserID(ctx context.Context, userID int64) ([]*entity.NotificationPreference, error)
+	FindAllByUserIDAndType(ctx context.Context, userID int64, notificationType string) ([]*entity.NotificationPreference, error)
+	Save(ctx context.Context, preference *entity.NotificationPreference) error
+	FindQuietHoursByUserID(ctx context.Context, userID int64) (*entity.NotificationQuietHours, error)
+	SaveQuietHours(ctx context.Context, quietHours *entity.NotificationQuietHours) error
+	DeleteQuietHours(ctx context.Context, userID int64) error
+	FindRecipientByUserID(ctx context.Context, userID int64) (*entity.NotificationRecipient, error)
+}
+
+type notificationPreferenceRepositoryImpl struct {
+	db *sql.DB
+}
+
+func NewNotificationPreferenceRepository(db *sql.DB) *notificationPreferenceRepositoryImpl {
+	return &notificationPreferenceRepositoryImpl{
+		db: db,
+	}
+}
+
+const notificationPreferenceSelectQuery = `
+	select id, user_id, type, channel, enabled, created_at, updated_at
+	from notification_preferences
+`
+
+func (r *notificationPreferenceRepositoryImpl) FindAllByUserID(ctx context.Context, userID int64) ([]*entity.NotificationPreference, error) {
+	return r.findAll(ctx, notificationPreferenceSelectQuery+" where user_id = $1 order by type, channel", userID)
+}
+
+func (r *notificationPreferenceRepositoryImpl) FindAllByUserIDAndType(ctx context.Context, userID int64, notificationType string) ([]*entity.NotificationPreference, error) {
+	return r.findAll(ctx, notificationPreferenceSelectQuery+" where user_id = $1 and type = $2", userID, notificationType)
+}
+
+// Save stores the preference for the user, type and channel, replacing the
+// previous choice if there is one.
+func (r *notificationPreferenceRepositoryImpl) Save(ctx context.Context, preference *entity.NotificationPreference) error {
+	query := `
+		insert into notification_preferences(user_id, type, channel, enabled)
+		values ($1, $2, $3, $4)
+		on conflict (user_id, type, channel) do update set enabled = excluded.enabled, updated_at = now()
+		returning id, created_at, updated_at
+	`
+	tx := transactor.ExtractTx(ctx)
+
+	var err error
+	if tx != nil {
+		err = tx.QueryRowContext(ctx, query, preference.UserID, preference.Type, preference.Channel, preference.Enabled).Scan(&preference.ID, &preference.CreatedAt, &preference.UpdatedAt)
+	} else {
+		err = r.db.QueryRowContext(ctx, query, preference.UserID, preference.Type, preference.Channel, preference.Enabled).Scan(&preference.ID, &preference.CreatedAt, &preference.UpdatedAt)
+	}
+
+	return err
+}
+
+// FindQuietHoursByUserID returns nil when the user has no quiet hours.
+func (r *notificationPreferenceRepositoryImpl) FindQuietHoursByUserID(ctx context.Context, userID int64) (*entity.NotificationQuietHours, error) {
+	query := `
+		select user_id, to_char(start_time, 'HH24:MI'), to_char(end_time, 'HH24:MI'), created_at, updated_at
+		from notification_quiet_hours
+		where user_id = $1
+	`
+	tx := transactor.ExtractTx(ctx)
+
+	var row *sql.Row
+	if tx != nil {
+		row = tx.QueryRowContext(ctx, query, userID)
+	} else {
+		row = r.db.QueryRowContext(ctx, query, userID)
+	}
+
+	quietHours := new(entity.NotificationQuietHours)
+	err := row.Scan(
+		&quietHours.UserID,
+		&quietHours.StartTime,
+		&quietHours.EndTime,
+		&quietHours.CreatedAt,
+		&quietHours.UpdatedAt,
+	)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return quietHours, nil
+}
+
+func (r *notificationPreferenceRepositoryImpl) SaveQuietHours(ctx context.Context, quietHours *entity.NotificationQuietHours) error {
+	query := `
+		insert into notification_quiet_hours(user_id, start_time, end_time)
+		values ($1, $2::time, $3::time)
+		on conflict (user_id) do update set start_time = excluded.start_time, end_time = excluded.end_time, updated_at = now()
+		returning created_at, updated_at
+	`
+	tx := transactor.ExtractTx(ctx)
+
+	var err error
+	if tx != nil {
+		err = tx.QueryRowContext(ctx, query, quietHours.UserID, quietHours.StartTime, quietHours.EndTime).Scan(&quietHours.CreatedAt, &quietHours.UpdatedAt)
+	} else {
+		err = r.db.QueryRowContext(ctx, query, quietHours.UserID, quietHours.StartTime, quietHours.EndTime).Scan(&quietHours.CreatedAt, &quietHours.UpdatedAt)
+	}
+
+	return err
+}
+
+func (r *notificationPreferenceRepositoryImpl) DeleteQuietHours(ctx context.Context, userID int64) error {
+	query := `
+		delete from notification_quiet_hours where user_id = $1
+	`
+	tx := transactor.ExtractTx(ctx)
+
+	var err error
+	if tx != nil {
+		_, err = tx.ExecContext(ctx, query, userID)
+	} else {
+		_, err = r.db.ExecContext(ctx, query, userID)
+	}
+
+	return err
+}
+
+func (r *notificationPreferenceRepositoryImpl) FindRecipientByUserID(ctx context.Context, userID int64) (*entity.NotificationRecipient, error) {
+	query := `
+		select u.id, u.email, ud.whatsapp_number
+		from users u
+		left join user_details ud on ud.user_id = u.id
+		where u.id = $1 and u.deleted_at is null
+	`
+	tx := transactor.ExtractTx(ctx)
+
+	var row *sql.Row
+	if tx != nil {
+		row = tx.QueryRowContext(ctx, query, userID)
+	} else {
+		row = r.db.QueryRowContext(ctx, query, userID)
+	}
+
+	recipient := new(entity.NotificationRecipient)
+	err := row.Scan(&recipient.UserID, &recipient.Email, &recipient.WhatsappNumber)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, apperrorPkg.NewEntityNotFoundError("user")
+	}
+	if err != nil {
+		return nil, err
+	}
+	return recipient, nil
+}
+
+func (r *notificationPreferenceRepositoryImpl) findAll(ctx context.Context, query string, args ...any) ([]*entity.NotificationPreference, error) {
+	tx := transactor.ExtractTx(ctx)
+
+	var (
+		err  error
+		rows *sql.Rows
+	)
+	if tx != nil {
+		rows, err = tx.QueryContext(ctx, query, args...)
+	} else {
+		rows, err = r.db.QueryContext(ctx, query, args...)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	preferences := []*entity.NotificationPreference{}
+	for rows.Next() {
+		preference := new(entity.NotificationPreference)
+		if err := rows.Scan(
+			&preference.ID,
+			&preference.UserID,
+			&preference.Type,
+			&preference.Channel,
+			&preference.Enabled,
+			&preference.CreatedAt,
+			&preference.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		preferences = append(preferences, preference)
+	}
+
+	err = rows.Err()
+	if err != nil {
+		return nil, err
+	}
+	return preferences, nil
+}
@@ -0,0 +1,192 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"strconv"
+
+	"healthcare-app/internal/notification/dto"
+	"healthcare-app/internal/notification/entity"
+	apperrorPkg "healthcare-app/pkg/apperror"
+	"healthcare-app/pkg/database/transactor"
+)
+
+type NotificationRepository interface {
+	FindAllByUserID(ctx context.Context, request *dto.GetNotificationRequest) ([]*entity.Notification, error)
+	FindByIDAndUserID(ctx context.Context, id int64, userID int64) (*entity.Notification, error)
+	CountUnreadByUserID(ctx context.Context, userID int64) (int64, error)
+	Save(ctx context.Context, notification *entity.Notification) error
+	MarkAsRead(ctx context.Context, userID int64, ids []int64) (int64, error)
+}
+
+type notificationRepositoryImpl struct {
+	db *sql.DB
+}
+
+func NewNotificationRepository(db *sql.DB) *notificationRepositoryImpl {
+	return &notificationRepositoryImpl{
+		db: db,
+	}
+}
+
+// FindAllByUserID pages backwards from the newest notification, last is the
+// smallest id the client already has. One extra row is fetched to tell
+// whether there is an older page.
+func (r *notificationRepositoryImpl) FindAllByUserID(ctx context.Context, request *dto.GetNotificationRequest) ([]*entity.Notification, error) {
+	query := `
+		select id, user_id, type, title, body, link, read_at, created_at
+		from notifications
+		where user_id = $1 and ($2 = 0 or id < $2) and ($3 = false or read_at is null)
+		order by id desc
+		limit $4
+	`
+	tx := transactor.ExtractTx(ctx)
+
+	lastID, _ := strconv.Atoi(request.Last)
+	unread, _ := strconv.ParseBool(request.Unread)
+	var (
+		err  error
+		rows *sql.Rows
+	)
+	if tx != nil {
+		rows, err = tx.QueryContext(ctx, query, request.UserID, lastID, unread, request.Limit+1)
+	} else {
+		rows, err = r.db.QueryContext(ctx, query, request.UserID, lastID, unread, request.Limit+1)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	notifications := []*entity.Notification{}
+	for rows.Next() {
+		notification := new(entity.Notification)
+		if err := rows.Scan(
+			&notification.ID,
+			&notification.UserID,
+			&notification.Type,
+			&notification.Title,
+			&notification.Body,
+			&notification.Link,
+			&notification.ReadAt,
+			&notification.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		notifications = append(notifications, notification)
+	}
+
+	err = rows.Err()
+	if err != nil {
+		return nil, err
+	}
+	return notifications, nil
+}
+
+func (r *notificationRepositoryImpl) FindByIDAndUserID(ctx context.Context, id int64, userID int64) (*entity.Notification, error) {
+	query := `
+		select id, user_id, type, title, body, link, read_at, created_at
+		from notifications
+		where id = $1 and user_id = $2
+	`
+	tx := transactor.ExtractTx(ctx)
+
+	var (
+		err error
+		row *sql.Row
+	)
+	if tx != nil {
+		row = tx.QueryRowContext(ctx, query, id, userID)
+	} else {
+		row = r.db.QueryRowContext(ctx, query, id, userID)
+	}
+
+	notification := new(entity.Notification)
+	err = row.Scan(
+		&notification.ID,
+		&notification.UserID,
+		&notification.Type,
+		&notification.Title,
+		&notification.Body,
+		&notification.Link,
+		&notification.ReadAt,
+		&notification.CreatedAt,
+	)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, apperrorPkg.NewEntityNotFoundError("notification")
+	}
+	if err != nil {
+		return nil, err
+	}
+	return notification, nil
+}
+
+func (r *notificationRepositoryImpl) CountUnreadByUserID(ctx context.Context, userID int64) (int64, error) {
+	query := `
+		select count(id) from notifications where user_id = $1 and read_at is null
+	`
+	tx := transactor.ExtractTx(ctx)
+
+	var (
+		err   error
+		count int64
+	)
+	if tx != nil {
+		err = tx.QueryRowContext(ctx, query, userID).Scan(&count)
+	} else {
+		err = r.db.QueryRowContext(ctx, query, userID).Scan(&count)
+	}
+
+	if err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+func (r *notificationRepositoryImpl) Save(ctx context.Context, notification *entity.Notification) error {
+	query := `
+		insert into notifications(user_id, type, title, body, link)
+		values ($1, $2, $3, $4, $5) returning id, created_at
+	`
+	tx := transactor.ExtractTx(ctx)
+
+	var err error
+	if tx != nil {
+		err = tx.QueryRowContext(ctx, query, notification.UserID, notification.Type, notification.Title, notification.Body, notification.Link).Scan(&notification.ID, &notification.CreatedAt)
+	} else {
+		err = r.db.QueryRowContext(ctx, query, notification.UserID, notification.Type, notification.Title, notification.Body, notification.Link).Scan(&notification.ID, &notification.CreatedAt)
+	}
+
+	return err
+}
+
+// MarkAsRead marks the given unread notifications of the user as read, or all
+// of them when ids is empty, and returns how many rows changed.
+func (r *notificationRepositoryImpl) MarkAsRead(ctx context.Context, userID int64, ids []int64) (int64, error) {
+	query := `
+		update notifications set read_at = now()
+		where user_id = $1 and read_at is null
+		and (coalesce(array_length($2::bigint[], 1), 0) = 0 or id = any($2::bigint[]))
+	`
+	tx := transactor.ExtractTx(ctx)
+
+	if ids == nil {
+		ids = []int64{}
+	}
+	var (
+		err    error
+		result sql.Result
+	)
+	if tx != nil {
+		result, err = tx.ExecContext(ctx, query, userID, ids)
+	} else {
+		result, err = r.db.ExecContext(ctx, query, userID, ids)
+	}
+
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
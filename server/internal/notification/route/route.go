@@ -0,0 +1,26 @@
+package route
+
+import (
+	"healthcare-app/internal/auth/constant"
+	"healthcare-app/internal/notification/controller"
+	"healthcare-app/pkg/middleware"
+
+	"github.com/gin-gonic/gin"
+)
+
+func NotificationControllerRoute(c *controller.NotificationController, pc *controller.NotificationPreferenceController, r *gin.Engine, authMiddleware *middleware.AuthMiddleware) {
+	g := r.Group("/users/me/notifications", authMiddleware.Authorization(), authMiddleware.ProtectedRoles(constant.USER, constant.PHARMACIST, constant.ADMIN, constant.DOCTOR))
+	{
+		g.GET("", c.GetAllMyNotifications)
+		g.PATCH("/read", c.ReadNotifications)
+		g.PATCH("/:notificationId/read", c.ReadNotification)
+	}
+
+	p := r.Group("/users/me/notification-preferences", authMiddleware.Authorization(), authMiddleware.ProtectedRoles(constant.USER, constant.PHARMACIST, constant.ADMIN, constant.DOCTOR))
+	{
+		p.GET("", pc.GetMyPreferences)
+		p.PUT("", pc.UpdateMyPreferences)
+		p.PUT("/quiet-hours", authMiddleware.ProtectedRoles(constant.PHARMACIST), pc.UpdateMyQuietHours)
+		p.DELETE("/quiet-hours", authMiddleware.ProtectedRoles(constant.PHARMACIST), pc.DeleteMyQuietHours)
+	}
+}
@@ -0,0 +1,166 @@
+package usecase
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"healthcare-app/internal/notification/constant"
+	dtoNotification "healthcare-app/internal/notification/dto"
+	"healthcare-app/internal/notification/entity"
+	"healthcare-app/internal/notification/repository"
+	"healthcare-app/internal/notification/utils"
+	"healthcare-app/internal/queue/payload"
+	"healthcare-app/internal/queue/tasks"
+	apperrorPkg "healthcare-app/pkg/apperror"
+	"healthcare-app/pkg/config"
+	"healthcare-app/pkg/database/transactor"
+	"healthcare-app/pkg/logger"
+	"healthcare-app/pkg/utils/notificationutils"
+	"healthcare-app/pkg/utils/smtputils"
+)
+
+// NotificationDispatcher decides which channels an event goes out on from the
+// user's preferences and quiet hours. Rich emails are rendered by the caller
+// and passed to SendMail, every other channel goes through Dispatch.
+type NotificationDispatcher interface {
+	Dispatch(ctx context.Context, notification *entity.Notification) error
+	SendMail(ctx context.Context, mail *dtoNotification.DispatchMail) error
+}
+
+type notificationDispatcherImpl struct {
+	appConfig              *config.AppConfig
+	smtpUtil               smtputils.SMTPUtils
+	notificationTask       tasks.NotificationTask
+	notificationRepository repository.NotificationRepository
+	preferenceRepository   repository.NotificationPreferenceRepository
+	location               *time.Location
+}
+
+func NewNotificationDispatcher(
+	appConfig *config.AppConfig,
+	smtpUtil smtputils.SMTPUtils,
+	notificationTask tasks.NotificationTask,
+	notificationRepository repository.NotificationRepository,
+	preferenceRepository repository.NotificationPreferenceRepository,
+) *notificationDispatcherImpl {
+	location, err := time.LoadLocation(constant.QUIET_HOURS_TIMEZONE)
+	if err != nil {
+		location = time.Local
+	}
+	return &notificationDispatcherImpl{
+		appConfig:              appConfig,
+		smtpUtil:               smtpUtil,
+		notificationTask:       notificationTask,
+		notificationRepository: notificationRepository,
+		preferenceRepository:   preferenceRepository,
+		location:               location,
+	}
+}
+
+// Dispatch stores the notification in the in-app inbox and queues it for the
+// other channels the user turned on. Only the inbox row is part of the
+// caller's transaction, the other channels are queued once it commits so a
+// rolled back change never reaches the user and a slow provider never holds
+// the caller's locks.
+func (u *notificationDispatcherImpl) Dispatch(ctx context.Context, notification *entity.Notification) error {
+	enabled, err := u.enabledChannels(ctx, notification.UserID, notification.Type)
+	if err != nil {
+		return apperrorPkg.NewServerError(err)
+	}
+
+	if enabled[notificationutils.ChannelInApp] {
+		if err := u.notificationRepository.Save(ctx, notification); err != nil {
+			return apperrorPkg.NewServerError(err)
+		}
+	}
+
+	var (
+		recipient  *entity.NotificationRecipient
+		deliveries []*payload.NotificationDeliveryPayload
+	)
+	for _, channel := range constant.DeliveredChannels {
+		if !enabled[channel] {
+			continue
+		}
+		if recipient == nil {
+			if recipient, err = u.preferenceRepository.FindRecipientByUserID(ctx, notification.UserID); err != nil {
+				return err
+			}
+		}
+
+		delivery := &payload.NotificationDeliveryPayload{
+			Channel: channel,
+			Subject: notification.Title,
+			Title:   notification.Title,
+			Body:    notification.Body,
+		}
+		if notification.Link != nil {
+			delivery.Link = u.appConfig.FrontendURL + *notification.Link
+		}
+		switch channel {
+		case notificationutils.ChannelWhatsapp:
+			if recipient.WhatsappNumber == nil {
+				continue
+			}
+			delivery.Recipient = *recipient.WhatsappNumber
+		case notificationutils.ChannelPush:
+			delivery.Recipient = strconv.Itoa(int(recipient.UserID))
+		}
+		deliveries = append(deliveries, delivery)
+	}
+
+	transactor.AfterCommit(ctx, func() {
+		for _, delivery := range deliveries {
+			if err := u.notificationTask.QueueNotificationDelivery(ctx, delivery); err != nil {
+				logger.Log.WithFields(map[string]any{"channel": delivery.Channel, "user_id": notification.UserID}).Warn("failed to queue notification:", err)
+			}
+		}
+	})
+	return nil
+}
+
+func (u *notificationDispatcherImpl) SendMail(ctx context.Context, mail *dtoNotification.DispatchMail) error {
+	enabled, err := u.enabledChannels(ctx, mail.UserID, mail.Type)
+	if err != nil {
+		return err
+	}
+	if !enabled[notificationutils.ChannelEmail] {
+		return nil
+	}
+
+	return u.smtpUtil.SendMailHTMLContext(ctx, mail.To, mail.Subject, mail.Template, mail.Data)
+}
+
+// enabledChannels starts from the defaults, applies what the user stored for
+// the event type, then mutes everything but the inbox during quiet hours.
+func (u *notificationDispatcherImpl) enabledChannels(ctx context.Context, userID int64, notificationType string) (map[string]bool, error) {
+	enabled := map[string]bool{}
+	for channel, on := range constant.DefaultChannels {
+		enabled[channel] = on
+	}
+
+	preferences, err := u.preferenceRepository.FindAllByUserIDAndType(ctx, userID, notificationType)
+	if err != nil {
+		return nil, err
+	}
+	for _, preference := range preferences {
+		enabled[preference.Channel] = preference.Enabled
+	}
+
+	if _, ok := constant.QuietHoursTypes[notificationType]; !ok {
+		return enabled, nil
+	}
+	quietHours, err := u.preferenceRepository.FindQuietHoursByUserID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if utils.IsWithinQuietHours(quietHours, time.Now().In(u.location)) {
+		for channel := range enabled {
+			if channel != notificationutils.ChannelInApp {
+				enabled[channel] = false
+			}
+		}
+	}
+	return enabled, nil
+}
@@ -0,0 +1,128 @@
+package usecase
+
+import (
+	"context"
+	"slices"
+
+	apperrorNotification "healthcare-app/internal/notification/apperror"
+	"healthcare-app/internal/notification/constant"
+	dtoNotification "healthcare-app/internal/notification/dto"
+	"healthcare-app/internal/notification/entity"
+	"healthcare-app/internal/notification/repository"
+	apperrorPkg "healthcare-app/pkg/apperror"
+	"healthcare-app/pkg/database/transactor"
+)
+
+type NotificationPreferenceUseCase interface {
+	GetMyPreferences(ctx context.Context, userID int64, role int) (*dtoNotification.NotificationPreferenceResponse, error)
+	UpdateMyPreferences(ctx context.Context, reqBody *dtoNotification.RequestNotificationPreference) (*dtoNotification.NotificationPreferenceResponse, error)
+	UpdateMyQuietHours(ctx context.Context, reqBody *dtoNotification.RequestNotificationQuietHours) (*dtoNotification.NotificationQuietHoursResponse, error)
+	DeleteMyQuietHours(ctx context.Context, userID int64) error
+}
+
+type notificationPreferenceUseCaseImpl struct {
+	preferenceRepository repository.NotificationPreferenceRepository
+	transactor           transactor.Transactor
+}
+
+func NewNotificationPreferenceUseCase(
+	preferenceRepository repository.NotificationPreferenceRepository,
+	transactor transactor.Transactor,
+) *notificationPreferenceUseCaseImpl {
+	return &notificationPreferenceUseCaseImpl{
+		preferenceRepository: preferenceRepository,
+		transactor:           transactor,
+	}
+}
+
+// GetMyPreferences lists every event type the role can tune with the
+// effective value of each channel, stored choices on top of the defaults.
+func (u *notificationPreferenceUseCaseImpl) GetMyPreferences(ctx context.Context, userID int64, role int) (*dtoNotification.NotificationPreferenceResponse, error) {
+	preferences, err := u.preferenceRepository.FindAllByUserID(ctx, userID)
+	if err != nil {
+		return nil, apperrorPkg.NewServerError(err)
+	}
+
+	stored := map[string]map[string]bool{}
+	for _, preference := range preferences {
+		if stored[preference.Type] == nil {
+			stored[preference.Type] = map[string]bool{}
+		}
+		stored[preference.Type][preference.Channel] = preference.Enabled
+	}
+
+	res := &dtoNotification.NotificationPreferenceResponse{Preferences: []*dtoNotification.EventPreferenceResponse{}}
+	for _, notificationType := range constant.RoleEventTypes[role] {
+		channels := map[string]bool{}
+		for _, channel := range constant.Channels {
+			enabled, ok := stored[notificationType][channel]
+			if !ok {
+				enabled = constant.DefaultChannels[channel]
+			}
+			channels[channel] = enabled
+		}
+		res.Preferences = append(res.Preferences, &dtoNotification.EventPreferenceResponse{
+			Type:     notificationType,
+			Channels: channels,
+		})
+	}
+
+	quietHours, err := u.preferenceRepository.FindQuietHoursByUserID(ctx, userID)
+	if err != nil {
+		return nil, apperrorPkg.NewServerError(err)
+	}
+	res.QuietHours = dtoNotification.ConvertToNotificationQuietHoursResponse(quietHours)
+	return res, nil
+}
+
+func (u *notificationPreferenceUseCaseImpl) UpdateMyPreferences(ctx context.Context, reqBody *dtoNotification.RequestNotificationPreference) (*dtoNotification.NotificationPreferenceResponse, error) {
+	for _, preference := range reqBody.Preferences {
+		if !slices.Contains(constant.RoleEventTypes[reqBody.Role], preference.Type) {
+			return nil, apperrorNotification.NewInvalidNotificationTypeError()
+		}
+		if !slices.Contains(constant.Channels, preference.Channel) {
+			return nil, apperrorNotification.NewInvalidNotificationChannelError()
+		}
+	}
+
+	err := u.transactor.Atomic(ctx, func(txCtx context.Context) error {
+		for _, preference := range reqBody.Preferences {
+			if err := u.preferenceRepository.Save(txCtx, &entity.NotificationPreference{
+				UserID:  reqBody.UserID,
+				Type:    preference.Type,
+				Channel: preference.Channel,
+				Enabled: *preference.Enabled,
+			}); err != nil {
+				return apperrorPkg.NewServerError(err)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return u.GetMyPreferences(ctx, reqBody.UserID, reqBody.Role)
+}
+
+func (u *notificationPreferenceUseCaseImpl) UpdateMyQuietHours(ctx context.Context, reqBody *dtoNotification.RequestNotificationQuietHours) (*dtoNotification.NotificationQuietHoursResponse, error) {
+	if reqBody.StartTime == reqBody.EndTime {
+		return nil, apperrorNotification.NewInvalidQuietHoursError()
+	}
+
+	quietHours := &entity.NotificationQuietHours{
+		UserID:    reqBody.UserID,
+		StartTime: reqBody.StartTime,
+		EndTime:   reqBody.EndTime,
+	}
+	if err := u.preferenceRepository.SaveQuietHours(ctx, quietHours); err != nil {
+		return nil, apperrorPkg.NewServerError(err)
+	}
+	return dtoNotification.ConvertToNotificationQuietHoursResponse(quietHours), nil
+}
+
+func (u *notificationPreferenceUseCaseImpl) DeleteMyQuietHours(ctx context.Context, userID int64) error {
+	if err := u.preferenceRepository.DeleteQuietHours(ctx, userID); err != nil {
+		return apperrorPkg.NewServerError(err)
+	}
+	return nil
+}
@@ -0,0 +1,91 @@
+package usecase
+
+import (
+	"context"
+	"strconv"
+
+	dtoNotification "healthcare-app/internal/notification/dto"
+	"healthcare-app/internal/notification/entity"
+	"healthcare-app/internal/notification/repository"
+	apperrorPkg "healthcare-app/pkg/apperror"
+	dtoPkg "healthcare-app/pkg/dto"
+	"healthcare-app/pkg/utils/pageutils"
+)
+
+type NotificationUseCase interface {
+	GetAllMyNotifications(ctx context.Context, request *dtoNotification.GetNotificationRequest) (*dtoNotification.NotificationListResponse, *dtoPkg.SeekPageMetaData, error)
+	ReadNotification(ctx context.Context, id int64, userID int64) (*dtoNotification.NotificationResponse, error)
+	ReadNotifications(ctx context.Context, reqBody *dtoNotification.RequestReadNotification) error
+	Notify(ctx context.Context, notifications ...*entity.Notification) error
+}
+
+type notificationUseCaseImpl struct {
+	notificationRepository repository.NotificationRepository
+	notificationDispatcher NotificationDispatcher
+}
+
+func NewNotificationUseCase(notificationRepository repository.NotificationRepository, notificationDispatcher NotificationDispatcher) *notificationUseCaseImpl {
+	return &notificationUseCaseImpl{
+		notificationRepository: notificationRepository,
+		notificationDispatcher: notificationDispatcher,
+	}
+}
+
+func (u *notificationUseCaseImpl) GetAllMyNotifications(ctx context.Context, request *dtoNotification.GetNotificationRequest) (*dtoNotification.NotificationListResponse, *dtoPkg.SeekPageMetaData, error) {
+	notifications, err := u.notificationRepository.FindAllByUserID(ctx, request)
+	if err != nil {
+		return nil, nil, apperrorPkg.NewServerError(err)
+	}
+	itemLen := int64(len(notifications))
+	if itemLen >= request.Limit {
+		notifications = notifications[:request.Limit]
+	}
+
+	last := ""
+	if len(notifications) != 0 {
+		last = strconv.Itoa(int(notifications[len(notifications)-1].ID))
+	}
+
+	unreadCount, err := u.notificationRepository.CountUnreadByUserID(ctx, request.UserID)
+	if err != nil {
+		return nil, nil, apperrorPkg.NewServerError(err)
+	}
+
+	metaData := pageutils.CreateSeekMetaData(itemLen, request.Limit, last)
+	return &dtoNotification.NotificationListResponse{
+		UnreadCount:   unreadCount,
+		Notifications: dtoNotification.ConvertToNotificationResponses(notifications),
+	}, metaData, nil
+}
+
+func (u *notificationUseCaseImpl) ReadNotification(ctx context.Context, id int64, userID int64) (*dtoNotification.NotificationResponse, error) {
+	if _, err := u.notificationRepository.MarkAsRead(ctx, userID, []int64{id}); err != nil {
+		return nil, apperrorPkg.NewServerError(err)
+	}
+
+	notification, err := u.notificationRepository.FindByIDAndUserID(ctx, id, userID)
+	if err != nil {
+		return nil, err
+	}
+	return dtoNotification.ConvertToNotificationResponse(notification), nil
+}
+
+func (u *notificationUseCaseImpl) ReadNotifications(ctx context.Context, reqBody *dtoNotification.RequestReadNotification) error {
+	if _, err := u.notificationRepository.MarkAsRead(ctx, reqBody.UserID, reqBody.IDs); err != nil {
+		return apperrorPkg.NewServerError(err)
+	}
+	return nil
+}
+
+// Notify is how other modules tell someone about a domain event. It runs in
+// the caller's transaction when there is one, the inbox row is written with
+// the change and the other channels are only queued once it commits. Which
+// channels it reaches is up to the recipient's preferences.
+func (u *notificationUseCaseImpl) Notify(ctx context.Context, notifications ...*entity.Notification) error {
+	for _, notification := range notifications {
+		if err := u.notificationDispatcher.Dispatch(ctx, notification); err != nil {
+			return err
+		}
+	}
+	return nil
+}
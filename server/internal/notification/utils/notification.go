@@ -0,0 +1,70 @@
+package utils
+
+import (
+	"fmt"
+
+	"healthcare-app/internal/notification/constant"
+	"healthcare-app/internal/notification/entity"
+)
+
+func NewOrderProcessedNotification(userID int64, orderID int64, invoice string) *entity.Notification {
+	return newOrderNotification(userID, orderID, constant.TYPE_ORDER_PROCESSED, constant.TITLE_ORDER_PROCESSED, fmt.Sprintf(constant.BODY_ORDER_PROCESSED, invoice))
+}
+
+func NewOrderSentNotification(userID int64, orderID int64, invoice string) *entity.Notification {
+	return newOrderNotification(userID, orderID, constant.TYPE_ORDER_SENT, constant.TITLE_ORDER_SENT, fmt.Sprintf(constant.BODY_ORDER_SENT, invoice))
+}
+
+func NewOrderCancelledNotification(userID int64, orderID int64, invoice string) *entity.Notification {
+	return newOrderNotification(userID, orderID, constant.TYPE_ORDER_CANCELLED, constant.TITLE_ORDER_CANCELLED, fmt.Sprintf(constant.BODY_ORDER_CANCELLED, invoice))
+}
+
+func NewPaymentRejectedNotification(userID int64, orderID int64, invoice string) *entity.Notification {
+	return newOrderNotification(userID, orderID, constant.TYPE_PAYMENT_REJECTED, constant.TITLE_PAYMENT_REJECTED, fmt.Sprintf(constant.BODY_PAYMENT_REJECTED, invoice))
+}
+
+func NewLowStockNotification(pharmacistID int64, pharmacyID int64, pharmacyName string, productName string, stock int) *entity.Notification {
+	link := fmt.Sprintf(constant.LINK_PHARMACY_PRODUCT, pharmacyID)
+	return &entity.Notification{
+		UserID: pharmacistID,
+		Type:   constant.TYPE_LOW_STOCK,
+		Title:  constant.TITLE_LOW_STOCK,
+		Body:   fmt.Sprintf(constant.BODY_LOW_STOCK, productName, pharmacyName, stock),
+		Link:   &link,
+	}
+}
+
+// NewInviteNotification welcomes an account the admin created, role is one of
+// the INVITE_ROLE constants.
+func NewInviteNotification(userID int64, role string) *entity.Notification {
+	link := constant.LINK_PROFILE
+	return &entity.Notification{
+		UserID: userID,
+		Type:   constant.TYPE_INVITE,
+		Title:  constant.TITLE_INVITE,
+		Body:   fmt.Sprintf(constant.BODY_INVITE, role),
+		Link:   &link,
+	}
+}
+
+func NewNewOrderNotification(pharmacistID int64, pharmacyID int64, pharmacyName string, invoice string) *entity.Notification {
+	link := fmt.Sprintf(constant.LINK_PHARMACY_ORDER, pharmacyID)
+	return &entity.Notification{
+		UserID: pharmacistID,
+		Type:   constant.TYPE_NEW_ORDER,
+		Title:  constant.TITLE_NEW_ORDER,
+		Body:   fmt.Sprintf(constant.BODY_NEW_ORDER, invoice, pharmacyName),
+		Link:   &link,
+	}
+}
+
+func newOrderNotification(userID int64, orderID int64, notificationType string, title string, body string) *entity.Notification {
+	link := fmt.Sprintf(constant.LINK_USER_ORDER, orderID)
+	return &entity.Notification{
+		UserID: userID,
+		Type:   notificationType,
+		Title:  title,
+		Body:   body,
+		Link:   &link,
+	}
+}
@@ -0,0 +1,33 @@
+package utils
+
+import (
+	"time"
+
+	"healthcare-app/internal/notification/constant"
+	"healthcare-app/internal/notification/entity"
+)
+
+// IsWithinQuietHours reports whether now falls in the quiet window, the start
+// is inclusive and the end exclusive. A window whose end is earlier than its
+// start spans midnight.
+func IsWithinQuietHours(quietHours *entity.NotificationQuietHours, now time.Time) bool {
+	if quietHours == nil {
+		return false
+	}
+	start, err := time.Parse(constant.QUIET_HOURS_LAYOUT, quietHours.StartTime)
+	if err != nil {
+		return false
+	}
+	end, err := time.Parse(constant.QUIET_HOURS_LAYOUT, quietHours.EndTime)
+	if err != nil {
+		return false
+	}
+
+	minute := now.Hour()*60 + now.Minute()
+	startMinute := start.Hour()*60 + start.Minute()
+	endMinute := end.Hour()*60 + end.Minute()
+	if startMinute <= endMinute {
+		return minute >= startMinute && minute < endMinute
+	}
+	return minute >= startMinute || minute < endMinute
+}
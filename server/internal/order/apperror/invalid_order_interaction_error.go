@@ -0,0 +1,20 @@
+package apperror
+
+import (
+	"errors"
+
+	"healthcare-app/internal/order/constant"
+	"healthcare-app/pkg/apperror"
+)
+
+func NewInvalidOrderInteractionHold() *apperror.AppError {
+	msg := constant.InvalidOrderInteractionHold
+	err := errors.New(msg)
+	return apperror.NewAppError(err, apperror.DefaultClientErrorCode, msg)
+}
+
+func NewInvalidOrderInteractionOverride() *apperror.AppError {
+	msg := constant.InvalidOrderInteractionOverride
+	err := errors.New(msg)
+	return apperror.NewAppError(err, apperror.DefaultClientErrorCode, msg)
+}
@@ -0,0 +1,14 @@
+package apperror
+
+import (
+	"errors"
+
+	"healthcare-app/internal/order/constant"
+	"healthcare-app/pkg/apperror"
+)
+
+func NewInvalidOrderPrescriptionPatient() *apperror.AppError {
+	msg := constant.InvalidOrderPrescriptionPatient
+	err := errors.New(msg)
+	return apperror.NewAppError(err, apperror.DefaultClientErrorCode, msg)
+}
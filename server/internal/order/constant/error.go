@@ -7,10 +7,13 @@ const (
 	InvalidQueryStatus                   = "invalid query status"
 	InvalidIdOrder                       = "invalid id order"
 	InvalidOrderNotFound                 = "order not found"
-	InvalidImageErrorMessagePaymentProof = "the image must be in .png/.jpg/.jpeg format"
+	InvalidImageErrorMessagePaymentProof = "the image must be a png, jpeg, gif or webp image"
 	InvalidAlreadyUploadPaymentProof     = "you already upload the payment proof"
 	InvalidPhotoMaxSize                  = "max size photo is %v"
 	InvalidStatusAlreadyConfirmed        = "status already confirmed"
 	InvalidStatusPhotoPaymentProofNull   = "you haven't uploaded proof of payment"
 	InvalidStatusChanges                 = "invalid status changes"
+	InvalidOrderInteractionHold          = "order contains a severe drug interaction and is awaiting pharmacist review"
+	InvalidOrderInteractionOverride      = "order is not on hold for a drug interaction"
+	InvalidOrderPrescriptionPatient      = "the prescription was written for a different patient"
 )
@@ -1,5 +1,7 @@
 package constant
 
+import "time"
+
 const (
 	STATUS_WAITING   = "WAITING"
 	STATUS_PROCESSED = "PROCESSED"
@@ -9,13 +11,22 @@ const (
 )
 
 const (
-	MAX_IMAGE_SIZE = 500 * 1024 // 500 kb
+	MAX_IMAGE_SIZE      = 5 * 1024 * 1024 // 5 mb
+	IMAGE_MAX_DIMENSION = 1600
+
+	MAX_CHECKOUT_BODY_SIZE = 1024 * 1024                // 1 mb
+	MAX_PAYMENT_BODY_SIZE  = MAX_IMAGE_SIZE + 1024*1024 // the image plus the multipart framing
 )
 
 const (
 	OFFICIAL_CODE = "official"
 )
 
+const (
+	EVENT_ORDER_STATUS            = "order_status"
+	EVENT_STREAM_HEARTBEAT_PERIOD = 30 * time.Second
+)
+
 var (
 	UserAllowedSorts = map[string]string{
 		"date":   "created_at",
@@ -0,0 +1,82 @@
+package controller
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"healthcare-app/internal/auth/utils"
+	"healthcare-app/internal/order/constant"
+	"healthcare-app/internal/order/usecase"
+	"healthcare-app/pkg/apperror"
+	"healthcare-app/pkg/utils/pubsubutils"
+
+	"github.com/gin-gonic/gin"
+)
+
+type OrderEventController struct {
+	orderEventUseCase usecase.OrderEventUseCase
+}
+
+func NewOrderEventController(orderEventUseCase usecase.OrderEventUseCase) *OrderEventController {
+	return &OrderEventController{
+		orderEventUseCase: orderEventUseCase,
+	}
+}
+
+func (c *OrderEventController) StreamMyOrders(ctx *gin.Context) {
+	subscriber := c.orderEventUseCase.SubscribeMyOrders(ctx, utils.GetValueUserIdFromToken(ctx))
+	defer c.orderEventUseCase.Unsubscribe(subscriber)
+
+	streamOrderEvents(ctx, subscriber)
+}
+
+func (c *OrderEventController) StreamPharmacyOrders(ctx *gin.Context) {
+	pharmacyID, err := strconv.Atoi(ctx.Param("pharmacyId"))
+	if err != nil {
+		ctx.Error(apperror.NewInvalidIdError())
+		return
+	}
+
+	subscriber, err := c.orderEventUseCase.SubscribePharmacyOrders(ctx, int64(pharmacyID), utils.GetValueUserIdFromToken(ctx))
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+	defer c.orderEventUseCase.Unsubscribe(subscriber)
+
+	streamOrderEvents(ctx, subscriber)
+}
+
+// streamOrderEvents writes the subscription out as server-sent events until
+// the client leaves or the subscription is closed. The heartbeat is a comment
+// line, it keeps proxies from dropping an idle connection.
+func streamOrderEvents(ctx *gin.Context, subscriber *pubsubutils.Subscriber) {
+	ctx.Header("Content-Type", "text/event-stream")
+	ctx.Header("Cache-Control", "no-cache")
+	ctx.Header("Connection", "keep-alive")
+	ctx.Header("X-Accel-Buffering", "no")
+	ctx.Status(http.StatusOK)
+	ctx.Writer.Flush()
+
+	ticker := time.NewTicker(constant.EVENT_STREAM_HEARTBEAT_PERIOD)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Request.Context().Done():
+			return
+		case data, ok := <-subscriber.Events:
+			if !ok {
+				return
+			}
+			ctx.SSEvent(constant.EVENT_ORDER_STATUS, string(data))
+		case <-ticker.C:
+			if _, err := fmt.Fprint(ctx.Writer, ": heartbeat\n\n"); err != nil {
+				return
+			}
+		}
+		ctx.Writer.Flush()
+	}
+}
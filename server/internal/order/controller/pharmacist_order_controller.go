@@ -108,3 +108,26 @@ func (c *PharmacistOrderController) CancelOrder(ctx *gin.Context) {
 
 	ginutils.ResponseOKPlain(ctx)
 }
+
+func (c *PharmacistOrderController) OverrideInteraction(ctx *gin.Context) {
+	pharmacyId, err := strconv.Atoi(ctx.Param("pharmacyId"))
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	orderID, err := strconv.Atoi(ctx.Param("orderId"))
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	req := &dto.GetOrderRequest{PharmacyID: int64(pharmacyId), PharmacistID: utils.GetValueUserIdFromToken(ctx), ID: int64(orderID)}
+
+	if err := c.pharmacitsOrderUseCase.OverrideInteraction(ctx, req); err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	ginutils.ResponseOKPlain(ctx)
+}
@@ -5,29 +5,36 @@ import (
 	"time"
 
 	cartDto "healthcare-app/internal/cart/dto"
+	dtoInteraction "healthcare-app/internal/interaction/dto"
 	"healthcare-app/internal/order/constant"
 	orderEntity "healthcare-app/internal/order/entity"
+	dtoProfile "healthcare-app/internal/profile/dto"
 
 	"github.com/shopspring/decimal"
 )
 
 type OrderResponse struct {
-	ID                int64                 `json:"id"`
-	OrderStatus       string                `json:"order_status"`
-	VoiceNumber       string                `json:"voice_number"`
-	Customer          string                `json:"customer"`
-	PaymentImgURL     *string               `json:"payment_url"`
-	TotalProductPrice decimal.Decimal       `json:"total_product_price"`
-	ShipCost          decimal.Decimal       `json:"ship_cost"`
-	TotalPayment      decimal.Decimal       `json:"total_payment"`
-	Description       *string               `json:"description"`
-	Detail            *OrderProductResponse `json:"detail"`
-	CreatedAt         time.Time             `json:"created_at"`
+	ID                int64                              `json:"id"`
+	UserID            int64                              `json:"-"`
+	OrderStatus       string                             `json:"order_status"`
+	VoiceNumber       string                             `json:"voice_number"`
+	Customer          string                             `json:"customer"`
+	PaymentImgURL     *string                            `json:"payment_url"`
+	TotalProductPrice decimal.Decimal                    `json:"total_product_price"`
+	ShipCost          decimal.Decimal                    `json:"ship_cost"`
+	TotalPayment      decimal.Decimal                    `json:"total_payment"`
+	Description       *string                            `json:"description"`
+	InteractionHold   bool                               `json:"interaction_hold"`
+	Detail            *OrderProductResponse              `json:"detail"`
+	Patient           *dtoProfile.DependentResponse      `json:"patient"`
+	MedicalProfile    *dtoProfile.MedicalProfileResponse `json:"medical_profile,omitempty"`
+	CreatedAt         time.Time                          `json:"created_at"`
 }
 
 type RequestOrderID struct {
 	OrderID      []int64 `json:"order_id" binding:"required,dive,numeric,gte=1"`
 	PharmacyID   int64   `json:"pharmacy_id" binding:"required"`
+	Reason       string  `json:"reason" binding:"omitempty,max=255"`
 	PharmacistID int64   `json:"-"`
 }
 
@@ -49,14 +56,16 @@ type RequestOrder struct {
 	AddressID     int64                     `json:"address_id" binding:"required,gte=1,numeric"`
 	PharmacyID    int64                     `json:"pharmacy_id" binding:"required,gte=1,numeric"`
 	Description   *string                   `json:"description" binding:"required"`
+	DependentID   *int64                    `json:"dependent_id" binding:"omitempty,gte=1"`
 	OrderProducts []RequestListOrderProduct `json:"order_products" binding:"required"`
 	ShipCost      decimal.Decimal           `json:"ship_cost" binding:"required"`
 }
 
 type RequestListOrderProduct struct {
-	PharmacyProductId int64 `json:"pharmacy_product_id" binding:"required,gte=1,numeric"`
-	Quantity          int   `json:"quantity" binding:"required,gte=1,numeric"`
-	Price             int64 `json:"price" binding:"required,gte=1,numeric"`
+	PharmacyProductId  int64  `json:"pharmacy_product_id" binding:"required,gte=1,numeric"`
+	Quantity           int    `json:"quantity" binding:"required,gte=1,numeric"`
+	Price              int64  `json:"price" binding:"required,gte=1,numeric"`
+	PrescriptionItemID *int64 `json:"-"`
 }
 
 type GetOrderRequest struct {
@@ -107,6 +116,7 @@ func ConvertToOrderResponses(orders []*orderEntity.Order, FilterStatus ...string
 			if !ok {
 				res := &OrderResponse{
 					ID:                order.ID,
+					UserID:            order.UserID,
 					OrderStatus:       order.OrderStatus,
 					VoiceNumber:       order.VoiceNumber,
 					Customer:          order.UserEmail,
@@ -115,6 +125,7 @@ func ConvertToOrderResponses(orders []*orderEntity.Order, FilterStatus ...string
 					ShipCost:          order.ShipCost,
 					TotalPayment:      order.TotalPayment,
 					Description:       order.Description,
+					InteractionHold:   order.InteractionHold,
 					Detail: &OrderProductResponse{
 						Pharmacy: pharmacy{
 							ID:   order.OrderProduct.PharmacyID,
@@ -167,6 +178,7 @@ func ConvertToOrderResponse(orders []*orderEntity.Order) *OrderResponse {
 				ShipCost:          order.ShipCost,
 				TotalPayment:      order.TotalPayment,
 				Description:       order.Description,
+				InteractionHold:   order.InteractionHold,
 				Detail: &OrderProductResponse{
 					Pharmacy: pharmacy{
 						ID:   order.OrderProduct.PharmacyID,
@@ -179,6 +191,7 @@ func ConvertToOrderResponse(orders []*orderEntity.Order) *OrderResponse {
 							Quantity:     order.OrderProduct.Quantity,
 							Price:        order.OrderProduct.Price,
 							ThumbnailURL: order.OrderProduct.ProductThumbnailURL,
+							Prescription: convertToPrescription(order.OrderProduct.Prescription),
 						},
 					},
 				},
@@ -194,6 +207,7 @@ func ConvertToOrderResponse(orders []*orderEntity.Order) *OrderResponse {
 			Quantity:     order.OrderProduct.Quantity,
 			Price:        order.OrderProduct.Price,
 			ThumbnailURL: order.OrderProduct.ProductThumbnailURL,
+			Prescription: convertToPrescription(order.OrderProduct.Prescription),
 		})
 
 	}
@@ -201,22 +215,39 @@ func ConvertToOrderResponse(orders []*orderEntity.Order) *OrderResponse {
 	return response
 }
 
+func convertToPrescription(orderPrescription orderEntity.OrderPrescription) *prescription {
+	if orderPrescription.ID == nil {
+		return nil
+	}
+	return &prescription{
+		ID:             *orderPrescription.ID,
+		Code:           *orderPrescription.Code,
+		PrescriberName: *orderPrescription.PrescriberName,
+		Dosage:         *orderPrescription.Dosage,
+		Quantity:       *orderPrescription.Quantity,
+		ExpiresAt:      *orderPrescription.ExpiresAt,
+	}
+}
+
 type ResponseOrder struct {
-	ID                int64                    `json:"id"`
-	UserID            int64                    `json:"user_id"`
-	OrderStatus       string                   `json:"order_status"`
-	VoiceNumber       string                   `json:"voice_number"`
-	PaymentImgURL     *string                  `json:"payment_img_url"`
-	TotalProductPrice decimal.Decimal          `json:"total_product_price"`
-	ShipCost          decimal.Decimal          `json:"ship_cost"`
-	TotalPayment      decimal.Decimal          `json:"total_payment"`
-	Description       *string                  `json:"description"`
-	Address           string                   `json:"address"`
-	Pharmacy          cartDto.ResponsePharmacy `json:"pharmacy_info"`
-	Product           []ResponseOrderProduct   `json:"product_info"`
-	CreatedAt         time.Time                `json:"created_at"`
-	UpdatedAt         time.Time                `json:"updated_at"`
-	DeletedAt         *time.Time               `json:"deleted_at"`
+	ID                int64                                        `json:"id"`
+	UserID            int64                                        `json:"user_id"`
+	OrderStatus       string                                       `json:"order_status"`
+	VoiceNumber       string                                       `json:"voice_number"`
+	PaymentImgURL     *string                                      `json:"payment_img_url"`
+	TotalProductPrice decimal.Decimal                              `json:"total_product_price"`
+	ShipCost          decimal.Decimal                              `json:"ship_cost"`
+	TotalPayment      decimal.Decimal                              `json:"total_payment"`
+	Description       *string                                      `json:"description"`
+	Address           string                                       `json:"address"`
+	DependentID       *int64                                       `json:"dependent_id"`
+	InteractionHold   bool                                         `json:"interaction_hold"`
+	Pharmacy          cartDto.ResponsePharmacy                     `json:"pharmacy_info"`
+	Product           []ResponseOrderProduct                       `json:"product_info"`
+	Warnings          []*dtoInteraction.InteractionWarningResponse `json:"warnings,omitempty"`
+	CreatedAt         time.Time                                    `json:"created_at"`
+	UpdatedAt         time.Time                                    `json:"updated_at"`
+	DeletedAt         *time.Time                                   `json:"deleted_at"`
 }
 
 type ResponseOrderProduct struct {
@@ -0,0 +1,54 @@
+package dto
+
+import (
+	"time"
+
+	"healthcare-app/internal/order/constant"
+)
+
+// OrderEvent is streamed to the customer and the pharmacy of an order every
+// time its status changes.
+type OrderEvent struct {
+	Type        string    `json:"type"`
+	OrderID     int64     `json:"order_id"`
+	UserID      int64     `json:"-"`
+	PharmacyID  int64     `json:"pharmacy_id"`
+	VoiceNumber string    `json:"voice_number"`
+	OrderStatus string    `json:"order_status"`
+	UpdatedAt   time.Time `json:"updated_at"`
+}
+
+func ConvertToOrderEvent(order *OrderResponse, status string) *OrderEvent {
+	event := &OrderEvent{
+		Type:        constant.EVENT_ORDER_STATUS,
+		OrderID:     order.ID,
+		UserID:      order.UserID,
+		VoiceNumber: order.VoiceNumber,
+		OrderStatus: status,
+		UpdatedAt:   time.Now(),
+	}
+	if order.Detail != nil {
+		event.PharmacyID = order.Detail.Pharmacy.ID
+	}
+	return event
+}
+
+func ConvertResponseOrderToOrderEvent(order *ResponseOrder) *OrderEvent {
+	return &OrderEvent{
+		Type:        constant.EVENT_ORDER_STATUS,
+		OrderID:     order.ID,
+		UserID:      order.UserID,
+		PharmacyID:  order.Pharmacy.ID,
+		VoiceNumber: order.VoiceNumber,
+		OrderStatus: order.OrderStatus,
+		UpdatedAt:   order.UpdatedAt,
+	}
+}
+
+func ConvertToOrderEvents(orders []*OrderResponse, status string) []*OrderEvent {
+	events := []*OrderEvent{}
+	for _, order := range orders {
+		events = append(events, ConvertToOrderEvent(order, status))
+	}
+	return events
+}
@@ -1,6 +1,8 @@
 package dto
 
 import (
+	"time"
+
 	"github.com/shopspring/decimal"
 )
 
@@ -20,4 +22,14 @@ type product struct {
 	Quantity     int64           `json:"quantity"`
 	Price        decimal.Decimal `json:"price"`
 	ThumbnailURL string          `json:"thumbnail_url"`
+	Prescription *prescription   `json:"prescription,omitempty"`
+}
+
+type prescription struct {
+	ID             int64     `json:"id"`
+	Code           string    `json:"code"`
+	PrescriberName string    `json:"prescriber_name"`
+	Dosage         string    `json:"dosage"`
+	Quantity       int64     `json:"quantity"`
+	ExpiresAt      time.Time `json:"expires_at"`
 }
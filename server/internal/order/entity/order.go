@@ -21,6 +21,8 @@ type Order struct {
 	ID                int64
 	UserID            int64
 	UserEmail         string
+	DependentID       *int64
+	InteractionHold   bool
 }
 
 type OrderCheckout struct {
@@ -34,6 +36,8 @@ type OrderCheckout struct {
 	TotalPayment      decimal.Decimal
 	Description       *string
 	Address           string
+	DependentID       *int64
+	InteractionHold   bool
 	CreatedAt         time.Time
 	UpdatedAt         time.Time
 	DeletedAt         *time.Time
@@ -71,6 +75,8 @@ type OrderWithData struct {
 	TotalPayment      decimal.Decimal
 	Description       *string
 	Address           string
+	DependentID       *int64
+	InteractionHold   bool
 	OrderProduct      OrderProductWithData
 	CreatedAt         time.Time
 	UpdatedAt         time.Time
@@ -1,6 +1,10 @@
 package entity
 
-import "github.com/shopspring/decimal"
+import (
+	"time"
+
+	"github.com/shopspring/decimal"
+)
 
 type OrderProduct struct {
 	Price               decimal.Decimal
@@ -13,4 +17,16 @@ type OrderProduct struct {
 	PharmacyProductID   int64
 	ProductID           int64
 	PharmacyID          int64
+	Prescription        OrderPrescription
+}
+
+// OrderPrescription is the prescription line a product was redeemed from,
+// every field is nil when the product was not ordered on a prescription.
+type OrderPrescription struct {
+	ID             *int64
+	Code           *string
+	PrescriberName *string
+	Dosage         *string
+	Quantity       *int64
+	ExpiresAt      *time.Time
 }
@@ -12,6 +12,7 @@ import (
 
 type OrderRepository interface {
 	FindAllByPharmacy(ctx context.Context, request *dto.AdminGetOrderRequest) ([]*entity.Order, error)
+	FindAllByIDs(ctx context.Context, ids []int64) ([]*entity.Order, error)
 }
 
 type orderRepositoryImpl struct {
@@ -27,7 +28,7 @@ func NewOrderRepository(db *sql.DB) *orderRepositoryImpl {
 func (r *orderRepositoryImpl) FindAllByPharmacy(ctx context.Context, request *dto.AdminGetOrderRequest) ([]*entity.Order, error) {
 	tx := transactor.ExtractTx(ctx)
 	query := `
-		select o.id, u.email, o.order_status, o.voice_number, o.payment_img_url, o.total_product_price, o.ship_cost, o.total_payment, o.description, o.created_at, p.id, p.name, p.image_url, ph.id, ph.name, op.price, op.quantity
+		select o.id, u.email, o.order_status, o.voice_number, o.payment_img_url, o.total_product_price, o.ship_cost, o.total_payment, o.description, o.created_at, o.interaction_hold, p.id, p.name, p.image_url, ph.id, ph.name, op.price, op.quantity
 		from orders o 
 		join users u on u.id = o.user_id
 		join order_products op on o.id = op.order_id 
@@ -70,6 +71,74 @@ func (r *orderRepositoryImpl) FindAllByPharmacy(ctx context.Context, request *dt
 			&entity.TotalPayment,
 			&entity.Description,
 			&entity.CreatedAt,
+			&entity.InteractionHold,
+			&entity.OrderProduct.ProductID,
+			&entity.OrderProduct.ProductName,
+			&entity.OrderProduct.ProductThumbnailURL,
+			&entity.OrderProduct.PharmacyID,
+			&entity.OrderProduct.PharmacyName,
+			&entity.OrderProduct.Price,
+			&entity.OrderProduct.Quantity,
+		); err != nil {
+			return nil, err
+		}
+		entities = append(entities, entity)
+	}
+
+	err = rows.Err()
+	if err != nil {
+		return nil, err
+	}
+	return entities, nil
+}
+
+// FindAllByIDs returns one row per ordered product, regardless of who owns the
+// orders. It is meant for background work like emails, not for handlers.
+func (r *orderRepositoryImpl) FindAllByIDs(ctx context.Context, ids []int64) ([]*entity.Order, error) {
+	query := `
+		select o.id, o.user_id, u.email, o.order_status, o.voice_number, o.payment_img_url, o.total_product_price, o.ship_cost, o.total_payment, o.description, o.created_at, o.interaction_hold, p.id, p.name, p.image_url, ph.id, ph.name, op.price, op.quantity
+		from orders o 
+		join users u on u.id = o.user_id
+		join order_products op on o.id = op.order_id 
+		join pharmacy_products pp on op.pharmacy_product_id = pp.id 
+		join products p on pp.product_id = p.id
+		join pharmacies ph on pp.pharmacy_id = ph.id
+		where o.id = any($1)
+		order by o.id, op.id
+	`
+	tx := transactor.ExtractTx(ctx)
+
+	var (
+		err  error
+		rows *sql.Rows
+	)
+	if tx != nil {
+		rows, err = tx.QueryContext(ctx, query, ids)
+	} else {
+		rows, err = r.db.QueryContext(ctx, query, ids)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	entities := []*entity.Order{}
+	for rows.Next() {
+		entity := &entity.Order{OrderProduct: entity.OrderProduct{}}
+		if err := rows.Scan(
+			&entity.ID,
+			&entity.UserID,
+			&entity.UserEmail,
+			&entity.OrderStatus,
+			&entity.VoiceNumber,
+			&entity.PaymentImgURL,
+			&entity.TotalProductPrice,
+			&entity.ShipCost,
+			&entity.TotalPayment,
+			&entity.Description,
+			&entity.CreatedAt,
+			&entity.InteractionHold,
 			&entity.OrderProduct.ProductID,
 			&entity.OrderProduct.ProductName,
 			&entity.OrderProduct.ProductThumbnailURL,
@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"strings"
 
+	apperrorOrder "healthcare-app/internal/order/apperror"
 	"healthcare-app/internal/order/dto"
 	"healthcare-app/internal/order/entity"
 	utilsOrder "healthcare-app/internal/order/utils"
@@ -20,8 +21,9 @@ type PharmacistOrderRepository interface {
 	IsPharmacistAssign(ctx context.Context, pharmacyId, pharmacistId int64) (bool, error)
 	SendOrderStatus(ctx context.Context, orders []*dto.OrderResponse) error
 	CancelOrderStatus(ctx context.Context, orders []*dto.OrderResponse) error
-	ConfirmOrderStatus(ctx context.Context, ids []int64) error
+	ConfirmOrderStatus(ctx context.Context, ids []int64) ([]int64, error)
 	ReturnStockOnCanceledOrder(ctx context.Context, productId, productQuantity int64) error
+	OverrideInteractionHold(ctx context.Context, request *dto.GetOrderRequest) error
 }
 
 type pharmacistOrderRepositoryImpl struct {
@@ -37,15 +39,19 @@ func NewPharmacistOrderRepository(db *sql.DB) *pharmacistOrderRepositoryImpl {
 func (r *pharmacistOrderRepositoryImpl) FindByID(ctx context.Context, request *dto.GetOrderRequest) ([]*entity.Order, error) {
 	query := `
 		select 
-			o.id, o.user_id, u.email, o.order_status, o.voice_number, o.payment_img_url, o.total_product_price, o.ship_cost, o.total_payment, o.description, o.created_at, 
+			o.id, o.user_id, u.email, o.order_status, o.voice_number, o.payment_img_url, o.total_product_price, o.ship_cost, o.total_payment, o.description, o.created_at, o.interaction_hold, o.dependent_id, 
 			p2.id, p2."name", 
-			op.pharmacy_product_id, p."name", op.quantity, op.price, p.image_url  
+			op.pharmacy_product_id, p."name", op.quantity, op.price, p.image_url,
+			rx.id, rx.code, rxd.full_name, pi.dosage, pi.quantity, rx.expires_at
 		from orders o 
 		left join users u on u.id = o.user_id
 		left join order_products op on o.id = op.order_id 
 		left join pharmacy_products pp  on pp.id = op.pharmacy_product_id
 		left join products p on p.id = pp.product_id 
 		left join pharmacies p2 on p2.id = pp.pharmacy_id 
+		left join prescription_items pi on pi.id = op.prescription_item_id
+		left join prescriptions rx on rx.id = pi.prescription_id
+		left join user_details rxd on rxd.user_id = rx.prescriber_id
 		where p2.pharmacist_id = $1 and p2.id = $2 and o.id = $3
 	`
 
@@ -84,6 +90,8 @@ func (r *pharmacistOrderRepositoryImpl) FindByID(ctx context.Context, request *d
 			&order.TotalPayment,
 			&order.Description,
 			&order.CreatedAt,
+			&order.InteractionHold,
+			&order.DependentID,
 			&order.OrderProduct.PharmacyID,
 			&order.OrderProduct.PharmacyName,
 			&order.OrderProduct.ProductID,
@@ -91,6 +99,12 @@ func (r *pharmacistOrderRepositoryImpl) FindByID(ctx context.Context, request *d
 			&order.OrderProduct.Quantity,
 			&order.OrderProduct.Price,
 			&order.OrderProduct.ProductThumbnailURL,
+			&order.OrderProduct.Prescription.ID,
+			&order.OrderProduct.Prescription.Code,
+			&order.OrderProduct.Prescription.PrescriberName,
+			&order.OrderProduct.Prescription.Dosage,
+			&order.OrderProduct.Prescription.Quantity,
+			&order.OrderProduct.Prescription.ExpiresAt,
 		); err != nil {
 			return nil, err
 		}
@@ -181,7 +195,7 @@ func (r *pharmacistOrderRepositoryImpl) GetOrderById(ctx context.Context, order
 func (r *pharmacistOrderRepositoryImpl) GetAllOrderFromPharmacist(ctx context.Context, request *dto.PharmacistGetOrderRequest, userId int64) ([]*entity.Order, error) {
 	tx := transactor.ExtractTx(ctx)
 	query := `
-		select o.id, o.user_id, u.email, o.order_status, o.voice_number, o.payment_img_url, o.total_product_price, o.ship_cost, o.total_payment, o.description, o.created_at, p.id, p.name, p.image_url, ph.id, ph.name, op.price, op.quantity
+		select o.id, o.user_id, u.email, o.order_status, o.voice_number, o.payment_img_url, o.total_product_price, o.ship_cost, o.total_payment, o.description, o.created_at, o.interaction_hold, p.id, p.name, p.image_url, ph.id, ph.name, op.price, op.quantity
 		from orders o 
 		join users u on u.id = o.user_id
 		join order_products op on o.id = op.order_id 
@@ -232,6 +246,7 @@ func (r *pharmacistOrderRepositoryImpl) GetAllOrderFromPharmacist(ctx context.Co
 			&entity.TotalPayment,
 			&entity.Description,
 			&entity.CreatedAt,
+			&entity.InteractionHold,
 			&entity.OrderProduct.ProductID,
 			&entity.OrderProduct.ProductName,
 			&entity.OrderProduct.ProductThumbnailURL,
@@ -343,10 +358,12 @@ func (r *pharmacistOrderRepositoryImpl) CancelOrderStatus(ctx context.Context, o
 	return nil
 }
 
-func (r *pharmacistOrderRepositoryImpl) ConfirmOrderStatus(ctx context.Context, ids []int64) error {
+// ConfirmOrderStatus confirms the orders that are still on their way and
+// returns the ids it confirmed, orders the user already confirmed are skipped.
+func (r *pharmacistOrderRepositoryImpl) ConfirmOrderStatus(ctx context.Context, ids []int64) ([]int64, error) {
 	query := `
 		update orders set order_status = 'CONFIRMED', updated_at = NOW()
-		where order_status = 'PROCESSED' and id in (
+		where order_status = 'SENT' and id in (
 	`
 	placeholders := make([]string, len(ids))
 	args := make([]any, len(ids))
@@ -355,25 +372,40 @@ func (r *pharmacistOrderRepositoryImpl) ConfirmOrderStatus(ctx context.Context,
 		placeholders[i] = fmt.Sprintf("$%v", i+1)
 		args[i] = order
 	}
-	query += strings.Join(placeholders, ",") + ")"
+	query += strings.Join(placeholders, ",") + ") returning id"
 
 	tx := transactor.ExtractTx(ctx)
 
 	var (
-		err error
+		err  error
+		rows *sql.Rows
 	)
 
 	if tx != nil {
-		_, err = tx.ExecContext(ctx, query, args...)
+		rows, err = tx.QueryContext(ctx, query, args...)
 	} else {
-		_, err = r.db.ExecContext(ctx, query, args...)
+		rows, err = r.db.QueryContext(ctx, query, args...)
 	}
 
 	if err != nil {
-		return err
+		return nil, err
 	}
+	defer rows.Close()
 
-	return nil
+	confirmed := []int64{}
+	for rows.Next() {
+		var id int64
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		confirmed = append(confirmed, id)
+	}
+
+	err = rows.Err()
+	if err != nil {
+		return nil, err
+	}
+	return confirmed, nil
 }
 
 func (r *pharmacistOrderRepositoryImpl) ReturnStockOnCanceledOrder(ctx context.Context, productId, productQuantity int64) error {
@@ -398,3 +430,36 @@ func (r *pharmacistOrderRepositoryImpl) ReturnStockOnCanceledOrder(ctx context.C
 
 	return nil
 }
+
+func (r *pharmacistOrderRepositoryImpl) OverrideInteractionHold(ctx context.Context, request *dto.GetOrderRequest) error {
+	query := `
+		update orders set interaction_hold = false, interaction_overridden_by = $1, interaction_overridden_at = now(), updated_at = now()
+		where id = $3 and interaction_hold and order_status = 'WAITING' and exists (
+			select 1 from order_products op
+			join pharmacy_products pp on pp.id = op.pharmacy_product_id
+			where op.order_id = orders.id and pp.pharmacy_id = $2
+		)
+	`
+	tx := transactor.ExtractTx(ctx)
+
+	var (
+		err error
+		res sql.Result
+	)
+
+	if tx != nil {
+		res, err = tx.ExecContext(ctx, query, request.PharmacistID, request.PharmacyID, request.ID)
+	} else {
+		res, err = r.db.ExecContext(ctx, query, request.PharmacistID, request.PharmacyID, request.ID)
+	}
+
+	if err != nil {
+		return err
+	}
+
+	rowsAffected, _ := res.RowsAffected()
+	if rowsAffected == 0 {
+		return apperrorOrder.NewInvalidOrderInteractionOverride()
+	}
+	return nil
+}
@@ -23,12 +23,15 @@ type UserOrderRepository interface {
 	PostNewOrderUser(ctx context.Context, reqBody dtoOrder.RequestOrder, addressDb profileEntity.Address, userId int64) (*orderEntity.OrderCheckout, error)
 	PostNewOrderProductUser(ctx context.Context, orderID int64, reqBody dtoOrder.RequestListOrderProduct) (*orderEntity.OrderProductCheckout, error)
 	GetPharmacyAndPartner(ctx context.Context, pharmacyProductId int64) (*pharmacyEntity.PharmacyForCart, error)
+	GetProductByPharmacyProductID(ctx context.Context, pharmacyProductId int64, pharmacyId int64) (*productEntity.ProductForCart, error)
 	GetMyOrders(ctx context.Context, request *dtoOrder.QueryGetMyOrder, userId int64) ([]orderEntity.OrderWithData, error)
 	GetOrderByID(ctx context.Context, orderId int64, userId int64) ([]orderEntity.OrderWithData, error)
 	GetOrderByIDWithSingleData(ctx context.Context, orderId int64, userId int64) (*orderEntity.OrderCheckout, error)
 	PostUploadPaymentProof(ctx context.Context, imgURL string, orderId int64, userId int64) error
 	PatchStatusOrder(ctx context.Context, status string, orderId int64, userId int64) error
 	ProcessOrder(ctx context.Context, id int64) error
+	HoldOrderForInteraction(ctx context.Context, orderId int64) error
+	IsPrescriptionItemForPatient(ctx context.Context, prescriptionItemId int64, userId int64, dependentId *int64) (bool, error)
 }
 
 type userOrderRepositoryImpl struct {
@@ -76,9 +79,9 @@ func (uo *userOrderRepositoryImpl) CalculateShipCost(ctx context.Context, reques
 
 func (uo *userOrderRepositoryImpl) PostNewOrderUser(ctx context.Context, reqBody dtoOrder.RequestOrder, addressDb profileEntity.Address, userId int64) (*orderEntity.OrderCheckout, error) {
 	query := `
-		INSERT INTO orders (user_id, order_status, voice_number, payment_img_url, total_product_price, ship_cost, total_payment, description, address) VALUES 
-		($1, $2, $3, NULL, $4, $5, $6, $7, $8)
-		RETURNING id, user_id, order_status, voice_number, payment_img_url, total_product_price, ship_cost, total_payment, description, address, created_at, updated_at, deleted_at;
+		INSERT INTO orders (user_id, order_status, voice_number, payment_img_url, total_product_price, ship_cost, total_payment, description, address, dependent_id) VALUES 
+		($1, $2, $3, NULL, $4, $5, $6, $7, $8, $9)
+		RETURNING id, user_id, order_status, voice_number, payment_img_url, total_product_price, ship_cost, total_payment, description, address, dependent_id, interaction_hold, created_at, updated_at, deleted_at;
 	`
 	totalProductPrice, totalPayment := int64(0), int64(0)
 	for _, orderProduct := range reqBody.OrderProducts {
@@ -91,7 +94,7 @@ func (uo *userOrderRepositoryImpl) PostNewOrderUser(ctx context.Context, reqBody
 	var err error
 	tx := transactor.ExtractTx(ctx)
 	if tx != nil {
-		err = tx.QueryRowContext(ctx, query, userId, constant.STATUS_WAITING, utils.GenerateInvoiceNumber(), totalProductPrice, reqBody.ShipCost, totalPayment, reqBody.Description, addressOrder).Scan(
+		err = tx.QueryRowContext(ctx, query, userId, constant.STATUS_WAITING, utils.GenerateInvoiceNumber(), totalProductPrice, reqBody.ShipCost, totalPayment, reqBody.Description, addressOrder, reqBody.DependentID).Scan(
 			&order.ID,
 			&order.UserID,
 			&order.OrderStatus,
@@ -102,12 +105,14 @@ func (uo *userOrderRepositoryImpl) PostNewOrderUser(ctx context.Context, reqBody
 			&order.TotalPayment,
 			&order.Description,
 			&order.Address,
+			&order.DependentID,
+			&order.InteractionHold,
 			&order.CreatedAt,
 			&order.UpdatedAt,
 			&order.DeletedAt,
 		)
 	} else {
-		err = uo.db.QueryRowContext(ctx, query, userId, constant.STATUS_WAITING, utils.GenerateInvoiceNumber(), totalProductPrice, reqBody.ShipCost, totalPayment, reqBody.Description, addressOrder).Scan(
+		err = uo.db.QueryRowContext(ctx, query, userId, constant.STATUS_WAITING, utils.GenerateInvoiceNumber(), totalProductPrice, reqBody.ShipCost, totalPayment, reqBody.Description, addressOrder, reqBody.DependentID).Scan(
 			&order.ID,
 			&order.UserID,
 			&order.OrderStatus,
@@ -118,6 +123,8 @@ func (uo *userOrderRepositoryImpl) PostNewOrderUser(ctx context.Context, reqBody
 			&order.TotalPayment,
 			&order.Description,
 			&order.Address,
+			&order.DependentID,
+			&order.InteractionHold,
 			&order.CreatedAt,
 			&order.UpdatedAt,
 			&order.DeletedAt,
@@ -134,15 +141,15 @@ func (uo *userOrderRepositoryImpl) PostNewOrderUser(ctx context.Context, reqBody
 
 func (uo *userOrderRepositoryImpl) PostNewOrderProductUser(ctx context.Context, orderID int64, reqBody dtoOrder.RequestListOrderProduct) (*orderEntity.OrderProductCheckout, error) {
 	query := `
-		INSERT INTO order_products (order_id, pharmacy_product_id, quantity, price, created_at, updated_at) VALUES 
-		($1, $2, $3, $4, NOW(), NOW())
+		INSERT INTO order_products (order_id, pharmacy_product_id, quantity, price, prescription_item_id, created_at, updated_at) VALUES 
+		($1, $2, $3, $4, $5, NOW(), NOW())
 		RETURNING id, order_id, pharmacy_product_id, quantity, price, created_at, updated_at;
 	`
 	var orderProduct orderEntity.OrderProductCheckout
 	var err error
 	tx := transactor.ExtractTx(ctx)
 	if tx != nil {
-		err = tx.QueryRowContext(ctx, query, orderID, reqBody.PharmacyProductId, reqBody.Quantity, reqBody.Price).Scan(
+		err = tx.QueryRowContext(ctx, query, orderID, reqBody.PharmacyProductId, reqBody.Quantity, reqBody.Price, reqBody.PrescriptionItemID).Scan(
 			&orderProduct.ID,
 			&orderProduct.OrderID,
 			&orderProduct.PharmacyProductID,
@@ -152,7 +159,7 @@ func (uo *userOrderRepositoryImpl) PostNewOrderProductUser(ctx context.Context,
 			&orderProduct.UpdatedAt,
 		)
 	} else {
-		err = uo.db.QueryRowContext(ctx, query, orderID, reqBody.PharmacyProductId, reqBody.Quantity, reqBody.Price).Scan(
+		err = uo.db.QueryRowContext(ctx, query, orderID, reqBody.PharmacyProductId, reqBody.Quantity, reqBody.Price, reqBody.PrescriptionItemID).Scan(
 			&orderProduct.ID,
 			&orderProduct.OrderID,
 			&orderProduct.PharmacyProductID,
@@ -203,11 +210,40 @@ func (c *userOrderRepositoryImpl) GetPharmacyAndPartner(ctx context.Context, pha
 	return &pharmacyAndPartners, nil
 }
 
+func (c *userOrderRepositoryImpl) GetProductByPharmacyProductID(ctx context.Context, pharmacyProductId int64, pharmacyId int64) (*productEntity.ProductForCart, error) {
+	query := `
+		SELECT 
+			p2.id, p2.manufacture_id, p2.product_classification_id, p2.product_form_id, p2.name, p2.generic_name, p2.description, p2.unit_in_pack, p2.selling_unit, p2.sold_amount, p2.weight, p2.height, p2.length, p2.width, p2.image_url, p2.is_active, p2.created_at, p2.updated_at, p2.deleted_at
+		FROM pharmacy_products pp
+		INNER JOIN products p2 ON pp.product_id = p2.id 
+		WHERE pp.id = $1 AND pp.pharmacy_id = $2 AND pp.deleted_at IS NULL
+	`
+	var product productEntity.ProductForCart
+	tx := transactor.ExtractTx(ctx)
+	var err error
+	if tx != nil {
+		err = tx.QueryRowContext(ctx, query, pharmacyProductId, pharmacyId).Scan(
+			&product.ID, &product.ManufactureID, &product.ProductClassificationID, &product.ProductFormID, &product.Name, &product.GenericName, &product.Description, &product.UnitInPack, &product.SellingUnit, &product.SoldAmount, &product.Weight, &product.Height, &product.Length, &product.Width, &product.ImageURL, &product.IsActive, &product.CreatedAt, &product.UpdatedAt, &product.DeletedAt,
+		)
+	} else {
+		err = c.db.QueryRowContext(ctx, query, pharmacyProductId, pharmacyId).Scan(
+			&product.ID, &product.ManufactureID, &product.ProductClassificationID, &product.ProductFormID, &product.Name, &product.GenericName, &product.Description, &product.UnitInPack, &product.SellingUnit, &product.SoldAmount, &product.Weight, &product.Height, &product.Length, &product.Width, &product.ImageURL, &product.IsActive, &product.CreatedAt, &product.UpdatedAt, &product.DeletedAt,
+		)
+	}
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &product, nil
+}
+
 func (c *userOrderRepositoryImpl) GetMyOrders(ctx context.Context, request *dtoOrder.QueryGetMyOrder, userId int64) ([]orderEntity.OrderWithData, error) {
 	tx := transactor.ExtractTx(ctx)
 	query := `
 		SELECT 
-			o.id, o.user_id, o.order_status, o.voice_number, o.payment_img_url, o.total_payment, o.ship_cost, o.total_product_price, o.description, o.address, o.created_at, o.updated_at, o.deleted_at,
+			o.id, o.user_id, o.order_status, o.voice_number, o.payment_img_url, o.total_payment, o.ship_cost, o.total_product_price, o.description, o.address, o.dependent_id, o.interaction_hold, o.created_at, o.updated_at, o.deleted_at,
 			op.id, op.order_id, op.pharmacy_product_id, op.quantity, op.price, op.created_at, op.updated_at,
 			pp.id, pp.pharmacy_id, pp.product_id, pp.stock_quantity, pp.price, pp.sold_amount, pp.created_at, pp.updated_at, pp.deleted_at,
 			p.id, p.manufacture_id, p.product_classification_id, p.product_form_id, p.name, p.generic_name, p.description, p.unit_in_pack, p.selling_unit, p.sold_amount, p.weight, p.height, p.length, p.width, p.image_url, p.is_active, p.created_at, p.updated_at, p.deleted_at,
@@ -261,7 +297,7 @@ func (c *userOrderRepositoryImpl) GetMyOrders(ctx context.Context, request *dtoO
 			partner         pharmacyEntity.Partner
 		)
 		if err := rows.Scan(
-			&orders.ID, &orders.UserID, &orders.OrderStatus, &orders.VoiceNumber, &orders.PaymentImgURL, &orders.TotalPayment, &orders.ShipCost, &orders.TotalProductPrice, &orders.Description, &orders.Address, &orders.CreatedAt, &orders.UpdatedAt, &orders.DeletedAt,
+			&orders.ID, &orders.UserID, &orders.OrderStatus, &orders.VoiceNumber, &orders.PaymentImgURL, &orders.TotalPayment, &orders.ShipCost, &orders.TotalProductPrice, &orders.Description, &orders.Address, &orders.DependentID, &orders.InteractionHold, &orders.CreatedAt, &orders.UpdatedAt, &orders.DeletedAt,
 			&orderProduct.ID, &orderProduct.OrderID, &orderProduct.PharmacyProductID, &orderProduct.Quantity, &orderProduct.Price, &orderProduct.CreatedAt, &orderProduct.UpdatedAt,
 			&pharmacyProduct.ID, &pharmacyProduct.PharmacyId, &pharmacyProduct.ProductId, &pharmacyProduct.StockQuantity, &pharmacyProduct.Price, &pharmacyProduct.SoldAmount, &pharmacyProduct.CreatedAt, &pharmacyProduct.UpdatedAt, &pharmacyProduct.DeletedAt,
 			&product.ID, &product.ManufactureID, &product.ProductClassificationID, &product.ProductFormID, &product.Name, &product.GenericName, &product.Description, &product.UnitInPack, &product.SellingUnit, &product.SoldAmount, &product.Weight, &product.Height, &product.Length, &product.Width, &product.ImageURL, &product.IsActive, &product.CreatedAt, &product.UpdatedAt, &product.DeletedAt,
@@ -288,7 +324,7 @@ func (c *userOrderRepositoryImpl) GetOrderByID(ctx context.Context, orderId int6
 	tx := transactor.ExtractTx(ctx)
 	query := `
 		SELECT 
-			o.id, o.user_id, o.order_status, o.voice_number, o.payment_img_url, o.total_product_price, o.ship_cost, o.total_payment, o.description, o.address, o.created_at, o.updated_at, o.deleted_at,
+			o.id, o.user_id, o.order_status, o.voice_number, o.payment_img_url, o.total_product_price, o.ship_cost, o.total_payment, o.description, o.address, o.dependent_id, o.interaction_hold, o.created_at, o.updated_at, o.deleted_at,
 			op.id, op.order_id, op.pharmacy_product_id, op.quantity, op.price, op.created_at, op.updated_at,
 			pp.id, pp.pharmacy_id, pp.product_id, pp.stock_quantity, pp.price, pp.sold_amount, pp.created_at, pp.updated_at, pp.deleted_at,
 			p.id, p.manufacture_id, p.product_classification_id, p.product_form_id, p.name, p.generic_name, p.description, p.unit_in_pack, p.selling_unit, p.sold_amount, p.weight, p.height, p.length, p.width, p.image_url, p.is_active, p.created_at, p.updated_at, p.deleted_at,
@@ -327,7 +363,7 @@ func (c *userOrderRepositoryImpl) GetOrderByID(ctx context.Context, orderId int6
 			partner         pharmacyEntity.Partner
 		)
 		if err := rows.Scan(
-			&orders.ID, &orders.UserID, &orders.OrderStatus, &orders.VoiceNumber, &orders.PaymentImgURL, &orders.TotalProductPrice, &orders.ShipCost, &orders.TotalPayment, &orders.Description, &orders.Address, &orders.CreatedAt, &orders.UpdatedAt, &orders.DeletedAt,
+			&orders.ID, &orders.UserID, &orders.OrderStatus, &orders.VoiceNumber, &orders.PaymentImgURL, &orders.TotalProductPrice, &orders.ShipCost, &orders.TotalPayment, &orders.Description, &orders.Address, &orders.DependentID, &orders.InteractionHold, &orders.CreatedAt, &orders.UpdatedAt, &orders.DeletedAt,
 			&orderProduct.ID, &orderProduct.OrderID, &orderProduct.PharmacyProductID, &orderProduct.Quantity, &orderProduct.Price, &orderProduct.CreatedAt, &orderProduct.UpdatedAt,
 			&pharmacyProduct.ID, &pharmacyProduct.PharmacyId, &pharmacyProduct.ProductId, &pharmacyProduct.StockQuantity, &pharmacyProduct.Price, &pharmacyProduct.SoldAmount, &pharmacyProduct.CreatedAt, &pharmacyProduct.UpdatedAt, &pharmacyProduct.DeletedAt,
 			&product.ID, &product.ManufactureID, &product.ProductClassificationID, &product.ProductFormID, &product.Name, &product.GenericName, &product.Description, &product.UnitInPack, &product.SellingUnit, &product.SoldAmount, &product.Weight, &product.Height, &product.Length, &product.Width, &product.ImageURL, &product.IsActive, &product.CreatedAt, &product.UpdatedAt, &product.DeletedAt,
@@ -354,7 +390,7 @@ func (c *userOrderRepositoryImpl) GetOrderByID(ctx context.Context, orderId int6
 func (c *userOrderRepositoryImpl) GetOrderByIDWithSingleData(ctx context.Context, orderId int64, userId int64) (*orderEntity.OrderCheckout, error) {
 	query := `
 		SELECT 
-			o.id, o.user_id, o.order_status, o.voice_number, o.payment_img_url, o.total_product_price, o.ship_cost, o.total_payment, o.description, o.address, o.created_at, o.updated_at, o.deleted_at
+			o.id, o.user_id, o.order_status, o.voice_number, o.payment_img_url, o.total_product_price, o.ship_cost, o.total_payment, o.description, o.address, o.dependent_id, o.interaction_hold, o.created_at, o.updated_at, o.deleted_at
 		FROM orders o 
 		WHERE o.id = $1 AND o.user_id = $2 AND o.deleted_at IS NULL
 	`
@@ -373,6 +409,8 @@ func (c *userOrderRepositoryImpl) GetOrderByIDWithSingleData(ctx context.Context
 			&order.TotalPayment,
 			&order.Description,
 			&order.Address,
+			&order.DependentID,
+			&order.InteractionHold,
 			&order.CreatedAt,
 			&order.UpdatedAt,
 			&order.DeletedAt,
@@ -389,6 +427,8 @@ func (c *userOrderRepositoryImpl) GetOrderByIDWithSingleData(ctx context.Context
 			&order.TotalPayment,
 			&order.Description,
 			&order.Address,
+			&order.DependentID,
+			&order.InteractionHold,
 			&order.CreatedAt,
 			&order.UpdatedAt,
 			&order.DeletedAt,
@@ -456,3 +496,47 @@ func (c *userOrderRepositoryImpl) ProcessOrder(ctx context.Context, id int64) er
 
 	return err
 }
+
+func (c *userOrderRepositoryImpl) HoldOrderForInteraction(ctx context.Context, orderId int64) error {
+	query := `
+		update orders 
+		set interaction_hold = true, updated_at = now() 
+		where id = $1
+	`
+	tx := transactor.ExtractTx(ctx)
+
+	var err error
+	if tx != nil {
+		_, err = tx.ExecContext(ctx, query, orderId)
+	} else {
+		_, err = c.db.ExecContext(ctx, query, orderId)
+	}
+
+	return err
+}
+
+// IsPrescriptionItemForPatient checks the prescription was written for the
+// account holder (no dependent) or for that exact dependent.
+func (c *userOrderRepositoryImpl) IsPrescriptionItemForPatient(ctx context.Context, prescriptionItemId int64, userId int64, dependentId *int64) (bool, error) {
+	query := `
+		select exists (
+			select 1
+			from prescription_items pi
+			join prescriptions rx on rx.id = pi.prescription_id
+			where pi.id = $1 and rx.user_id = $2 and rx.dependent_id is not distinct from $3
+		)
+	`
+	tx := transactor.ExtractTx(ctx)
+
+	var (
+		err    error
+		exists bool
+	)
+	if tx != nil {
+		err = tx.QueryRowContext(ctx, query, prescriptionItemId, userId, dependentId).Scan(&exists)
+	} else {
+		err = c.db.QueryRowContext(ctx, query, prescriptionItemId, userId, dependentId).Scan(&exists)
+	}
+
+	return exists, err
+}
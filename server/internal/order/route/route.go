@@ -2,12 +2,19 @@ package route
 
 import (
 	"healthcare-app/internal/auth/constant"
+	constantOrder "healthcare-app/internal/order/constant"
 	"healthcare-app/internal/order/controller"
 	"healthcare-app/pkg/middleware"
 
 	"github.com/gin-gonic/gin"
 )
 
+// The order event streams stay open, they are exempt from the request timeout.
+const (
+	MyOrderEventsPath       = "/orders/events"
+	PharmacyOrderEventsPath = "/pharmacists/pharmacies/:pharmacyId/orders/events"
+)
+
 func AdminOrderControllerRoute(c *controller.AdminOrderController, r *gin.Engine, authMiddleware *middleware.AuthMiddleware) {
 	g := r.Group("/admin/pharmacies", authMiddleware.Authorization(), authMiddleware.ProtectedRoles(constant.ADMIN))
 	{
@@ -21,18 +28,24 @@ func PharmacistOrderControllerRoute(c *controller.PharmacistOrderController, r *
 	g := r.Group("/pharmacists/pharmacies/:pharmacyId/orders", authMiddleware.Authorization(), authMiddleware.ProtectedRoles(constant.PHARMACIST))
 	{
 		g.GET("/:orderId", c.GetOrderById)
+		g.PATCH("/:orderId/interaction-override", c.OverrideInteraction)
 		g.PATCH("", c.SendOrder)
 		g.DELETE("", c.CancelOrder)
 	}
 }
 
-func UserOrderControllerRoute(c *controller.UserOrderController, r *gin.Engine, authMiddleware *middleware.AuthMiddleware) {
+func OrderEventControllerRoute(c *controller.OrderEventController, r *gin.Engine, authMiddleware *middleware.AuthMiddleware) {
+	r.GET(MyOrderEventsPath, authMiddleware.WebSocketAuthorization(), authMiddleware.ProtectedRoles(constant.USER), c.StreamMyOrders)
+	r.GET(PharmacyOrderEventsPath, authMiddleware.WebSocketAuthorization(), authMiddleware.ProtectedRoles(constant.PHARMACIST), c.StreamPharmacyOrders)
+}
+
+func UserOrderControllerRoute(c *controller.UserOrderController, r *gin.Engine, authMiddleware *middleware.AuthMiddleware, idempotencyMiddleware *middleware.IdempotencyMiddleware) {
 	r.GET("/orders/:orderId", authMiddleware.Authorization(), c.GetOrderByID)
 	g := r.Group("/orders", authMiddleware.Authorization(), authMiddleware.ProtectedRoles(constant.USER))
 	{
 		g.GET("", c.GetMyOrders)
-		g.POST("/checkout", c.PostNewOrder)
-		g.POST("/payment/:orderId", c.PostUploadPaymentProof)
+		g.POST("/checkout", idempotencyMiddleware.Idempotent(constantOrder.MAX_CHECKOUT_BODY_SIZE), c.PostNewOrder)
+		g.POST("/payment/:orderId", idempotencyMiddleware.Idempotent(constantOrder.MAX_PAYMENT_BODY_SIZE), c.PostUploadPaymentProof)
 		g.PATCH("/confirm/:orderId", c.PatchStatusOrder)
 	}
 }
@@ -5,9 +5,11 @@ import (
 
 	dtoOrder "healthcare-app/internal/order/dto"
 	"healthcare-app/internal/order/repository"
+	"healthcare-app/internal/order/utils"
 	"healthcare-app/pkg/apperror"
 	dtoPkg "healthcare-app/pkg/dto"
 	"healthcare-app/pkg/utils/pageutils"
+	"healthcare-app/pkg/utils/storageutils"
 )
 
 type AdminOrderUseCase interface {
@@ -15,14 +17,17 @@ type AdminOrderUseCase interface {
 }
 
 type adminOrderUseCaseImpl struct {
-	orderRepo repository.OrderRepository
+	objectStorage storageutils.ObjectStorage
+	orderRepo     repository.OrderRepository
 }
 
 func NewAdminOrderUseCase(
+	objectStorage storageutils.ObjectStorage,
 	orderRepo repository.OrderRepository,
 ) *adminOrderUseCaseImpl {
 	return &adminOrderUseCaseImpl{
-		orderRepo: orderRepo,
+		objectStorage: objectStorage,
+		orderRepo:     orderRepo,
 	}
 }
 
@@ -33,5 +38,10 @@ func (u *adminOrderUseCaseImpl) Search(ctx context.Context, request *dtoOrder.Ad
 	}
 
 	res, metaData := pageutils.CreateMetaData(dtoOrder.ConvertToOrderResponses(orders), request.Page, request.Limit)
+	for _, order := range res {
+		if order.PaymentImgURL, err = utils.SignPaymentProof(ctx, u.objectStorage, order.PaymentImgURL); err != nil {
+			return nil, nil, apperror.NewServerError(err)
+		}
+	}
 	return res, metaData, nil
 }
@@ -0,0 +1,86 @@
+package usecase
+
+import (
+	"context"
+
+	dtoOrder "healthcare-app/internal/order/dto"
+	repositoryOrder "healthcare-app/internal/order/repository"
+	"healthcare-app/internal/order/utils"
+	constantWebhook "healthcare-app/internal/webhook/constant"
+	usecaseWebhook "healthcare-app/internal/webhook/usecase"
+	appErrorPkg "healthcare-app/pkg/apperror"
+	"healthcare-app/pkg/logger"
+	"healthcare-app/pkg/utils/pubsubutils"
+)
+
+type OrderEventUseCase interface {
+	Publish(ctx context.Context, events ...*dtoOrder.OrderEvent)
+	SubscribeMyOrders(ctx context.Context, userID int64) *pubsubutils.Subscriber
+	SubscribePharmacyOrders(ctx context.Context, pharmacyID int64, pharmacistID int64) (*pubsubutils.Subscriber, error)
+	Unsubscribe(subscriber *pubsubutils.Subscriber)
+}
+
+type orderEventUseCaseImpl struct {
+	pharmacistOrderRepository repositoryOrder.PharmacistOrderRepository
+	broker                    pubsubutils.Broker
+	webhookDeliveryUseCase    usecaseWebhook.WebhookDeliveryUseCase
+}
+
+func NewOrderEventUseCase(
+	pharmacistOrderRepository repositoryOrder.PharmacistOrderRepository,
+	broker pubsubutils.Broker,
+	webhookDeliveryUseCase usecaseWebhook.WebhookDeliveryUseCase,
+) *orderEventUseCaseImpl {
+	return &orderEventUseCaseImpl{
+		pharmacistOrderRepository: pharmacistOrderRepository,
+		broker:                    broker,
+		webhookDeliveryUseCase:    webhookDeliveryUseCase,
+	}
+}
+
+// Publish is called once the status change is committed. The change already
+// happened by then, so a failing broker is logged and the clients catch up on
+// their next fetch. The statuses partners subscribe to are also pushed to
+// their webhooks.
+func (u *orderEventUseCaseImpl) Publish(ctx context.Context, events ...*dtoOrder.OrderEvent) {
+	for _, event := range events {
+		topics := []string{utils.GenerateUserOrderTopic(event.UserID)}
+		if event.PharmacyID != 0 {
+			topics = append(topics, utils.GeneratePharmacyOrderTopic(event.PharmacyID))
+		}
+		for _, topic := range topics {
+			if err := u.broker.Publish(ctx, topic, event); err != nil {
+				logger.Log.WithFields(map[string]any{"topic": topic, "order_id": event.OrderID}).Warn("failed to publish order event:", err)
+			}
+		}
+
+		webhookEvent, ok := constantWebhook.OrderStatusEvents[event.OrderStatus]
+		if !ok || event.PharmacyID == 0 {
+			continue
+		}
+		if err := u.webhookDeliveryUseCase.Trigger(ctx, event.PharmacyID, webhookEvent, event); err != nil {
+			logger.Log.WithFields(map[string]any{"event": webhookEvent, "order_id": event.OrderID}).Warn("failed to trigger webhook:", err)
+		}
+	}
+}
+
+func (u *orderEventUseCaseImpl) SubscribeMyOrders(ctx context.Context, userID int64) *pubsubutils.Subscriber {
+	return u.broker.Subscribe(utils.GenerateUserOrderTopic(userID))
+}
+
+func (u *orderEventUseCaseImpl) SubscribePharmacyOrders(ctx context.Context, pharmacyID int64, pharmacistID int64) (*pubsubutils.Subscriber, error) {
+	ok, err := u.pharmacistOrderRepository.IsPharmacistAssign(ctx, pharmacyID, pharmacistID)
+	if err != nil {
+		return nil, appErrorPkg.NewServerError(err)
+	}
+
+	if !ok {
+		return nil, appErrorPkg.NewForbiddenAccessError()
+	}
+
+	return u.broker.Subscribe(utils.GeneratePharmacyOrderTopic(pharmacyID)), nil
+}
+
+func (u *orderEventUseCaseImpl) Unsubscribe(subscriber *pubsubutils.Subscriber) {
+	u.broker.Unsubscribe(subscriber)
+}
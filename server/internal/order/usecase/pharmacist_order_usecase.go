@@ -3,18 +3,30 @@ package usecase
 import (
 	"context"
 
+	constantAudit "healthcare-app/internal/audit/constant"
+	dtoAudit "healthcare-app/internal/audit/dto"
+	usecaseAudit "healthcare-app/internal/audit/usecase"
+	constantAuth "healthcare-app/internal/auth/constant"
+	usecaseNotification "healthcare-app/internal/notification/usecase"
+	utilsNotification "healthcare-app/internal/notification/utils"
 	apperrorOrder "healthcare-app/internal/order/apperror"
 	"healthcare-app/internal/order/constant"
 	dtoOrder "healthcare-app/internal/order/dto"
 	repositoryOrder "healthcare-app/internal/order/repository"
+	"healthcare-app/internal/order/utils"
 	"healthcare-app/internal/product/entity"
 	repositoryProduct "healthcare-app/internal/product/repository"
+	constantProfile "healthcare-app/internal/profile/constant"
+	dtoProfile "healthcare-app/internal/profile/dto"
+	entityProfile "healthcare-app/internal/profile/entity"
+	repositoryProfile "healthcare-app/internal/profile/repository"
 	"healthcare-app/internal/queue/payload"
 	"healthcare-app/internal/queue/tasks"
 	appErrorPkg "healthcare-app/pkg/apperror"
 	"healthcare-app/pkg/database/transactor"
 	dtoPkg "healthcare-app/pkg/dto"
 	"healthcare-app/pkg/utils/pageutils"
+	"healthcare-app/pkg/utils/storageutils"
 )
 
 type PharmacistOrderUseCase interface {
@@ -22,28 +34,50 @@ type PharmacistOrderUseCase interface {
 	GetAllOrders(ctx context.Context, request *dtoOrder.PharmacistGetOrderRequest, userId int64) ([]*dtoOrder.OrderResponse, *dtoPkg.PageMetaData, error)
 	SendOrder(ctx context.Context, order *dtoOrder.RequestOrderID) error
 	CancelOrder(ctx context.Context, order *dtoOrder.RequestOrderID) error
+	OverrideInteraction(ctx context.Context, order *dtoOrder.GetOrderRequest) error
 }
 
 type pharmacistOrderUseCaseImpl struct {
+	objectStorage             storageutils.ObjectStorage
 	orderTask                 tasks.OrderTask
+	emailTask                 tasks.EmailTask
+	notificationUseCase       usecaseNotification.NotificationUseCase
+	orderEventUseCase         OrderEventUseCase
+	auditLogUseCase           usecaseAudit.AuditLogUseCase
 	productRepository         repositoryProduct.ProductRepository
 	pharmacyProductRepository repositoryProduct.PharmacyProductRepository
 	pharmacistOrderRepository repositoryOrder.PharmacistOrderRepository
+	medicalProfileRepository  repositoryProfile.MedicalProfileRepository
+	dependentRepository       repositoryProfile.DependentRepository
 	transactor                transactor.Transactor
 }
 
 func NewPharmacistOrderUseCase(
+	objectStorage storageutils.ObjectStorage,
 	orderTask tasks.OrderTask,
+	emailTask tasks.EmailTask,
+	notificationUseCase usecaseNotification.NotificationUseCase,
+	orderEventUseCase OrderEventUseCase,
+	auditLogUseCase usecaseAudit.AuditLogUseCase,
 	productRepository repositoryProduct.ProductRepository,
 	pharmacyProductRepository repositoryProduct.PharmacyProductRepository,
 	pharmacistOrderRepository repositoryOrder.PharmacistOrderRepository,
+	medicalProfileRepository repositoryProfile.MedicalProfileRepository,
+	dependentRepository repositoryProfile.DependentRepository,
 	transactor transactor.Transactor,
 ) *pharmacistOrderUseCaseImpl {
 	return &pharmacistOrderUseCaseImpl{
+		objectStorage:             objectStorage,
 		orderTask:                 orderTask,
+		emailTask:                 emailTask,
+		notificationUseCase:       notificationUseCase,
+		orderEventUseCase:         orderEventUseCase,
+		auditLogUseCase:           auditLogUseCase,
 		productRepository:         productRepository,
 		pharmacyProductRepository: pharmacyProductRepository,
 		pharmacistOrderRepository: pharmacistOrderRepository,
+		medicalProfileRepository:  medicalProfileRepository,
+		dependentRepository:       dependentRepository,
 		transactor:                transactor,
 	}
 }
@@ -63,7 +97,49 @@ func (u *pharmacistOrderUseCaseImpl) GetOrderById(ctx context.Context, order *dt
 		return nil, err
 	}
 
-	return dtoOrder.ConvertToOrderResponse(orders), nil
+	res := dtoOrder.ConvertToOrderResponse(orders)
+	if res.PaymentImgURL, err = utils.SignPaymentProof(ctx, u.objectStorage, res.PaymentImgURL); err != nil {
+		return nil, appErrorPkg.NewServerError(err)
+	}
+	if len(orders) == 0 {
+		return res, nil
+	}
+	// the patient is the dependent when there is one, the access is logged
+	// either way so the account holder sees who looked at their family's data
+	err = u.transactor.Atomic(ctx, func(txCtx context.Context) error {
+		if orders[0].DependentID != nil {
+			dependent, err := u.dependentRepository.FindByID(txCtx, *orders[0].DependentID)
+			if err != nil {
+				return err
+			}
+			if dependent.Allergies, err = u.dependentRepository.FindAllAllergiesByID(txCtx, dependent.ID); err != nil {
+				return appErrorPkg.NewServerError(err)
+			}
+			res.Patient = dtoProfile.ConvertToDependentResponse(dependent)
+		} else {
+			medicalProfile, err := u.medicalProfileRepository.FindByUserID(txCtx, orders[0].UserID)
+			if err != nil {
+				return appErrorPkg.NewServerError(err)
+			}
+			res.MedicalProfile = dtoProfile.ConvertToMedicalProfileResponse(medicalProfile)
+		}
+
+		if err := u.medicalProfileRepository.SaveAccessLog(txCtx, &entityProfile.MedicalProfileAccessLog{
+			UserID:       orders[0].UserID,
+			AccessedBy:   order.PharmacistID,
+			AccessorRole: constantAuth.PHARMACIST,
+			Action:       constantProfile.MEDICAL_PROFILE_ACTION_VIEW,
+			OrderID:      &orders[0].ID,
+			DependentID:  orders[0].DependentID,
+		}); err != nil {
+			return appErrorPkg.NewServerError(err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
 }
 
 func (u *pharmacistOrderUseCaseImpl) GetAllOrders(ctx context.Context, request *dtoOrder.PharmacistGetOrderRequest, userId int64) ([]*dtoOrder.OrderResponse, *dtoPkg.PageMetaData, error) {
@@ -73,6 +149,11 @@ func (u *pharmacistOrderUseCaseImpl) GetAllOrders(ctx context.Context, request *
 	}
 
 	res, metaData := pageutils.CreateMetaData(dtoOrder.ConvertToOrderResponses(orders), request.Page, request.Limit)
+	for _, order := range res {
+		if order.PaymentImgURL, err = utils.SignPaymentProof(ctx, u.objectStorage, order.PaymentImgURL); err != nil {
+			return nil, nil, appErrorPkg.NewServerError(err)
+		}
+	}
 
 	return res, metaData, nil
 }
@@ -87,6 +168,7 @@ func (u *pharmacistOrderUseCaseImpl) SendOrder(ctx context.Context, orders *dtoO
 		return appErrorPkg.NewForbiddenAccessError()
 	}
 
+	var events []*dtoOrder.OrderEvent
 	err = u.transactor.Atomic(ctx, func(ctx context.Context) error {
 		if len(orders.OrderID) <= 0 {
 			return appErrorPkg.NewEntityNotFoundError("order")
@@ -106,6 +188,16 @@ func (u *pharmacistOrderUseCaseImpl) SendOrder(ctx context.Context, orders *dtoO
 		if err != nil {
 			return appErrorPkg.NewServerError(err)
 		}
+		events = dtoOrder.ConvertToOrderEvents(OrderResponse, constant.STATUS_SENT)
+
+		for _, order := range OrderResponse {
+			if err := u.notificationUseCase.Notify(ctx, utilsNotification.NewOrderSentNotification(order.UserID, order.ID, order.VoiceNumber)); err != nil {
+				return err
+			}
+			if err := u.emailTask.QueueOrderShippedEmail(ctx, utils.ConvertToOrderEmailPayload(order, "")); err != nil {
+				return appErrorPkg.NewServerError(err)
+			}
+		}
 
 		return u.orderTask.QueueConfirmOrder(ctx, &payload.ConfirmOrderPayload{IDs: orders.OrderID})
 	})
@@ -114,6 +206,7 @@ func (u *pharmacistOrderUseCaseImpl) SendOrder(ctx context.Context, orders *dtoO
 		return err
 	}
 
+	u.orderEventUseCase.Publish(ctx, events...)
 	return nil
 }
 
@@ -127,6 +220,7 @@ func (u *pharmacistOrderUseCaseImpl) CancelOrder(ctx context.Context, orders *dt
 		return appErrorPkg.NewForbiddenAccessError()
 	}
 
+	var events []*dtoOrder.OrderEvent
 	err = u.transactor.Atomic(ctx, func(ctx context.Context) error {
 		if len(orders.OrderID) <= 0 {
 			return appErrorPkg.NewEntityNotFoundError("order")
@@ -146,8 +240,31 @@ func (u *pharmacistOrderUseCaseImpl) CancelOrder(ctx context.Context, orders *dt
 		if err != nil {
 			return appErrorPkg.NewServerError(err)
 		}
+		events = dtoOrder.ConvertToOrderEvents(OrderResponse, constant.STATUS_CANCELLED)
 
 		for _, order := range OrderResponse {
+			// a processed order already has a payment proof, cancelling it
+			// means the pharmacy rejected the payment
+			notification := utilsNotification.NewOrderCancelledNotification(order.UserID, order.ID, order.VoiceNumber)
+			if order.OrderStatus == constant.STATUS_PROCESSED {
+				notification = utilsNotification.NewPaymentRejectedNotification(order.UserID, order.ID, order.VoiceNumber)
+			}
+			if err := u.notificationUseCase.Notify(ctx, notification); err != nil {
+				return err
+			}
+			if err := u.emailTask.QueueOrderCancelledEmail(ctx, utils.ConvertToOrderEmailPayload(order, orders.Reason)); err != nil {
+				return appErrorPkg.NewServerError(err)
+			}
+			if err := u.auditLogUseCase.Record(ctx, &dtoAudit.AuditEntry{
+				Action:     constantAudit.ACTION_CANCEL,
+				EntityType: constantAudit.ENTITY_ORDER,
+				EntityID:   order.ID,
+				Before:     map[string]any{"order_status": order.OrderStatus},
+				After:      map[string]any{"order_status": constant.STATUS_CANCELLED, "reason": orders.Reason},
+			}); err != nil {
+				return err
+			}
+
 			for _, product := range order.Detail.Products {
 				if err := u.pharmacistOrderRepository.ReturnStockOnCanceledOrder(ctx, product.ID, product.Quantity); err != nil {
 					return appErrorPkg.NewServerError(err)
@@ -170,5 +287,19 @@ func (u *pharmacistOrderUseCaseImpl) CancelOrder(ctx context.Context, orders *dt
 		return err
 	}
 
+	u.orderEventUseCase.Publish(ctx, events...)
 	return nil
 }
+
+func (u *pharmacistOrderUseCaseImpl) OverrideInteraction(ctx context.Context, order *dtoOrder.GetOrderRequest) error {
+	ok, err := u.pharmacistOrderRepository.IsPharmacistAssign(ctx, order.PharmacyID, order.PharmacistID)
+	if err != nil {
+		return appErrorPkg.NewServerError(err)
+	}
+
+	if !ok {
+		return appErrorPkg.NewForbiddenAccessError()
+	}
+
+	return u.pharmacistOrderRepository.OverrideInteractionHold(ctx, order)
+}
@@ -2,35 +2,46 @@ package usecase
 
 import (
 	"context"
-	"path/filepath"
 	"strings"
 
 	appErrorCart "healthcare-app/internal/cart/apperror"
 	cartDto "healthcare-app/internal/cart/dto"
 	cartRepository "healthcare-app/internal/cart/repository"
+	entityInteraction "healthcare-app/internal/interaction/entity"
+	interactionRepository "healthcare-app/internal/interaction/repository"
+	interactionUtils "healthcare-app/internal/interaction/utils"
+	usecaseNotification "healthcare-app/internal/notification/usecase"
+	utilsNotification "healthcare-app/internal/notification/utils"
 	appErrorOrder "healthcare-app/internal/order/apperror"
 	"healthcare-app/internal/order/constant"
 	orderDto "healthcare-app/internal/order/dto"
 	"healthcare-app/internal/order/entity"
 	orderRepository "healthcare-app/internal/order/repository"
 	"healthcare-app/internal/order/utils"
+	constantPharmacy "healthcare-app/internal/pharmacy/constant"
 	pharmacyRepo "healthcare-app/internal/pharmacy/repository"
-	productConstant "healthcare-app/internal/product/constant"
 	entityProduct "healthcare-app/internal/product/entity"
 	productRepository "healthcare-app/internal/product/repository"
 	appErrorProfile "healthcare-app/internal/profile/apperror"
 	profileRepo "healthcare-app/internal/profile/repository"
 	"healthcare-app/internal/queue/payload"
 	"healthcare-app/internal/queue/tasks"
+	constantWebhook "healthcare-app/internal/webhook/constant"
+	dtoWebhook "healthcare-app/internal/webhook/dto"
+	usecaseWebhook "healthcare-app/internal/webhook/usecase"
 	appErrorPkg "healthcare-app/pkg/apperror"
 	"healthcare-app/pkg/database/transactor"
 	pkgDTO "healthcare-app/pkg/dto"
+	"healthcare-app/pkg/logger"
 	"healthcare-app/pkg/utils/encryptutils"
+	"healthcare-app/pkg/utils/imageutils"
 	"healthcare-app/pkg/utils/pageutils"
+	"healthcare-app/pkg/utils/storageutils"
 )
 
 type UserOrderUseCase interface {
 	PostNewOrder(ctx context.Context, req *orderDto.RequestOrder, userId int64) (*orderDto.ResponseOrder, error)
+	PostRefillOrder(ctx context.Context, req *orderDto.RequestOrder, userId int64) (*orderDto.ResponseOrder, error)
 	GetMyOrders(ctx context.Context, req *orderDto.QueryGetMyOrder, userId int64) ([]orderDto.ResponseOrder, *pkgDTO.PageMetaData, error)
 	GetOrderByID(ctx context.Context, orderId int64, userId int64) (*orderDto.ResponseOrder, error)
 	PostUploadPaymentProof(ctx context.Context, req *orderDto.RequestUploadPaymentProof, orderId int64, userId int64) error
@@ -39,46 +50,86 @@ type UserOrderUseCase interface {
 
 type userOrderUseCaseImpl struct {
 	userOrderRepository orderRepository.UserOrderRepository
+	orderRepo           orderRepository.OrderRepository
 	cartRepo            cartRepository.CartRepository
 	addressRepo         profileRepo.AddressRepository
+	medicalProfileRepo  profileRepo.MedicalProfileRepository
+	dependentRepo       profileRepo.DependentRepository
 	productRepo         productRepository.ProductRepository
 	pharmacyProductRepo productRepository.PharmacyProductRepository
 	pharmacyRepo        pharmacyRepo.PharmacyRepository
 	logisticRepo        pharmacyRepo.LogisticRepository
+	interactionRepo     interactionRepository.InteractionRepository
 	base64Encryptor     encryptutils.Base64Encryptor
+	objectStorage       storageutils.ObjectStorage
 	transactor          transactor.Transactor
 	orderTask           tasks.OrderTask
+	emailTask           tasks.EmailTask
+	notificationUseCase usecaseNotification.NotificationUseCase
+	orderEventUseCase   OrderEventUseCase
+	webhookUseCase      usecaseWebhook.WebhookDeliveryUseCase
 }
 
 func NewUserOrderUseCase(
 	userOrderRepository orderRepository.UserOrderRepository,
+	orderRepo orderRepository.OrderRepository,
 	cartRepo cartRepository.CartRepository,
 	addressRepo profileRepo.AddressRepository,
+	medicalProfileRepo profileRepo.MedicalProfileRepository,
+	dependentRepo profileRepo.DependentRepository,
 	productRepo productRepository.ProductRepository,
 	pharmacyProductRepo productRepository.PharmacyProductRepository,
 	pharmacyRepo pharmacyRepo.PharmacyRepository,
 	logisticRepo pharmacyRepo.LogisticRepository,
+	interactionRepo interactionRepository.InteractionRepository,
 	base64Encryptor encryptutils.Base64Encryptor,
+	objectStorage storageutils.ObjectStorage,
 	transactor transactor.Transactor,
 	orderTask tasks.OrderTask,
+	emailTask tasks.EmailTask,
+	notificationUseCase usecaseNotification.NotificationUseCase,
+	orderEventUseCase OrderEventUseCase,
+	webhookUseCase usecaseWebhook.WebhookDeliveryUseCase,
 ) *userOrderUseCaseImpl {
 	return &userOrderUseCaseImpl{
 		userOrderRepository: userOrderRepository,
+		orderRepo:           orderRepo,
 		cartRepo:            cartRepo,
 		addressRepo:         addressRepo,
+		medicalProfileRepo:  medicalProfileRepo,
+		dependentRepo:       dependentRepo,
 		productRepo:         productRepo,
 		pharmacyProductRepo: pharmacyProductRepo,
 		pharmacyRepo:        pharmacyRepo,
 		logisticRepo:        logisticRepo,
+		interactionRepo:     interactionRepo,
 		base64Encryptor:     base64Encryptor,
+		objectStorage:       objectStorage,
 		transactor:          transactor,
 		orderTask:           orderTask,
+		emailTask:           emailTask,
+		notificationUseCase: notificationUseCase,
+		orderEventUseCase:   orderEventUseCase,
+		webhookUseCase:      webhookUseCase,
 	}
 }
 
 func (u *userOrderUseCaseImpl) PostNewOrder(ctx context.Context, req *orderDto.RequestOrder, userId int64) (*orderDto.ResponseOrder, error) {
+	return u.placeOrder(ctx, req, userId, true)
+}
+
+// PostRefillOrder places an order for a refill subscription. It follows the
+// same path as PostNewOrder but takes the products from the request instead
+// of the user's cart.
+func (u *userOrderUseCaseImpl) PostRefillOrder(ctx context.Context, req *orderDto.RequestOrder, userId int64) (*orderDto.ResponseOrder, error) {
+	return u.placeOrder(ctx, req, userId, false)
+}
+
+func (u *userOrderUseCaseImpl) placeOrder(ctx context.Context, req *orderDto.RequestOrder, userId int64, fromCart bool) (*orderDto.ResponseOrder, error) {
 	var responseNewOrderProduct []orderDto.ResponseOrderProduct
 	var response *orderDto.ResponseOrder
+	var interactionProducts []*entityInteraction.InteractionProduct
+	var lowStocks []*dtoWebhook.StockLowData
 	err := u.transactor.Atomic(ctx, func(cForTx context.Context) error {
 		pharmacy, err := u.pharmacyRepo.FindByID(cForTx, req.PharmacyID)
 		if err != nil {
@@ -94,6 +145,12 @@ func (u *userOrderUseCaseImpl) PostNewOrder(ctx context.Context, req *orderDto.R
 			return appErrorProfile.NewInvalidAddressNotFoundError()
 		}
 
+		if req.DependentID != nil {
+			if _, err := u.dependentRepo.FindByIDAndUserID(cForTx, *req.DependentID, userId); err != nil {
+				return err
+			}
+		}
+
 		pharmacyWithPartner, err := u.userOrderRepository.GetPharmacyAndPartner(cForTx, req.PharmacyID)
 
 		if err != nil {
@@ -105,15 +162,39 @@ func (u *userOrderUseCaseImpl) PostNewOrder(ctx context.Context, req *orderDto.R
 			return appErrorPkg.NewServerError(err)
 		}
 		for _, orderProduct := range req.OrderProducts {
-			cartItem, err := u.cartRepo.GetCartItemWithPharmacyId(cForTx, userId, orderProduct.PharmacyProductId, req.PharmacyID)
-			if err != nil {
-				return appErrorPkg.NewServerError(err)
-			}
-			if cartItem == nil || cartItem.ID == 0 {
-				return appErrorCart.NewCartItemNotFoundError()
-			}
-			if cartItem.Quantity < int64(orderProduct.Quantity) {
-				return appErrorCart.NewInsufficientStockOnCartError()
+			var product entityProduct.ProductForCart
+			if fromCart {
+				cartItem, err := u.cartRepo.GetCartItemWithPharmacyId(cForTx, userId, orderProduct.PharmacyProductId, req.PharmacyID)
+				if err != nil {
+					return appErrorPkg.NewServerError(err)
+				}
+				if cartItem == nil || cartItem.ID == 0 {
+					return appErrorCart.NewCartItemNotFoundError()
+				}
+				if cartItem.Quantity < int64(orderProduct.Quantity) {
+					return appErrorCart.NewInsufficientStockOnCartError()
+				}
+				product = cartItem.Product
+				// a prescription only fills orders for the patient it names
+				if cartItem.PrescriptionItemID != nil {
+					forPatient, err := u.userOrderRepository.IsPrescriptionItemForPatient(cForTx, *cartItem.PrescriptionItemID, userId, req.DependentID)
+					if err != nil {
+						return appErrorPkg.NewServerError(err)
+					}
+					if !forPatient {
+						return appErrorOrder.NewInvalidOrderPrescriptionPatient()
+					}
+				}
+				orderProduct.PrescriptionItemID = cartItem.PrescriptionItemID
+			} else {
+				orderedProduct, err := u.userOrderRepository.GetProductByPharmacyProductID(cForTx, orderProduct.PharmacyProductId, req.PharmacyID)
+				if err != nil {
+					return appErrorPkg.NewServerError(err)
+				}
+				if orderedProduct == nil {
+					return appErrorOrder.NewInvalidProductIsNotActiveError()
+				}
+				product = *orderedProduct
 			}
 			checkProduct, err := u.productRepo.CheckActiveAndQuantityProduct(cForTx, orderProduct.PharmacyProductId)
 			if err != nil {
@@ -123,7 +204,7 @@ func (u *userOrderUseCaseImpl) PostNewOrder(ctx context.Context, req *orderDto.R
 				return appErrorOrder.NewInvalidProductIsNotActiveError()
 			}
 			if checkProduct.StockQuantity < orderProduct.Quantity {
-				return appErrorCart.NewInsufficientStockError()
+				return appErrorCart.NewInsufficientStockError(checkProduct.ProductID)
 			}
 			newOrderProduct, err := u.userOrderRepository.PostNewOrderProductUser(cForTx, newOrder.ID, orderProduct)
 			if err != nil {
@@ -136,15 +217,85 @@ func (u *userOrderUseCaseImpl) PostNewOrder(ctx context.Context, req *orderDto.R
 			if err := u.productRepo.UpdateSoldAmountByPharmacyProductID(cForTx, &entityProduct.Product{SoldAmount: int64(orderProduct.Quantity)}, checkProduct.PharmacyProductID); err != nil {
 				return appErrorPkg.NewServerError(err)
 			}
-			responseProduct := cartDto.ResponseProduct(cartItem.Product)
+			// only the order that crosses the threshold notifies, later ones
+			// would repeat what the pharmacist already knows
+			remainingStock := checkProduct.StockQuantity - orderProduct.Quantity
+			if checkProduct.StockQuantity >= constantPharmacy.LOW_STOCK_THRESHOLD && remainingStock < constantPharmacy.LOW_STOCK_THRESHOLD {
+				lowStocks = append(lowStocks, &dtoWebhook.StockLowData{
+					PharmacyID:   pharmacy.ID,
+					PharmacyName: pharmacy.Name,
+					ProductID:    product.ID,
+					ProductName:  product.Name,
+					Stock:        remainingStock,
+				})
+				if pharmacy.PharmacistID != nil {
+					if err := u.notificationUseCase.Notify(cForTx, utilsNotification.NewLowStockNotification(*pharmacy.PharmacistID, pharmacy.ID, pharmacy.Name, product.Name, remainingStock)); err != nil {
+						return err
+					}
+				}
+			}
+			interactionProducts = append(interactionProducts, &entityInteraction.InteractionProduct{
+				ID:          product.ID,
+				Name:        product.Name,
+				GenericName: product.GenericName,
+			})
+			responseProduct := cartDto.ResponseProduct(product)
 			responseNewOrderProduct = append(responseNewOrderProduct, utils.ConvertProductToResponseProduct(newOrderProduct, responseProduct))
+			if !fromCart {
+				continue
+			}
 			err = u.cartRepo.DeleteCart(cForTx, userId, orderProduct.PharmacyProductId)
 			if err != nil {
 				return appErrorPkg.NewServerError(err)
 			}
 		}
+		interactions, err := u.interactionRepo.FindAllByIngredients(cForTx, interactionUtils.CollectIngredients(interactionProducts))
+		if err != nil {
+			return appErrorPkg.NewServerError(err)
+		}
+		warnings := interactionUtils.BuildWarnings(interactionProducts, interactions)
+		// screen against the allergies of whoever the order is for
+		var allergies []string
+		if req.DependentID != nil {
+			allergies, err = u.dependentRepo.FindAllAllergiesByID(cForTx, *req.DependentID)
+		} else {
+			allergies, err = u.medicalProfileRepo.FindAllAllergiesByUserID(cForTx, userId)
+		}
+		if err != nil {
+			return appErrorPkg.NewServerError(err)
+		}
+		warnings = append(warnings, interactionUtils.BuildAllergyWarnings(interactionProducts, allergies)...)
+		interactionUtils.SortWarnings(warnings)
+		if interactionUtils.HasSevereInteraction(warnings) {
+			if err := u.userOrderRepository.HoldOrderForInteraction(cForTx, newOrder.ID); err != nil {
+				return appErrorPkg.NewServerError(err)
+			}
+			newOrder.InteractionHold = true
+		}
 		responsePharmacy := utils.ConvertPharmacyToResponsePharmacy(pharmacyWithPartner)
 		response = utils.ConvertOrderToResponseOrder(*newOrder, responsePharmacy, responseNewOrderProduct)
+		response.Warnings = warnings
+
+		orders, err := u.orderRepo.FindAllByIDs(cForTx, []int64{newOrder.ID})
+		if err != nil {
+			return appErrorPkg.NewServerError(err)
+		}
+		for _, order := range orderDto.ConvertToOrderResponses(orders) {
+			if err := u.emailTask.QueueOrderReceiptEmail(cForTx, utils.ConvertToOrderEmailPayload(order, "")); err != nil {
+				return appErrorPkg.NewServerError(err)
+			}
+		}
+
+		// a refill places the order inside the subscription's transaction, so
+		// the events wait for whichever transaction commits last
+		transactor.AfterCommit(cForTx, func() {
+			u.orderEventUseCase.Publish(ctx, orderDto.ConvertResponseOrderToOrderEvent(response))
+			for _, lowStock := range lowStocks {
+				if err := u.webhookUseCase.Trigger(ctx, lowStock.PharmacyID, constantWebhook.EVENT_STOCK_LOW, lowStock); err != nil {
+					logger.Log.WithFields(map[string]any{"event": constantWebhook.EVENT_STOCK_LOW, "pharmacy_id": lowStock.PharmacyID}).Warn("failed to trigger webhook:", err)
+				}
+			}
+		})
 		return nil
 	})
 	if err != nil {
@@ -194,6 +345,8 @@ func (u *userOrderUseCaseImpl) GetMyOrders(ctx context.Context, req *orderDto.Qu
 					ShipCost:          orderData.ShipCost,
 					Description:       orderData.Description,
 					Address:           orderData.Address,
+					DependentID:       orderData.DependentID,
+					InteractionHold:   orderData.InteractionHold,
 					CreatedAt:         orderData.CreatedAt,
 					UpdatedAt:         orderData.UpdatedAt,
 					DeletedAt:         orderData.DeletedAt,
@@ -203,6 +356,9 @@ func (u *userOrderUseCaseImpl) GetMyOrders(ctx context.Context, req *orderDto.Qu
 			orderResponses[orderData.ID].Product = append(orderResponses[orderData.ID].Product, orderProductResponse)
 		}
 		for _, orderResponse := range orderResponses {
+			if orderResponse.PaymentImgURL, err = utils.SignPaymentProof(cForTx, u.objectStorage, orderResponse.PaymentImgURL); err != nil {
+				return appErrorPkg.NewServerError(err)
+			}
 			response = append(response, *orderResponse)
 		}
 		return nil
@@ -248,6 +404,8 @@ func (u *userOrderUseCaseImpl) GetOrderByID(ctx context.Context, orderId int64,
 					ShipCost:          orderData.ShipCost,
 					Description:       orderData.Description,
 					Address:           orderData.Address,
+					DependentID:       orderData.DependentID,
+					InteractionHold:   orderData.InteractionHold,
 					CreatedAt:         orderData.CreatedAt,
 					UpdatedAt:         orderData.UpdatedAt,
 					DeletedAt:         orderData.DeletedAt,
@@ -255,6 +413,10 @@ func (u *userOrderUseCaseImpl) GetOrderByID(ctx context.Context, orderId int64,
 			}
 			response.Product = append(response.Product, orderProductResponse)
 		}
+		response.PaymentImgURL, err = utils.SignPaymentProof(cForTx, u.objectStorage, response.PaymentImgURL)
+		if err != nil {
+			return appErrorPkg.NewServerError(err)
+		}
 		return nil
 	})
 	if err != nil {
@@ -264,14 +426,20 @@ func (u *userOrderUseCaseImpl) GetOrderByID(ctx context.Context, orderId int64,
 }
 
 func (u *userOrderUseCaseImpl) PostUploadPaymentProof(ctx context.Context, req *orderDto.RequestUploadPaymentProof, orderId int64, userId int64) error {
-	if filepath.Ext(req.PaymentProof.Filename) != ".png" && filepath.Ext(req.PaymentProof.Filename) != ".jpg" && filepath.Ext(req.PaymentProof.Filename) != ".jpeg" {
+	if req.PaymentProof.Size > constant.MAX_IMAGE_SIZE {
+		return appErrorOrder.NewInvalidPhotoMaxSizeError()
+	}
+	f, err := req.PaymentProof.Open()
+	if err != nil {
 		return appErrorOrder.NewInvalidImageErrorMessagePaymentProofError()
 	}
-	if req.PaymentProof.Size > productConstant.MAX_IMAGE_SIZE {
-		return appErrorOrder.NewInvalidPhotoMaxSizeError()
+	_, err = imageutils.DetectFormat(f)
+	f.Close()
+	if err != nil {
+		return appErrorOrder.NewInvalidImageErrorMessagePaymentProofError()
 	}
 
-	err := u.transactor.Atomic(ctx, func(cForTx context.Context) error {
+	err = u.transactor.Atomic(ctx, func(cForTx context.Context) error {
 		orderDb, err := u.userOrderRepository.GetOrderByIDWithSingleData(cForTx, orderId, userId)
 		if err != nil || orderDb == nil {
 			return appErrorOrder.NewInvalidOrderNotFound()
@@ -279,6 +447,9 @@ func (u *userOrderUseCaseImpl) PostUploadPaymentProof(ctx context.Context, req *
 		if orderDb.PaymentImgURL != nil {
 			return appErrorOrder.NewInvalidPaymentAlreadyUpload()
 		}
+		if orderDb.InteractionHold {
+			return appErrorOrder.NewInvalidOrderInteractionHold()
+		}
 
 		orders, err := u.userOrderRepository.GetOrderByID(cForTx, orderId, userId)
 		if err != nil {
@@ -345,6 +516,8 @@ func (u *userOrderUseCaseImpl) PatchStatusOrder(ctx context.Context, status stri
 					TotalPayment:      orderData.TotalPayment,
 					Description:       orderData.Description,
 					Address:           orderData.Address,
+					DependentID:       orderData.DependentID,
+					InteractionHold:   orderData.InteractionHold,
 					CreatedAt:         orderData.CreatedAt,
 					UpdatedAt:         orderData.UpdatedAt,
 					DeletedAt:         orderData.DeletedAt,
@@ -352,10 +525,15 @@ func (u *userOrderUseCaseImpl) PatchStatusOrder(ctx context.Context, status stri
 			}
 			response.Product = append(response.Product, orderProductResponse)
 		}
+		response.PaymentImgURL, err = utils.SignPaymentProof(cForTx, u.objectStorage, response.PaymentImgURL)
+		if err != nil {
+			return appErrorPkg.NewServerError(err)
+		}
 		return nil
 	})
 	if err != nil {
 		return nil, err
 	}
+	u.orderEventUseCase.Publish(ctx, orderDto.ConvertResponseOrderToOrderEvent(response))
 	return response, nil
 }
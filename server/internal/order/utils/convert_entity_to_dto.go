@@ -20,6 +20,8 @@ func ConvertOrderToResponseOrder(order orderEntity.OrderCheckout, pharmacy cartD
 		TotalPayment:      order.TotalPayment,
 		Description:       order.Description,
 		Address:           order.Address,
+		DependentID:       order.DependentID,
+		InteractionHold:   order.InteractionHold,
 		Pharmacy:          pharmacy,
 		Product:           products,
 		CreatedAt:         order.CreatedAt,
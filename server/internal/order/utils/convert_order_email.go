@@ -0,0 +1,39 @@
+package utils
+
+import (
+	"fmt"
+
+	orderDTO "healthcare-app/internal/order/dto"
+	"healthcare-app/internal/queue/payload"
+
+	"github.com/shopspring/decimal"
+)
+
+func ConvertToOrderEmailPayload(order *orderDTO.OrderResponse, reason string) *payload.OrderEmailPayload {
+	products := []payload.OrderEmailProduct{}
+	for _, product := range order.Detail.Products {
+		products = append(products, payload.OrderEmailProduct{
+			Name:     product.Name,
+			Quantity: product.Quantity,
+			Price:    formatRupiah(product.Price),
+			Subtotal: formatRupiah(product.Price.Mul(decimal.NewFromInt(product.Quantity))),
+		})
+	}
+
+	return &payload.OrderEmailPayload{
+		UserID:            order.UserID,
+		Email:             order.Customer,
+		OrderID:           order.ID,
+		Invoice:           order.VoiceNumber,
+		PharmacyName:      order.Detail.Pharmacy.Name,
+		Products:          products,
+		TotalProductPrice: formatRupiah(order.TotalProductPrice),
+		ShipCost:          formatRupiah(order.ShipCost),
+		TotalPayment:      formatRupiah(order.TotalPayment),
+		Reason:            reason,
+	}
+}
+
+func formatRupiah(amount decimal.Decimal) string {
+	return fmt.Sprintf("Rp.%v", amount.StringFixed(0))
+}
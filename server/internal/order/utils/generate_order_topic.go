@@ -0,0 +1,11 @@
+package utils
+
+import "fmt"
+
+func GenerateUserOrderTopic(userID int64) string {
+	return fmt.Sprintf("order:user:%v", userID)
+}
+
+func GeneratePharmacyOrderTopic(pharmacyID int64) string {
+	return fmt.Sprintf("order:pharmacy:%v", pharmacyID)
+}
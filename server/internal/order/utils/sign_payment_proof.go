@@ -0,0 +1,19 @@
+package utils
+
+import (
+	"context"
+
+	"healthcare-app/pkg/utils/storageutils"
+)
+
+func SignPaymentProof(ctx context.Context, objectStorage storageutils.ObjectStorage, paymentImgURL *string) (*string, error) {
+	if paymentImgURL == nil || *paymentImgURL == "" {
+		return paymentImgURL, nil
+	}
+
+	signedURL, err := objectStorage.SignedURL(ctx, *paymentImgURL)
+	if err != nil {
+		return nil, err
+	}
+	return &signedURL, nil
+}
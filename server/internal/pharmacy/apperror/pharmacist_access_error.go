@@ -0,0 +1,16 @@
+package apperror
+
+import (
+	"errors"
+
+	"healthcare-app/internal/pharmacy/constant"
+	"healthcare-app/pkg/apperror"
+)
+
+func NewPharmacistAccessError() *apperror.AppError {
+	msg := constant.PharmacistAccessErrorMessage
+
+	err := errors.New(msg)
+
+	return apperror.NewAppError(err, apperror.ForbiddenAccessErrorCode, msg)
+}
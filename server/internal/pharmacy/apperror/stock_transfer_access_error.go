@@ -0,0 +1,16 @@
+package apperror
+
+import (
+	"errors"
+
+	"healthcare-app/internal/pharmacy/constant"
+	"healthcare-app/pkg/apperror"
+)
+
+func NewStockTransferAccessError() *apperror.AppError {
+	msg := constant.StockTransferAccessErrorMessage
+
+	err := errors.New(msg)
+
+	return apperror.NewAppError(err, apperror.ForbiddenAccessErrorCode, msg)
+}
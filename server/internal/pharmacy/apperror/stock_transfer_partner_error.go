@@ -0,0 +1,16 @@
+package apperror
+
+import (
+	"errors"
+
+	"healthcare-app/internal/pharmacy/constant"
+	"healthcare-app/pkg/apperror"
+)
+
+func NewStockTransferPartnerError() *apperror.AppError {
+	msg := constant.StockTransferPartnerErrorMessage
+
+	err := errors.New(msg)
+
+	return apperror.NewAppError(err, apperror.DefaultClientErrorCode, msg)
+}
@@ -0,0 +1,17 @@
+package apperror
+
+import (
+	"errors"
+	"fmt"
+
+	"healthcare-app/internal/pharmacy/constant"
+	"healthcare-app/pkg/apperror"
+)
+
+func NewStockTransferStatusError(from, to string) *apperror.AppError {
+	msg := fmt.Sprintf(constant.StockTransferStatusErrorMessage, from, to)
+
+	err := errors.New(msg)
+
+	return apperror.NewAppError(err, apperror.DefaultClientErrorCode, msg)
+}
@@ -8,4 +8,9 @@ const (
 	PharmacyDependencyErrorMessage    = "there are pharmacist assigned or ongoing orders associated with this pharmacy"
 	PharmacyAlreadyExistsErrorMessage = "pharmacy already exists"
 	PharmacistModifyErrorMessage      = "can't modify the pharmacy, pharmacist doesn't belong to the pharmacy"
+	StockTransferPartnerErrorMessage  = "stock can only be transferred between different pharmacies of the same partner"
+	StockTransferStockErrorMessage    = "source pharmacy doesn't have enough stock for this transfer"
+	StockTransferStatusErrorMessage   = "transfer can't be moved from %v to %v"
+	StockTransferAccessErrorMessage   = "pharmacist doesn't belong to the pharmacy involved in this transfer"
+	PharmacistAccessErrorMessage      = "pharmacist doesn't belong to the pharmacy"
 )
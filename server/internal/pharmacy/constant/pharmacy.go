@@ -1,5 +1,14 @@
 package constant
 
+const (
+	EXPORT_CSV  = "csv"
+	EXPORT_XLSX = "xlsx"
+)
+
+const (
+	LOW_STOCK_THRESHOLD = 10
+)
+
 var (
 	AdminAllowedSorts = map[string]string{
 		"date": "p.created_at",
@@ -10,3 +19,7 @@ var (
 		"desc": {},
 	}
 )
+
+var (
+	MedicineExportHeaders = []string{"id", "product_id", "name", "stock_quantity", "price", "is_active", "sold_amount", "created_at", "updated_at"}
+)
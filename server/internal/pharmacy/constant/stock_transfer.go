@@ -0,0 +1,15 @@
+package constant
+
+const (
+	TRANSFER_REQUESTED = "REQUESTED"
+	TRANSFER_APPROVED  = "APPROVED"
+	TRANSFER_REJECTED  = "REJECTED"
+	TRANSFER_CANCELLED = "CANCELLED"
+	TRANSFER_SHIPPED   = "SHIPPED"
+	TRANSFER_RECEIVED  = "RECEIVED"
+)
+
+const (
+	TRANSFER_INCOMING = "incoming"
+	TRANSFER_OUTGOING = "outgoing"
+)
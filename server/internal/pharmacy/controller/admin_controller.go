@@ -1,12 +1,16 @@
 package controller
 
 import (
-	"os"
+	"context"
+	"fmt"
+	"io"
 	"strconv"
 
 	"healthcare-app/internal/pharmacy/dto"
 	"healthcare-app/internal/pharmacy/usecase"
+	"healthcare-app/internal/pharmacy/utils"
 	"healthcare-app/pkg/apperror"
+	"healthcare-app/pkg/logger"
 	"healthcare-app/pkg/utils/ginutils"
 	"healthcare-app/pkg/utils/pageutils"
 
@@ -204,13 +208,28 @@ func (c *AdminController) DownloadPharmacyMedicines(ctx *gin.Context) {
 	}
 
 	req := &dto.DownloadMedicineRequest{ID: int64(pharmacyID)}
-	f, err := c.pharmacyUseCase.DownloadMedicines(ctx, req)
-	if err != nil {
+	if err := ctx.ShouldBindQuery(req); err != nil {
 		ctx.Error(err)
 		return
 	}
-	defer f.Close()
-	defer os.Remove(f.Name())
+	downloadMedicines(ctx, req, c.pharmacyUseCase.DownloadMedicines)
+}
 
-	ctx.FileAttachment(f.Name(), "medicines.csv")
+func downloadMedicines(
+	ctx *gin.Context,
+	req *dto.DownloadMedicineRequest,
+	download func(context.Context, *dto.DownloadMedicineRequest, io.Writer) error,
+) {
+	fileName, contentType := utils.MedicineExportFile(req.Format)
+	ctx.Header("Content-Disposition", fmt.Sprintf("attachment; filename=%q", fileName))
+	ctx.Header("Content-Type", contentType)
+
+	if err := download(ctx, req, ctx.Writer); err != nil {
+		if ctx.Writer.Written() {
+			logger.Log.Error("error streaming medicines export:", err)
+			return
+		}
+		ctx.Writer.Header().Del("Content-Disposition")
+		ctx.Error(err)
+	}
 }
@@ -77,3 +77,18 @@ func (c *PharmacistController) UpdatePharmacy(ctx *gin.Context) {
 	}
 	ginutils.ResponseOK(ctx, res)
 }
+
+func (c *PharmacistController) DownloadPharmacyMedicines(ctx *gin.Context) {
+	pharmacyID, err := strconv.Atoi(ctx.Param("pharmacyId"))
+	if err != nil {
+		ctx.Error(apperror.NewInvalidIdError())
+		return
+	}
+
+	req := &dto.DownloadMedicineRequest{ID: int64(pharmacyID), PharmacistID: utils.GetValueUserIdFromToken(ctx)}
+	if err := ctx.ShouldBindQuery(req); err != nil {
+		ctx.Error(err)
+		return
+	}
+	downloadMedicines(ctx, req, c.pharmacistUseCase.DownloadMedicines)
+}
@@ -0,0 +1,116 @@
+package controller
+
+import (
+	"strconv"
+
+	"healthcare-app/internal/auth/utils"
+	"healthcare-app/internal/pharmacy/dto"
+	"healthcare-app/internal/pharmacy/usecase"
+	"healthcare-app/pkg/apperror"
+	"healthcare-app/pkg/utils/ginutils"
+	"healthcare-app/pkg/utils/pageutils"
+
+	"github.com/gin-gonic/gin"
+)
+
+type StockTransferController struct {
+	stockTransferUseCase usecase.StockTransferUseCase
+}
+
+func NewStockTransferController(stockTransferUseCase usecase.StockTransferUseCase) *StockTransferController {
+	return &StockTransferController{
+		stockTransferUseCase: stockTransferUseCase,
+	}
+}
+
+func (c *StockTransferController) Search(ctx *gin.Context) {
+	pharmacyID, err := strconv.Atoi(ctx.Param("pharmacyId"))
+	if err != nil {
+		ctx.Error(apperror.NewInvalidIdError())
+		return
+	}
+
+	req := &dto.SearchStockTransferRequest{PharmacyID: int64(pharmacyID), PharmacistID: utils.GetValueUserIdFromToken(ctx)}
+	if err := ctx.ShouldBindQuery(req); err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	res, paging, err := c.stockTransferUseCase.Search(ctx, req)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+	paging.Links = pageutils.CreateLinks(ctx.Request, int(paging.Page), int(paging.Size), int(paging.TotalItem), int(paging.TotalPage))
+	ginutils.ResponseOKPagination(ctx, res, paging)
+}
+
+func (c *StockTransferController) Get(ctx *gin.Context) {
+	pharmacyID, err := strconv.Atoi(ctx.Param("pharmacyId"))
+	if err != nil {
+		ctx.Error(apperror.NewInvalidIdError())
+		return
+	}
+
+	transferID, err := strconv.Atoi(ctx.Param("transferId"))
+	if err != nil {
+		ctx.Error(apperror.NewInvalidIdError())
+		return
+	}
+
+	req := &dto.GetStockTransferRequest{ID: int64(transferID), PharmacyID: int64(pharmacyID), PharmacistID: utils.GetValueUserIdFromToken(ctx)}
+	res, err := c.stockTransferUseCase.Get(ctx, req)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+	ginutils.ResponseOK(ctx, res)
+}
+
+func (c *StockTransferController) Create(ctx *gin.Context) {
+	pharmacyID, err := strconv.Atoi(ctx.Param("pharmacyId"))
+	if err != nil {
+		ctx.Error(apperror.NewInvalidIdError())
+		return
+	}
+
+	req := &dto.CreateStockTransferRequest{PharmacyID: int64(pharmacyID), PharmacistID: utils.GetValueUserIdFromToken(ctx)}
+	if err := ctx.ShouldBindJSON(req); err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	res, err := c.stockTransferUseCase.Create(ctx, req)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+	ginutils.ResponseCreated(ctx, res)
+}
+
+func (c *StockTransferController) UpdateStatus(ctx *gin.Context) {
+	pharmacyID, err := strconv.Atoi(ctx.Param("pharmacyId"))
+	if err != nil {
+		ctx.Error(apperror.NewInvalidIdError())
+		return
+	}
+
+	transferID, err := strconv.Atoi(ctx.Param("transferId"))
+	if err != nil {
+		ctx.Error(apperror.NewInvalidIdError())
+		return
+	}
+
+	req := &dto.UpdateStockTransferRequest{ID: int64(transferID), PharmacyID: int64(pharmacyID), PharmacistID: utils.GetValueUserIdFromToken(ctx)}
+	if err := ctx.ShouldBindJSON(req); err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	res, err := c.stockTransferUseCase.UpdateStatus(ctx, req)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+	ginutils.ResponseOK(ctx, res)
+}
@@ -33,14 +33,16 @@ type PharmacyDetailResponse struct {
 }
 
 type ProductPharmacyResponse struct {
-	ID         int64       `json:"id"`
-	Name       string      `json:"name"`
-	Address    string      `json:"address"`
-	City       string      `json:"city"`
-	Pharmacist *pharmacist `json:"pharmacist,omitempty"`
-	Partner    partner     `json:"partner"`
-	IsActive   bool        `json:"is_active"`
-	Product    *product    `json:"product"`
+	ID            int64           `json:"id"`
+	Name          string          `json:"name"`
+	Address       string          `json:"address"`
+	City          string          `json:"city"`
+	Pharmacist    *pharmacist     `json:"pharmacist,omitempty"`
+	Partner       partner         `json:"partner"`
+	IsActive      bool            `json:"is_active"`
+	RatingAverage decimal.Decimal `json:"rating_average"`
+	RatingCount   int64           `json:"rating_count"`
+	Product       *product        `json:"product"`
 }
 
 type pharmacist struct {
@@ -90,7 +92,13 @@ type RequestDeletePharmacy struct {
 }
 
 type DownloadMedicineRequest struct {
-	ID int64 `json:"-"`
+	ID           int64   `json:"-"`
+	PharmacistID int64   `json:"-"`
+	Format       string  `form:"format" binding:"omitempty,oneof=csv xlsx"`
+	IsActive     string  `form:"is-active" binding:"omitempty,boolean"`
+	LowStock     string  `form:"low-stock" binding:"omitempty,boolean"`
+	Category     []int64 `form:"category" binding:"max=20,dive,numeric"`
+	UpdatedSince string  `form:"updated-since" binding:"omitempty,time_format=02-01-2006"`
 }
 
 type GetPharmacyRequest struct {
@@ -188,7 +196,9 @@ func ConvertToProductPharmacyResponse(pharmacy *entity.ProductPharmacy) *Product
 			pharmacy.PartnerID,
 			pharmacy.PartnerName,
 		},
-		IsActive: pharmacy.IsActive,
+		IsActive:      pharmacy.IsActive,
+		RatingAverage: pharmacy.RatingAverage,
+		RatingCount:   pharmacy.RatingCount,
 		Product: &product{
 			ID:            pharmacy.PharmacyProductID,
 			StockQuantity: pharmacy.StockQuantity,
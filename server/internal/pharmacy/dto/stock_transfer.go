@@ -0,0 +1,91 @@
+package dto
+
+import (
+	"time"
+
+	"healthcare-app/internal/pharmacy/constant"
+	"healthcare-app/internal/pharmacy/entity"
+)
+
+type StockTransferResponse struct {
+	ID                  int64            `json:"id"`
+	SourcePharmacy      transferPharmacy `json:"source_pharmacy"`
+	DestinationPharmacy transferPharmacy `json:"destination_pharmacy"`
+	Product             transferProduct  `json:"product"`
+	Quantity            int64            `json:"quantity"`
+	Status              string           `json:"status"`
+	CreatedAt           time.Time        `json:"created_at"`
+	UpdatedAt           time.Time        `json:"updated_at"`
+}
+
+type transferPharmacy struct {
+	ID   int64  `json:"id"`
+	Name string `json:"name"`
+}
+
+type transferProduct struct {
+	ID   int64  `json:"id"`
+	Name string `json:"name"`
+}
+
+type CreateStockTransferRequest struct {
+	SourcePharmacyID int64 `json:"source_pharmacy_id" binding:"required,numeric"`
+	ProductID        int64 `json:"product_id" binding:"required,numeric"`
+	Quantity         int64 `json:"quantity" binding:"required,gte=1"`
+	PharmacyID       int64 `json:"-"`
+	PharmacistID     int64 `json:"-"`
+}
+
+type UpdateStockTransferRequest struct {
+	Status       string `json:"status" binding:"required,oneof=APPROVED REJECTED CANCELLED SHIPPED RECEIVED"`
+	ID           int64  `json:"-"`
+	PharmacyID   int64  `json:"-"`
+	PharmacistID int64  `json:"-"`
+}
+
+type GetStockTransferRequest struct {
+	ID           int64
+	PharmacyID   int64
+	PharmacistID int64
+}
+
+type SearchStockTransferRequest struct {
+	Direction    string `form:"direction" binding:"omitempty,oneof=incoming outgoing"`
+	Status       string `form:"status" binding:"omitempty,oneof=REQUESTED APPROVED REJECTED CANCELLED SHIPPED RECEIVED"`
+	Limit        int64  `form:"limit" binding:"numeric,gte=1,lte=25"`
+	Page         int64  `form:"page" binding:"numeric,gte=1"`
+	PharmacyID   int64  `form:"-"`
+	PharmacistID int64  `form:"-"`
+}
+
+func ConvertToStockTransferResponses(transfers []*entity.StockTransfer) []*StockTransferResponse {
+	res := []*StockTransferResponse{}
+	for _, transfer := range transfers {
+		res = append(res, ConvertToStockTransferResponse(transfer))
+	}
+	return res
+}
+
+func ConvertToStockTransferResponse(transfer *entity.StockTransfer) *StockTransferResponse {
+	return &StockTransferResponse{
+		ID:                  transfer.ID,
+		SourcePharmacy:      transferPharmacy{ID: transfer.SourcePharmacyID, Name: transfer.SourcePharmacyName},
+		DestinationPharmacy: transferPharmacy{ID: transfer.DestinationPharmacyID, Name: transfer.DestinationPharmacyName},
+		Product:             transferProduct{ID: transfer.ProductID, Name: transfer.ProductName},
+		Quantity:            transfer.Quantity,
+		Status:              transfer.Status,
+		CreatedAt:           transfer.CreatedAt,
+		UpdatedAt:           transfer.UpdatedAt,
+	}
+}
+
+func CreateStockTransferRequestToEntity(request *CreateStockTransferRequest) *entity.StockTransfer {
+	return &entity.StockTransfer{
+		SourcePharmacyID:      request.SourcePharmacyID,
+		DestinationPharmacyID: request.PharmacyID,
+		ProductID:             request.ProductID,
+		Quantity:              request.Quantity,
+		Status:                constant.TRANSFER_REQUESTED,
+		RequestedBy:           request.PharmacistID,
+	}
+}
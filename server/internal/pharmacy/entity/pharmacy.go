@@ -37,6 +37,8 @@ type ProductPharmacy struct {
 	PharmacyProductID    int64
 	StockQuantity        int64
 	Price                decimal.Decimal
+	RatingAverage        decimal.Decimal
+	RatingCount          int64
 }
 
 type PharmacyForCart struct {
@@ -0,0 +1,19 @@
+package entity
+
+import "time"
+
+type StockTransfer struct {
+	CreatedAt               time.Time
+	UpdatedAt               time.Time
+	SourcePharmacyName      string
+	DestinationPharmacyName string
+	ProductName             string
+	Status                  string
+	ID                      int64
+	SourcePharmacyID        int64
+	DestinationPharmacyID   int64
+	ProductID               int64
+	Quantity                int64
+	RequestedBy             int64
+	ReviewedBy              *int64
+}
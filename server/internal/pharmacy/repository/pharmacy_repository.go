@@ -9,6 +9,7 @@ import (
 	"strings"
 
 	apperrorPharmacy "healthcare-app/internal/pharmacy/apperror"
+	"healthcare-app/internal/pharmacy/constant"
 	"healthcare-app/internal/pharmacy/dto"
 	"healthcare-app/internal/pharmacy/entity"
 	apperrorPkg "healthcare-app/pkg/apperror"
@@ -21,7 +22,7 @@ type PharmacyRepository interface {
 	CountOnGoingOrders(ctx context.Context, pharmacy *entity.Pharmacy) (int64, error)
 	Search(ctx context.Context, request *dto.SearchPharmacyRequest) ([]*entity.Pharmacy, error)
 	FindAllByProductID(ctx context.Context, request *dto.GetProductPharmacyRequest) ([]*entity.ProductPharmacy, error)
-	FindAllProducts(ctx context.Context, id int64) ([]*entity.PharmacyProduct, error)
+	FindAllProducts(ctx context.Context, request *dto.DownloadMedicineRequest, fn func(*entity.PharmacyProduct) error) error
 	FindByID(ctx context.Context, id int64) (*entity.Pharmacy, error)
 	Save(ctx context.Context, pharmacy *entity.Pharmacy) error
 	SaveLogisticPartners(ctx context.Context, pharmacy *entity.Pharmacy, logisticPartners []*entity.Logistic) error
@@ -158,13 +159,22 @@ func (r *pharmacyRepositoryImpl) FindAllByProductID(ctx context.Context, request
 	tx := transactor.ExtractTx(ctx)
 
 	query := `
+		with pharmacy_ratings as (
+			select rpp.pharmacy_id, round(avg(r.rating), 1) rating, count(r.id) rating_count
+			from reviews r
+			join pharmacy_products rpp on rpp.id = r.pharmacy_product_id
+			where r.is_hidden = false and r.deleted_at is null
+			group by rpp.pharmacy_id
+		)
 		select
-			u.id, ud.full_name, ud.sipa_number, pa.id, pa.name, p.id, p.name, p.address, p.city, CONCAT(ST_X(p.location), ' ', ST_Y(p.location)), p.is_active, pp.id, pp.stock_quantity, pp.price
+			u.id, ud.full_name, ud.sipa_number, pa.id, pa.name, p.id, p.name, p.address, p.city, CONCAT(ST_X(p.location), ' ', ST_Y(p.location)), p.is_active, pp.id, pp.stock_quantity, pp.price,
+			coalesce(pr.rating, 0), coalesce(pr.rating_count, 0)
 		from pharmacies p
 		join pharmacy_products pp on p.id = pp.pharmacy_id
 		join pharmacy_partners pa on pa.id = p.partner_id
 		left join users u on u.id = p.pharmacist_id
 		join user_details ud on ud.user_id = u.id
+		left join pharmacy_ratings pr on pr.pharmacy_id = p.id
 		where 
 			CURRENT_TIME BETWEEN pa.start_opt AND pa.end_opt
 			and p.is_active = true 
@@ -205,6 +215,8 @@ func (r *pharmacyRepositoryImpl) FindAllByProductID(ctx context.Context, request
 			&pharmacy.PharmacyProductID,
 			&pharmacy.StockQuantity,
 			&pharmacy.Price,
+			&pharmacy.RatingAverage,
+			&pharmacy.RatingCount,
 		); err != nil {
 			return nil, err
 		}
@@ -218,12 +230,35 @@ func (r *pharmacyRepositoryImpl) FindAllByProductID(ctx context.Context, request
 	return pharmacies, nil
 }
 
-func (r *pharmacyRepositoryImpl) FindAllProducts(ctx context.Context, id int64) ([]*entity.PharmacyProduct, error) {
+// FindAllProducts hands each product to fn as soon as it is scanned instead of
+// collecting them, an export can cover every product of a pharmacy.
+func (r *pharmacyRepositoryImpl) FindAllProducts(ctx context.Context, request *dto.DownloadMedicineRequest, fn func(*entity.PharmacyProduct) error) error {
 	query := `
-		select pp.id, p.name, pp.stock_quantity, pp.price, pp.sold_amount, pp.created_at, pp.updated_at
+		select pp.id, pp.product_id, p.name, pp.stock_quantity, pp.price, pp.is_active, pp.sold_amount, pp.created_at, pp.updated_at
 		from pharmacy_products pp join products p on pp.product_id = p.id
 		where pp.deleted_at is null and pp.pharmacy_id = $1
 	`
+	args := []any{request.ID}
+	queryBuilder := strings.Builder{}
+	queryBuilder.WriteString(query)
+
+	if request.IsActive != "" {
+		queryBuilder.WriteString(fmt.Sprintf(" and pp.is_active = $%v", len(args)+1))
+		args = append(args, request.IsActive)
+	}
+	if isLowStock, _ := strconv.ParseBool(request.LowStock); isLowStock {
+		queryBuilder.WriteString(fmt.Sprintf(" and pp.stock_quantity <= $%v", len(args)+1))
+		args = append(args, constant.LOW_STOCK_THRESHOLD)
+	}
+	if len(request.Category) != 0 {
+		queryBuilder.WriteString(fmt.Sprintf(" and exists(select 1 from product_categories pc where pc.product_id = p.id and pc.category_id = any($%v))", len(args)+1))
+		args = append(args, request.Category)
+	}
+	if request.UpdatedSince != "" {
+		queryBuilder.WriteString(fmt.Sprintf(" and pp.updated_at >= to_date($%v, 'DD-MM-YYYY')", len(args)+1))
+		args = append(args, request.UpdatedSince)
+	}
+	queryBuilder.WriteString(" order by pp.id")
 	tx := transactor.ExtractTx(ctx)
 
 	var (
@@ -231,38 +266,37 @@ func (r *pharmacyRepositoryImpl) FindAllProducts(ctx context.Context, id int64)
 		rows *sql.Rows
 	)
 	if tx != nil {
-		rows, err = tx.QueryContext(ctx, query, id)
+		rows, err = tx.QueryContext(ctx, queryBuilder.String(), args...)
 	} else {
-		rows, err = r.db.QueryContext(ctx, query, id)
+		rows, err = r.db.QueryContext(ctx, queryBuilder.String(), args...)
 	}
 
 	if err != nil {
-		return nil, err
+		return err
 	}
 	defer rows.Close()
 
-	entities := []*entity.PharmacyProduct{}
 	for rows.Next() {
 		entity := new(entity.PharmacyProduct)
 		if err := rows.Scan(
 			&entity.ID,
+			&entity.ProductId,
 			&entity.Name,
 			&entity.StockQuantity,
 			&entity.Price,
+			&entity.IsActive,
 			&entity.SoldAmount,
 			&entity.CreatedAt,
 			&entity.UpdatedAt,
 		); err != nil {
-			return nil, err
+			return err
+		}
+		if err := fn(entity); err != nil {
+			return err
 		}
-		entities = append(entities, entity)
 	}
 
-	err = rows.Err()
-	if err != nil {
-		return nil, err
-	}
-	return entities, nil
+	return rows.Err()
 }
 
 func (r *pharmacyRepositoryImpl) FindByID(ctx context.Context, id int64) (*entity.Pharmacy, error) {
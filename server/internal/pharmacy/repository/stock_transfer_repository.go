@@ -0,0 +1,319 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+
+	apperrorPharmacy "healthcare-app/internal/pharmacy/apperror"
+	"healthcare-app/internal/pharmacy/constant"
+	"healthcare-app/internal/pharmacy/dto"
+	"healthcare-app/internal/pharmacy/entity"
+	apperrorPkg "healthcare-app/pkg/apperror"
+	"healthcare-app/pkg/database/transactor"
+)
+
+type StockTransferRepository interface {
+	IsStockAvailable(ctx context.Context, pharmacyID, productID, quantity int64) bool
+	Search(ctx context.Context, request *dto.SearchStockTransferRequest) ([]*entity.StockTransfer, error)
+	FindByID(ctx context.Context, id int64) (*entity.StockTransfer, error)
+	Save(ctx context.Context, transfer *entity.StockTransfer) error
+	UpdateStatus(ctx context.Context, transfer *entity.StockTransfer) error
+	DeductSourceStock(ctx context.Context, transfer *entity.StockTransfer) error
+	AddDestinationStock(ctx context.Context, transfer *entity.StockTransfer) error
+}
+
+type stockTransferRepositoryImpl struct {
+	db *sql.DB
+}
+
+func NewStockTransferRepository(db *sql.DB) *stockTransferRepositoryImpl {
+	return &stockTransferRepositoryImpl{
+		db: db,
+	}
+}
+
+func (r *stockTransferRepositoryImpl) IsStockAvailable(ctx context.Context, pharmacyID, productID, quantity int64) bool {
+	query := `
+		select exists(select 1 from pharmacy_products where pharmacy_id = $1 and product_id = $2 and stock_quantity >= $3 and deleted_at is null)
+	`
+	tx := transactor.ExtractTx(ctx)
+
+	var (
+		err    error
+		exists bool
+	)
+	if tx != nil {
+		err = tx.QueryRowContext(ctx, query, pharmacyID, productID, quantity).Scan(&exists)
+	} else {
+		err = r.db.QueryRowContext(ctx, query, pharmacyID, productID, quantity).Scan(&exists)
+	}
+
+	if err != nil {
+		return false
+	}
+	return exists
+}
+
+func (r *stockTransferRepositoryImpl) Search(ctx context.Context, request *dto.SearchStockTransferRequest) ([]*entity.StockTransfer, error) {
+	query := `
+		select st.id, st.source_pharmacy_id, sp.name, st.destination_pharmacy_id, dp.name, st.product_id, p.name,
+			st.quantity, st.transfer_status, st.requested_by, st.reviewed_by, st.created_at, st.updated_at
+		from stock_transfers st
+		join pharmacies sp on sp.id = st.source_pharmacy_id
+		join pharmacies dp on dp.id = st.destination_pharmacy_id
+		join products p on p.id = st.product_id
+	`
+	args := []any{request.PharmacyID}
+	queryBuilder := strings.Builder{}
+	queryBuilder.WriteString(query)
+
+	switch request.Direction {
+	case constant.TRANSFER_INCOMING:
+		queryBuilder.WriteString(" where st.destination_pharmacy_id = $1")
+	case constant.TRANSFER_OUTGOING:
+		queryBuilder.WriteString(" where st.source_pharmacy_id = $1")
+	default:
+		queryBuilder.WriteString(" where (st.source_pharmacy_id = $1 or st.destination_pharmacy_id = $1)")
+	}
+
+	if request.Status != "" {
+		queryBuilder.WriteString(fmt.Sprintf(" and st.transfer_status = $%v", len(args)+1))
+		args = append(args, request.Status)
+	}
+	queryBuilder.WriteString(" order by st.created_at desc")
+
+	tx := transactor.ExtractTx(ctx)
+
+	var (
+		err  error
+		rows *sql.Rows
+	)
+	if tx != nil {
+		rows, err = tx.QueryContext(ctx, queryBuilder.String(), args...)
+	} else {
+		rows, err = r.db.QueryContext(ctx, queryBuilder.String(), args...)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	transfers := []*entity.StockTransfer{}
+	for rows.Next() {
+		transfer := new(entity.StockTransfer)
+		if err := rows.Scan(
+			&transfer.ID,
+			&transfer.SourcePharmacyID,
+			&transfer.SourcePharmacyName,
+			&transfer.DestinationPharmacyID,
+			&transfer.DestinationPharmacyName,
+			&transfer.ProductID,
+			&transfer.ProductName,
+			&transfer.Quantity,
+			&transfer.Status,
+			&transfer.RequestedBy,
+			&transfer.ReviewedBy,
+			&transfer.CreatedAt,
+			&transfer.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		transfers = append(transfers, transfer)
+	}
+
+	err = rows.Err()
+	if err != nil {
+		return nil, err
+	}
+	return transfers, nil
+}
+
+func (r *stockTransferRepositoryImpl) FindByID(ctx context.Context, id int64) (*entity.StockTransfer, error) {
+	query := `
+		select st.id, st.source_pharmacy_id, sp.name, st.destination_pharmacy_id, dp.name, st.product_id, p.name,
+			st.quantity, st.transfer_status, st.requested_by, st.reviewed_by, st.created_at, st.updated_at
+		from stock_transfers st
+		join pharmacies sp on sp.id = st.source_pharmacy_id
+		join pharmacies dp on dp.id = st.destination_pharmacy_id
+		join products p on p.id = st.product_id
+		where st.id = $1
+	`
+	tx := transactor.ExtractTx(ctx)
+
+	var (
+		err      error
+		transfer = new(entity.StockTransfer)
+	)
+	if tx != nil {
+		query = fmt.Sprintf("%v for update of st", query)
+		err = tx.QueryRowContext(ctx, query, id).Scan(
+			&transfer.ID,
+			&transfer.SourcePharmacyID,
+			&transfer.SourcePharmacyName,
+			&transfer.DestinationPharmacyID,
+			&transfer.DestinationPharmacyName,
+			&transfer.ProductID,
+			&transfer.ProductName,
+			&transfer.Quantity,
+			&transfer.Status,
+			&transfer.RequestedBy,
+			&transfer.ReviewedBy,
+			&transfer.CreatedAt,
+			&transfer.UpdatedAt,
+		)
+	} else {
+		err = r.db.QueryRowContext(ctx, query, id).Scan(
+			&transfer.ID,
+			&transfer.SourcePharmacyID,
+			&transfer.SourcePharmacyName,
+			&transfer.DestinationPharmacyID,
+			&transfer.DestinationPharmacyName,
+			&transfer.ProductID,
+			&transfer.ProductName,
+			&transfer.Quantity,
+			&transfer.Status,
+			&transfer.RequestedBy,
+			&transfer.ReviewedBy,
+			&transfer.CreatedAt,
+			&transfer.UpdatedAt,
+		)
+	}
+
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, apperrorPkg.NewEntityNotFoundError("stock transfer")
+		}
+		return nil, err
+	}
+	return transfer, nil
+}
+
+func (r *stockTransferRepositoryImpl) Save(ctx context.Context, transfer *entity.StockTransfer) error {
+	query := `
+		insert into stock_transfers(source_pharmacy_id, destination_pharmacy_id, product_id, quantity, transfer_status, requested_by)
+		values ($1, $2, $3, $4, $5, $6) returning id, created_at, updated_at
+	`
+	tx := transactor.ExtractTx(ctx)
+
+	var err error
+	if tx != nil {
+		err = tx.QueryRowContext(
+			ctx,
+			query,
+			transfer.SourcePharmacyID,
+			transfer.DestinationPharmacyID,
+			transfer.ProductID,
+			transfer.Quantity,
+			transfer.Status,
+			transfer.RequestedBy,
+		).Scan(&transfer.ID, &transfer.CreatedAt, &transfer.UpdatedAt)
+	} else {
+		err = r.db.QueryRowContext(
+			ctx,
+			query,
+			transfer.SourcePharmacyID,
+			transfer.DestinationPharmacyID,
+			transfer.ProductID,
+			transfer.Quantity,
+			transfer.Status,
+			transfer.RequestedBy,
+		).Scan(&transfer.ID, &transfer.CreatedAt, &transfer.UpdatedAt)
+	}
+
+	return err
+}
+
+func (r *stockTransferRepositoryImpl) UpdateStatus(ctx context.Context, transfer *entity.StockTransfer) error {
+	query := `
+		update stock_transfers set transfer_status = $2, reviewed_by = $3, updated_at = now() where id = $1 returning updated_at
+	`
+	tx := transactor.ExtractTx(ctx)
+
+	var err error
+	if tx != nil {
+		err = tx.QueryRowContext(ctx, query, transfer.ID, transfer.Status, transfer.ReviewedBy).Scan(&transfer.UpdatedAt)
+	} else {
+		err = r.db.QueryRowContext(ctx, query, transfer.ID, transfer.Status, transfer.ReviewedBy).Scan(&transfer.UpdatedAt)
+	}
+
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return apperrorPkg.NewEntityNotFoundError("stock transfer")
+		}
+		return err
+	}
+	return nil
+}
+
+func (r *stockTransferRepositoryImpl) DeductSourceStock(ctx context.Context, transfer *entity.StockTransfer) error {
+	query := `
+		update pharmacy_products set stock_quantity = stock_quantity - $3, updated_at = now()
+		where pharmacy_id = $1 and product_id = $2 and stock_quantity >= $3 and deleted_at is null
+	`
+	tx := transactor.ExtractTx(ctx)
+
+	var (
+		err    error
+		result sql.Result
+	)
+	if tx != nil {
+		result, err = tx.ExecContext(ctx, query, transfer.SourcePharmacyID, transfer.ProductID, transfer.Quantity)
+	} else {
+		result, err = r.db.ExecContext(ctx, query, transfer.SourcePharmacyID, transfer.ProductID, transfer.Quantity)
+	}
+
+	if err != nil {
+		return err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rowsAffected == 0 {
+		return apperrorPharmacy.NewStockTransferStockError()
+	}
+	return nil
+}
+
+func (r *stockTransferRepositoryImpl) AddDestinationStock(ctx context.Context, transfer *entity.StockTransfer) error {
+	query := `
+		insert into pharmacy_products(pharmacy_id, product_id, stock_quantity, price, is_active)
+		select $1, product_id, $3, price, false
+		from pharmacy_products
+		where pharmacy_id = $4 and product_id = $2
+		on conflict (pharmacy_id, product_id) do update set
+			stock_quantity = case when pharmacy_products.deleted_at is null then pharmacy_products.stock_quantity + excluded.stock_quantity else excluded.stock_quantity end,
+			is_active = case when pharmacy_products.deleted_at is null then pharmacy_products.is_active else false end,
+			deleted_at = null,
+			updated_at = now()
+	`
+	tx := transactor.ExtractTx(ctx)
+
+	var (
+		err    error
+		result sql.Result
+	)
+	if tx != nil {
+		result, err = tx.ExecContext(ctx, query, transfer.DestinationPharmacyID, transfer.ProductID, transfer.Quantity, transfer.SourcePharmacyID)
+	} else {
+		result, err = r.db.ExecContext(ctx, query, transfer.DestinationPharmacyID, transfer.ProductID, transfer.Quantity, transfer.SourcePharmacyID)
+	}
+
+	if err != nil {
+		return err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rowsAffected == 0 {
+		return apperrorPkg.NewEntityNotFoundError("pharmacy product")
+	}
+	return nil
+}
@@ -55,5 +55,17 @@ func PharmacistControllerRoute(c *controller.PharmacistController, r *gin.Engine
 		pharmacies.GET("", c.SearchPharmacy)
 		pharmacies.GET(pharmacyId, c.GetPharmacy)
 		pharmacies.PUT(pharmacyId, c.UpdatePharmacy)
+		pharmacies.GET(fmt.Sprintf("%v/products/export", pharmacyId), c.DownloadPharmacyMedicines)
+	}
+}
+
+func StockTransferControllerRoute(c *controller.StockTransferController, r *gin.Engine, authMiddleware *middleware.AuthMiddleware) {
+	transfers := r.Group(fmt.Sprintf("/pharmacists/pharmacies%v/transfers", pharmacyId), authMiddleware.Authorization(), authMiddleware.ProtectedRoles(constant.PHARMACIST))
+	{
+		const transferId = "/:transferId"
+		transfers.GET("", c.Search)
+		transfers.POST("", c.Create)
+		transfers.GET(transferId, c.Get)
+		transfers.PATCH(transferId, c.UpdateStatus)
 	}
 }
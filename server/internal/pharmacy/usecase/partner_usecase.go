@@ -5,6 +5,9 @@ import (
 	"path/filepath"
 	"strings"
 
+	constantAudit "healthcare-app/internal/audit/constant"
+	dtoAudit "healthcare-app/internal/audit/dto"
+	usecaseAudit "healthcare-app/internal/audit/usecase"
 	apperrorPharmacy "healthcare-app/internal/pharmacy/apperror"
 	"healthcare-app/internal/pharmacy/constant"
 	dtoPharmacy "healthcare-app/internal/pharmacy/dto"
@@ -13,11 +16,8 @@ import (
 	apperrorPkg "healthcare-app/pkg/apperror"
 	"healthcare-app/pkg/database/transactor"
 	dtoPkg "healthcare-app/pkg/dto"
-	"healthcare-app/pkg/utils/cloudinaryutils"
 	"healthcare-app/pkg/utils/pageutils"
-
-	"github.com/cloudinary/cloudinary-go/v2/api"
-	"github.com/cloudinary/cloudinary-go/v2/api/uploader"
+	"healthcare-app/pkg/utils/storageutils"
 )
 
 type PartnerUseCase interface {
@@ -29,20 +29,23 @@ type PartnerUseCase interface {
 }
 
 type partnerUseCaseImpl struct {
-	cloudinaryUtil    cloudinaryutils.CloudinaryUtil
+	objectStorage     storageutils.ObjectStorage
+	auditLogUseCase   usecaseAudit.AuditLogUseCase
 	partnerChangeRepo repository.PartnerChangeRepository
 	partnerRepo       repository.PartnerRepository
 	transactor        transactor.Transactor
 }
 
 func NewPartnerUseCase(
-	cloudinaryUtil cloudinaryutils.CloudinaryUtil,
+	objectStorage storageutils.ObjectStorage,
+	auditLogUseCase usecaseAudit.AuditLogUseCase,
 	partnerChangeRepo repository.PartnerChangeRepository,
 	partnerRepo repository.PartnerRepository,
 	transactor transactor.Transactor,
 ) *partnerUseCaseImpl {
 	return &partnerUseCaseImpl{
-		cloudinaryUtil:    cloudinaryUtil,
+		objectStorage:     objectStorage,
+		auditLogUseCase:   auditLogUseCase,
 		partnerChangeRepo: partnerChangeRepo,
 		partnerRepo:       partnerRepo,
 		transactor:        transactor,
@@ -85,11 +88,8 @@ func (u *partnerUseCaseImpl) Create(ctx context.Context, request *dtoPharmacy.Re
 		if err != nil {
 			return err
 		}
-		imgUrl, err := u.cloudinaryUtil.UploadImage(txCtx, f, uploader.UploadParams{
-			PublicID:       strings.ToLower(strings.ReplaceAll(request.Name, " ", "-")),
-			UniqueFilename: api.Bool(true),
-			Overwrite:      api.Bool(true),
-			Invalidate:     api.Bool(true),
+		imgUrl, err := u.objectStorage.Upload(txCtx, f, storageutils.UploadParams{
+			Key: strings.ToLower(strings.ReplaceAll(request.Name, " ", "-")),
 		})
 		if err != nil {
 			return apperrorPkg.NewServerError(err)
@@ -108,7 +108,12 @@ func (u *partnerUseCaseImpl) Create(ctx context.Context, request *dtoPharmacy.Re
 		if err := u.partnerRepo.Save(txCtx, partner); err != nil {
 			return apperrorPkg.NewServerError(err)
 		}
-		return nil
+		return u.auditLogUseCase.Record(txCtx, &dtoAudit.AuditEntry{
+			Action:     constantAudit.ACTION_CREATE,
+			EntityType: constantAudit.ENTITY_PARTNER,
+			EntityID:   partner.ID,
+			After:      dtoPharmacy.ConvertToPartnerResponse(partner),
+		})
 	})
 
 	if err != nil {
@@ -132,6 +137,7 @@ func (u *partnerUseCaseImpl) Update(ctx context.Context, request *dtoPharmacy.Re
 	if err != nil {
 		return nil, apperrorPkg.NewEntityNotFoundError("partner")
 	}
+	before := dtoPharmacy.ConvertToPartnerResponse(partner)
 
 	err = u.transactor.Atomic(ctx, func(txCtx context.Context) error {
 		if request.Name != partner.Name {
@@ -145,10 +151,8 @@ func (u *partnerUseCaseImpl) Update(ctx context.Context, request *dtoPharmacy.Re
 			if err != nil {
 				return err
 			}
-			imgUrl, err := u.cloudinaryUtil.UploadImage(txCtx, f, uploader.UploadParams{
-				PublicID:       strings.ToLower(strings.ReplaceAll(request.Name, " ", "-")),
-				UniqueFilename: api.Bool(true),
-				Overwrite:      api.Bool(true),
+			imgUrl, err := u.objectStorage.Upload(txCtx, f, storageutils.UploadParams{
+				Key: strings.ToLower(strings.ReplaceAll(request.Name, " ", "-")),
 			})
 			if err != nil {
 				return apperrorPkg.NewServerError(err)
@@ -170,7 +174,13 @@ func (u *partnerUseCaseImpl) Update(ctx context.Context, request *dtoPharmacy.Re
 		if err := u.partnerChangeRepo.Save(txCtx, &entity.PartnerChange{PartnerID: request.ID, ActiveDays: strings.Join(request.ActiveDays, ","), StartOpt: request.StartOpt, EndOpt: request.EndOpt}); err != nil {
 			return apperrorPkg.NewServerError(err)
 		}
-		return nil
+		return u.auditLogUseCase.Record(txCtx, &dtoAudit.AuditEntry{
+			Action:     constantAudit.ACTION_UPDATE,
+			EntityType: constantAudit.ENTITY_PARTNER,
+			EntityID:   partner.ID,
+			Before:     before,
+			After:      dtoPharmacy.ConvertToPartnerResponse(partner),
+		})
 	})
 
 	if err != nil {
@@ -190,7 +200,19 @@ func (u *partnerUseCaseImpl) Delete(ctx context.Context, request *dtoPharmacy.Re
 			return apperrorPharmacy.NewPartnerDependencyError()
 		}
 
-		return u.partnerRepo.DeleteByID(txCtx, request.ID)
+		partner, err := u.partnerRepo.FindByID(txCtx, request.ID)
+		if err != nil {
+			return apperrorPkg.NewEntityNotFoundError("partner")
+		}
+		if err := u.partnerRepo.DeleteByID(txCtx, request.ID); err != nil {
+			return err
+		}
+		return u.auditLogUseCase.Record(txCtx, &dtoAudit.AuditEntry{
+			Action:     constantAudit.ACTION_DELETE,
+			EntityType: constantAudit.ENTITY_PARTNER,
+			EntityID:   request.ID,
+			Before:     dtoPharmacy.ConvertToPartnerResponse(partner),
+		})
 	})
 
 	return err
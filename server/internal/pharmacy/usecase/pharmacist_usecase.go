@@ -2,10 +2,12 @@ package usecase
 
 import (
 	"context"
+	"io"
 
 	apperrorPharmacy "healthcare-app/internal/pharmacy/apperror"
 	dtoPharmacy "healthcare-app/internal/pharmacy/dto"
 	"healthcare-app/internal/pharmacy/repository"
+	"healthcare-app/internal/pharmacy/utils"
 	apperrorPkg "healthcare-app/pkg/apperror"
 	"healthcare-app/pkg/database/transactor"
 	dtoPkg "healthcare-app/pkg/dto"
@@ -16,6 +18,7 @@ type PharmacistUseCase interface {
 	Search(ctx context.Context, request *dtoPharmacy.PharmacistSearchPharmacyRequest) ([]*dtoPharmacy.ResponsePharmacy, *dtoPkg.PageMetaData, error)
 	Get(ctx context.Context, request *dtoPharmacy.PharmacistGetPharmacyRequest) (*dtoPharmacy.PharmacyDetailResponse, error)
 	Update(ctx context.Context, request *dtoPharmacy.PharmacistUpdatePharmacyRequest) (*dtoPharmacy.ResponsePharmacy, error)
+	DownloadMedicines(ctx context.Context, request *dtoPharmacy.DownloadMedicineRequest, w io.Writer) error
 }
 
 type pharmacistUseCaseImpl struct {
@@ -76,3 +79,22 @@ func (u *pharmacistUseCaseImpl) Update(ctx context.Context, request *dtoPharmacy
 	}
 	return dtoPharmacy.ConvertToPharmacyResponse(pharmacy), nil
 }
+
+func (u *pharmacistUseCaseImpl) DownloadMedicines(ctx context.Context, request *dtoPharmacy.DownloadMedicineRequest, w io.Writer) error {
+	pharmacy, err := u.pharmacyRepo.FindByID(ctx, request.ID)
+	if err != nil {
+		return err
+	}
+	if pharmacy.PharmacistID == nil || *pharmacy.PharmacistID != request.PharmacistID {
+		return apperrorPharmacy.NewPharmacistAccessError()
+	}
+
+	writer, err := utils.NewMedicineWriter(w, request.Format)
+	if err != nil {
+		return apperrorPkg.NewServerError(err)
+	}
+	if err := u.pharmacyRepo.FindAllProducts(ctx, request, writer.Write); err != nil {
+		return apperrorPkg.NewServerError(err)
+	}
+	return writer.Close()
+}
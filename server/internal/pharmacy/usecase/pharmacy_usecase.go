@@ -2,18 +2,20 @@ package usecase
 
 import (
 	"context"
-	"fmt"
-	"os"
+	"io"
 	"strconv"
 
+	constantAudit "healthcare-app/internal/audit/constant"
+	dtoAudit "healthcare-app/internal/audit/dto"
+	usecaseAudit "healthcare-app/internal/audit/usecase"
 	apperrorPharmacy "healthcare-app/internal/pharmacy/apperror"
 	dtoPharmacy "healthcare-app/internal/pharmacy/dto"
 	"healthcare-app/internal/pharmacy/entity"
 	"healthcare-app/internal/pharmacy/repository"
+	"healthcare-app/internal/pharmacy/utils"
 	apperrorPkg "healthcare-app/pkg/apperror"
 	"healthcare-app/pkg/database/transactor"
 	dtoPkg "healthcare-app/pkg/dto"
-	"healthcare-app/pkg/utils/csvutils"
 	"healthcare-app/pkg/utils/pageutils"
 )
 
@@ -23,21 +25,24 @@ type PharmacyUseCase interface {
 	Create(ctx context.Context, request *dtoPharmacy.RequestCreatePharmacy) (*dtoPharmacy.ResponsePharmacy, error)
 	Update(ctx context.Context, request *dtoPharmacy.RequestUpdatePharmacy) (*dtoPharmacy.ResponsePharmacy, error)
 	Delete(ctx context.Context, request *dtoPharmacy.RequestDeletePharmacy) error
-	DownloadMedicines(ctx context.Context, request *dtoPharmacy.DownloadMedicineRequest) (*os.File, error)
+	DownloadMedicines(ctx context.Context, request *dtoPharmacy.DownloadMedicineRequest, w io.Writer) error
 }
 
 type pharmacyUseCaseImpl struct {
-	pharmacyRepo repository.PharmacyRepository
-	transactor   transactor.Transactor
+	auditLogUseCase usecaseAudit.AuditLogUseCase
+	pharmacyRepo    repository.PharmacyRepository
+	transactor      transactor.Transactor
 }
 
 func NewPharmacyUseCase(
+	auditLogUseCase usecaseAudit.AuditLogUseCase,
 	pharmacyRepo repository.PharmacyRepository,
 	transactor transactor.Transactor,
 ) *pharmacyUseCaseImpl {
 	return &pharmacyUseCaseImpl{
-		pharmacyRepo: pharmacyRepo,
-		transactor:   transactor,
+		auditLogUseCase: auditLogUseCase,
+		pharmacyRepo:    pharmacyRepo,
+		transactor:      transactor,
 	}
 }
 
@@ -85,7 +90,17 @@ func (u *pharmacyUseCaseImpl) Create(ctx context.Context, request *dtoPharmacy.R
 		if err := u.pharmacyRepo.SaveLogisticPartners(txCtx, pharmacy, logisticPartners); err != nil {
 			return apperrorPkg.NewServerError(err)
 		}
-		return nil
+
+		created, err := u.pharmacyRepo.FindByID(txCtx, pharmacy.ID)
+		if err != nil {
+			return err
+		}
+		return u.auditLogUseCase.Record(txCtx, &dtoAudit.AuditEntry{
+			Action:     constantAudit.ACTION_CREATE,
+			EntityType: constantAudit.ENTITY_PHARMACY,
+			EntityID:   pharmacy.ID,
+			After:      dtoPharmacy.ConvertToPharmacyDetailResponse(created),
+		})
 	})
 
 	if err != nil {
@@ -127,7 +142,17 @@ func (u *pharmacyUseCaseImpl) Update(ctx context.Context, request *dtoPharmacy.R
 			return err
 		}
 
-		return nil
+		updated, err := u.pharmacyRepo.FindByID(txCtx, request.ID)
+		if err != nil {
+			return err
+		}
+		return u.auditLogUseCase.Record(txCtx, &dtoAudit.AuditEntry{
+			Action:     constantAudit.ACTION_UPDATE,
+			EntityType: constantAudit.ENTITY_PHARMACY,
+			EntityID:   request.ID,
+			Before:     dtoPharmacy.ConvertToPharmacyDetailResponse(entityPharmacy),
+			After:      dtoPharmacy.ConvertToPharmacyDetailResponse(updated),
+		})
 	})
 
 	if err != nil {
@@ -154,36 +179,31 @@ func (u *pharmacyUseCaseImpl) Delete(ctx context.Context, request *dtoPharmacy.R
 			return apperrorPharmacy.NewPharmacyDependencyError()
 		}
 
-		return u.pharmacyRepo.DeleteByID(txCtx, pharmacy.ID)
+		if err := u.pharmacyRepo.DeleteByID(txCtx, pharmacy.ID); err != nil {
+			return err
+		}
+		return u.auditLogUseCase.Record(txCtx, &dtoAudit.AuditEntry{
+			Action:     constantAudit.ACTION_DELETE,
+			EntityType: constantAudit.ENTITY_PHARMACY,
+			EntityID:   pharmacy.ID,
+			Before:     dtoPharmacy.ConvertToPharmacyDetailResponse(pharmacy),
+		})
 	})
 
 	return err
 }
 
-func (u *pharmacyUseCaseImpl) DownloadMedicines(ctx context.Context, request *dtoPharmacy.DownloadMedicineRequest) (*os.File, error) {
-	products, err := u.pharmacyRepo.FindAllProducts(ctx, request.ID)
-	if err != nil {
-		return nil, err
+func (u *pharmacyUseCaseImpl) DownloadMedicines(ctx context.Context, request *dtoPharmacy.DownloadMedicineRequest, w io.Writer) error {
+	if _, err := u.pharmacyRepo.FindByID(ctx, request.ID); err != nil {
+		return err
 	}
 
-	f, writer, err := csvutils.OpenCsvWriter("medicines.csv")
+	writer, err := utils.NewMedicineWriter(w, request.Format)
 	if err != nil {
-		return nil, err
+		return apperrorPkg.NewServerError(err)
 	}
-	defer writer.Flush()
-
-	if err := writer.Write([]string{"id", "name", "stock_quantity", "price", "sold_amount", "created_at", "updated_at"}); err != nil {
-		return nil, err
+	if err := u.pharmacyRepo.FindAllProducts(ctx, request, writer.Write); err != nil {
+		return apperrorPkg.NewServerError(err)
 	}
-	for i, product := range products {
-		if err := writer.Write([]string{fmt.Sprint(product.ID), product.Name, fmt.Sprint(product.StockQuantity), fmt.Sprintf("Rp.%v", product.Price), fmt.Sprint(product.SoldAmount), product.CreatedAt.String(), product.UpdatedAt.String()}); err != nil {
-			return nil, err
-		}
-
-		if i%100 == 0 {
-			writer.Flush()
-		}
-	}
-
-	return f, nil
+	return writer.Close()
 }
@@ -0,0 +1,180 @@
+package usecase
+
+import (
+	"context"
+
+	constantAudit "healthcare-app/internal/audit/constant"
+	dtoAudit "healthcare-app/internal/audit/dto"
+	usecaseAudit "healthcare-app/internal/audit/usecase"
+	apperrorPharmacy "healthcare-app/internal/pharmacy/apperror"
+	"healthcare-app/internal/pharmacy/constant"
+	dtoPharmacy "healthcare-app/internal/pharmacy/dto"
+	"healthcare-app/internal/pharmacy/entity"
+	"healthcare-app/internal/pharmacy/repository"
+	"healthcare-app/internal/pharmacy/utils"
+	apperrorPkg "healthcare-app/pkg/apperror"
+	"healthcare-app/pkg/database/transactor"
+	dtoPkg "healthcare-app/pkg/dto"
+	"healthcare-app/pkg/utils/pageutils"
+)
+
+type StockTransferUseCase interface {
+	Search(ctx context.Context, request *dtoPharmacy.SearchStockTransferRequest) ([]*dtoPharmacy.StockTransferResponse, *dtoPkg.PageMetaData, error)
+	Get(ctx context.Context, request *dtoPharmacy.GetStockTransferRequest) (*dtoPharmacy.StockTransferResponse, error)
+	Create(ctx context.Context, request *dtoPharmacy.CreateStockTransferRequest) (*dtoPharmacy.StockTransferResponse, error)
+	UpdateStatus(ctx context.Context, request *dtoPharmacy.UpdateStockTransferRequest) (*dtoPharmacy.StockTransferResponse, error)
+}
+
+type stockTransferUseCaseImpl struct {
+	auditLogUseCase   usecaseAudit.AuditLogUseCase
+	pharmacyRepo      repository.PharmacyRepository
+	stockTransferRepo repository.StockTransferRepository
+	transactor        transactor.Transactor
+}
+
+func NewStockTransferUseCase(
+	auditLogUseCase usecaseAudit.AuditLogUseCase,
+	pharmacyRepo repository.PharmacyRepository,
+	stockTransferRepo repository.StockTransferRepository,
+	transactor transactor.Transactor,
+) *stockTransferUseCaseImpl {
+	return &stockTransferUseCaseImpl{
+		auditLogUseCase:   auditLogUseCase,
+		pharmacyRepo:      pharmacyRepo,
+		stockTransferRepo: stockTransferRepo,
+		transactor:        transactor,
+	}
+}
+
+func (u *stockTransferUseCaseImpl) Search(ctx context.Context, request *dtoPharmacy.SearchStockTransferRequest) ([]*dtoPharmacy.StockTransferResponse, *dtoPkg.PageMetaData, error) {
+	if _, err := u.findPharmacistPharmacy(ctx, request.PharmacyID, request.PharmacistID); err != nil {
+		return nil, nil, err
+	}
+
+	transfers, err := u.stockTransferRepo.Search(ctx, request)
+	if err != nil {
+		return nil, nil, apperrorPkg.NewServerError(err)
+	}
+
+	res, metaData := pageutils.CreateMetaData(transfers, request.Page, request.Limit)
+	return dtoPharmacy.ConvertToStockTransferResponses(res), metaData, nil
+}
+
+func (u *stockTransferUseCaseImpl) Get(ctx context.Context, request *dtoPharmacy.GetStockTransferRequest) (*dtoPharmacy.StockTransferResponse, error) {
+	if _, err := u.findPharmacistPharmacy(ctx, request.PharmacyID, request.PharmacistID); err != nil {
+		return nil, err
+	}
+
+	transfer, err := u.stockTransferRepo.FindByID(ctx, request.ID)
+	if err != nil {
+		return nil, err
+	}
+	if transfer.SourcePharmacyID != request.PharmacyID && transfer.DestinationPharmacyID != request.PharmacyID {
+		return nil, apperrorPkg.NewEntityNotFoundError("stock transfer")
+	}
+	return dtoPharmacy.ConvertToStockTransferResponse(transfer), nil
+}
+
+func (u *stockTransferUseCaseImpl) Create(ctx context.Context, request *dtoPharmacy.CreateStockTransferRequest) (*dtoPharmacy.StockTransferResponse, error) {
+	if request.SourcePharmacyID == request.PharmacyID {
+		return nil, apperrorPharmacy.NewStockTransferPartnerError()
+	}
+
+	transfer := dtoPharmacy.CreateStockTransferRequestToEntity(request)
+	err := u.transactor.Atomic(ctx, func(txCtx context.Context) error {
+		destination, err := u.findPharmacistPharmacy(txCtx, request.PharmacyID, request.PharmacistID)
+		if err != nil {
+			return err
+		}
+
+		source, err := u.pharmacyRepo.FindByID(txCtx, request.SourcePharmacyID)
+		if err != nil {
+			return err
+		}
+		if source.PartnerID != destination.PartnerID {
+			return apperrorPharmacy.NewStockTransferPartnerError()
+		}
+
+		if !u.stockTransferRepo.IsStockAvailable(txCtx, source.ID, request.ProductID, request.Quantity) {
+			return apperrorPharmacy.NewStockTransferStockError()
+		}
+
+		if err := u.stockTransferRepo.Save(txCtx, transfer); err != nil {
+			return apperrorPkg.NewServerError(err)
+		}
+
+		transfer, err = u.stockTransferRepo.FindByID(txCtx, transfer.ID)
+		return err
+	})
+
+	if err != nil {
+		return nil, err
+	}
+	return dtoPharmacy.ConvertToStockTransferResponse(transfer), nil
+}
+
+func (u *stockTransferUseCaseImpl) UpdateStatus(ctx context.Context, request *dtoPharmacy.UpdateStockTransferRequest) (*dtoPharmacy.StockTransferResponse, error) {
+	var transfer *entity.StockTransfer
+	err := u.transactor.Atomic(ctx, func(txCtx context.Context) error {
+		if _, err := u.findPharmacistPharmacy(txCtx, request.PharmacyID, request.PharmacistID); err != nil {
+			return err
+		}
+
+		var err error
+		transfer, err = u.stockTransferRepo.FindByID(txCtx, request.ID)
+		if err != nil {
+			return err
+		}
+		if transfer.SourcePharmacyID != request.PharmacyID && transfer.DestinationPharmacyID != request.PharmacyID {
+			return apperrorPkg.NewEntityNotFoundError("stock transfer")
+		}
+
+		isSource := transfer.SourcePharmacyID == request.PharmacyID
+		if !utils.IsValidStockTransferTransition(transfer.Status, request.Status, isSource) {
+			return apperrorPharmacy.NewStockTransferStatusError(transfer.Status, request.Status)
+		}
+		before := dtoPharmacy.ConvertToStockTransferResponse(transfer)
+
+		switch request.Status {
+		case constant.TRANSFER_APPROVED:
+			if err := u.stockTransferRepo.DeductSourceStock(txCtx, transfer); err != nil {
+				return err
+			}
+		case constant.TRANSFER_RECEIVED:
+			if err := u.stockTransferRepo.AddDestinationStock(txCtx, transfer); err != nil {
+				return err
+			}
+		}
+
+		if request.Status == constant.TRANSFER_APPROVED || request.Status == constant.TRANSFER_REJECTED {
+			transfer.ReviewedBy = &request.PharmacistID
+		}
+		transfer.Status = request.Status
+		if err := u.stockTransferRepo.UpdateStatus(txCtx, transfer); err != nil {
+			return err
+		}
+		return u.auditLogUseCase.Record(txCtx, &dtoAudit.AuditEntry{
+			Action:     constantAudit.ACTION_UPDATE,
+			EntityType: constantAudit.ENTITY_STOCK_TRANSFER,
+			EntityID:   transfer.ID,
+			Before:     before,
+			After:      dtoPharmacy.ConvertToStockTransferResponse(transfer),
+		})
+	})
+
+	if err != nil {
+		return nil, err
+	}
+	return dtoPharmacy.ConvertToStockTransferResponse(transfer), nil
+}
+
+func (u *stockTransferUseCaseImpl) findPharmacistPharmacy(ctx context.Context, pharmacyID, pharmacistID int64) (*entity.Pharmacy, error) {
+	pharmacy, err := u.pharmacyRepo.FindByID(ctx, pharmacyID)
+	if err != nil {
+		return nil, err
+	}
+	if pharmacy.PharmacistID == nil || *pharmacy.PharmacistID != pharmacistID {
+		return nil, apperrorPharmacy.NewStockTransferAccessError()
+	}
+	return pharmacy, nil
+}
@@ -0,0 +1,64 @@
+package utils
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+
+	"healthcare-app/internal/pharmacy/constant"
+	"healthcare-app/internal/pharmacy/entity"
+	"healthcare-app/pkg/utils/xlsxutils"
+)
+
+// MedicineWriter writes the medicines export one product at a time, so the
+// export never holds more than the product being written.
+type MedicineWriter struct {
+	writer interface{ Write(record []string) error }
+	close  func() error
+}
+
+func NewMedicineWriter(w io.Writer, format string) (*MedicineWriter, error) {
+	medicineWriter := &MedicineWriter{}
+	if format == constant.EXPORT_XLSX {
+		writer := xlsxutils.NewWriter(w, "medicines")
+		medicineWriter.writer = writer
+		medicineWriter.close = writer.Close
+	} else {
+		writer := csv.NewWriter(w)
+		medicineWriter.writer = writer
+		medicineWriter.close = func() error {
+			writer.Flush()
+			return writer.Error()
+		}
+	}
+
+	if err := medicineWriter.writer.Write(constant.MedicineExportHeaders); err != nil {
+		return nil, err
+	}
+	return medicineWriter, nil
+}
+
+func (w *MedicineWriter) Write(product *entity.PharmacyProduct) error {
+	return w.writer.Write([]string{
+		fmt.Sprint(product.ID),
+		fmt.Sprint(product.ProductId),
+		product.Name,
+		fmt.Sprint(product.StockQuantity),
+		fmt.Sprintf("Rp.%v", product.Price),
+		fmt.Sprint(product.IsActive),
+		fmt.Sprint(product.SoldAmount),
+		product.CreatedAt.String(),
+		product.UpdatedAt.String(),
+	})
+}
+
+func (w *MedicineWriter) Close() error {
+	return w.close()
+}
+
+func MedicineExportFile(format string) (string, string) {
+	if format == constant.EXPORT_XLSX {
+		return "medicines.xlsx", "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet"
+	}
+	return "medicines.csv", "text/csv"
+}
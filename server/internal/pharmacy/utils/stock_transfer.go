@@ -0,0 +1,31 @@
+package utils
+
+import "healthcare-app/internal/pharmacy/constant"
+
+// stockTransferTransitions maps the current status of a transfer to the statuses it can move into,
+// along with the side of the transfer (source or destination pharmacy) allowed to make that move.
+var stockTransferTransitions = map[string]map[string]bool{
+	constant.TRANSFER_REQUESTED: {
+		constant.TRANSFER_APPROVED:  true,
+		constant.TRANSFER_REJECTED:  true,
+		constant.TRANSFER_CANCELLED: false,
+	},
+	constant.TRANSFER_APPROVED: {
+		constant.TRANSFER_SHIPPED: true,
+	},
+	constant.TRANSFER_SHIPPED: {
+		constant.TRANSFER_RECEIVED: false,
+	},
+}
+
+func IsValidStockTransferTransition(currentStatus, newStatus string, isSource bool) bool {
+	nextStatuses, ok := stockTransferTransitions[currentStatus]
+	if !ok {
+		return false
+	}
+	bySource, ok := nextStatuses[newStatus]
+	if !ok {
+		return false
+	}
+	return bySource == isSource
+}
@@ -0,0 +1,51 @@
+package apperror
+
+import (
+	"errors"
+	"fmt"
+
+	"healthcare-app/internal/prescription/constant"
+	"healthcare-app/pkg/apperror"
+)
+
+func NewInvalidPrescriptionCodeError() *apperror.AppError {
+	msg := constant.InvalidPrescriptionCode
+	err := errors.New(msg)
+	return apperror.NewAppError(err, apperror.DefaultClientErrorCode, msg)
+}
+
+func NewInvalidPrescriptionItemError() *apperror.AppError {
+	msg := constant.InvalidPrescriptionItem
+	err := errors.New(msg)
+	return apperror.NewAppError(err, apperror.DefaultClientErrorCode, msg)
+}
+
+func NewInvalidPrescriptionConsultationError() *apperror.AppError {
+	msg := constant.InvalidPrescriptionConsultation
+	err := errors.New(msg)
+	return apperror.NewAppError(err, apperror.DefaultClientErrorCode, msg)
+}
+
+func NewInvalidPrescriptionExpiredError() *apperror.AppError {
+	msg := constant.InvalidPrescriptionExpired
+	err := errors.New(msg)
+	return apperror.NewAppError(err, apperror.DefaultClientErrorCode, msg)
+}
+
+func NewInvalidPrescriptionRedeemedError() *apperror.AppError {
+	msg := constant.InvalidPrescriptionRedeemed
+	err := errors.New(msg)
+	return apperror.NewAppError(err, apperror.DefaultClientErrorCode, msg)
+}
+
+func NewInvalidPrescriptionPharmacyError() *apperror.AppError {
+	msg := constant.InvalidPrescriptionPharmacy
+	err := errors.New(msg)
+	return apperror.NewAppError(err, apperror.DefaultClientErrorCode, msg)
+}
+
+func NewInvalidPrescriptionStockError(name string) *apperror.AppError {
+	msg := fmt.Sprintf(constant.InvalidPrescriptionStock, name)
+	err := errors.New(msg)
+	return apperror.NewAppError(err, apperror.DefaultClientErrorCode, msg)
+}
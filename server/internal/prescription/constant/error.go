@@ -0,0 +1,11 @@
+package constant
+
+const (
+	InvalidPrescriptionCode         = "prescription code is not valid"
+	InvalidPrescriptionItem         = "each prescription item needs either a product or a generic name"
+	InvalidPrescriptionConsultation = "prescription can only be issued for an ongoing or completed consultation"
+	InvalidPrescriptionExpired      = "prescription has expired"
+	InvalidPrescriptionRedeemed     = "prescription has no redemptions remaining"
+	InvalidPrescriptionPharmacy     = "pharmacy is not available"
+	InvalidPrescriptionStock        = "pharmacy does not stock %s"
+)
@@ -0,0 +1,12 @@
+package constant
+
+const (
+	STATUS_ACTIVE   = "ACTIVE"
+	STATUS_EXPIRED  = "EXPIRED"
+	STATUS_REDEEMED = "REDEEMED"
+)
+
+const (
+	CODE_PREFIX = "RX"
+	CODE_LENGTH = 10
+)
@@ -0,0 +1,84 @@
+package controller
+
+import (
+	"strconv"
+
+	"healthcare-app/internal/auth/utils"
+	dtoPrescription "healthcare-app/internal/prescription/dto"
+	"healthcare-app/internal/prescription/usecase"
+	"healthcare-app/pkg/apperror"
+	"healthcare-app/pkg/utils/ginutils"
+
+	"github.com/gin-gonic/gin"
+)
+
+type PrescriptionController struct {
+	prescriptionUseCase usecase.PrescriptionUseCase
+}
+
+func NewPrescriptionController(prescriptionUseCase usecase.PrescriptionUseCase) *PrescriptionController {
+	return &PrescriptionController{
+		prescriptionUseCase: prescriptionUseCase,
+	}
+}
+
+func (pc *PrescriptionController) GetAllMyPrescriptions(ctx *gin.Context) {
+	req := &dtoPrescription.GetPrescriptionRequest{UserID: utils.GetValueUserIdFromToken(ctx), Role: utils.GetValueRoleUserFromToken(ctx)}
+	res, err := pc.prescriptionUseCase.GetAllMyPrescriptions(ctx, req)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+	ginutils.ResponseOK(ctx, res)
+}
+
+func (pc *PrescriptionController) GetMyPrescription(ctx *gin.Context) {
+	prescriptionID, err := strconv.Atoi(ctx.Param("prescriptionId"))
+	if err != nil {
+		ctx.Error(apperror.NewInvalidIdError())
+		return
+	}
+	res, err := pc.prescriptionUseCase.GetMyPrescription(ctx, int64(prescriptionID), utils.GetValueUserIdFromToken(ctx))
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+	ginutils.ResponseOK(ctx, res)
+}
+
+func (pc *PrescriptionController) PostNewPrescription(ctx *gin.Context) {
+	req := &dtoPrescription.RequestPrescription{PrescriberID: utils.GetValueUserIdFromToken(ctx)}
+	if err := ctx.ShouldBindJSON(req); err != nil {
+		ctx.Error(err)
+		return
+	}
+	res, err := pc.prescriptionUseCase.PostNewPrescription(ctx, req)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+	ginutils.ResponseCreated(ctx, res)
+}
+
+func (pc *PrescriptionController) VerifyPrescription(ctx *gin.Context) {
+	res, err := pc.prescriptionUseCase.VerifyPrescription(ctx, ctx.Param("code"), utils.GetValueUserIdFromToken(ctx))
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+	ginutils.ResponseOK(ctx, res)
+}
+
+func (pc *PrescriptionController) RedeemPrescription(ctx *gin.Context) {
+	req := &dtoPrescription.RequestPrescriptionRedemption{UserID: utils.GetValueUserIdFromToken(ctx)}
+	if err := ctx.ShouldBindJSON(req); err != nil {
+		ctx.Error(err)
+		return
+	}
+	res, err := pc.prescriptionUseCase.RedeemPrescription(ctx, req)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+	ginutils.ResponseCreated(ctx, res)
+}
@@ -0,0 +1,172 @@
+package dto
+
+import (
+	"time"
+
+	"healthcare-app/internal/prescription/constant"
+	"healthcare-app/internal/prescription/entity"
+
+	"github.com/shopspring/decimal"
+)
+
+type PrescriptionResponse struct {
+	ID                   int64                       `json:"id"`
+	Code                 string                      `json:"code"`
+	Prescriber           *PrescriptionPrescriber     `json:"prescriber"`
+	UserID               int64                       `json:"user_id"`
+	UserName             string                      `json:"user_name"`
+	DependentID          *int64                      `json:"dependent_id"`
+	ConsultationID       *int64                      `json:"consultation_id"`
+	Notes                *string                     `json:"notes"`
+	RefillsAllowed       int                         `json:"refills_allowed"`
+	RedemptionsRemaining int                         `json:"redemptions_remaining"`
+	Status               string                      `json:"status"`
+	ExpiresAt            time.Time                   `json:"expires_at"`
+	Items                []*PrescriptionItemResponse `json:"items,omitempty"`
+	CreatedAt            time.Time                   `json:"created_at"`
+	UpdatedAt            time.Time                   `json:"updated_at"`
+}
+
+type PrescriptionPrescriber struct {
+	ID             int64  `json:"id"`
+	Name           string `json:"name"`
+	Specialization string `json:"specialization"`
+	LicenseNumber  string `json:"license_number"`
+}
+
+type PrescriptionItemResponse struct {
+	ID          int64   `json:"id"`
+	ProductID   *int64  `json:"product_id"`
+	ProductName *string `json:"product_name"`
+	GenericName string  `json:"generic_name"`
+	Dosage      string  `json:"dosage"`
+	Quantity    int     `json:"quantity"`
+}
+
+type PrescriptionRedemptionResponse struct {
+	Prescription *PrescriptionResponse           `json:"prescription"`
+	PharmacyID   int64                           `json:"pharmacy_id"`
+	CartItems    []*PrescriptionCartItemResponse `json:"cart_items"`
+}
+
+type PrescriptionCartItemResponse struct {
+	PrescriptionItemID int64           `json:"prescription_item_id"`
+	PharmacyProductID  int64           `json:"pharmacy_product_id"`
+	ProductName        string          `json:"product_name"`
+	Quantity           int             `json:"quantity"`
+	Price              decimal.Decimal `json:"price"`
+}
+
+type GetPrescriptionRequest struct {
+	UserID int64 `form:"-"`
+	Role   int   `form:"-"`
+}
+
+type RequestPrescription struct {
+	ConsultationID int64                      `json:"consultation_id" binding:"required,gte=1"`
+	Notes          *string                    `json:"notes" binding:"omitempty,max=2000"`
+	RefillsAllowed int                        `json:"refills_allowed" binding:"gte=0,lte=12"`
+	ValidDays      int                        `json:"valid_days" binding:"required,gte=1,lte=365"`
+	Items          []*RequestPrescriptionItem `json:"items" binding:"required,min=1,max=20,dive"`
+	PrescriberID   int64                      `json:"-"`
+}
+
+type RequestPrescriptionItem struct {
+	ProductID   *int64  `json:"product_id" binding:"omitempty,gte=1"`
+	GenericName *string `json:"generic_name" binding:"omitempty,min=1,max=255"`
+	Dosage      string  `json:"dosage" binding:"required,max=255"`
+	Quantity    int     `json:"quantity" binding:"required,gte=1,lte=1000"`
+}
+
+type RequestPrescriptionRedemption struct {
+	Code       string `json:"code" binding:"required,max=20"`
+	PharmacyID int64  `json:"pharmacy_id" binding:"required,gte=1"`
+	UserID     int64  `json:"-"`
+}
+
+func ConvertToPrescriptionResponses(prescriptions []*entity.Prescription) []*PrescriptionResponse {
+	responses := []*PrescriptionResponse{}
+	for _, prescription := range prescriptions {
+		responses = append(responses, ConvertToPrescriptionResponse(prescription))
+	}
+	return responses
+}
+
+func ConvertToPrescriptionResponse(prescription *entity.Prescription) *PrescriptionResponse {
+	res := &PrescriptionResponse{
+		ID:   prescription.ID,
+		Code: prescription.Code,
+		Prescriber: &PrescriptionPrescriber{
+			ID:             prescription.PrescriberID,
+			Name:           prescription.PrescriberName,
+			Specialization: prescription.PrescriberSpecialization,
+			LicenseNumber:  prescription.PrescriberLicenseNumber,
+		},
+		UserID:               prescription.UserID,
+		UserName:             prescription.UserName,
+		DependentID:          prescription.DependentID,
+		ConsultationID:       prescription.ConsultationID,
+		Notes:                prescription.Notes,
+		RefillsAllowed:       prescription.RefillsAllowed,
+		RedemptionsRemaining: prescription.RedemptionsRemaining,
+		Status:               prescriptionStatus(prescription),
+		ExpiresAt:            prescription.ExpiresAt,
+		CreatedAt:            prescription.CreatedAt,
+		UpdatedAt:            prescription.UpdatedAt,
+	}
+	for _, item := range prescription.Items {
+		res.Items = append(res.Items, ConvertToPrescriptionItemResponse(item))
+	}
+	return res
+}
+
+func ConvertToPrescriptionItemResponse(item *entity.PrescriptionItem) *PrescriptionItemResponse {
+	return &PrescriptionItemResponse{
+		ID:          item.ID,
+		ProductID:   item.ProductID,
+		ProductName: item.ProductName,
+		GenericName: item.GenericName,
+		Dosage:      item.Dosage,
+		Quantity:    item.Quantity,
+	}
+}
+
+func ConvertToPrescriptionCartItemResponses(cartItems []*entity.PrescriptionCartItem) []*PrescriptionCartItemResponse {
+	responses := []*PrescriptionCartItemResponse{}
+	for _, cartItem := range cartItems {
+		responses = append(responses, &PrescriptionCartItemResponse{
+			PrescriptionItemID: cartItem.PrescriptionItemID,
+			PharmacyProductID:  cartItem.PharmacyProductID,
+			ProductName:        cartItem.ProductName,
+			Quantity:           cartItem.Quantity,
+			Price:              cartItem.Price,
+		})
+	}
+	return responses
+}
+
+func PrescriptionRequestToItemEntities(reqBody *RequestPrescription) []*entity.PrescriptionItem {
+	items := []*entity.PrescriptionItem{}
+	for _, item := range reqBody.Items {
+		prescriptionItem := &entity.PrescriptionItem{
+			ProductID: item.ProductID,
+			Dosage:    item.Dosage,
+			Quantity:  item.Quantity,
+		}
+		if item.GenericName != nil {
+			prescriptionItem.GenericName = *item.GenericName
+		}
+		items = append(items, prescriptionItem)
+	}
+	return items
+}
+
+func prescriptionStatus(prescription *entity.Prescription) string {
+	if prescription.RedemptionsRemaining == 0 {
+		return constant.STATUS_REDEEMED
+	}
+	if time.Now().After(prescription.ExpiresAt) {
+		return constant.STATUS_EXPIRED
+	}
+	return constant.STATUS_ACTIVE
+}
@@ -0,0 +1,53 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+type Prescription struct {
+	ID                       int64
+	Code                     string
+	PrescriberID             int64
+	PrescriberName           string
+	PrescriberSpecialization string
+	PrescriberLicenseNumber  string
+	UserID                   int64
+	UserName                 string
+	DependentID              *int64
+	ConsultationID           *int64
+	Notes                    *string
+	RefillsAllowed           int
+	RedemptionsRemaining     int
+	ExpiresAt                time.Time
+	Items                    []*PrescriptionItem
+	CreatedAt                time.Time
+	UpdatedAt                time.Time
+}
+
+type PrescriptionItem struct {
+	ID             int64
+	PrescriptionID int64
+	ProductID      *int64
+	ProductName    *string
+	GenericName    string
+	Dosage         string
+	Quantity       int
+}
+
+type PrescriptionRedemption struct {
+	ID             int64
+	PrescriptionID int64
+	UserID         int64
+	PharmacyID     int64
+	CreatedAt      time.Time
+}
+
+type PrescriptionCartItem struct {
+	PrescriptionItemID int64
+	PharmacyProductID  int64
+	ProductName        string
+	Quantity           int
+	Price              decimal.Decimal
+}
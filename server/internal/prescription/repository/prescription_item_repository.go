@@ -0,0 +1,113 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+
+	"healthcare-app/internal/prescription/entity"
+	"healthcare-app/pkg/database/transactor"
+)
+
+type PrescriptionItemRepository interface {
+	FindAllByPrescriptionID(ctx context.Context, prescriptionID int64) ([]*entity.PrescriptionItem, error)
+	IsProductExists(ctx context.Context, productID int64) (bool, error)
+	Save(ctx context.Context, item *entity.PrescriptionItem) error
+}
+
+type prescriptionItemRepositoryImpl struct {
+	db *sql.DB
+}
+
+func NewPrescriptionItemRepository(db *sql.DB) *prescriptionItemRepositoryImpl {
+	return &prescriptionItemRepositoryImpl{
+		db: db,
+	}
+}
+
+func (r *prescriptionItemRepositoryImpl) FindAllByPrescriptionID(ctx context.Context, prescriptionID int64) ([]*entity.PrescriptionItem, error) {
+	query := `
+		select pi.id, pi.prescription_id, pi.product_id, p.name, coalesce(pi.generic_name, p.generic_name), pi.dosage, pi.quantity
+		from prescription_items pi
+		left join products p on p.id = pi.product_id
+		where pi.prescription_id = $1
+		order by pi.id
+	`
+	tx := transactor.ExtractTx(ctx)
+
+	var (
+		err  error
+		rows *sql.Rows
+	)
+	if tx != nil {
+		rows, err = tx.QueryContext(ctx, query, prescriptionID)
+	} else {
+		rows, err = r.db.QueryContext(ctx, query, prescriptionID)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	items := []*entity.PrescriptionItem{}
+	for rows.Next() {
+		item := new(entity.PrescriptionItem)
+		if err := rows.Scan(
+			&item.ID,
+			&item.PrescriptionID,
+			&item.ProductID,
+			&item.ProductName,
+			&item.GenericName,
+			&item.Dosage,
+			&item.Quantity,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, item)
+	}
+
+	err = rows.Err()
+	if err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+func (r *prescriptionItemRepositoryImpl) IsProductExists(ctx context.Context, productID int64) (bool, error) {
+	query := `
+		select exists(select 1 from products where id = $1 and deleted_at is null)
+	`
+	tx := transactor.ExtractTx(ctx)
+
+	var (
+		err    error
+		exists bool
+	)
+	if tx != nil {
+		err = tx.QueryRowContext(ctx, query, productID).Scan(&exists)
+	} else {
+		err = r.db.QueryRowContext(ctx, query, productID).Scan(&exists)
+	}
+
+	if err != nil {
+		return false, err
+	}
+	return exists, nil
+}
+
+func (r *prescriptionItemRepositoryImpl) Save(ctx context.Context, item *entity.PrescriptionItem) error {
+	query := `
+		insert into prescription_items(prescription_id, product_id, generic_name, dosage, quantity)
+		values ($1, $2, nullif($3, ''), $4, $5) returning id
+	`
+	tx := transactor.ExtractTx(ctx)
+
+	var err error
+	if tx != nil {
+		err = tx.QueryRowContext(ctx, query, item.PrescriptionID, item.ProductID, item.GenericName, item.Dosage, item.Quantity).Scan(&item.ID)
+	} else {
+		err = r.db.QueryRowContext(ctx, query, item.PrescriptionID, item.ProductID, item.GenericName, item.Dosage, item.Quantity).Scan(&item.ID)
+	}
+
+	return err
+}
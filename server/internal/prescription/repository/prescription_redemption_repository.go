@@ -0,0 +1,102 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"healthcare-app/internal/prescription/entity"
+	"healthcare-app/pkg/database/transactor"
+)
+
+type PrescriptionRedemptionRepository interface {
+	FindPharmacyProduct(ctx context.Context, pharmacyID int64, item *entity.PrescriptionItem) (*entity.PrescriptionCartItem, error)
+	Save(ctx context.Context, redemption *entity.PrescriptionRedemption) error
+	SaveCartItem(ctx context.Context, userID int64, cartItem *entity.PrescriptionCartItem) error
+}
+
+type prescriptionRedemptionRepositoryImpl struct {
+	db *sql.DB
+}
+
+func NewPrescriptionRedemptionRepository(db *sql.DB) *prescriptionRedemptionRepositoryImpl {
+	return &prescriptionRedemptionRepositoryImpl{
+		db: db,
+	}
+}
+
+// FindPharmacyProduct looks for an active listing at the pharmacy with enough
+// stock for the item. Lines written against a generic name take the cheapest
+// matching product, it returns nil when the pharmacy has none.
+func (r *prescriptionRedemptionRepositoryImpl) FindPharmacyProduct(ctx context.Context, pharmacyID int64, item *entity.PrescriptionItem) (*entity.PrescriptionCartItem, error) {
+	query := `
+		select pp.id, p.name, pp.price
+		from pharmacy_products pp
+		join products p on p.id = pp.product_id
+		where pp.pharmacy_id = $1
+			and pp.is_active = true and pp.deleted_at is null
+			and p.is_active = true and p.deleted_at is null
+			and pp.stock_quantity >= $4
+			and (($2::bigint is not null and p.id = $2) or ($2::bigint is null and lower(p.generic_name) = lower($3)))
+		order by pp.price asc
+		limit 1
+	`
+	tx := transactor.ExtractTx(ctx)
+
+	var err error
+	cartItem := entity.PrescriptionCartItem{
+		PrescriptionItemID: item.ID,
+		Quantity:           item.Quantity,
+	}
+	if tx != nil {
+		err = tx.QueryRowContext(ctx, query, pharmacyID, item.ProductID, item.GenericName, item.Quantity).Scan(&cartItem.PharmacyProductID, &cartItem.ProductName, &cartItem.Price)
+	} else {
+		err = r.db.QueryRowContext(ctx, query, pharmacyID, item.ProductID, item.GenericName, item.Quantity).Scan(&cartItem.PharmacyProductID, &cartItem.ProductName, &cartItem.Price)
+	}
+
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &cartItem, nil
+}
+
+func (r *prescriptionRedemptionRepositoryImpl) Save(ctx context.Context, redemption *entity.PrescriptionRedemption) error {
+	query := `
+		insert into prescription_redemptions(prescription_id, user_id, pharmacy_id)
+		values ($1, $2, $3) returning id, created_at
+	`
+	tx := transactor.ExtractTx(ctx)
+
+	var err error
+	if tx != nil {
+		err = tx.QueryRowContext(ctx, query, redemption.PrescriptionID, redemption.UserID, redemption.PharmacyID).Scan(&redemption.ID, &redemption.CreatedAt)
+	} else {
+		err = r.db.QueryRowContext(ctx, query, redemption.PrescriptionID, redemption.UserID, redemption.PharmacyID).Scan(&redemption.ID, &redemption.CreatedAt)
+	}
+
+	return err
+}
+
+// SaveCartItem adds the prescribed quantity on top of whatever the user
+// already has in the cart for the same product.
+func (r *prescriptionRedemptionRepositoryImpl) SaveCartItem(ctx context.Context, userID int64, cartItem *entity.PrescriptionCartItem) error {
+	query := `
+		insert into user_cart_items(user_id, pharmacy_product_id, quantity, prescription_item_id)
+		values ($1, $2, $3, $4)
+		on conflict (user_id, pharmacy_product_id) do update
+		set quantity = user_cart_items.quantity + excluded.quantity, prescription_item_id = excluded.prescription_item_id, updated_at = now()
+	`
+	tx := transactor.ExtractTx(ctx)
+
+	var err error
+	if tx != nil {
+		_, err = tx.ExecContext(ctx, query, userID, cartItem.PharmacyProductID, cartItem.Quantity, cartItem.PrescriptionItemID)
+	} else {
+		_, err = r.db.ExecContext(ctx, query, userID, cartItem.PharmacyProductID, cartItem.Quantity, cartItem.PrescriptionItemID)
+	}
+
+	return err
+}
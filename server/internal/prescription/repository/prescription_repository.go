@@ -0,0 +1,251 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"healthcare-app/internal/prescription/entity"
+	apperrorPkg "healthcare-app/pkg/apperror"
+	"healthcare-app/pkg/database/transactor"
+)
+
+type PrescriptionRepository interface {
+	FindAllByUserID(ctx context.Context, userID int64) ([]*entity.Prescription, error)
+	FindAllByPrescriberID(ctx context.Context, prescriberID int64) ([]*entity.Prescription, error)
+	FindByIDAndParticipantID(ctx context.Context, id int64, participantID int64) (*entity.Prescription, error)
+	FindByCode(ctx context.Context, code string) (*entity.Prescription, error)
+	FindByCodeAndPharmacistID(ctx context.Context, code string, pharmacistID int64) (*entity.Prescription, error)
+	Save(ctx context.Context, prescription *entity.Prescription) error
+	DecrementRedemption(ctx context.Context, prescription *entity.Prescription) (bool, error)
+}
+
+type prescriptionRepositoryImpl struct {
+	db *sql.DB
+}
+
+func NewPrescriptionRepository(db *sql.DB) *prescriptionRepositoryImpl {
+	return &prescriptionRepositoryImpl{
+		db: db,
+	}
+}
+
+const prescriptionSelectQuery = `
+	select rx.id, rx.code, rx.prescriber_id, pud.full_name, dd.specialization, dd.license_number, rx.user_id, coalesce(uud.full_name, u.email), rx.dependent_id, rx.consultation_id, rx.notes, rx.refills_allowed, rx.redemptions_remaining, rx.expires_at, rx.created_at, rx.updated_at
+	from prescriptions rx
+	join users u on u.id = rx.user_id
+	left join user_details uud on uud.user_id = rx.user_id
+	join user_details pud on pud.user_id = rx.prescriber_id
+	join doctor_details dd on dd.user_id = rx.prescriber_id
+`
+
+func (r *prescriptionRepositoryImpl) FindAllByUserID(ctx context.Context, userID int64) ([]*entity.Prescription, error) {
+	query := prescriptionSelectQuery + " where rx.user_id = $1 order by rx.created_at desc"
+	return r.findAll(ctx, query, userID)
+}
+
+func (r *prescriptionRepositoryImpl) FindAllByPrescriberID(ctx context.Context, prescriberID int64) ([]*entity.Prescription, error) {
+	query := prescriptionSelectQuery + " where rx.prescriber_id = $1 order by rx.created_at desc"
+	return r.findAll(ctx, query, prescriberID)
+}
+
+func (r *prescriptionRepositoryImpl) FindByIDAndParticipantID(ctx context.Context, id int64, participantID int64) (*entity.Prescription, error) {
+	query := prescriptionSelectQuery + " where rx.id = $1 and (rx.user_id = $2 or rx.prescriber_id = $2)"
+	return r.findOne(ctx, query, id, participantID)
+}
+
+func (r *prescriptionRepositoryImpl) FindByCode(ctx context.Context, code string) (*entity.Prescription, error) {
+	query := prescriptionSelectQuery + " where rx.code = $1"
+	return r.findOne(ctx, query, code)
+}
+
+// FindByCodeAndPharmacistID only finds the prescription once one of its items
+// was ordered from a pharmacy the pharmacist manages.
+func (r *prescriptionRepositoryImpl) FindByCodeAndPharmacistID(ctx context.Context, code string, pharmacistID int64) (*entity.Prescription, error) {
+	query := prescriptionSelectQuery + `
+		where rx.code = $1 and exists (
+			select 1
+			from prescription_items pi
+			join order_products op on op.prescription_item_id = pi.id
+			join pharmacy_products pp on pp.id = op.pharmacy_product_id
+			join pharmacies ph on ph.id = pp.pharmacy_id
+			where pi.prescription_id = rx.id and ph.pharmacist_id = $2
+		)
+	`
+	return r.findOne(ctx, query, code, pharmacistID)
+}
+
+func (r *prescriptionRepositoryImpl) Save(ctx context.Context, prescription *entity.Prescription) error {
+	query := `
+		insert into prescriptions(code, prescriber_id, user_id, dependent_id, consultation_id, notes, refills_allowed, redemptions_remaining, expires_at)
+		values ($1, $2, $3, $4, $5, $6, $7, $8, $9) returning id, created_at, updated_at
+	`
+	tx := transactor.ExtractTx(ctx)
+
+	var err error
+	if tx != nil {
+		err = tx.QueryRowContext(
+			ctx,
+			query,
+			prescription.Code,
+			prescription.PrescriberID,
+			prescription.UserID,
+			prescription.DependentID,
+			prescription.ConsultationID,
+			prescription.Notes,
+			prescription.RefillsAllowed,
+			prescription.RedemptionsRemaining,
+			prescription.ExpiresAt,
+		).Scan(&prescription.ID, &prescription.CreatedAt, &prescription.UpdatedAt)
+	} else {
+		err = r.db.QueryRowContext(
+			ctx,
+			query,
+			prescription.Code,
+			prescription.PrescriberID,
+			prescription.UserID,
+			prescription.DependentID,
+			prescription.ConsultationID,
+			prescription.Notes,
+			prescription.RefillsAllowed,
+			prescription.RedemptionsRemaining,
+			prescription.ExpiresAt,
+		).Scan(&prescription.ID, &prescription.CreatedAt, &prescription.UpdatedAt)
+	}
+
+	return err
+}
+
+// DecrementRedemption takes one redemption off the prescription, it reports
+// false when another request already used the last one or it has expired.
+func (r *prescriptionRepositoryImpl) DecrementRedemption(ctx context.Context, prescription *entity.Prescription) (bool, error) {
+	query := `
+		update prescriptions
+		set redemptions_remaining = redemptions_remaining - 1, updated_at = now()
+		where id = $1 and redemptions_remaining > 0 and expires_at > now()
+		returning redemptions_remaining, updated_at
+	`
+	tx := transactor.ExtractTx(ctx)
+
+	var err error
+	if tx != nil {
+		err = tx.QueryRowContext(ctx, query, prescription.ID).Scan(&prescription.RedemptionsRemaining, &prescription.UpdatedAt)
+	} else {
+		err = r.db.QueryRowContext(ctx, query, prescription.ID).Scan(&prescription.RedemptionsRemaining, &prescription.UpdatedAt)
+	}
+
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return false, nil
+		}
+		return false, err
+	}
+	return true, nil
+}
+
+func (r *prescriptionRepositoryImpl) findOne(ctx context.Context, query string, args ...any) (*entity.Prescription, error) {
+	tx := transactor.ExtractTx(ctx)
+
+	var (
+		err          error
+		prescription entity.Prescription
+	)
+	if tx != nil {
+		err = tx.QueryRowContext(ctx, query, args...).Scan(
+			&prescription.ID,
+			&prescription.Code,
+			&prescription.PrescriberID,
+			&prescription.PrescriberName,
+			&prescription.PrescriberSpecialization,
+			&prescription.PrescriberLicenseNumber,
+			&prescription.UserID,
+			&prescription.UserName,
+			&prescription.DependentID,
+			&prescription.ConsultationID,
+			&prescription.Notes,
+			&prescription.RefillsAllowed,
+			&prescription.RedemptionsRemaining,
+			&prescription.ExpiresAt,
+			&prescription.CreatedAt,
+			&prescription.UpdatedAt,
+		)
+	} else {
+		err = r.db.QueryRowContext(ctx, query, args...).Scan(
+			&prescription.ID,
+			&prescription.Code,
+			&prescription.PrescriberID,
+			&prescription.PrescriberName,
+			&prescription.PrescriberSpecialization,
+			&prescription.PrescriberLicenseNumber,
+			&prescription.UserID,
+			&prescription.UserName,
+			&prescription.DependentID,
+			&prescription.ConsultationID,
+			&prescription.Notes,
+			&prescription.RefillsAllowed,
+			&prescription.RedemptionsRemaining,
+			&prescription.ExpiresAt,
+			&prescription.CreatedAt,
+			&prescription.UpdatedAt,
+		)
+	}
+
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, apperrorPkg.NewEntityNotFoundError("prescription")
+		}
+		return nil, err
+	}
+	return &prescription, nil
+}
+
+func (r *prescriptionRepositoryImpl) findAll(ctx context.Context, query string, args ...any) ([]*entity.Prescription, error) {
+	tx := transactor.ExtractTx(ctx)
+
+	var (
+		err  error
+		rows *sql.Rows
+	)
+	if tx != nil {
+		rows, err = tx.QueryContext(ctx, query, args...)
+	} else {
+		rows, err = r.db.QueryContext(ctx, query, args...)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	prescriptions := []*entity.Prescription{}
+	for rows.Next() {
+		prescription := new(entity.Prescription)
+		if err := rows.Scan(
+			&prescription.ID,
+			&prescription.Code,
+			&prescription.PrescriberID,
+			&prescription.PrescriberName,
+			&prescription.PrescriberSpecialization,
+			&prescription.PrescriberLicenseNumber,
+			&prescription.UserID,
+			&prescription.UserName,
+			&prescription.DependentID,
+			&prescription.ConsultationID,
+			&prescription.Notes,
+			&prescription.RefillsAllowed,
+			&prescription.RedemptionsRemaining,
+			&prescription.ExpiresAt,
+			&prescription.CreatedAt,
+			&prescription.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		prescriptions = append(prescriptions, prescription)
+	}
+
+	err = rows.Err()
+	if err != nil {
+		return nil, err
+	}
+	return prescriptions, nil
+}
@@ -0,0 +1,29 @@
+package route
+
+import (
+	"healthcare-app/internal/auth/constant"
+	"healthcare-app/internal/prescription/controller"
+	"healthcare-app/pkg/middleware"
+
+	"github.com/gin-gonic/gin"
+)
+
+const prescriptionId = "/:prescriptionId"
+
+func PrescriptionControllerRoute(c *controller.PrescriptionController, r *gin.Engine, authMiddleware *middleware.AuthMiddleware) {
+	d := r.Group("/doctors/me/prescriptions", authMiddleware.Authorization(), authMiddleware.ProtectedRoles(constant.DOCTOR))
+	{
+		d.GET("", c.GetAllMyPrescriptions)
+		d.POST("", c.PostNewPrescription)
+		d.GET(prescriptionId, c.GetMyPrescription)
+	}
+
+	u := r.Group("/users/me/prescriptions", authMiddleware.Authorization(), authMiddleware.ProtectedRoles(constant.USER))
+	{
+		u.GET("", c.GetAllMyPrescriptions)
+		u.GET(prescriptionId, c.GetMyPrescription)
+		u.POST("/redeem", c.RedeemPrescription)
+	}
+
+	r.GET("/pharmacists/prescriptions/:code", authMiddleware.Authorization(), authMiddleware.ProtectedRoles(constant.PHARMACIST), c.VerifyPrescription)
+}
@@ -0,0 +1,260 @@
+package usecase
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	constantAuth "healthcare-app/internal/auth/constant"
+	constantConsultation "healthcare-app/internal/consultation/constant"
+	consultationRepository "healthcare-app/internal/consultation/repository"
+	pharmacyRepository "healthcare-app/internal/pharmacy/repository"
+	apperrorPrescription "healthcare-app/internal/prescription/apperror"
+	dtoPrescription "healthcare-app/internal/prescription/dto"
+	"healthcare-app/internal/prescription/entity"
+	prescriptionRepository "healthcare-app/internal/prescription/repository"
+	"healthcare-app/internal/prescription/utils"
+	apperrorPkg "healthcare-app/pkg/apperror"
+	"healthcare-app/pkg/database/transactor"
+)
+
+type PrescriptionUseCase interface {
+	GetAllMyPrescriptions(ctx context.Context, request *dtoPrescription.GetPrescriptionRequest) ([]*dtoPrescription.PrescriptionResponse, error)
+	GetMyPrescription(ctx context.Context, id int64, participantID int64) (*dtoPrescription.PrescriptionResponse, error)
+	PostNewPrescription(ctx context.Context, reqBody *dtoPrescription.RequestPrescription) (*dtoPrescription.PrescriptionResponse, error)
+	VerifyPrescription(ctx context.Context, code string, pharmacistID int64) (*dtoPrescription.PrescriptionResponse, error)
+	RedeemPrescription(ctx context.Context, reqBody *dtoPrescription.RequestPrescriptionRedemption) (*dtoPrescription.PrescriptionRedemptionResponse, error)
+}
+
+type prescriptionUseCaseImpl struct {
+	prescriptionRepo prescriptionRepository.PrescriptionRepository
+	itemRepo         prescriptionRepository.PrescriptionItemRepository
+	redemptionRepo   prescriptionRepository.PrescriptionRedemptionRepository
+	consultationRepo consultationRepository.ConsultationRepository
+	pharmacyRepo     pharmacyRepository.PharmacyRepository
+	transactor       transactor.Transactor
+}
+
+func NewPrescriptionUseCase(
+	prescriptionRepo prescriptionRepository.PrescriptionRepository,
+	itemRepo prescriptionRepository.PrescriptionItemRepository,
+	redemptionRepo prescriptionRepository.PrescriptionRedemptionRepository,
+	consultationRepo consultationRepository.ConsultationRepository,
+	pharmacyRepo pharmacyRepository.PharmacyRepository,
+	transactor transactor.Transactor,
+) *prescriptionUseCaseImpl {
+	return &prescriptionUseCaseImpl{
+		prescriptionRepo: prescriptionRepo,
+		itemRepo:         itemRepo,
+		redemptionRepo:   redemptionRepo,
+		consultationRepo: consultationRepo,
+		pharmacyRepo:     pharmacyRepo,
+		transactor:       transactor,
+	}
+}
+
+func (u *prescriptionUseCaseImpl) GetAllMyPrescriptions(ctx context.Context, request *dtoPrescription.GetPrescriptionRequest) ([]*dtoPrescription.PrescriptionResponse, error) {
+	var (
+		prescriptions []*entity.Prescription
+		err           error
+	)
+	if request.Role == constantAuth.DOCTOR {
+		prescriptions, err = u.prescriptionRepo.FindAllByPrescriberID(ctx, request.UserID)
+	} else {
+		prescriptions, err = u.prescriptionRepo.FindAllByUserID(ctx, request.UserID)
+	}
+	if err != nil {
+		return nil, apperrorPkg.NewServerError(err)
+	}
+	return dtoPrescription.ConvertToPrescriptionResponses(prescriptions), nil
+}
+
+func (u *prescriptionUseCaseImpl) GetMyPrescription(ctx context.Context, id int64, participantID int64) (*dtoPrescription.PrescriptionResponse, error) {
+	prescription, err := u.prescriptionRepo.FindByIDAndParticipantID(ctx, id, participantID)
+	if err != nil {
+		return nil, err
+	}
+
+	prescription.Items, err = u.itemRepo.FindAllByPrescriptionID(ctx, prescription.ID)
+	if err != nil {
+		return nil, apperrorPkg.NewServerError(err)
+	}
+	return dtoPrescription.ConvertToPrescriptionResponse(prescription), nil
+}
+
+// PostNewPrescription issues a prescription to the patient of one of the
+// doctor's consultations. The first fill counts as a redemption, so n refills
+// allow the code to be redeemed n + 1 times.
+func (u *prescriptionUseCaseImpl) PostNewPrescription(ctx context.Context, reqBody *dtoPrescription.RequestPrescription) (*dtoPrescription.PrescriptionResponse, error) {
+	code, err := utils.GeneratePrescriptionCode()
+	if err != nil {
+		return nil, apperrorPkg.NewServerError(err)
+	}
+
+	items := dtoPrescription.PrescriptionRequestToItemEntities(reqBody)
+	prescription := &entity.Prescription{
+		Code:                 code,
+		PrescriberID:         reqBody.PrescriberID,
+		Notes:                reqBody.Notes,
+		RefillsAllowed:       reqBody.RefillsAllowed,
+		RedemptionsRemaining: reqBody.RefillsAllowed + 1,
+		ExpiresAt:            time.Now().AddDate(0, 0, reqBody.ValidDays),
+	}
+	err = u.transactor.Atomic(ctx, func(cForTx context.Context) error {
+		consultation, err := u.consultationRepo.FindByIDAndParticipantID(cForTx, reqBody.ConsultationID, reqBody.PrescriberID)
+		if err != nil {
+			return err
+		}
+		if consultation.DoctorID != reqBody.PrescriberID {
+			return apperrorPkg.NewEntityNotFoundError("consultation")
+		}
+		if consultation.Status != constantConsultation.STATUS_ONGOING && consultation.Status != constantConsultation.STATUS_COMPLETED {
+			return apperrorPrescription.NewInvalidPrescriptionConsultationError()
+		}
+
+		for _, item := range items {
+			if (item.ProductID == nil) == (item.GenericName == "") {
+				return apperrorPrescription.NewInvalidPrescriptionItemError()
+			}
+			if item.ProductID == nil {
+				continue
+			}
+			exists, err := u.itemRepo.IsProductExists(cForTx, *item.ProductID)
+			if err != nil {
+				return apperrorPkg.NewServerError(err)
+			}
+			if !exists {
+				return apperrorPkg.NewEntityNotFoundError("product")
+			}
+		}
+
+		prescription.UserID = consultation.UserID
+		prescription.DependentID = consultation.DependentID
+		prescription.ConsultationID = &consultation.ID
+		if err := u.prescriptionRepo.Save(cForTx, prescription); err != nil {
+			return apperrorPkg.NewServerError(err)
+		}
+		for _, item := range items {
+			item.PrescriptionID = prescription.ID
+			if err := u.itemRepo.Save(cForTx, item); err != nil {
+				return apperrorPkg.NewServerError(err)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return u.GetMyPrescription(ctx, prescription.ID, reqBody.PrescriberID)
+}
+
+// VerifyPrescription only shows the prescription to a pharmacist whose
+// pharmacy it was ordered from, other codes read as not found.
+func (u *prescriptionUseCaseImpl) VerifyPrescription(ctx context.Context, code string, pharmacistID int64) (*dtoPrescription.PrescriptionResponse, error) {
+	code = strings.ToUpper(strings.TrimSpace(code))
+	if !utils.IsValidPrescriptionCode(code) {
+		return nil, apperrorPrescription.NewInvalidPrescriptionCodeError()
+	}
+	prescription, err := u.prescriptionRepo.FindByCodeAndPharmacistID(ctx, code, pharmacistID)
+	if err != nil {
+		return nil, err
+	}
+
+	prescription.Items, err = u.itemRepo.FindAllByPrescriptionID(ctx, prescription.ID)
+	if err != nil {
+		return nil, apperrorPkg.NewServerError(err)
+	}
+	return dtoPrescription.ConvertToPrescriptionResponse(prescription), nil
+}
+
+// RedeemPrescription puts every line of the prescription into the user's cart
+// at the chosen pharmacy. Nothing is added unless the pharmacy can fill all of
+// them, and the redemption count is only taken once everything matched.
+func (u *prescriptionUseCaseImpl) RedeemPrescription(ctx context.Context, reqBody *dtoPrescription.RequestPrescriptionRedemption) (*dtoPrescription.PrescriptionRedemptionResponse, error) {
+	var (
+		prescription *entity.Prescription
+		cartItems    []*entity.PrescriptionCartItem
+	)
+	err := u.transactor.Atomic(ctx, func(cForTx context.Context) error {
+		var err error
+		prescription, err = u.findByCode(cForTx, reqBody.Code)
+		if err != nil {
+			return err
+		}
+		if prescription.UserID != reqBody.UserID {
+			return apperrorPkg.NewEntityNotFoundError("prescription")
+		}
+		if prescription.RedemptionsRemaining == 0 {
+			return apperrorPrescription.NewInvalidPrescriptionRedeemedError()
+		}
+		if time.Now().After(prescription.ExpiresAt) {
+			return apperrorPrescription.NewInvalidPrescriptionExpiredError()
+		}
+
+		pharmacy, err := u.pharmacyRepo.FindByID(cForTx, reqBody.PharmacyID)
+		if err != nil {
+			return err
+		}
+		if !pharmacy.IsActive {
+			return apperrorPrescription.NewInvalidPrescriptionPharmacyError()
+		}
+
+		prescription.Items, err = u.itemRepo.FindAllByPrescriptionID(cForTx, prescription.ID)
+		if err != nil {
+			return apperrorPkg.NewServerError(err)
+		}
+		for _, item := range prescription.Items {
+			cartItem, err := u.redemptionRepo.FindPharmacyProduct(cForTx, pharmacy.ID, item)
+			if err != nil {
+				return apperrorPkg.NewServerError(err)
+			}
+			if cartItem == nil {
+				name := item.GenericName
+				if item.ProductName != nil {
+					name = *item.ProductName
+				}
+				return apperrorPrescription.NewInvalidPrescriptionStockError(name)
+			}
+			cartItems = append(cartItems, cartItem)
+		}
+
+		ok, err := u.prescriptionRepo.DecrementRedemption(cForTx, prescription)
+		if err != nil {
+			return apperrorPkg.NewServerError(err)
+		}
+		if !ok {
+			return apperrorPrescription.NewInvalidPrescriptionRedeemedError()
+		}
+
+		redemption := &entity.PrescriptionRedemption{
+			PrescriptionID: prescription.ID,
+			UserID:         reqBody.UserID,
+			PharmacyID:     pharmacy.ID,
+		}
+		if err := u.redemptionRepo.Save(cForTx, redemption); err != nil {
+			return apperrorPkg.NewServerError(err)
+		}
+		for _, cartItem := range cartItems {
+			if err := u.redemptionRepo.SaveCartItem(cForTx, reqBody.UserID, cartItem); err != nil {
+				return apperrorPkg.NewServerError(err)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &dtoPrescription.PrescriptionRedemptionResponse{
+		Prescription: dtoPrescription.ConvertToPrescriptionResponse(prescription),
+		PharmacyID:   reqBody.PharmacyID,
+		CartItems:    dtoPrescription.ConvertToPrescriptionCartItemResponses(cartItems),
+	}, nil
+}
+
+func (u *prescriptionUseCaseImpl) findByCode(ctx context.Context, code string) (*entity.Prescription, error) {
+	code = strings.ToUpper(strings.TrimSpace(code))
+	if !utils.IsValidPrescriptionCode(code) {
+		return nil, apperrorPrescription.NewInvalidPrescriptionCodeError()
+	}
+	return u.prescriptionRepo.FindByCode(ctx, code)
+}
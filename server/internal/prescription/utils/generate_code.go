@@ -0,0 +1,49 @@
+package utils
+
+import (
+	"crypto/rand"
+	"math/big"
+	"strings"
+
+	"healthcare-app/internal/prescription/constant"
+)
+
+const codeCharset = "0123456789ABCDEFGHIJKLMNOPQRSTUVWXYZ"
+
+// GeneratePrescriptionCode returns a code whose last character is a check
+// character over the rest, so a mistyped code is rejected before any lookup.
+// The code is all a pharmacist needs to look the prescription up, so it is
+// drawn from crypto/rand.
+func GeneratePrescriptionCode() (string, error) {
+	body := make([]byte, constant.CODE_LENGTH-1)
+	max := big.NewInt(int64(len(codeCharset)))
+	for i := range body {
+		n, err := rand.Int(rand.Reader, max)
+		if err != nil {
+			return "", err
+		}
+		body[i] = codeCharset[n.Int64()]
+	}
+	return constant.CODE_PREFIX + string(body) + string(checkCharacter(string(body))), nil
+}
+
+func IsValidPrescriptionCode(code string) bool {
+	body, ok := strings.CutPrefix(code, constant.CODE_PREFIX)
+	if !ok || len(body) != constant.CODE_LENGTH {
+		return false
+	}
+	for _, c := range body {
+		if !strings.ContainsRune(codeCharset, c) {
+			return false
+		}
+	}
+	return checkCharacter(body[:len(body)-1]) == body[len(body)-1]
+}
+
+func checkCharacter(body string) byte {
+	sum := 0
+	for i, c := range body {
+		sum += (i + 1) * strings.IndexRune(codeCharset, c)
+	}
+	return codeCharset[sum%len(codeCharset)]
+}
@@ -0,0 +1,16 @@
+package apperror
+
+import (
+	"errors"
+
+	"healthcare-app/internal/product/constant"
+	"healthcare-app/pkg/apperror"
+)
+
+func NewPharmacyProductImportMismatchError() *apperror.AppError {
+	msg := constant.PharmacyProductImportMismatchMessage
+
+	err := errors.New(msg)
+
+	return apperror.NewAppError(err, apperror.DefaultClientErrorCode, msg)
+}
@@ -0,0 +1,17 @@
+package apperror
+
+import (
+	"errors"
+	"fmt"
+
+	"healthcare-app/internal/product/constant"
+	"healthcare-app/pkg/apperror"
+)
+
+func NewProductImportFileError(format string, maxSize int64) *apperror.AppError {
+	msg := fmt.Sprintf(constant.ProductImportFileErrorMessage, format, fmt.Sprintf("%vmb", maxSize/(1024*1024)))
+
+	err := errors.New(msg)
+
+	return apperror.NewAppError(err, apperror.DefaultClientErrorCode, msg)
+}
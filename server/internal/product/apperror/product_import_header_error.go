@@ -0,0 +1,18 @@
+package apperror
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"healthcare-app/internal/product/constant"
+	"healthcare-app/pkg/apperror"
+)
+
+func NewProductImportHeaderError(headers []string) *apperror.AppError {
+	msg := fmt.Sprintf(constant.ProductImportHeaderErrorMessage, strings.Join(headers, ","))
+
+	err := errors.New(msg)
+
+	return apperror.NewAppError(err, apperror.DefaultClientErrorCode, msg)
+}
@@ -0,0 +1,17 @@
+package apperror
+
+import (
+	"errors"
+	"fmt"
+
+	"healthcare-app/internal/product/constant"
+	"healthcare-app/pkg/apperror"
+)
+
+func NewProductImportRowsError(maxRows int) *apperror.AppError {
+	msg := fmt.Sprintf(constant.ProductImportRowsErrorMessage, maxRows)
+
+	err := errors.New(msg)
+
+	return apperror.NewAppError(err, apperror.DefaultClientErrorCode, msg)
+}
@@ -1,7 +1,7 @@
 package constant
 
 const (
-	ProductImageErrorMessage                 = "the image must be in .png format and must not exceed %v in size"
+	ProductImageErrorMessage                 = "the image must be a png, jpeg, gif or webp image and must not exceed %v in size"
 	ProductAlreadyExistsErrorMessage         = "product already exists"
 	ProductClassificationErrorMessage        = "product form, selling unit, and unit in pack are required"
 	NoNearbyPharmaciesErrorMessage           = "there are no pharmacies within 25 km of your location, please consider updating your location"
@@ -13,4 +13,9 @@ const (
 	InvalidCategoryAlreadyExists             = "category name already exists"
 	InvalidCategoryIdDoesNotExists           = "category id does not exists"
 	InvalidCategoryNameAtLeast3char          = "category name must be at least 3 characters long"
+	ProductImportFileErrorMessage            = "the file must be in %v format and must not exceed %v in size"
+	ProductImportHeaderErrorMessage          = "the csv header must be: %v"
+	ProductImportRowsErrorMessage            = "the csv must contain between 1 and %v rows"
+	ProductImportReportErrorMessage          = "the import report is not available yet"
+	PharmacyProductImportMismatchMessage     = "product_id doesn't match the pharmacy product"
 )
@@ -8,12 +8,19 @@ const (
 )
 
 const (
-	MAX_IMAGE_SIZE = 500 * 1024 // 500 kb
+	MAX_IMAGE_SIZE          = 5 * 1024 * 1024 // 5 mb
+	IMAGE_MAX_DIMENSION     = 1200
+	THUMBNAIL_MAX_DIMENSION = 300
+)
+
+const (
+	BOUGHT_TOGETHER_LIMIT = 10
 )
 
 var (
 	UserAllowedSorts = map[string]string{
-		"price": "rfp.price",
+		"price":  "rfp.price",
+		"rating": "rfp.rating",
 	}
 	AdminAllowedSorts = map[string]string{
 		"date":  "p.created_at",
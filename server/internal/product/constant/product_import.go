@@ -0,0 +1,74 @@
+package constant
+
+const (
+	IMPORT_PENDING    = "PENDING"
+	IMPORT_PROCESSING = "PROCESSING"
+	IMPORT_COMPLETED  = "COMPLETED"
+	IMPORT_FAILED     = "FAILED"
+)
+
+const (
+	IMPORT_ROW_CREATED = "CREATED"
+	IMPORT_ROW_VALID   = "VALID"
+	IMPORT_ROW_FAILED  = "FAILED"
+)
+
+const (
+	MAX_IMPORT_FILE_SIZE   = 5 * 1024 * 1024  // 5 mb
+	MAX_IMPORT_IMAGES_SIZE = 50 * 1024 * 1024 // 50 mb
+	MAX_IMPORT_ROWS        = 1000
+	// the archive is read whole in memory, a row has at most four images
+	MAX_IMPORT_IMAGES           = 4 * MAX_IMPORT_ROWS
+	MAX_IMPORT_IMAGES_READ_SIZE = 100 * 1024 * 1024 // 100 mb
+)
+
+const (
+	IMPORT_CATEGORY_SEPARATOR = ";"
+)
+
+var (
+	ProductImportHeaders = []string{
+		"name",
+		"generic_name",
+		"description",
+		"manufacture_id",
+		"product_classification_id",
+		"product_form_id",
+		"unit_in_pack",
+		"selling_unit",
+		"weight",
+		"height",
+		"length",
+		"width",
+		"is_active",
+		"product_categories",
+		"thumbnail",
+		"image",
+		"secondary_image",
+		"tertiary_image",
+	}
+	ProductImportReportHeaders = []string{"row", "name", "status", "product_id", "message"}
+)
+
+const (
+	IMPORT_ROW_UPDATED = "UPDATED"
+)
+
+const (
+	MAX_PHARMACY_PRODUCT_IMPORT_ROWS = 5000
+)
+
+var (
+	PharmacyProductImportHeaders = []string{
+		"id",
+		"product_id",
+		"name",
+		"stock_quantity",
+		"price",
+		"is_active",
+		"sold_amount",
+		"created_at",
+		"updated_at",
+	}
+	PharmacyProductImportReportHeaders = []string{"row", "id", "product_id", "status", "message"}
+)
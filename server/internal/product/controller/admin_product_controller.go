@@ -1,8 +1,11 @@
 package controller
 
 import (
+	"fmt"
+	"net/http"
 	"strconv"
 
+	"healthcare-app/internal/auth/utils"
 	"healthcare-app/internal/product/dto"
 	"healthcare-app/internal/product/usecase"
 	"healthcare-app/pkg/apperror"
@@ -108,3 +111,53 @@ func (c *AdminProductController) DeleteProduct(ctx *gin.Context) {
 	}
 	ginutils.ResponseOKPlain(ctx)
 }
+
+func (c *AdminProductController) ImportProduct(ctx *gin.Context) {
+	req := new(dto.ImportProductRequest)
+	if err := ctx.ShouldBind(req); err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	req.AdminID = utils.GetValueUserIdFromToken(ctx)
+
+	res, err := c.productUseCase.Import(ctx, req)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+	ginutils.ResponseCreated(ctx, res)
+}
+
+func (c *AdminProductController) GetProductImport(ctx *gin.Context) {
+	importId, err := strconv.Atoi(ctx.Param("importId"))
+	if err != nil {
+		ctx.Error(apperror.NewInvalidIdError())
+		return
+	}
+
+	req := &dto.GetProductImportRequest{ID: int64(importId)}
+	res, err := c.productUseCase.GetImport(ctx, req)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+	ginutils.ResponseOK(ctx, res)
+}
+
+func (c *AdminProductController) DownloadProductImportReport(ctx *gin.Context) {
+	importId, err := strconv.Atoi(ctx.Param("importId"))
+	if err != nil {
+		ctx.Error(apperror.NewInvalidIdError())
+		return
+	}
+
+	req := &dto.GetProductImportRequest{ID: int64(importId)}
+	res, err := c.productUseCase.DownloadImportReport(ctx, req)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+	ctx.Header("Content-Disposition", fmt.Sprintf("attachment; filename=%q", res.FileName))
+	ctx.Data(http.StatusOK, "text/csv", res.Content)
+}
@@ -1,6 +1,8 @@
 package controller
 
 import (
+	"fmt"
+	"net/http"
 	"strconv"
 
 	"healthcare-app/internal/auth/utils"
@@ -172,3 +174,69 @@ func (c *PharmacistProductController) Delete(ctx *gin.Context) {
 	}
 	ginutils.ResponseOKPlain(ctx)
 }
+
+func (c *PharmacistProductController) Import(ctx *gin.Context) {
+	pharmacyID, err := strconv.Atoi(ctx.Param("pharmacyId"))
+	if err != nil {
+		ctx.Error(apperror.NewInvalidIdError())
+		return
+	}
+
+	req := &dto.ImportPharmacyProductRequest{PharmacistID: utils.GetValueUserIdFromToken(ctx), PharmacyID: int64(pharmacyID)}
+	if err := ctx.ShouldBind(req); err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	res, err := c.pharmacistProductUseCase.Import(ctx, req)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+	ginutils.ResponseCreated(ctx, res)
+}
+
+func (c *PharmacistProductController) GetImport(ctx *gin.Context) {
+	pharmacyID, err := strconv.Atoi(ctx.Param("pharmacyId"))
+	if err != nil {
+		ctx.Error(apperror.NewInvalidIdError())
+		return
+	}
+
+	importID, err := strconv.Atoi(ctx.Param("importId"))
+	if err != nil {
+		ctx.Error(apperror.NewInvalidIdError())
+		return
+	}
+
+	req := &dto.GetPharmacyProductImportRequest{ID: int64(importID), PharmacistID: utils.GetValueUserIdFromToken(ctx), PharmacyID: int64(pharmacyID)}
+	res, err := c.pharmacistProductUseCase.GetImport(ctx, req)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+	ginutils.ResponseOK(ctx, res)
+}
+
+func (c *PharmacistProductController) DownloadImportReport(ctx *gin.Context) {
+	pharmacyID, err := strconv.Atoi(ctx.Param("pharmacyId"))
+	if err != nil {
+		ctx.Error(apperror.NewInvalidIdError())
+		return
+	}
+
+	importID, err := strconv.Atoi(ctx.Param("importId"))
+	if err != nil {
+		ctx.Error(apperror.NewInvalidIdError())
+		return
+	}
+
+	req := &dto.GetPharmacyProductImportRequest{ID: int64(importID), PharmacistID: utils.GetValueUserIdFromToken(ctx), PharmacyID: int64(pharmacyID)}
+	res, err := c.pharmacistProductUseCase.DownloadImportReport(ctx, req)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+	ctx.Header("Content-Disposition", fmt.Sprintf("attachment; filename=%q", res.FileName))
+	ctx.Data(http.StatusOK, "text/csv", res.Content)
+}
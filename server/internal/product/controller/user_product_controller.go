@@ -94,3 +94,25 @@ func (c *UserProductController) Get(ctx *gin.Context) {
 	}
 	ginutils.ResponseOK(ctx, res)
 }
+
+func (c *UserProductController) ListAlternatives(ctx *gin.Context) {
+	productId, err := strconv.Atoi(ctx.Param("productId"))
+	if err != nil {
+		ctx.Error(apperror.NewInvalidIdError())
+		return
+	}
+
+	req := &dto.AlternativeProductRequest{ID: int64(productId), UserID: utils.GetValueUserIdFromToken(ctx)}
+	if err := ctx.ShouldBindQuery(req); err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	res, paging, err := c.userProductUseCase.ListAlternatives(ctx, req)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+	paging.Links = pageutils.CreateLinks(ctx.Request, int(paging.Page), int(paging.Size), int(paging.TotalItem), int(paging.TotalPage))
+	ginutils.ResponseOKPagination(ctx, res, paging)
+}
@@ -30,6 +30,7 @@ type ProductDetailResponse struct {
 	ProductCategories     []*ProductCategoryResponse     `json:"product_categories,omitempty"`
 	Name                  string                         `json:"name"`
 	GenericName           string                         `json:"generic_name"`
+	Strength              *string                        `json:"strength"`
 	Description           string                         `json:"description"`
 	UnitInPack            *string                        `json:"unit_in_pack"`
 	SellingUnit           *string                        `json:"selling_unit"`
@@ -44,10 +45,31 @@ type ProductDetailResponse struct {
 	ImageURL              *string                        `json:"image_url"`
 	SecondaryImageURL     *string                        `json:"secondary_image_url,omitempty"`
 	TertiaryImageURL      *string                        `json:"tertiary_image_url,omitempty"`
+	ThumbnailWebpURL      *string                        `json:"thumbnail_webp_url,omitempty"`
+	ImageWebpURL          *string                        `json:"image_webp_url,omitempty"`
 	IsActive              bool                           `json:"is_active"`
 	CreatedAt             time.Time                      `json:"created_at"`
 }
 
+// ProductAuditLog holds the fields an admin sets on a product, so a product
+// loaded from the database and one built from a request compare field by field.
+type ProductAuditLog struct {
+	ManufactureID           int64           `json:"manufacture_id"`
+	ProductClassificationID int64           `json:"product_classification_id"`
+	ProductFormID           *int64          `json:"product_form_id"`
+	Name                    string          `json:"name"`
+	GenericName             string          `json:"generic_name"`
+	Strength                *string         `json:"strength"`
+	Description             string          `json:"description"`
+	UnitInPack              *string         `json:"unit_in_pack"`
+	SellingUnit             *string         `json:"selling_unit"`
+	Weight                  decimal.Decimal `json:"weight"`
+	Height                  decimal.Decimal `json:"height"`
+	Length                  decimal.Decimal `json:"length"`
+	Width                   decimal.Decimal `json:"width"`
+	IsActive                bool            `json:"is_active"`
+}
+
 type CreateProductRequest struct {
 	ManufactureID           int64                 `form:"manufacture_id" binding:"required,numeric"`
 	ProductClassificationID int64                 `form:"product_classification_id" binding:"required,numeric"`
@@ -55,6 +77,7 @@ type CreateProductRequest struct {
 	ProductCategories       []int64               `form:"product_categories" binding:"required,min=1,max=20,no_duplicates,dive,required,numeric"`
 	Name                    string                `form:"name" binding:"required,max=75"`
 	GenericName             string                `form:"generic_name" binding:"required"`
+	Strength                *string               `form:"strength,omitempty"`
 	Description             string                `form:"description" binding:"required"`
 	UnitInPack              *string               `form:"unit_in_pack,omitempty"`
 	SellingUnit             *string               `form:"selling_unit,omitempty"`
@@ -63,7 +86,7 @@ type CreateProductRequest struct {
 	Length                  *decimal.Decimal      `form:"length" binding:"required,dgt=0"`
 	Width                   *decimal.Decimal      `form:"width" binding:"required,dgt=0"`
 	IsActive                bool                  `form:"is_active" binding:"required,boolean"`
-	Thumbnail               *multipart.FileHeader `form:"thumbnail" binding:"required"`
+	Thumbnail               *multipart.FileHeader `form:"thumbnail"`
 	Image                   *multipart.FileHeader `form:"image" binding:"required"`
 	SecondaryImage          *multipart.FileHeader `form:"secondary_image"`
 	TertiaryImage           *multipart.FileHeader `form:"tertiary_image"`
@@ -77,6 +100,7 @@ type UpdateProductRequest struct {
 	ProductCategories       []int64               `form:"product_categories" binding:"required,min=1,max=20,no_duplicates,dive,required,numeric"`
 	Name                    string                `form:"name" binding:"required,max=75"`
 	GenericName             string                `form:"generic_name" binding:"required"`
+	Strength                *string               `form:"strength,omitempty"`
 	Description             string                `form:"description" binding:"required"`
 	UnitInPack              *string               `form:"unit_in_pack,omitempty"`
 	SellingUnit             *string               `form:"selling_unit,omitempty"`
@@ -130,6 +154,14 @@ type HomeProductRequest struct {
 	UserID   int64  `form:"-"`
 }
 
+type AlternativeProductRequest struct {
+	ID       int64  `form:"-"`
+	Location string `form:"-"`
+	Limit    int64  `form:"limit" binding:"numeric,gte=1,lte=25"`
+	Page     int64  `form:"page" binding:"numeric,gte=1"`
+	UserID   int64  `form:"-"`
+}
+
 func ConvertToProductResponses(products []*entity.Product) []*ProductResponse {
 	res := []*ProductResponse{}
 	for _, product := range products {
@@ -163,6 +195,7 @@ func ConvertToProductDetailResponse(product *entity.Product) *ProductDetailRespo
 		PharmacyProductID: product.PharmacyProductID,
 		Name:              product.Name,
 		GenericName:       product.GenericName,
+		Strength:          product.Strength,
 		Description:       product.Description,
 		UnitInPack:        product.UnitInPack,
 		SellingUnit:       product.SellingUnit,
@@ -177,6 +210,8 @@ func ConvertToProductDetailResponse(product *entity.Product) *ProductDetailRespo
 		ImageURL:          product.ImageURL,
 		SecondaryImageURL: product.SecondaryImageURL,
 		TertiaryImageURL:  product.TertiaryImageURL,
+		ThumbnailWebpURL:  product.ThumbnailWebpURL,
+		ImageWebpURL:      product.ImageWebpURL,
 		IsActive:          product.IsActive,
 		CreatedAt:         product.CreatedAt,
 	}
@@ -197,6 +232,28 @@ func ConvertToProductDetailResponse(product *entity.Product) *ProductDetailRespo
 	return res
 }
 
+func ConvertToProductAuditLog(product *entity.Product) *ProductAuditLog {
+	res := &ProductAuditLog{
+		ManufactureID:           product.Manufacture.ID,
+		ProductClassificationID: product.ProductClassification.ID,
+		Name:                    product.Name,
+		GenericName:             product.GenericName,
+		Strength:                product.Strength,
+		Description:             product.Description,
+		UnitInPack:              product.UnitInPack,
+		SellingUnit:             product.SellingUnit,
+		Weight:                  product.Weight,
+		Height:                  product.Height,
+		Length:                  product.Length,
+		Width:                   product.Width,
+		IsActive:                product.IsActive,
+	}
+	if product.ProductForm != nil {
+		res.ProductFormID = product.ProductForm.ID
+	}
+	return res
+}
+
 func CreateRequestToProductEntity(request *CreateProductRequest) *entity.Product {
 	var productForm *entity.ProductForm
 	if request.ProductFormID != nil {
@@ -209,6 +266,7 @@ func CreateRequestToProductEntity(request *CreateProductRequest) *entity.Product
 		ProductForm:           productForm,
 		Name:                  request.Name,
 		GenericName:           request.GenericName,
+		Strength:              request.Strength,
 		Description:           request.Description,
 		UnitInPack:            request.UnitInPack,
 		SellingUnit:           request.SellingUnit,
@@ -233,6 +291,7 @@ func UpdateRequestToProductEntity(request *UpdateProductRequest) *entity.Product
 		ProductForm:           productForm,
 		Name:                  request.Name,
 		GenericName:           request.GenericName,
+		Strength:              request.Strength,
 		Description:           request.Description,
 		UnitInPack:            request.UnitInPack,
 		SellingUnit:           request.SellingUnit,
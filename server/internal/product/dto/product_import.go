@@ -0,0 +1,188 @@
+package dto
+
+import (
+	"mime/multipart"
+	"time"
+
+	"healthcare-app/internal/product/constant"
+	"healthcare-app/internal/product/entity"
+
+	"github.com/shopspring/decimal"
+)
+
+type ProductImportResponse struct {
+	ID          int64     `json:"id"`
+	FileName    string    `json:"file_name"`
+	Status      string    `json:"status"`
+	IsDryRun    bool      `json:"is_dry_run"`
+	TotalRows   int64     `json:"total_rows"`
+	SuccessRows int64     `json:"success_rows"`
+	FailedRows  int64     `json:"failed_rows"`
+	CreatedAt   time.Time `json:"created_at"`
+	UpdatedAt   time.Time `json:"updated_at"`
+}
+
+type ImportProductRequest struct {
+	File    *multipart.FileHeader `form:"file" binding:"required"`
+	Images  *multipart.FileHeader `form:"images"`
+	DryRun  bool                  `form:"dry_run" binding:"omitempty,boolean"`
+	AdminID int64                 `form:"-"`
+}
+
+type GetProductImportRequest struct {
+	ID int64 `json:"-"`
+}
+
+type ProductImportReport struct {
+	FileName string
+	Content  []byte
+}
+
+type ProductImportRow struct {
+	ManufactureID           int64
+	ProductClassificationID int64
+	ProductFormID           *int64
+	ProductCategories       []int64
+	Name                    string
+	GenericName             string
+	Description             string
+	UnitInPack              *string
+	SellingUnit             *string
+	Weight                  decimal.Decimal
+	Height                  decimal.Decimal
+	Length                  decimal.Decimal
+	Width                   decimal.Decimal
+	IsActive                bool
+	Thumbnail               string
+	Image                   string
+	SecondaryImage          string
+	TertiaryImage           string
+}
+
+type ProductImportRowResult struct {
+	Row       int
+	Name      string
+	Status    string
+	ProductID int64
+	Message   string
+}
+
+func ConvertToProductImportResponse(productImport *entity.ProductImport) *ProductImportResponse {
+	return &ProductImportResponse{
+		ID:          productImport.ID,
+		FileName:    productImport.FileName,
+		Status:      productImport.Status,
+		IsDryRun:    productImport.IsDryRun,
+		TotalRows:   productImport.TotalRows,
+		SuccessRows: productImport.SuccessRows,
+		FailedRows:  productImport.FailedRows,
+		CreatedAt:   productImport.CreatedAt,
+		UpdatedAt:   productImport.UpdatedAt,
+	}
+}
+
+func ImportRequestToProductImportEntity(request *ImportProductRequest, totalRows int64) *entity.ProductImport {
+	return &entity.ProductImport{
+		AdminID:   request.AdminID,
+		FileName:  request.File.Filename,
+		Status:    constant.IMPORT_PENDING,
+		IsDryRun:  request.DryRun,
+		TotalRows: totalRows,
+	}
+}
+
+func ProductImportRowToProductEntity(row *ProductImportRow) *entity.Product {
+	return &entity.Product{
+		Manufacture:           entity.Manufacture{ID: row.ManufactureID},
+		ProductClassification: entity.ProductClassification{ID: row.ProductClassificationID},
+		ProductForm:           &entity.ProductForm{ID: row.ProductFormID},
+		Name:                  row.Name,
+		GenericName:           row.GenericName,
+		Description:           row.Description,
+		UnitInPack:            row.UnitInPack,
+		SellingUnit:           row.SellingUnit,
+		Height:                row.Height,
+		Weight:                row.Weight,
+		Length:                row.Length,
+		Width:                 row.Width,
+		IsActive:              row.IsActive,
+	}
+}
+
+type PharmacyProductImportResponse struct {
+	ID          int64     `json:"id"`
+	PharmacyID  int64     `json:"pharmacy_id"`
+	FileName    string    `json:"file_name"`
+	Status      string    `json:"status"`
+	TotalRows   int64     `json:"total_rows"`
+	SuccessRows int64     `json:"success_rows"`
+	FailedRows  int64     `json:"failed_rows"`
+	CreatedAt   time.Time `json:"created_at"`
+	UpdatedAt   time.Time `json:"updated_at"`
+}
+
+type ImportPharmacyProductRequest struct {
+	File         *multipart.FileHeader `form:"file" binding:"required"`
+	PharmacistID int64                 `form:"-"`
+	PharmacyID   int64                 `form:"-"`
+}
+
+type GetPharmacyProductImportRequest struct {
+	ID           int64 `json:"-"`
+	PharmacistID int64 `json:"-"`
+	PharmacyID   int64 `json:"-"`
+}
+
+type PharmacyProductImportRow struct {
+	ID            *int64
+	ProductID     int64
+	StockQuantity int64
+	Price         decimal.Decimal
+	IsActive      bool
+}
+
+type PharmacyProductImportRowResult struct {
+	Row       int
+	ID        int64
+	ProductID int64
+	Status    string
+	Message   string
+}
+
+func ConvertToPharmacyProductImportResponse(productImport *entity.PharmacyProductImport) *PharmacyProductImportResponse {
+	return &PharmacyProductImportResponse{
+		ID:          productImport.ID,
+		PharmacyID:  productImport.PharmacyID,
+		FileName:    productImport.FileName,
+		Status:      productImport.Status,
+		TotalRows:   productImport.TotalRows,
+		SuccessRows: productImport.SuccessRows,
+		FailedRows:  productImport.FailedRows,
+		CreatedAt:   productImport.CreatedAt,
+		UpdatedAt:   productImport.UpdatedAt,
+	}
+}
+
+func ImportRequestToPharmacyProductImportEntity(request *ImportPharmacyProductRequest, totalRows int64) *entity.PharmacyProductImport {
+	return &entity.PharmacyProductImport{
+		PharmacyID:   request.PharmacyID,
+		PharmacistID: request.PharmacistID,
+		FileName:     request.File.Filename,
+		Status:       constant.IMPORT_PENDING,
+		TotalRows:    totalRows,
+	}
+}
+
+func PharmacyProductImportRowToEntity(row *PharmacyProductImportRow, pharmacyID int64) *entity.PharmacyProduct {
+	pharmacyProduct := &entity.PharmacyProduct{
+		PharmacyId:    pharmacyID,
+		Product:       entity.Product{ID: row.ProductID},
+		StockQuantity: row.StockQuantity,
+		Price:         row.Price,
+		IsActive:      row.IsActive,
+	}
+	if row.ID != nil {
+		pharmacyProduct.ID = *row.ID
+	}
+	return pharmacyProduct
+}
@@ -18,6 +18,7 @@ type UserProductDetailResponse struct {
 	ProductCategories     []*ProductCategoryResponse     `json:"product_categories,omitempty"`
 	Name                  string                         `json:"name"`
 	GenericName           string                         `json:"generic_name"`
+	Strength              *string                        `json:"strength"`
 	Description           string                         `json:"description"`
 	UnitInPack            *string                        `json:"unit_in_pack"`
 	SellingUnit           *string                        `json:"selling_unit"`
@@ -32,6 +33,11 @@ type UserProductDetailResponse struct {
 	ImageURL              *string                        `json:"image_url"`
 	SecondaryImageURL     *string                        `json:"secondary_image_url,omitempty"`
 	TertiaryImageURL      *string                        `json:"tertiary_image_url,omitempty"`
+	ThumbnailWebpURL      *string                        `json:"thumbnail_webp_url,omitempty"`
+	ImageWebpURL          *string                        `json:"image_webp_url,omitempty"`
+	RatingAverage         decimal.Decimal                `json:"rating_average"`
+	RatingCount           int64                          `json:"rating_count"`
+	BoughtTogether        []*ProductResponse             `json:"customers_also_bought"`
 	IsActive              bool                           `json:"is_active"`
 	CreatedAt             time.Time                      `json:"created_at"`
 }
@@ -71,6 +77,7 @@ func ConvertToUserProductDetailResponse(product *entity.Product, pharmacy *entit
 		},
 		Name:              product.Name,
 		GenericName:       product.GenericName,
+		Strength:          product.Strength,
 		Description:       product.Description,
 		UnitInPack:        product.UnitInPack,
 		SellingUnit:       product.SellingUnit,
@@ -85,6 +92,10 @@ func ConvertToUserProductDetailResponse(product *entity.Product, pharmacy *entit
 		ImageURL:          product.ImageURL,
 		SecondaryImageURL: product.SecondaryImageURL,
 		TertiaryImageURL:  product.TertiaryImageURL,
+		ThumbnailWebpURL:  product.ThumbnailWebpURL,
+		ImageWebpURL:      product.ImageWebpURL,
+		RatingAverage:     product.RatingAverage,
+		RatingCount:       product.RatingCount,
 		IsActive:          product.IsActive,
 		CreatedAt:         product.CreatedAt,
 	}
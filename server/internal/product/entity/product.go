@@ -15,6 +15,7 @@ type Product struct {
 	PharmacyProductID     int64
 	Name                  string
 	GenericName           string
+	Strength              *string
 	Description           string
 	UnitInPack            *string
 	SellingUnit           *string
@@ -29,6 +30,10 @@ type Product struct {
 	ImageURL              *string
 	SecondaryImageURL     *string
 	TertiaryImageURL      *string
+	ThumbnailWebpURL      *string
+	ImageWebpURL          *string
+	RatingAverage         decimal.Decimal
+	RatingCount           int64
 	IsActive              bool
 	CreatedAt             time.Time
 	UpdatedAt             time.Time
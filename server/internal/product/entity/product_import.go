@@ -0,0 +1,31 @@
+package entity
+
+import "time"
+
+type ProductImport struct {
+	ID          int64
+	AdminID     int64
+	FileName    string
+	Status      string
+	IsDryRun    bool
+	TotalRows   int64
+	SuccessRows int64
+	FailedRows  int64
+	Report      *string
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
+
+type PharmacyProductImport struct {
+	ID           int64
+	PharmacyID   int64
+	PharmacistID int64
+	FileName     string
+	Status       string
+	TotalRows    int64
+	SuccessRows  int64
+	FailedRows   int64
+	Report       *string
+	CreatedAt    time.Time
+	UpdatedAt    time.Time
+}
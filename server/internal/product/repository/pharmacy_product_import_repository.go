@@ -0,0 +1,164 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"healthcare-app/internal/product/entity"
+	apperrorPkg "healthcare-app/pkg/apperror"
+	"healthcare-app/pkg/database/transactor"
+)
+
+type PharmacyProductImportRepository interface {
+	FindByID(ctx context.Context, id, pharmacyID int64) (*entity.PharmacyProductImport, error)
+	Save(ctx context.Context, productImport *entity.PharmacyProductImport) error
+	UpdateStatus(ctx context.Context, id int64, status string) error
+	Complete(ctx context.Context, productImport *entity.PharmacyProductImport) error
+}
+
+type pharmacyProductImportRepositoryImpl struct {
+	db *sql.DB
+}
+
+func NewPharmacyProductImportRepository(db *sql.DB) *pharmacyProductImportRepositoryImpl {
+	return &pharmacyProductImportRepositoryImpl{
+		db: db,
+	}
+}
+
+func (r *pharmacyProductImportRepositoryImpl) FindByID(ctx context.Context, id, pharmacyID int64) (*entity.PharmacyProductImport, error) {
+	query := `
+		select id, pharmacy_id, pharmacist_id, file_name, import_status, total_rows, success_rows, failed_rows, report, created_at, updated_at
+		from pharmacy_product_imports
+		where id = $1 and pharmacy_id = $2
+	`
+	tx := transactor.ExtractTx(ctx)
+
+	var (
+		err           error
+		productImport = new(entity.PharmacyProductImport)
+	)
+	if tx != nil {
+		err = tx.QueryRowContext(ctx, query, id, pharmacyID).Scan(
+			&productImport.ID,
+			&productImport.PharmacyID,
+			&productImport.PharmacistID,
+			&productImport.FileName,
+			&productImport.Status,
+			&productImport.TotalRows,
+			&productImport.SuccessRows,
+			&productImport.FailedRows,
+			&productImport.Report,
+			&productImport.CreatedAt,
+			&productImport.UpdatedAt,
+		)
+	} else {
+		err = r.db.QueryRowContext(ctx, query, id, pharmacyID).Scan(
+			&productImport.ID,
+			&productImport.PharmacyID,
+			&productImport.PharmacistID,
+			&productImport.FileName,
+			&productImport.Status,
+			&productImport.TotalRows,
+			&productImport.SuccessRows,
+			&productImport.FailedRows,
+			&productImport.Report,
+			&productImport.CreatedAt,
+			&productImport.UpdatedAt,
+		)
+	}
+
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, apperrorPkg.NewEntityNotFoundError("pharmacy product import")
+		}
+		return nil, err
+	}
+	return productImport, nil
+}
+
+func (r *pharmacyProductImportRepositoryImpl) Save(ctx context.Context, productImport *entity.PharmacyProductImport) error {
+	query := `
+		insert into pharmacy_product_imports(pharmacy_id, pharmacist_id, file_name, import_status, total_rows)
+		values ($1, $2, $3, $4, $5) returning id, created_at, updated_at
+	`
+	tx := transactor.ExtractTx(ctx)
+
+	var err error
+	if tx != nil {
+		err = tx.QueryRowContext(
+			ctx,
+			query,
+			productImport.PharmacyID,
+			productImport.PharmacistID,
+			productImport.FileName,
+			productImport.Status,
+			productImport.TotalRows,
+		).Scan(&productImport.ID, &productImport.CreatedAt, &productImport.UpdatedAt)
+	} else {
+		err = r.db.QueryRowContext(
+			ctx,
+			query,
+			productImport.PharmacyID,
+			productImport.PharmacistID,
+			productImport.FileName,
+			productImport.Status,
+			productImport.TotalRows,
+		).Scan(&productImport.ID, &productImport.CreatedAt, &productImport.UpdatedAt)
+	}
+
+	return err
+}
+
+func (r *pharmacyProductImportRepositoryImpl) UpdateStatus(ctx context.Context, id int64, status string) error {
+	query := `
+		update pharmacy_product_imports set import_status = $2, updated_at = now() where id = $1
+	`
+	tx := transactor.ExtractTx(ctx)
+
+	var err error
+	if tx != nil {
+		_, err = tx.ExecContext(ctx, query, id, status)
+	} else {
+		_, err = r.db.ExecContext(ctx, query, id, status)
+	}
+
+	return err
+}
+
+func (r *pharmacyProductImportRepositoryImpl) Complete(ctx context.Context, productImport *entity.PharmacyProductImport) error {
+	query := `
+		update pharmacy_product_imports
+		set import_status = $2, total_rows = $3, success_rows = $4, failed_rows = $5, report = $6, updated_at = now()
+		where id = $1
+	`
+	tx := transactor.ExtractTx(ctx)
+
+	var err error
+	if tx != nil {
+		_, err = tx.ExecContext(
+			ctx,
+			query,
+			productImport.ID,
+			productImport.Status,
+			productImport.TotalRows,
+			productImport.SuccessRows,
+			productImport.FailedRows,
+			productImport.Report,
+		)
+	} else {
+		_, err = r.db.ExecContext(
+			ctx,
+			query,
+			productImport.ID,
+			productImport.Status,
+			productImport.TotalRows,
+			productImport.SuccessRows,
+			productImport.FailedRows,
+			productImport.Report,
+		)
+	}
+
+	return err
+}
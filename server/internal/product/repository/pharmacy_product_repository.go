@@ -375,7 +375,7 @@ func (r *pharmacyProductRepositoryImpl) Save(ctx context.Context, entity *entity
 
 func (r *pharmacyProductRepositoryImpl) Update(ctx context.Context, entity *entity.PharmacyProduct) error {
 	query := `
-		update pharmacy_products set stock_quantity = $3, is_active = $4, updated_at = now()
+		update pharmacy_products set stock_quantity = $3, is_active = $4, price = $5, updated_at = now()
 	`
 	if entity.StockQuantityUpdatedAt != nil {
 		query = fmt.Sprintf("%v, stock_quantity_updated_at = now()", query)
@@ -395,6 +395,7 @@ func (r *pharmacyProductRepositoryImpl) Update(ctx context.Context, entity *enti
 			entity.PharmacyId,
 			entity.StockQuantity,
 			entity.IsActive,
+			entity.Price,
 		)
 	} else {
 		result, err = r.db.ExecContext(
@@ -404,6 +405,7 @@ func (r *pharmacyProductRepositoryImpl) Update(ctx context.Context, entity *enti
 			entity.PharmacyId,
 			entity.StockQuantity,
 			entity.IsActive,
+			entity.Price,
 		)
 	}
 
@@ -0,0 +1,164 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"healthcare-app/internal/product/entity"
+	apperrorPkg "healthcare-app/pkg/apperror"
+	"healthcare-app/pkg/database/transactor"
+)
+
+type ProductImportRepository interface {
+	FindByID(ctx context.Context, id int64) (*entity.ProductImport, error)
+	Save(ctx context.Context, productImport *entity.ProductImport) error
+	UpdateStatus(ctx context.Context, id int64, status string) error
+	Complete(ctx context.Context, productImport *entity.ProductImport) error
+}
+
+type productImportRepositoryImpl struct {
+	db *sql.DB
+}
+
+func NewProductImportRepository(db *sql.DB) *productImportRepositoryImpl {
+	return &productImportRepositoryImpl{
+		db: db,
+	}
+}
+
+func (r *productImportRepositoryImpl) FindByID(ctx context.Context, id int64) (*entity.ProductImport, error) {
+	query := `
+		select id, admin_id, file_name, import_status, is_dry_run, total_rows, success_rows, failed_rows, report, created_at, updated_at
+		from product_imports
+		where id = $1
+	`
+	tx := transactor.ExtractTx(ctx)
+
+	var (
+		err           error
+		productImport = new(entity.ProductImport)
+	)
+	if tx != nil {
+		err = tx.QueryRowContext(ctx, query, id).Scan(
+			&productImport.ID,
+			&productImport.AdminID,
+			&productImport.FileName,
+			&productImport.Status,
+			&productImport.IsDryRun,
+			&productImport.TotalRows,
+			&productImport.SuccessRows,
+			&productImport.FailedRows,
+			&productImport.Report,
+			&productImport.CreatedAt,
+			&productImport.UpdatedAt,
+		)
+	} else {
+		err = r.db.QueryRowContext(ctx, query, id).Scan(
+			&productImport.ID,
+			&productImport.AdminID,
+			&productImport.FileName,
+			&productImport.Status,
+			&productImport.IsDryRun,
+			&productImport.TotalRows,
+			&productImport.SuccessRows,
+			&productImport.FailedRows,
+			&productImport.Report,
+			&productImport.CreatedAt,
+			&productImport.UpdatedAt,
+		)
+	}
+
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, apperrorPkg.NewEntityNotFoundError("product import")
+		}
+		return nil, err
+	}
+	return productImport, nil
+}
+
+func (r *productImportRepositoryImpl) Save(ctx context.Context, productImport *entity.ProductImport) error {
+	query := `
+		insert into product_imports(admin_id, file_name, import_status, is_dry_run, total_rows)
+		values ($1, $2, $3, $4, $5) returning id, created_at, updated_at
+	`
+	tx := transactor.ExtractTx(ctx)
+
+	var err error
+	if tx != nil {
+		err = tx.QueryRowContext(
+			ctx,
+			query,
+			productImport.AdminID,
+			productImport.FileName,
+			productImport.Status,
+			productImport.IsDryRun,
+			productImport.TotalRows,
+		).Scan(&productImport.ID, &productImport.CreatedAt, &productImport.UpdatedAt)
+	} else {
+		err = r.db.QueryRowContext(
+			ctx,
+			query,
+			productImport.AdminID,
+			productImport.FileName,
+			productImport.Status,
+			productImport.IsDryRun,
+			productImport.TotalRows,
+		).Scan(&productImport.ID, &productImport.CreatedAt, &productImport.UpdatedAt)
+	}
+
+	return err
+}
+
+func (r *productImportRepositoryImpl) UpdateStatus(ctx context.Context, id int64, status string) error {
+	query := `
+		update product_imports set import_status = $2, updated_at = now() where id = $1
+	`
+	tx := transactor.ExtractTx(ctx)
+
+	var err error
+	if tx != nil {
+		_, err = tx.ExecContext(ctx, query, id, status)
+	} else {
+		_, err = r.db.ExecContext(ctx, query, id, status)
+	}
+
+	return err
+}
+
+func (r *productImportRepositoryImpl) Complete(ctx context.Context, productImport *entity.ProductImport) error {
+	query := `
+		update product_imports
+		set import_status = $2, total_rows = $3, success_rows = $4, failed_rows = $5, report = $6, updated_at = now()
+		where id = $1
+	`
+	tx := transactor.ExtractTx(ctx)
+
+	var err error
+	if tx != nil {
+		_, err = tx.ExecContext(
+			ctx,
+			query,
+			productImport.ID,
+			productImport.Status,
+			productImport.TotalRows,
+			productImport.SuccessRows,
+			productImport.FailedRows,
+			productImport.Report,
+		)
+	} else {
+		_, err = r.db.ExecContext(
+			ctx,
+			query,
+			productImport.ID,
+			productImport.Status,
+			productImport.TotalRows,
+			productImport.SuccessRows,
+			productImport.FailedRows,
+			productImport.Report,
+		)
+	}
+
+	return err
+}
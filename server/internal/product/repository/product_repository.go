@@ -20,13 +20,48 @@ import (
 
 const iLike = "%%%v%%"
 
+const nearbyPharmaciesQuery = `
+	WITH params AS (
+		SELECT 
+			ST_Y(ST_GeomFromText($1)) AS user_lat,
+			ST_X(ST_GeomFromText($1)) AS user_lon
+	),
+	pharmacies_bb AS (
+		SELECT 
+			ph.id,
+			ph.partner_id,
+			ph.location,
+			ph.is_active
+		FROM
+			pharmacies ph, params
+		WHERE
+			ST_Within(ph.location, ST_MakeEnvelope(
+				user_lon - (0.009 * 25), user_lat - (0.009 * 25),
+				user_lon + (0.009 * 25), user_lat + (0.009 * 25),
+				4326
+			))
+		LIMIT 5000
+	),
+	nearby_pharmacies AS (
+		SELECT 
+			ph.id
+		FROM pharmacies_bb ph
+		JOIN pharmacy_partners pa ON pa.id = ph.partner_id
+		WHERE ST_DWithin(ph.location, ST_SetSRID($1, 4326), 25000)
+			AND ph.is_active = true
+			AND CURRENT_TIME BETWEEN pa.start_opt AND pa.end_opt
+	)`
+
 type ProductRepository interface {
 	RefreshView(ctx context.Context) error
+	RefreshCoPurchaseView(ctx context.Context) error
 	IsExists(ctx context.Context, entity *entity.Product) bool
 	IsExistsByID(ctx context.Context, id int64) bool
 	MostBoughtToday(ctx context.Context) ([]*entity.Product, error)
 	MostBoughtAllTime(ctx context.Context) ([]*entity.Product, error)
 	FastestCheapestNearest(ctx context.Context, request *dto.HomeProductRequest) ([]*entity.Product, error)
+	FindAlternatives(ctx context.Context, request *dto.AlternativeProductRequest) ([]*entity.Product, error)
+	FindAllBoughtTogether(ctx context.Context, productIDs []int64, pharmacyID int64, limit int64) ([]*entity.Product, error)
 	Search(ctx context.Context, request *dto.SearchProductRequest) ([]*entity.Product, error)
 	FindByID(ctx context.Context, id int64) (*entity.Product, error)
 	Save(ctx context.Context, entity *entity.Product) error
@@ -65,6 +100,20 @@ func (r *productRepositoryImpl) RefreshView(ctx context.Context) error {
 	return err
 }
 
+func (r *productRepositoryImpl) RefreshCoPurchaseView(ctx context.Context) error {
+	query := `SELECT refresh_product_co_purchases_view()`
+	tx := transactor.ExtractTx(ctx)
+
+	var err error
+	if tx != nil {
+		_, err = tx.ExecContext(ctx, query)
+	} else {
+		_, err = r.db.ExecContext(ctx, query)
+	}
+
+	return err
+}
+
 func (r *productRepositoryImpl) IsExists(ctx context.Context, entity *entity.Product) bool {
 	query := `
 		select exists(select 1 from products where name = $1 and generic_name = $2 and manufacture_id = $3 and deleted_at is null)
@@ -111,38 +160,13 @@ func (r *productRepositoryImpl) IsExistsByID(ctx context.Context, id int64) bool
 
 func (r *productRepositoryImpl) FastestCheapestNearest(ctx context.Context, request *dto.HomeProductRequest) ([]*entity.Product, error) {
 	tx := transactor.ExtractTx(ctx)
-	query := `
-	WITH params AS (
-		SELECT 
-			ST_Y(ST_GeomFromText($1)) AS user_lat,
-			ST_X(ST_GeomFromText($1)) AS user_lon
-	),
-	pharmacies_bb AS (
-		SELECT 
-			ph.id,
-			ph.partner_id,
-			ph.location,
-			ph.is_active
-		FROM
-			pharmacies ph, params
-		WHERE
-			ST_Within(ph.location, ST_MakeEnvelope(
-				user_lon - (0.009 * 25), user_lat - (0.009 * 25),
-				user_lon + (0.009 * 25), user_lat + (0.009 * 25),
-				4326
-			))
-		LIMIT 5000
-	),
+	query := nearbyPharmaciesQuery + `,
 	fastest_pharmacies AS (
 		SELECT DISTINCT ON (pl.pharmacy_id) 
 			pl.pharmacy_id
 		FROM pharmacy_logistics pl
 		JOIN logistics l ON pl.logistic_id = l.id  
-		JOIN pharmacies_bb ph ON ph.id = pl.pharmacy_id
-		JOIN pharmacy_partners pa ON pa.id = ph.partner_id
-		WHERE ST_DWithin(ph.location, ST_SetSRID($1, 4326), 25000)
-			AND ph.is_active = true
-			AND CURRENT_TIME BETWEEN pa.start_opt AND pa.end_opt
+		JOIN nearby_pharmacies np ON np.id = pl.pharmacy_id
 		ORDER BY pl.pharmacy_id, l.max_delivery ASC
 		LIMIT 1000
 	),
@@ -338,6 +362,178 @@ func (r *productRepositoryImpl) MostBoughtAllTime(ctx context.Context) ([]*entit
 	return products, nil
 }
 
+func (r *productRepositoryImpl) FindAlternatives(ctx context.Context, request *dto.AlternativeProductRequest) ([]*entity.Product, error) {
+	tx := transactor.ExtractTx(ctx)
+	query := nearbyPharmaciesQuery + `,
+	source_product AS (
+		SELECT 
+			id, 
+			generic_name, 
+			product_form_id, 
+			strength
+		FROM products
+		WHERE id = $2 AND deleted_at IS NULL
+	),
+	cheapest_alternatives AS (
+		SELECT 
+			pp.product_id, 
+			pp.id AS pharmacy_product_id, 
+			pp.price, 
+			pp.stock_quantity, 
+			ROW_NUMBER() OVER (PARTITION BY pp.product_id ORDER BY pp.price ASC) AS rank 
+		FROM nearby_pharmacies np 
+		JOIN pharmacy_products pp ON pp.pharmacy_id = np.id 
+		JOIN products p ON p.id = pp.product_id 
+		JOIN source_product sp ON LOWER(p.generic_name) = LOWER(sp.generic_name) 
+			AND p.product_form_id IS NOT DISTINCT FROM sp.product_form_id 
+			AND LOWER(p.strength) IS NOT DISTINCT FROM LOWER(sp.strength) 
+		WHERE p.id <> sp.id 
+			AND p.is_active = true 
+			AND p.deleted_at IS NULL 
+			AND pp.is_active = true 
+			AND pp.deleted_at IS NULL 
+			AND pp.stock_quantity > 0
+	)
+	SELECT  
+		pc.id AS product_classification_id, 
+		pc.name AS product_classification_name, 
+		ca.pharmacy_product_id, 
+		ca.product_id, 
+		p.name AS product_name, 
+		ca.stock_quantity, 
+		ca.price, 
+		p.sold_amount, 
+		p.selling_unit, 
+		p.thumbnail_url 
+	FROM cheapest_alternatives ca 
+	JOIN products p ON ca.product_id = p.id 
+	JOIN product_classifications pc ON p.product_classification_id = pc.id 
+	WHERE ca.rank = 1 
+	ORDER BY ca.price ASC 
+	LIMIT 500
+	`
+
+	var (
+		err  error
+		rows *sql.Rows
+	)
+	if tx != nil {
+		rows, err = tx.QueryContext(ctx, query, request.Location, request.ID)
+	} else {
+		rows, err = r.db.QueryContext(ctx, query, request.Location, request.ID)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	products := []*entity.Product{}
+	for rows.Next() {
+		product := &entity.Product{ProductClassification: entity.ProductClassification{}}
+		if err := rows.Scan(
+			&product.ProductClassification.ID,
+			&product.ProductClassification.Name,
+			&product.PharmacyProductID,
+			&product.ID,
+			&product.Name,
+			&product.StockQuantity,
+			&product.Price,
+			&product.SoldAmount,
+			&product.SellingUnit,
+			&product.ThumbnailURL,
+		); err != nil {
+			return nil, err
+		}
+		products = append(products, product)
+	}
+
+	err = rows.Err()
+	if err != nil {
+		return nil, err
+	}
+
+	return products, nil
+}
+
+func (r *productRepositoryImpl) FindAllBoughtTogether(ctx context.Context, productIDs []int64, pharmacyID int64, limit int64) ([]*entity.Product, error) {
+	tx := transactor.ExtractTx(ctx)
+	query := `
+	WITH co_purchases AS (
+		SELECT 
+			related_product_id, 
+			SUM(frequency) AS frequency
+		FROM product_co_purchases
+		WHERE product_id = ANY($1) AND related_product_id <> ALL($1)
+		GROUP BY related_product_id
+	)
+	SELECT  
+		pc.id AS product_classification_id, 
+		pc.name AS product_classification_name, 
+		pp.id AS pharmacy_product_id, 
+		p.id AS product_id, 
+		p.name AS product_name, 
+		pp.stock_quantity, 
+		pp.price, 
+		p.sold_amount, 
+		p.selling_unit, 
+		p.thumbnail_url 
+	FROM co_purchases cp 
+	JOIN products p ON cp.related_product_id = p.id 
+	JOIN pharmacy_products pp ON pp.product_id = p.id AND pp.pharmacy_id = $2 
+	JOIN product_classifications pc ON p.product_classification_id = pc.id 
+	WHERE p.is_active = true 
+		AND p.deleted_at IS NULL 
+		AND pp.is_active = true 
+		AND pp.deleted_at IS NULL 
+		AND pp.stock_quantity > 0
+	ORDER BY cp.frequency DESC, p.sold_amount DESC 
+	LIMIT $3
+	`
+
+	var (
+		err  error
+		rows *sql.Rows
+	)
+	if tx != nil {
+		rows, err = tx.QueryContext(ctx, query, productIDs, pharmacyID, limit)
+	} else {
+		rows, err = r.db.QueryContext(ctx, query, productIDs, pharmacyID, limit)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	products := []*entity.Product{}
+	for rows.Next() {
+		product := &entity.Product{ProductClassification: entity.ProductClassification{}}
+		if err := rows.Scan(
+			&product.ProductClassification.ID,
+			&product.ProductClassification.Name,
+			&product.PharmacyProductID,
+			&product.ID,
+			&product.Name,
+			&product.StockQuantity,
+			&product.Price,
+			&product.SoldAmount,
+			&product.SellingUnit,
+			&product.ThumbnailURL,
+		); err != nil {
+			return nil, err
+		}
+		products = append(products, product)
+	}
+
+	err = rows.Err()
+	if err != nil {
+		return nil, err
+	}
+
+	return products, nil
+}
+
 func (r *productRepositoryImpl) Search(ctx context.Context, request *dto.SearchProductRequest) ([]*entity.Product, error) {
 	tx := transactor.ExtractTx(ctx)
 
@@ -512,10 +708,11 @@ func (r *productRepositoryImpl) FindByID(ctx context.Context, id int64) (*entity
 			m.id, m.name, 
 			pc.id, pc.name, 
 			pf.id, pf.name,
-			p.id, p.name, p.generic_name, p.description, 
+			p.id, p.name, p.generic_name, p.strength, p.description, 
 			p.unit_in_pack, p.selling_unit, p.sold_amount,
 			p.height, p.weight, p.length, p.width, 
 			p.thumbnail_url, p.image_url, p.secondary_image_url, p.tertiary_image_url, 
+			p.thumbnail_webp_url, p.image_webp_url,
 			p.is_active, p.created_at, 
 			coalesce(string_agg(cp.id::TEXT, ','), ''), 
 			coalesce(string_agg(cp.name, ','), '')
@@ -557,6 +754,7 @@ func (r *productRepositoryImpl) FindByID(ctx context.Context, id int64) (*entity
 			&product.ID,
 			&product.Name,
 			&product.GenericName,
+			&product.Strength,
 			&product.Description,
 			&product.UnitInPack,
 			&product.SellingUnit,
@@ -569,6 +767,8 @@ func (r *productRepositoryImpl) FindByID(ctx context.Context, id int64) (*entity
 			&product.ImageURL,
 			&product.SecondaryImageURL,
 			&product.TertiaryImageURL,
+			&product.ThumbnailWebpURL,
+			&product.ImageWebpURL,
 			&product.IsActive,
 			&product.CreatedAt,
 			&categoryIDs,
@@ -585,6 +785,7 @@ func (r *productRepositoryImpl) FindByID(ctx context.Context, id int64) (*entity
 			&product.ID,
 			&product.Name,
 			&product.GenericName,
+			&product.Strength,
 			&product.Description,
 			&product.UnitInPack,
 			&product.SellingUnit,
@@ -597,6 +798,8 @@ func (r *productRepositoryImpl) FindByID(ctx context.Context, id int64) (*entity
 			&product.ImageURL,
 			&product.SecondaryImageURL,
 			&product.TertiaryImageURL,
+			&product.ThumbnailWebpURL,
+			&product.ImageWebpURL,
 			&product.IsActive,
 			&product.CreatedAt,
 			&categoryIDs,
@@ -635,8 +838,8 @@ func (r *productRepositoryImpl) FindByID(ctx context.Context, id int64) (*entity
 
 func (r *productRepositoryImpl) Save(ctx context.Context, entity *entity.Product) error {
 	query := `
-		insert into products(manufacture_id, product_classification_id, product_form_id, name, generic_name, description, unit_in_pack, selling_unit, height, weight, length, width, thumbnail_url, image_url, secondary_image_url, tertiary_image_url, is_active)
-		values ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17) returning id, created_at
+		insert into products(manufacture_id, product_classification_id, product_form_id, name, generic_name, description, unit_in_pack, selling_unit, height, weight, length, width, thumbnail_url, image_url, secondary_image_url, tertiary_image_url, is_active, strength)
+		values ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18) returning id, created_at
 	`
 	tx := transactor.ExtractTx(ctx)
 
@@ -662,6 +865,7 @@ func (r *productRepositoryImpl) Save(ctx context.Context, entity *entity.Product
 			entity.SecondaryImageURL,
 			entity.TertiaryImageURL,
 			entity.IsActive,
+			entity.Strength,
 		).Scan(&entity.ID, &entity.CreatedAt)
 	} else {
 		err = r.db.QueryRowContext(
@@ -684,6 +888,7 @@ func (r *productRepositoryImpl) Save(ctx context.Context, entity *entity.Product
 			entity.SecondaryImageURL,
 			entity.TertiaryImageURL,
 			entity.IsActive,
+			entity.Strength,
 		).Scan(&entity.ID, &entity.CreatedAt)
 	}
 
@@ -698,16 +903,16 @@ func (r *productRepositoryImpl) Save(ctx context.Context, entity *entity.Product
 
 func (r *productRepositoryImpl) SaveImages(ctx context.Context, entity *entity.Product) error {
 	query := `
-		update products set image_url = $2, thumbnail_url = $3, secondary_image_url = $4, tertiary_image_url = $5
+		update products set image_url = $2, thumbnail_url = $3, secondary_image_url = $4, tertiary_image_url = $5, image_webp_url = $6, thumbnail_webp_url = $7
 		where id = $1
 	`
 	tx := transactor.ExtractTx(ctx)
 
 	var err error
 	if tx != nil {
-		_, err = tx.ExecContext(ctx, query, entity.ID, entity.ImageURL, entity.ThumbnailURL, entity.SecondaryImageURL, entity.TertiaryImageURL)
+		_, err = tx.ExecContext(ctx, query, entity.ID, entity.ImageURL, entity.ThumbnailURL, entity.SecondaryImageURL, entity.TertiaryImageURL, entity.ImageWebpURL, entity.ThumbnailWebpURL)
 	} else {
-		_, err = r.db.ExecContext(ctx, query, entity.ID, entity.ImageURL, entity.ThumbnailURL, entity.SecondaryImageURL, entity.TertiaryImageURL)
+		_, err = r.db.ExecContext(ctx, query, entity.ID, entity.ImageURL, entity.ThumbnailURL, entity.SecondaryImageURL, entity.TertiaryImageURL, entity.ImageWebpURL, entity.ThumbnailWebpURL)
 	}
 
 	if err, ok := err.(*pgconn.PgError); ok {
@@ -768,7 +973,7 @@ func (r *productRepositoryImpl) UpdateProductCategories(ctx context.Context, ent
 
 func (r *productRepositoryImpl) Update(ctx context.Context, entity *entity.Product) error {
 	query := `
-		update products set manufacture_id = $2, product_classification_id = $3, product_form_id = $4, name = $5, generic_name = $6, description = $7, unit_in_pack = $8, selling_unit = $9, height = $10, weight = $11, length = $12, width = $13, is_active = $14
+		update products set manufacture_id = $2, product_classification_id = $3, product_form_id = $4, name = $5, generic_name = $6, description = $7, unit_in_pack = $8, selling_unit = $9, height = $10, weight = $11, length = $12, width = $13, is_active = $14, strength = $15
 		where id = $1 and deleted_at is null returning created_at
 	`
 	tx := transactor.ExtractTx(ctx)
@@ -792,6 +997,7 @@ func (r *productRepositoryImpl) Update(ctx context.Context, entity *entity.Produ
 			entity.Length,
 			entity.Width,
 			entity.IsActive,
+			entity.Strength,
 		).Scan(&entity.CreatedAt)
 	} else {
 		err = r.db.QueryRowContext(
@@ -811,6 +1017,7 @@ func (r *productRepositoryImpl) Update(ctx context.Context, entity *entity.Produ
 			entity.Length,
 			entity.Width,
 			entity.IsActive,
+			entity.Strength,
 		).Scan(&entity.CreatedAt)
 	}
 
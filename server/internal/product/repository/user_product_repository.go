@@ -33,7 +33,13 @@ func NewUserProductRepository(db *sql.DB) *userProductRepositoryImpl {
 func (r *userProductRepositoryImpl) Search(ctx context.Context, request *dto.UserSearchProductRequest) ([]*entity.Product, error) {
 	tx := transactor.ExtractTx(ctx)
 	query := `
-		with filtered_products as (
+		with product_ratings as (
+			select pp.product_id, round(avg(r.rating), 1) rating
+			from reviews r
+			join pharmacy_products pp on pp.id = r.pharmacy_product_id
+			where r.is_hidden = false and r.deleted_at is null
+			group by pp.product_id
+		), filtered_products as (
 			select
 				p.product_classification_id,
 				p.id, 
@@ -44,11 +50,13 @@ func (r *userProductRepositoryImpl) Search(ctx context.Context, request *dto.Use
 				pp.price,
 				pp.stock_quantity,
 				p.thumbnail_url,
+				coalesce(pr.rating, 0) rating,
 				row_number() over (partition by pp.product_id order by pp.price asc) as rank
 			from products p 
 			join pharmacy_products pp on pp.product_id = p.id
 			join pharmacies ph on ph.id = pp.pharmacy_id
 			join pharmacy_partners pa on pa.id = ph.partner_id
+			left join product_ratings pr on pr.product_id = p.id
 			where 
 				CURRENT_TIME BETWEEN pa.start_opt AND pa.end_opt
 				and p.deleted_at is null 
@@ -245,22 +253,32 @@ func (r *userProductRepositoryImpl) FindByID(ctx context.Context, id int64) (*en
 				from pharmacy_products
 				where product_id = $1
 			) as ranked_product where rank = 1
+		), product_rating as (
+			select pp.product_id, round(avg(r.rating), 1) rating, count(r.id) rating_count
+			from reviews r
+			join pharmacy_products pp on pp.id = r.pharmacy_product_id
+			where pp.product_id = $1 and r.is_hidden = false and r.deleted_at is null
+			group by pp.product_id
 		)
 		select 
 			m.id, m.name, 
 			pc.id, pc.name, 
 			pf.id, pf.name, chp.id,
-			p.id, p.name, p.generic_name, p.description, 
+			p.id, p.name, p.generic_name, p.strength, p.description, 
 			p.unit_in_pack, p.selling_unit, p.sold_amount, chp.price, chp.stock_quantity,
 			p.height, p.weight, p.length, p.width, 
 			p.thumbnail_url, p.image_url, p.secondary_image_url, p.tertiary_image_url, 
+			p.thumbnail_webp_url, p.image_webp_url,
 			p.is_active, p.created_at, 
+			coalesce(pr.rating, 0), coalesce(pr.rating_count, 0),
 			coalesce(string_agg(cp.id::TEXT, ','), ''), 
 			coalesce(string_agg(cp.name, ','), '')
 		from 
 			products p 
 		join 
 			cheapest_product chp on chp.product_id = p.id
+		left join 
+			product_rating pr on pr.product_id = p.id
 		join 
 			manufactures m ON p.manufacture_id = m.id 
 		join 
@@ -275,7 +293,7 @@ func (r *userProductRepositoryImpl) FindByID(ctx context.Context, id int64) (*en
 			p.id = $1 
 			AND p.deleted_at is null
 		group by 
-			m.id, pc.id, pf.id, p.id, chp.id, chp.price, chp.stock_quantity
+			m.id, pc.id, pf.id, p.id, chp.id, chp.price, chp.stock_quantity, pr.rating, pr.rating_count
 	`
 	tx := transactor.ExtractTx(ctx)
 
@@ -298,6 +316,7 @@ func (r *userProductRepositoryImpl) FindByID(ctx context.Context, id int64) (*en
 			&product.ID,
 			&product.Name,
 			&product.GenericName,
+			&product.Strength,
 			&product.Description,
 			&product.UnitInPack,
 			&product.SellingUnit,
@@ -312,8 +331,12 @@ func (r *userProductRepositoryImpl) FindByID(ctx context.Context, id int64) (*en
 			&product.ImageURL,
 			&product.SecondaryImageURL,
 			&product.TertiaryImageURL,
+			&product.ThumbnailWebpURL,
+			&product.ImageWebpURL,
 			&product.IsActive,
 			&product.CreatedAt,
+			&product.RatingAverage,
+			&product.RatingCount,
 			&categoryIDs,
 			&categoryNames,
 		)
@@ -329,6 +352,7 @@ func (r *userProductRepositoryImpl) FindByID(ctx context.Context, id int64) (*en
 			&product.ID,
 			&product.Name,
 			&product.GenericName,
+			&product.Strength,
 			&product.Description,
 			&product.UnitInPack,
 			&product.SellingUnit,
@@ -343,8 +367,12 @@ func (r *userProductRepositoryImpl) FindByID(ctx context.Context, id int64) (*en
 			&product.ImageURL,
 			&product.SecondaryImageURL,
 			&product.TertiaryImageURL,
+			&product.ThumbnailWebpURL,
+			&product.ImageWebpURL,
 			&product.IsActive,
 			&product.CreatedAt,
+			&product.RatingAverage,
+			&product.RatingCount,
 			&categoryIDs,
 			&categoryNames,
 		)
@@ -18,6 +18,7 @@ func UserProductControllerRoute(c *controller.UserProductController, r *gin.Engi
 		g.GET("", c.Search)
 		g.GET("/home", middlewareProduct.Home(jwtUtil), c.Home)
 		g.GET("/:productId", c.Get)
+		g.GET("/:productId/alternatives", middlewareProduct.Home(jwtUtil), c.ListAlternatives)
 	}
 }
 
@@ -30,6 +31,9 @@ func AdminProductControllerRoute(c *controller.AdminProductController, r *gin.En
 		products.GET(productId, c.GetProduct)
 		products.PUT(productId, c.UpdateProduct)
 		products.DELETE(productId, c.DeleteProduct)
+		products.POST("/import", c.ImportProduct)
+		products.GET("/imports/:importId", c.GetProductImport)
+		products.GET("/imports/:importId/report", c.DownloadProductImportReport)
 	}
 }
 
@@ -58,6 +62,9 @@ func PharmacistProductControllerRoute(c *controller.PharmacistProductController,
 		pharmacyProduct.GET(pharmacyProductId, c.Get)
 		pharmacyProduct.PUT(pharmacyProductId, c.Update)
 		pharmacyProduct.DELETE(pharmacyProductId, c.Delete)
+		pharmacyProduct.POST("/import", c.Import)
+		pharmacyProduct.GET("/imports/:importId", c.GetImport)
+		pharmacyProduct.GET("/imports/:importId/report", c.DownloadImportReport)
 	}
 }
 
@@ -2,23 +2,29 @@ package usecase
 
 import (
 	"context"
+	"fmt"
 	"mime/multipart"
 	"path/filepath"
 	"strings"
 
+	constantAudit "healthcare-app/internal/audit/constant"
+	dtoAudit "healthcare-app/internal/audit/dto"
+	usecaseAudit "healthcare-app/internal/audit/usecase"
 	apperrorProduct "healthcare-app/internal/product/apperror"
 	"healthcare-app/internal/product/constant"
 	dtoProduct "healthcare-app/internal/product/dto"
 	"healthcare-app/internal/product/entity"
 	"healthcare-app/internal/product/repository"
+	"healthcare-app/internal/product/utils"
 	"healthcare-app/internal/queue/payload"
 	"healthcare-app/internal/queue/tasks"
 	apperrorPkg "healthcare-app/pkg/apperror"
 	"healthcare-app/pkg/database/transactor"
 	dtoPkg "healthcare-app/pkg/dto"
-	"healthcare-app/pkg/utils/cloudinaryutils"
 	"healthcare-app/pkg/utils/encryptutils"
+	"healthcare-app/pkg/utils/imageutils"
 	"healthcare-app/pkg/utils/pageutils"
+	"healthcare-app/pkg/utils/storageutils"
 )
 
 type AdminProductUseCase interface {
@@ -27,37 +33,46 @@ type AdminProductUseCase interface {
 	Create(ctx context.Context, request *dtoProduct.CreateProductRequest) error
 	Update(ctx context.Context, request *dtoProduct.UpdateProductRequest) error
 	Delete(ctx context.Context, request *dtoProduct.DeleteProductRequest) error
+	Import(ctx context.Context, request *dtoProduct.ImportProductRequest) (*dtoProduct.ProductImportResponse, error)
+	GetImport(ctx context.Context, request *dtoProduct.GetProductImportRequest) (*dtoProduct.ProductImportResponse, error)
+	DownloadImportReport(ctx context.Context, request *dtoProduct.GetProductImportRequest) (*dtoProduct.ProductImportReport, error)
 }
 
 type adminProductUseCaseImpl struct {
 	base64Encryptor           encryptutils.Base64Encryptor
-	cloudinaryUtil            cloudinaryutils.CloudinaryUtil
+	objectStorage             storageutils.ObjectStorage
 	productTask               tasks.ProductTask
+	auditLogUseCase           usecaseAudit.AuditLogUseCase
 	manufactureRepo           repository.ManufactureRepository
 	productClassificationRepo repository.ProductClassificationRepository
 	productFormRepo           repository.ProductFormRepository
 	productRepo               repository.ProductRepository
+	productImportRepo         repository.ProductImportRepository
 	transactor                transactor.Transactor
 }
 
 func NewAdminProductUseCase(
 	base64Encryptor encryptutils.Base64Encryptor,
-	cloudinaryUtil cloudinaryutils.CloudinaryUtil,
+	objectStorage storageutils.ObjectStorage,
 	productTask tasks.ProductTask,
+	auditLogUseCase usecaseAudit.AuditLogUseCase,
 	manufactureRepo repository.ManufactureRepository,
 	productClassificationRepo repository.ProductClassificationRepository,
 	productFormRepo repository.ProductFormRepository,
 	productRepo repository.ProductRepository,
+	productImportRepo repository.ProductImportRepository,
 	transactor transactor.Transactor,
 ) *adminProductUseCaseImpl {
 	return &adminProductUseCaseImpl{
 		base64Encryptor:           base64Encryptor,
-		cloudinaryUtil:            cloudinaryUtil,
+		objectStorage:             objectStorage,
 		productTask:               productTask,
+		auditLogUseCase:           auditLogUseCase,
 		manufactureRepo:           manufactureRepo,
 		productClassificationRepo: productClassificationRepo,
 		productFormRepo:           productFormRepo,
 		productRepo:               productRepo,
+		productImportRepo:         productImportRepo,
 		transactor:                transactor,
 	}
 }
@@ -142,6 +157,14 @@ func (u *adminProductUseCaseImpl) Create(ctx context.Context, request *dtoProduc
 		if err := u.productRepo.Save(txCtx, product); err != nil {
 			return err
 		}
+		if err := u.auditLogUseCase.Record(txCtx, &dtoAudit.AuditEntry{
+			Action:     constantAudit.ACTION_CREATE,
+			EntityType: constantAudit.ENTITY_PRODUCT,
+			EntityID:   product.ID,
+			After:      dtoProduct.ConvertToProductAuditLog(product),
+		}); err != nil {
+			return err
+		}
 
 		return u.productTask.QueueCreateProduct(
 			txCtx,
@@ -197,6 +220,15 @@ func (u *adminProductUseCaseImpl) Update(ctx context.Context, request *dtoProduc
 				return apperrorProduct.NewProductAlreadyExistsError()
 			}
 		}
+		if err := u.auditLogUseCase.Record(txCtx, &dtoAudit.AuditEntry{
+			Action:     constantAudit.ACTION_UPDATE,
+			EntityType: constantAudit.ENTITY_PRODUCT,
+			EntityID:   request.ID,
+			Before:     dtoProduct.ConvertToProductAuditLog(extProduct),
+			After:      dtoProduct.ConvertToProductAuditLog(product),
+		}); err != nil {
+			return err
+		}
 
 		return u.productTask.QueueUpdateProduct(
 			txCtx,
@@ -211,47 +243,112 @@ func (u *adminProductUseCaseImpl) Update(ctx context.Context, request *dtoProduc
 
 func (u *adminProductUseCaseImpl) Delete(ctx context.Context, request *dtoProduct.DeleteProductRequest) error {
 	err := u.transactor.Atomic(ctx, func(txCtx context.Context) error {
+		product, err := u.productRepo.FindByID(txCtx, request.ID)
+		if err != nil {
+			return apperrorPkg.NewServerError(err)
+		}
+		if product == nil {
+			return apperrorPkg.NewEntityNotFoundError("product")
+		}
+
 		if err := u.productRepo.DeleteByID(txCtx, request.ID); err != nil {
 			return err
 		}
 		if err := u.productRepo.DeleteRelatedPharmacyProduct(txCtx, request.ID); err != nil {
 			return apperrorPkg.NewServerError(err)
 		}
-		return nil
+		return u.auditLogUseCase.Record(txCtx, &dtoAudit.AuditEntry{
+			Action:     constantAudit.ACTION_DELETE,
+			EntityType: constantAudit.ENTITY_PRODUCT,
+			EntityID:   request.ID,
+			Before:     dtoProduct.ConvertToProductDetailResponse(product),
+		})
 	})
 
 	return err
 }
 
-func (u *adminProductUseCaseImpl) validateProductImage(thumbnail, image, secondaryImage, tertiaryImage *multipart.FileHeader) error {
-	if filepath.Ext(thumbnail.Filename) != ".png" {
-		return apperrorProduct.NewProductImageError()
+func (u *adminProductUseCaseImpl) Import(ctx context.Context, request *dtoProduct.ImportProductRequest) (*dtoProduct.ProductImportResponse, error) {
+	if filepath.Ext(request.File.Filename) != ".csv" || request.File.Size > constant.MAX_IMPORT_FILE_SIZE {
+		return nil, apperrorProduct.NewProductImportFileError(".csv", constant.MAX_IMPORT_FILE_SIZE)
 	}
-	if thumbnail.Size > constant.MAX_IMAGE_SIZE {
-		return apperrorProduct.NewProductImageError()
+	if request.Images != nil &&
+		(filepath.Ext(request.Images.Filename) != ".zip" || request.Images.Size > constant.MAX_IMPORT_IMAGES_SIZE) {
+		return nil, apperrorProduct.NewProductImportFileError(".zip", constant.MAX_IMPORT_IMAGES_SIZE)
 	}
 
-	if filepath.Ext(image.Filename) != ".png" {
-		return apperrorProduct.NewProductImageError()
+	file, err := request.File.Open()
+	if err != nil {
+		return nil, apperrorPkg.NewServerError(err)
 	}
-	if image.Size > constant.MAX_IMAGE_SIZE {
-		return apperrorProduct.NewProductImageError()
+	defer file.Close()
+
+	rows, err := utils.ReadProductImportCsv(file)
+	if err != nil {
+		return nil, err
 	}
 
-	if secondaryImage != nil {
-		if filepath.Ext(secondaryImage.Filename) != ".png" {
-			return apperrorProduct.NewProductImageError()
+	productImport := dtoProduct.ImportRequestToProductImportEntity(request, int64(len(rows)))
+	err = u.transactor.Atomic(ctx, func(txCtx context.Context) error {
+		if err := u.productImportRepo.Save(txCtx, productImport); err != nil {
+			return apperrorPkg.NewServerError(err)
 		}
-		if secondaryImage.Size > constant.MAX_IMAGE_SIZE {
+
+		return u.productTask.QueueImportProducts(
+			txCtx,
+			payload.ImportRequestToProductImportPayload(
+				u.base64Encryptor,
+				productImport,
+				request,
+			),
+		)
+	})
+
+	if err != nil {
+		return nil, err
+	}
+	return dtoProduct.ConvertToProductImportResponse(productImport), nil
+}
+
+func (u *adminProductUseCaseImpl) GetImport(ctx context.Context, request *dtoProduct.GetProductImportRequest) (*dtoProduct.ProductImportResponse, error) {
+	productImport, err := u.productImportRepo.FindByID(ctx, request.ID)
+	if err != nil {
+		return nil, err
+	}
+	return dtoProduct.ConvertToProductImportResponse(productImport), nil
+}
+
+func (u *adminProductUseCaseImpl) DownloadImportReport(ctx context.Context, request *dtoProduct.GetProductImportRequest) (*dtoProduct.ProductImportReport, error) {
+	productImport, err := u.productImportRepo.FindByID(ctx, request.ID)
+	if err != nil {
+		return nil, err
+	}
+	if productImport.Report == nil {
+		return nil, apperrorProduct.NewProductImportReportError()
+	}
+
+	return &dtoProduct.ProductImportReport{
+		FileName: fmt.Sprintf("report-%v", productImport.FileName),
+		Content:  []byte(*productImport.Report),
+	}, nil
+}
+
+func (u *adminProductUseCaseImpl) validateProductImage(thumbnail, image, secondaryImage, tertiaryImage *multipart.FileHeader) error {
+	for _, fileHeader := range []*multipart.FileHeader{thumbnail, image, secondaryImage, tertiaryImage} {
+		if fileHeader == nil {
+			continue
+		}
+		if fileHeader.Size > constant.MAX_IMAGE_SIZE {
 			return apperrorProduct.NewProductImageError()
 		}
-	}
 
-	if tertiaryImage != nil {
-		if filepath.Ext(tertiaryImage.Filename) != ".png" {
+		f, err := fileHeader.Open()
+		if err != nil {
 			return apperrorProduct.NewProductImageError()
 		}
-		if tertiaryImage.Size > constant.MAX_IMAGE_SIZE {
+		_, err = imageutils.DetectFormat(f)
+		f.Close()
+		if err != nil {
 			return apperrorProduct.NewProductImageError()
 		}
 	}
@@ -2,16 +2,25 @@ package usecase
 
 import (
 	"context"
+	"fmt"
+	"path/filepath"
 	"strings"
 	"time"
 
+	constantAudit "healthcare-app/internal/audit/constant"
+	dtoAudit "healthcare-app/internal/audit/dto"
+	usecaseAudit "healthcare-app/internal/audit/usecase"
 	apperrorProduct "healthcare-app/internal/product/apperror"
 	"healthcare-app/internal/product/constant"
 	dtoProduct "healthcare-app/internal/product/dto"
 	"healthcare-app/internal/product/repository"
+	"healthcare-app/internal/product/utils"
+	"healthcare-app/internal/queue/payload"
+	"healthcare-app/internal/queue/tasks"
 	apperrorPkg "healthcare-app/pkg/apperror"
 	"healthcare-app/pkg/database/transactor"
 	dtoPkg "healthcare-app/pkg/dto"
+	"healthcare-app/pkg/utils/encryptutils"
 	"healthcare-app/pkg/utils/pageutils"
 )
 
@@ -22,23 +31,38 @@ type PharmacistProductUseCase interface {
 	Create(ctx context.Context, request *dtoProduct.CreatePharmacyProductRequest) (*dtoProduct.PharmacyProductResponse, error)
 	Update(ctx context.Context, request *dtoProduct.UpdatePharmacyProductRequest) (*dtoProduct.PharmacyProductResponse, error)
 	Delete(ctx context.Context, request *dtoProduct.DeletePharmacyProductRequest) error
+	Import(ctx context.Context, request *dtoProduct.ImportPharmacyProductRequest) (*dtoProduct.PharmacyProductImportResponse, error)
+	GetImport(ctx context.Context, request *dtoProduct.GetPharmacyProductImportRequest) (*dtoProduct.PharmacyProductImportResponse, error)
+	DownloadImportReport(ctx context.Context, request *dtoProduct.GetPharmacyProductImportRequest) (*dtoProduct.ProductImportReport, error)
 }
 
 type pharmacistProductUseCaseImpl struct {
-	productRepo         repository.ProductRepository
-	pharmacyProductRepo repository.PharmacyProductRepository
-	transactor          transactor.Transactor
+	base64Encryptor           encryptutils.Base64Encryptor
+	productTask               tasks.ProductTask
+	auditLogUseCase           usecaseAudit.AuditLogUseCase
+	productRepo               repository.ProductRepository
+	pharmacyProductRepo       repository.PharmacyProductRepository
+	pharmacyProductImportRepo repository.PharmacyProductImportRepository
+	transactor                transactor.Transactor
 }
 
 func NewPharmacistProductUseCase(
+	base64Encryptor encryptutils.Base64Encryptor,
+	productTask tasks.ProductTask,
+	auditLogUseCase usecaseAudit.AuditLogUseCase,
 	productRepo repository.ProductRepository,
 	pharmacyProductRepo repository.PharmacyProductRepository,
+	pharmacyProductImportRepo repository.PharmacyProductImportRepository,
 	transactor transactor.Transactor,
 ) *pharmacistProductUseCaseImpl {
 	return &pharmacistProductUseCaseImpl{
-		productRepo:         productRepo,
-		pharmacyProductRepo: pharmacyProductRepo,
-		transactor:          transactor,
+		base64Encryptor:           base64Encryptor,
+		productTask:               productTask,
+		auditLogUseCase:           auditLogUseCase,
+		productRepo:               productRepo,
+		pharmacyProductRepo:       pharmacyProductRepo,
+		pharmacyProductImportRepo: pharmacyProductImportRepo,
+		transactor:                transactor,
 	}
 }
 
@@ -124,7 +148,16 @@ func (u *pharmacistProductUseCaseImpl) Create(ctx context.Context, request *dtoP
 			return err
 		}
 
-		return nil
+		created, err := u.pharmacyProductRepo.FindByID(txCtx, pharmacyProduct.ID, request.PharmacyID)
+		if err != nil {
+			return err
+		}
+		return u.auditLogUseCase.Record(txCtx, &dtoAudit.AuditEntry{
+			Action:     constantAudit.ACTION_CREATE,
+			EntityType: constantAudit.ENTITY_PHARMACY_PRODUCT,
+			EntityID:   pharmacyProduct.ID,
+			After:      dtoProduct.ConvertToPharmacyProductResponse(created),
+		})
 	})
 
 	if err != nil {
@@ -159,7 +192,18 @@ func (u *pharmacistProductUseCaseImpl) Update(ctx context.Context, request *dtoP
 		if err := u.pharmacyProductRepo.Update(txCtx, pharmacyProduct); err != nil {
 			return err
 		}
-		return nil
+
+		updated, err := u.pharmacyProductRepo.FindByID(txCtx, request.ID, request.PharmacyID)
+		if err != nil {
+			return err
+		}
+		return u.auditLogUseCase.Record(txCtx, &dtoAudit.AuditEntry{
+			Action:     constantAudit.ACTION_UPDATE,
+			EntityType: constantAudit.ENTITY_PHARMACY_PRODUCT,
+			EntityID:   request.ID,
+			Before:     dtoProduct.ConvertToPharmacyProductResponse(extProduct),
+			After:      dtoProduct.ConvertToPharmacyProductResponse(updated),
+		})
 	})
 
 	if err != nil {
@@ -176,10 +220,19 @@ func (u *pharmacistProductUseCaseImpl) Delete(ctx context.Context, request *dtoP
 		if u.pharmacyProductRepo.IsBeenBought(txCtx, request.ID) {
 			return apperrorProduct.NewPharmacyProductDeletionError()
 		}
+		pharmacyProduct, err := u.pharmacyProductRepo.FindByID(txCtx, request.ID, request.PharmacyID)
+		if err != nil {
+			return err
+		}
 		if err := u.pharmacyProductRepo.Delete(txCtx, request.ID, request.PharmacyID); err != nil {
 			return err
 		}
-		return nil
+		return u.auditLogUseCase.Record(txCtx, &dtoAudit.AuditEntry{
+			Action:     constantAudit.ACTION_DELETE,
+			EntityType: constantAudit.ENTITY_PHARMACY_PRODUCT,
+			EntityID:   request.ID,
+			Before:     dtoProduct.ConvertToPharmacyProductResponse(pharmacyProduct),
+		})
 	})
 
 	if err != nil {
@@ -187,3 +240,76 @@ func (u *pharmacistProductUseCaseImpl) Delete(ctx context.Context, request *dtoP
 	}
 	return nil
 }
+
+func (u *pharmacistProductUseCaseImpl) Import(ctx context.Context, request *dtoProduct.ImportPharmacyProductRequest) (*dtoProduct.PharmacyProductImportResponse, error) {
+	if !u.pharmacyProductRepo.IsPharmacistRelated(ctx, request.PharmacistID, request.PharmacyID) {
+		return nil, apperrorProduct.NewPharmacistProductError()
+	}
+	if filepath.Ext(request.File.Filename) != ".csv" || request.File.Size > constant.MAX_IMPORT_FILE_SIZE {
+		return nil, apperrorProduct.NewProductImportFileError(".csv", constant.MAX_IMPORT_FILE_SIZE)
+	}
+
+	file, err := request.File.Open()
+	if err != nil {
+		return nil, apperrorPkg.NewServerError(err)
+	}
+	defer file.Close()
+
+	rows, err := utils.ReadPharmacyProductImportCsv(file)
+	if err != nil {
+		return nil, err
+	}
+
+	productImport := dtoProduct.ImportRequestToPharmacyProductImportEntity(request, int64(len(rows)))
+	err = u.transactor.Atomic(ctx, func(txCtx context.Context) error {
+		if err := u.pharmacyProductImportRepo.Save(txCtx, productImport); err != nil {
+			return apperrorPkg.NewServerError(err)
+		}
+
+		return u.productTask.QueueImportPharmacyProducts(
+			txCtx,
+			payload.ImportRequestToPharmacyProductImportPayload(
+				txCtx,
+				u.base64Encryptor,
+				productImport,
+				request,
+			),
+		)
+	})
+
+	if err != nil {
+		return nil, err
+	}
+	return dtoProduct.ConvertToPharmacyProductImportResponse(productImport), nil
+}
+
+func (u *pharmacistProductUseCaseImpl) GetImport(ctx context.Context, request *dtoProduct.GetPharmacyProductImportRequest) (*dtoProduct.PharmacyProductImportResponse, error) {
+	if !u.pharmacyProductRepo.IsPharmacistRelated(ctx, request.PharmacistID, request.PharmacyID) {
+		return nil, apperrorProduct.NewPharmacistProductError()
+	}
+
+	productImport, err := u.pharmacyProductImportRepo.FindByID(ctx, request.ID, request.PharmacyID)
+	if err != nil {
+		return nil, err
+	}
+	return dtoProduct.ConvertToPharmacyProductImportResponse(productImport), nil
+}
+
+func (u *pharmacistProductUseCaseImpl) DownloadImportReport(ctx context.Context, request *dtoProduct.GetPharmacyProductImportRequest) (*dtoProduct.ProductImportReport, error) {
+	if !u.pharmacyProductRepo.IsPharmacistRelated(ctx, request.PharmacistID, request.PharmacyID) {
+		return nil, apperrorProduct.NewPharmacistProductError()
+	}
+
+	productImport, err := u.pharmacyProductImportRepo.FindByID(ctx, request.ID, request.PharmacyID)
+	if err != nil {
+		return nil, err
+	}
+	if productImport.Report == nil {
+		return nil, apperrorProduct.NewProductImportReportError()
+	}
+
+	return &dtoProduct.ProductImportReport{
+		FileName: fmt.Sprintf("report-%v", productImport.FileName),
+		Content:  []byte(*productImport.Report),
+	}, nil
+}
@@ -24,7 +24,9 @@ type UserProductUseCase interface {
 	ListByCategory(ctx context.Context, request *dtoProduct.GetProductByCategoryRequest) ([]*dtoProduct.ProductResponse, *dtoPkg.PageMetaData, error)
 	Search(ctx context.Context, request *dtoProduct.UserSearchProductRequest) ([]*dtoProduct.ProductResponse, *dtoPkg.PageMetaData, error)
 	Get(ctx context.Context, request *dtoProduct.GetProductRequest) (*dtoProduct.UserProductDetailResponse, error)
+	ListAlternatives(ctx context.Context, request *dtoProduct.AlternativeProductRequest) ([]*dtoProduct.ProductResponse, *dtoPkg.PageMetaData, error)
 	RefreshView(ctx context.Context) error
+	RefreshCoPurchaseView(ctx context.Context) error
 }
 
 type userProductUseCaseImpl struct {
@@ -106,6 +108,30 @@ func (u *userProductUseCaseImpl) List(ctx context.Context, request *dtoProduct.H
 	return res, metaData, nil
 }
 
+func (u *userProductUseCaseImpl) ListAlternatives(ctx context.Context, request *dtoProduct.AlternativeProductRequest) ([]*dtoProduct.ProductResponse, *dtoPkg.PageMetaData, error) {
+	if !u.productRepository.IsExistsByID(ctx, request.ID) {
+		return nil, nil, apperrorPkg.NewEntityNotFoundError("product")
+	}
+
+	address, err := u.addressRepository.FindAddressByIDAndActive(ctx, request.UserID)
+	if err != nil {
+		return nil, nil, apperrorPkg.NewServerError(err)
+	}
+	if request.UserID == 0 || address == nil {
+		return nil, nil, apperrorProduct.NewNoNearbyPharmaciesError()
+	}
+
+	location := strings.Split(address.Location, " ")
+	request.Location = geoutils.GeoFromText(location[0], location[1])
+	products, err := u.productRepository.FindAlternatives(ctx, request)
+	if err != nil {
+		return nil, nil, apperrorPkg.NewServerError(err)
+	}
+
+	res, metaData := pageutils.CreateMetaData(dtoProduct.ConvertToProductResponses(products), request.Page, request.Limit)
+	return res, metaData, nil
+}
+
 func (u *userProductUseCaseImpl) mostBought(ctx context.Context) ([]*entity.Product, error) {
 	products, err := u.productRepository.MostBoughtToday(ctx)
 	if err != nil {
@@ -167,9 +193,20 @@ func (u *userProductUseCaseImpl) Get(ctx context.Context, request *dtoProduct.Ge
 		return nil, apperrorPkg.NewServerError(err)
 	}
 
-	return dtoProduct.ConvertToUserProductDetailResponse(product, pharmacy), nil
+	boughtTogether, err := u.productRepository.FindAllBoughtTogether(ctx, []int64{product.ID}, pharmacy.ID, constant.BOUGHT_TOGETHER_LIMIT)
+	if err != nil {
+		return nil, apperrorPkg.NewServerError(err)
+	}
+
+	res := dtoProduct.ConvertToUserProductDetailResponse(product, pharmacy)
+	res.BoughtTogether = dtoProduct.ConvertToProductResponses(boughtTogether)
+	return res, nil
 }
 
 func (u *userProductUseCaseImpl) RefreshView(ctx context.Context) error {
 	return u.productRepository.RefreshView(ctx)
 }
+
+func (u *userProductUseCaseImpl) RefreshCoPurchaseView(ctx context.Context) error {
+	return u.productRepository.RefreshCoPurchaseView(ctx)
+}
@@ -0,0 +1,67 @@
+package utils
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"healthcare-app/internal/product/constant"
+	"healthcare-app/internal/product/dto"
+
+	"github.com/shopspring/decimal"
+)
+
+func ReadPharmacyProductImportCsv(r io.Reader) ([][]string, error) {
+	return readImportCsv(r, constant.PharmacyProductImportHeaders, constant.MAX_PHARMACY_PRODUCT_IMPORT_ROWS)
+}
+
+func ParsePharmacyProductImportRow(record []string) (*dto.PharmacyProductImportRow, error) {
+	if len(record) != len(constant.PharmacyProductImportHeaders) {
+		return nil, fmt.Errorf("expected %v columns, got %v", len(constant.PharmacyProductImportHeaders), len(record))
+	}
+
+	value := func(column int) string {
+		return strings.TrimSpace(record[column])
+	}
+
+	row := new(dto.PharmacyProductImportRow)
+	if v := value(0); v != "" {
+		id, err := parseImportID(v, "id")
+		if err != nil {
+			return nil, err
+		}
+		row.ID = &id
+	}
+
+	if v := value(1); v != "" {
+		id, err := parseImportID(v, "product_id")
+		if err != nil {
+			return nil, err
+		}
+		row.ProductID = id
+	}
+	if row.ID == nil && row.ProductID == 0 {
+		return nil, errors.New("product_id is required for new products")
+	}
+
+	// a sold out product is imported with 0 stock
+	stock, err := strconv.ParseInt(value(3), 10, 64)
+	if err != nil || stock < 0 {
+		return nil, errors.New("stock_quantity must be a number not less than 0")
+	}
+	row.StockQuantity = stock
+
+	price, err := decimal.NewFromString(strings.TrimPrefix(strings.TrimPrefix(value(4), "Rp."), "Rp"))
+	if err != nil || price.LessThan(decimal.NewFromInt(1)) {
+		return nil, errors.New("price must be a number greater than 0")
+	}
+	row.Price = price
+
+	if row.IsActive, err = strconv.ParseBool(value(5)); err != nil {
+		return nil, errors.New("is_active must be a boolean")
+	}
+
+	return row, nil
+}
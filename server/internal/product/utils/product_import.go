@@ -0,0 +1,202 @@
+package utils
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	apperrorProduct "healthcare-app/internal/product/apperror"
+	"healthcare-app/internal/product/constant"
+	"healthcare-app/internal/product/dto"
+
+	"github.com/shopspring/decimal"
+)
+
+func ReadProductImportCsv(r io.Reader) ([][]string, error) {
+	return readImportCsv(r, constant.ProductImportHeaders, constant.MAX_IMPORT_ROWS)
+}
+
+func ReadProductImportImages(data []byte) (map[string][]byte, error) {
+	images := map[string][]byte{}
+	if len(data) == 0 {
+		return images, nil
+	}
+
+	reader, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+	if err != nil {
+		return nil, err
+	}
+	if len(reader.File) > constant.MAX_IMPORT_IMAGES {
+		return nil, apperrorProduct.NewProductImportFileError(".zip", constant.MAX_IMPORT_IMAGES_SIZE)
+	}
+
+	remaining := int64(constant.MAX_IMPORT_IMAGES_READ_SIZE)
+	for _, file := range reader.File {
+		if file.FileInfo().IsDir() {
+			continue
+		}
+
+		f, err := file.Open()
+		if err != nil {
+			return nil, err
+		}
+		content, err := io.ReadAll(io.LimitReader(f, min(constant.MAX_IMAGE_SIZE, remaining)+1))
+		f.Close()
+		if err != nil {
+			return nil, err
+		}
+		if remaining -= int64(len(content)); remaining < 0 {
+			return nil, apperrorProduct.NewProductImportFileError(".zip", constant.MAX_IMPORT_IMAGES_SIZE)
+		}
+		images[filepath.Base(file.Name)] = content
+	}
+	return images, nil
+}
+
+func ParseProductImportRow(record []string) (*dto.ProductImportRow, error) {
+	if len(record) != len(constant.ProductImportHeaders) {
+		return nil, fmt.Errorf("expected %v columns, got %v", len(constant.ProductImportHeaders), len(record))
+	}
+
+	value := func(column int) string {
+		return strings.TrimSpace(record[column])
+	}
+	optional := func(column int) *string {
+		if v := value(column); v != "" {
+			return &v
+		}
+		return nil
+	}
+
+	row := &dto.ProductImportRow{
+		Name:           value(0),
+		GenericName:    value(1),
+		Description:    value(2),
+		UnitInPack:     optional(6),
+		SellingUnit:    optional(7),
+		Thumbnail:      value(14),
+		Image:          value(15),
+		SecondaryImage: value(16),
+		TertiaryImage:  value(17),
+	}
+
+	switch {
+	case row.Name == "":
+		return nil, errors.New("name is required")
+	case len(row.Name) > 75:
+		return nil, errors.New("name length must be at most 75")
+	case row.GenericName == "":
+		return nil, errors.New("generic_name is required")
+	case row.Description == "":
+		return nil, errors.New("description is required")
+	case row.Image == "":
+		return nil, errors.New("image is required")
+	}
+
+	var err error
+	if row.ManufactureID, err = parseImportID(value(3), "manufacture_id"); err != nil {
+		return nil, err
+	}
+	if row.ProductClassificationID, err = parseImportID(value(4), "product_classification_id"); err != nil {
+		return nil, err
+	}
+	if v := value(5); v != "" {
+		id, err := parseImportID(v, "product_form_id")
+		if err != nil {
+			return nil, err
+		}
+		row.ProductFormID = &id
+	}
+
+	if row.ProductClassificationID != constant.NON_OBAT &&
+		(row.ProductFormID == nil || row.UnitInPack == nil || row.SellingUnit == nil) {
+		return nil, errors.New(constant.ProductClassificationErrorMessage)
+	}
+
+	for i, dimension := range []*decimal.Decimal{&row.Weight, &row.Height, &row.Length, &row.Width} {
+		column := constant.ProductImportHeaders[8+i]
+		d, err := decimal.NewFromString(value(8 + i))
+		if err != nil {
+			return nil, fmt.Errorf("%v must be a number", column)
+		}
+		if !d.GreaterThan(decimal.Zero) {
+			return nil, fmt.Errorf("%v must be greater than 0", column)
+		}
+		*dimension = d
+	}
+
+	if row.IsActive, err = strconv.ParseBool(value(12)); err != nil {
+		return nil, errors.New("is_active must be a boolean")
+	}
+
+	seen := map[int64]struct{}{}
+	for _, v := range strings.Split(value(13), constant.IMPORT_CATEGORY_SEPARATOR) {
+		if strings.TrimSpace(v) == "" {
+			continue
+		}
+		id, err := parseImportID(strings.TrimSpace(v), "product_categories")
+		if err != nil {
+			return nil, err
+		}
+		if _, ok := seen[id]; ok {
+			return nil, errors.New("product_categories duplicate values are not allowed")
+		}
+		seen[id] = struct{}{}
+		row.ProductCategories = append(row.ProductCategories, id)
+	}
+	if len(row.ProductCategories) == 0 || len(row.ProductCategories) > 20 {
+		return nil, errors.New("product_categories must contain between 1 and 20 categories")
+	}
+
+	return row, nil
+}
+
+func IsImportImageURL(image string) bool {
+	return strings.HasPrefix(image, "http://") || strings.HasPrefix(image, "https://")
+}
+
+func readImportCsv(r io.Reader, headers []string, maxRows int) ([][]string, error) {
+	reader := csv.NewReader(r)
+	reader.TrimLeadingSpace = true
+
+	records, err := reader.ReadAll()
+	if err != nil {
+		return nil, apperrorProduct.NewProductImportFileError(".csv", constant.MAX_IMPORT_FILE_SIZE)
+	}
+	if len(records) == 0 || !isImportHeader(records[0], headers) {
+		return nil, apperrorProduct.NewProductImportHeaderError(headers)
+	}
+
+	rows := records[1:]
+	if len(rows) == 0 || len(rows) > maxRows {
+		return nil, apperrorProduct.NewProductImportRowsError(maxRows)
+	}
+	return rows, nil
+}
+
+func isImportHeader(header []string, headers []string) bool {
+	if len(header) != len(headers) {
+		return false
+	}
+	for i, column := range header {
+		column = strings.TrimPrefix(column, "\ufeff")
+		if strings.ToLower(strings.TrimSpace(column)) != headers[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func parseImportID(value, column string) (int64, error) {
+	id, err := strconv.ParseInt(value, 10, 64)
+	if err != nil || id < 1 {
+		return 0, fmt.Errorf("%v must be a number", column)
+	}
+	return id, nil
+}
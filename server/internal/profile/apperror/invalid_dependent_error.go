@@ -0,0 +1,33 @@
+package apperror
+
+import (
+	"errors"
+	"fmt"
+
+	"healthcare-app/internal/profile/constant"
+	"healthcare-app/pkg/apperror"
+)
+
+func NewUserReachMaximumNumberOfDependents() *apperror.AppError {
+	msg := fmt.Sprintf(constant.InvalidUserReachesMaximumNumberOfDependents, constant.MAX_DEPENDENTS)
+
+	err := errors.New(msg)
+
+	return apperror.NewAppError(err, apperror.DefaultClientErrorCode, msg)
+}
+
+func NewInvalidDependentBirthDateError() *apperror.AppError {
+	msg := constant.InvalidDependentBirthDate
+
+	err := errors.New(msg)
+
+	return apperror.NewAppError(err, apperror.DefaultClientErrorCode, msg)
+}
+
+func NewInvalidDependentWeightError() *apperror.AppError {
+	msg := constant.InvalidDependentWeight
+
+	err := errors.New(msg)
+
+	return apperror.NewAppError(err, apperror.DefaultClientErrorCode, msg)
+}
@@ -0,0 +1,6 @@
+package constant
+
+const (
+	MAX_DEPENDENTS    = 10
+	BIRTH_DATE_LAYOUT = "2006-01-02"
+)
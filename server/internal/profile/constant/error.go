@@ -1,12 +1,15 @@
 package constant
 
 const (
-	InvalidUserReachesMaximumNumberOfAddresses = "the user already has 3 addresses"
-	InvalidIdAddress                           = "id address is not valid"
-	InvalidIdAddressNotExists                  = "id address does not exists"
-	InvalidAddressNotFound                     = "address not found or already deleted"
-	InvalidAddressAlreadyExists                = "address already exists"
-	InvalidIdUserProfile                       = "id user is not valid"
-	InvalidIdUserProfileDoesNotExists          = "id user does not exists"
-	InvalidAddressActiveNotExists              = "you don't have an active address. please choose first"
+	InvalidUserReachesMaximumNumberOfAddresses  = "the user already has 3 addresses"
+	InvalidIdAddress                            = "id address is not valid"
+	InvalidIdAddressNotExists                   = "id address does not exists"
+	InvalidAddressNotFound                      = "address not found or already deleted"
+	InvalidAddressAlreadyExists                 = "address already exists"
+	InvalidIdUserProfile                        = "id user is not valid"
+	InvalidIdUserProfileDoesNotExists           = "id user does not exists"
+	InvalidAddressActiveNotExists               = "you don't have an active address. please choose first"
+	InvalidUserReachesMaximumNumberOfDependents = "the user already has %v dependents"
+	InvalidDependentWeight                      = "weight must be greater than 0"
+	InvalidDependentBirthDate                   = "birth date can't be in the future"
 )
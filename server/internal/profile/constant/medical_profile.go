@@ -0,0 +1,18 @@
+package constant
+
+const (
+	PREGNANCY_STATUS_NONE          = "NONE"
+	PREGNANCY_STATUS_PREGNANT      = "PREGNANT"
+	PREGNANCY_STATUS_BREASTFEEDING = "BREASTFEEDING"
+)
+
+const (
+	MEDICAL_ENTRY_ALLERGY    = "ALLERGY"
+	MEDICAL_ENTRY_CONDITION  = "CONDITION"
+	MEDICAL_ENTRY_MEDICATION = "MEDICATION"
+)
+
+const (
+	MEDICAL_PROFILE_ACTION_VIEW   = "VIEW"
+	MEDICAL_PROFILE_ACTION_UPDATE = "UPDATE"
+)
@@ -4,4 +4,5 @@ const (
 	USER       = 1
 	PHARMACIST = 2
 	ADMIN      = 3
+	DOCTOR     = 4
 )
@@ -0,0 +1,92 @@
+package controller
+
+import (
+	"strconv"
+
+	"healthcare-app/internal/auth/utils"
+	dtoProfile "healthcare-app/internal/profile/dto"
+	"healthcare-app/internal/profile/usecase"
+	"healthcare-app/pkg/apperror"
+	"healthcare-app/pkg/utils/ginutils"
+
+	"github.com/gin-gonic/gin"
+)
+
+type DependentController struct {
+	dependentUseCase usecase.DependentUseCase
+}
+
+func NewDependentController(dependentUseCase usecase.DependentUseCase) *DependentController {
+	return &DependentController{
+		dependentUseCase: dependentUseCase,
+	}
+}
+
+func (dc *DependentController) GetAllMyDependents(ctx *gin.Context) {
+	res, err := dc.dependentUseCase.GetAllMyDependents(ctx, utils.GetValueUserIdFromToken(ctx))
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+	ginutils.ResponseOK(ctx, res)
+}
+
+func (dc *DependentController) GetMyDependent(ctx *gin.Context) {
+	dependentID, err := strconv.Atoi(ctx.Param("dependentId"))
+	if err != nil {
+		ctx.Error(apperror.NewInvalidIdError())
+		return
+	}
+	res, err := dc.dependentUseCase.GetMyDependent(ctx, int64(dependentID), utils.GetValueUserIdFromToken(ctx))
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+	ginutils.ResponseOK(ctx, res)
+}
+
+func (dc *DependentController) PostNewDependent(ctx *gin.Context) {
+	req := &dtoProfile.RequestDependent{UserID: utils.GetValueUserIdFromToken(ctx)}
+	if err := ctx.ShouldBindJSON(req); err != nil {
+		ctx.Error(err)
+		return
+	}
+	res, err := dc.dependentUseCase.PostNewDependent(ctx, req)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+	ginutils.ResponseCreated(ctx, res)
+}
+
+func (dc *DependentController) PutMyDependent(ctx *gin.Context) {
+	dependentID, err := strconv.Atoi(ctx.Param("dependentId"))
+	if err != nil {
+		ctx.Error(apperror.NewInvalidIdError())
+		return
+	}
+	req := &dtoProfile.RequestDependent{ID: int64(dependentID), UserID: utils.GetValueUserIdFromToken(ctx)}
+	if err := ctx.ShouldBindJSON(req); err != nil {
+		ctx.Error(err)
+		return
+	}
+	res, err := dc.dependentUseCase.PutMyDependent(ctx, req)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+	ginutils.ResponseOK(ctx, res)
+}
+
+func (dc *DependentController) DeleteMyDependent(ctx *gin.Context) {
+	dependentID, err := strconv.Atoi(ctx.Param("dependentId"))
+	if err != nil {
+		ctx.Error(apperror.NewInvalidIdError())
+		return
+	}
+	if err := dc.dependentUseCase.DeleteMyDependent(ctx, int64(dependentID), utils.GetValueUserIdFromToken(ctx)); err != nil {
+		ctx.Error(err)
+		return
+	}
+	ginutils.ResponseOKPlain(ctx)
+}
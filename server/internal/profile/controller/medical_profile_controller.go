@@ -0,0 +1,59 @@
+package controller
+
+import (
+	"healthcare-app/internal/auth/utils"
+	dtoProfile "healthcare-app/internal/profile/dto"
+	"healthcare-app/internal/profile/usecase"
+	"healthcare-app/pkg/utils/ginutils"
+	"healthcare-app/pkg/utils/pageutils"
+
+	"github.com/gin-gonic/gin"
+)
+
+type MedicalProfileController struct {
+	medicalProfileUseCase usecase.MedicalProfileUseCase
+}
+
+func NewMedicalProfileController(medicalProfileUseCase usecase.MedicalProfileUseCase) *MedicalProfileController {
+	return &MedicalProfileController{
+		medicalProfileUseCase: medicalProfileUseCase,
+	}
+}
+
+func (mc *MedicalProfileController) GetMyMedicalProfile(ctx *gin.Context) {
+	res, err := mc.medicalProfileUseCase.GetMyMedicalProfile(ctx, utils.GetValueUserIdFromToken(ctx))
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+	ginutils.ResponseOK(ctx, res)
+}
+
+func (mc *MedicalProfileController) PutMyMedicalProfile(ctx *gin.Context) {
+	req := new(dtoProfile.RequestPutMedicalProfile)
+	if err := ctx.ShouldBindJSON(req); err != nil {
+		ctx.Error(err)
+		return
+	}
+	res, err := mc.medicalProfileUseCase.PutMyMedicalProfile(ctx, req, utils.GetValueUserIdFromToken(ctx))
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+	ginutils.ResponseOK(ctx, res)
+}
+
+func (mc *MedicalProfileController) GetMyAccessLogs(ctx *gin.Context) {
+	req := &dtoProfile.RequestGetMedicalProfileAccessLog{UserID: utils.GetValueUserIdFromToken(ctx)}
+	if err := ctx.ShouldBindQuery(req); err != nil {
+		ctx.Error(err)
+		return
+	}
+	res, paging, err := mc.medicalProfileUseCase.GetMyAccessLogs(ctx, req)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+	paging.Links = pageutils.CreateLinks(ctx.Request, int(paging.Page), int(paging.Size), int(paging.TotalItem), int(paging.TotalPage))
+	ginutils.ResponseOKPagination(ctx, res, paging)
+}
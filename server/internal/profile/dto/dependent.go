@@ -0,0 +1,79 @@
+package dto
+
+import (
+	"time"
+
+	"healthcare-app/internal/profile/constant"
+	"healthcare-app/internal/profile/entity"
+
+	"github.com/shopspring/decimal"
+)
+
+type DependentResponse struct {
+	ID           int64            `json:"id"`
+	FullName     string           `json:"full_name"`
+	Relationship string           `json:"relationship"`
+	BirthDate    string           `json:"birth_date"`
+	Age          int              `json:"age"`
+	Weight       *decimal.Decimal `json:"weight"`
+	MedicalNotes *string          `json:"medical_notes"`
+	Allergies    []string         `json:"allergies"`
+	CreatedAt    time.Time        `json:"created_at"`
+	UpdatedAt    time.Time        `json:"updated_at"`
+}
+
+type RequestDependent struct {
+	FullName     string           `json:"full_name" binding:"required,max=255"`
+	Relationship string           `json:"relationship" binding:"required,max=50"`
+	BirthDate    string           `json:"birth_date" binding:"required,time_format=2006-01-02"`
+	Weight       *decimal.Decimal `json:"weight" binding:"omitempty"`
+	MedicalNotes *string          `json:"medical_notes" binding:"omitempty,max=1000"`
+	Allergies    []string         `json:"allergies" binding:"max=50,dive,required,max=255"`
+	ID           int64            `json:"-"`
+	UserID       int64            `json:"-"`
+}
+
+func ConvertToDependentResponses(dependents []*entity.Dependent) []*DependentResponse {
+	res := []*DependentResponse{}
+	for _, dependent := range dependents {
+		res = append(res, ConvertToDependentResponse(dependent))
+	}
+	return res
+}
+
+func ConvertToDependentResponse(dependent *entity.Dependent) *DependentResponse {
+	return &DependentResponse{
+		ID:           dependent.ID,
+		FullName:     dependent.FullName,
+		Relationship: dependent.Relationship,
+		BirthDate:    dependent.BirthDate.Format(constant.BIRTH_DATE_LAYOUT),
+		Age:          calculateAge(dependent.BirthDate, time.Now()),
+		Weight:       dependent.Weight,
+		MedicalNotes: dependent.MedicalNotes,
+		Allergies:    dependent.Allergies,
+		CreatedAt:    dependent.CreatedAt,
+		UpdatedAt:    dependent.UpdatedAt,
+	}
+}
+
+func DependentRequestToEntity(request *RequestDependent) *entity.Dependent {
+	birthDate, _ := time.Parse(constant.BIRTH_DATE_LAYOUT, request.BirthDate)
+	return &entity.Dependent{
+		ID:           request.ID,
+		UserID:       request.UserID,
+		FullName:     request.FullName,
+		Relationship: request.Relationship,
+		BirthDate:    birthDate,
+		Weight:       request.Weight,
+		MedicalNotes: request.MedicalNotes,
+		Allergies:    request.Allergies,
+	}
+}
+
+func calculateAge(birthDate, now time.Time) int {
+	age := now.Year() - birthDate.Year()
+	if now.Month() < birthDate.Month() || (now.Month() == birthDate.Month() && now.Day() < birthDate.Day()) {
+		age--
+	}
+	return age
+}
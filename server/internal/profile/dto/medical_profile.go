@@ -0,0 +1,109 @@
+package dto
+
+import (
+	"time"
+
+	"healthcare-app/internal/auth/utils"
+	"healthcare-app/internal/profile/constant"
+	"healthcare-app/internal/profile/entity"
+)
+
+type MedicalProfileResponse struct {
+	PregnancyStatus    string               `json:"pregnancy_status"`
+	Allergies          []string             `json:"allergies"`
+	ChronicConditions  []string             `json:"chronic_conditions"`
+	CurrentMedications []MedicationResponse `json:"current_medications"`
+	UpdatedAt          *time.Time           `json:"updated_at"`
+}
+
+type MedicationResponse struct {
+	Name   string  `json:"name"`
+	Dosage *string `json:"dosage"`
+}
+
+type MedicalProfileAccessLogResponse struct {
+	ID          int64     `json:"id"`
+	AccessedBy  accessor  `json:"accessed_by"`
+	Action      string    `json:"action"`
+	OrderID     *int64    `json:"order_id"`
+	DependentID *int64    `json:"dependent_id"`
+	CreatedAt   time.Time `json:"created_at"`
+}
+
+type accessor struct {
+	ID   int64  `json:"id"`
+	Name string `json:"name"`
+	Role string `json:"role"`
+}
+
+type RequestPutMedicalProfile struct {
+	PregnancyStatus    string              `json:"pregnancy_status" binding:"required,oneof=NONE PREGNANT BREASTFEEDING"`
+	Allergies          []string            `json:"allergies" binding:"max=50,dive,required,max=255"`
+	ChronicConditions  []string            `json:"chronic_conditions" binding:"max=50,dive,required,max=255"`
+	CurrentMedications []RequestMedication `json:"current_medications" binding:"max=50,dive"`
+}
+
+type RequestMedication struct {
+	Name   string  `json:"name" binding:"required,max=255"`
+	Dosage *string `json:"dosage" binding:"omitempty,max=255"`
+}
+
+type RequestGetMedicalProfileAccessLog struct {
+	Limit  int64 `form:"limit" binding:"numeric,gte=1,lte=25"`
+	Page   int64 `form:"page" binding:"numeric,gte=1"`
+	UserID int64 `form:"-"`
+}
+
+func ConvertToMedicalProfileResponse(profile *entity.MedicalProfile) *MedicalProfileResponse {
+	res := &MedicalProfileResponse{
+		PregnancyStatus:    profile.PregnancyStatus,
+		Allergies:          []string{},
+		ChronicConditions:  []string{},
+		CurrentMedications: []MedicationResponse{},
+		UpdatedAt:          profile.UpdatedAt,
+	}
+	for _, entry := range profile.Entries {
+		switch entry.EntryType {
+		case constant.MEDICAL_ENTRY_ALLERGY:
+			res.Allergies = append(res.Allergies, entry.Name)
+		case constant.MEDICAL_ENTRY_CONDITION:
+			res.ChronicConditions = append(res.ChronicConditions, entry.Name)
+		case constant.MEDICAL_ENTRY_MEDICATION:
+			res.CurrentMedications = append(res.CurrentMedications, MedicationResponse{Name: entry.Name, Dosage: entry.Description})
+		}
+	}
+	return res
+}
+
+func ConvertToMedicalProfileAccessLogResponses(logs []*entity.MedicalProfileAccessLog) []*MedicalProfileAccessLogResponse {
+	res := []*MedicalProfileAccessLogResponse{}
+	for _, log := range logs {
+		res = append(res, &MedicalProfileAccessLogResponse{
+			ID:          log.ID,
+			AccessedBy:  accessor{ID: log.AccessedBy, Name: log.AccessorName, Role: utils.SpecifyRole(log.AccessorRole)},
+			Action:      log.Action,
+			OrderID:     log.OrderID,
+			DependentID: log.DependentID,
+			CreatedAt:   log.CreatedAt,
+		})
+	}
+	return res
+}
+
+func PutMedicalProfileRequestToEntity(request *RequestPutMedicalProfile, userID int64) *entity.MedicalProfile {
+	profile := &entity.MedicalProfile{
+		UserID:          userID,
+		PregnancyStatus: request.PregnancyStatus,
+		Entries:         []*entity.MedicalProfileEntry{},
+	}
+	for _, allergy := range request.Allergies {
+		profile.Entries = append(profile.Entries, &entity.MedicalProfileEntry{EntryType: constant.MEDICAL_ENTRY_ALLERGY, Name: allergy})
+	}
+	for _, condition := range request.ChronicConditions {
+		profile.Entries = append(profile.Entries, &entity.MedicalProfileEntry{EntryType: constant.MEDICAL_ENTRY_CONDITION, Name: condition})
+	}
+	for _, medication := range request.CurrentMedications {
+		profile.Entries = append(profile.Entries, &entity.MedicalProfileEntry{EntryType: constant.MEDICAL_ENTRY_MEDICATION, Name: medication.Name, Description: medication.Dosage})
+	}
+	return profile
+}
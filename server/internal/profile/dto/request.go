@@ -33,6 +33,7 @@ type RequestPutMyProfile struct {
 	WhatsappNumber    string                `form:"whatsapp_number" binding:"omitempty,phone_number"`
 	ProfileImage      *multipart.FileHeader `form:"profile_image" binding:"omitempty"`
 	YearsOfExperience *int                  `form:"years_of_experience" binding:"omitempty,max=70"`
+	Locale            string                `form:"locale" binding:"omitempty,oneof=en id"`
 }
 
 type RequestProfileId struct {
@@ -0,0 +1,20 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+type Dependent struct {
+	BirthDate    time.Time
+	CreatedAt    time.Time
+	UpdatedAt    time.Time
+	Weight       *decimal.Decimal
+	MedicalNotes *string
+	FullName     string
+	Relationship string
+	Allergies    []string
+	ID           int64
+	UserID       int64
+}
@@ -0,0 +1,30 @@
+package entity
+
+import "time"
+
+type MedicalProfile struct {
+	CreatedAt       *time.Time
+	UpdatedAt       *time.Time
+	PregnancyStatus string
+	Entries         []*MedicalProfileEntry
+	UserID          int64
+}
+
+type MedicalProfileEntry struct {
+	Description *string
+	EntryType   string
+	Name        string
+	ID          int64
+}
+
+type MedicalProfileAccessLog struct {
+	CreatedAt    time.Time
+	OrderID      *int64
+	DependentID  *int64
+	AccessorName string
+	Action       string
+	ID           int64
+	UserID       int64
+	AccessedBy   int64
+	AccessorRole int
+}
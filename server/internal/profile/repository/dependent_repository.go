@@ -0,0 +1,333 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+
+	"healthcare-app/internal/profile/entity"
+	apperrorPkg "healthcare-app/pkg/apperror"
+	"healthcare-app/pkg/database/transactor"
+)
+
+type DependentRepository interface {
+	CountByUserID(ctx context.Context, userID int64) (int64, error)
+	FindAllByUserID(ctx context.Context, userID int64) ([]*entity.Dependent, error)
+	FindByID(ctx context.Context, id int64) (*entity.Dependent, error)
+	FindByIDAndUserID(ctx context.Context, id int64, userID int64) (*entity.Dependent, error)
+	Save(ctx context.Context, dependent *entity.Dependent) error
+	Update(ctx context.Context, dependent *entity.Dependent) error
+	DeleteByIDAndUserID(ctx context.Context, id int64, userID int64) error
+	FindAllAllergiesByID(ctx context.Context, id int64) ([]string, error)
+	ReplaceAllergies(ctx context.Context, dependent *entity.Dependent) error
+}
+
+type dependentRepositoryImpl struct {
+	db *sql.DB
+}
+
+func NewDependentRepository(db *sql.DB) *dependentRepositoryImpl {
+	return &dependentRepositoryImpl{
+		db: db,
+	}
+}
+
+const dependentSelectQuery = `
+	select id, user_id, full_name, relationship, birth_date, weight, medical_notes, created_at, updated_at
+	from dependents
+`
+
+func (r *dependentRepositoryImpl) CountByUserID(ctx context.Context, userID int64) (int64, error) {
+	query := `
+		select count(*) from dependents where user_id = $1 and deleted_at is null
+	`
+	tx := transactor.ExtractTx(ctx)
+
+	var (
+		err   error
+		count int64
+	)
+	if tx != nil {
+		err = tx.QueryRowContext(ctx, query, userID).Scan(&count)
+	} else {
+		err = r.db.QueryRowContext(ctx, query, userID).Scan(&count)
+	}
+
+	if err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+func (r *dependentRepositoryImpl) FindAllByUserID(ctx context.Context, userID int64) ([]*entity.Dependent, error) {
+	query := dependentSelectQuery + " where user_id = $1 and deleted_at is null order by created_at"
+	tx := transactor.ExtractTx(ctx)
+
+	var (
+		err  error
+		rows *sql.Rows
+	)
+	if tx != nil {
+		rows, err = tx.QueryContext(ctx, query, userID)
+	} else {
+		rows, err = r.db.QueryContext(ctx, query, userID)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	dependents := []*entity.Dependent{}
+	for rows.Next() {
+		dependent := new(entity.Dependent)
+		if err := rows.Scan(
+			&dependent.ID,
+			&dependent.UserID,
+			&dependent.FullName,
+			&dependent.Relationship,
+			&dependent.BirthDate,
+			&dependent.Weight,
+			&dependent.MedicalNotes,
+			&dependent.CreatedAt,
+			&dependent.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		dependents = append(dependents, dependent)
+	}
+
+	err = rows.Err()
+	if err != nil {
+		return nil, err
+	}
+	return dependents, nil
+}
+
+func (r *dependentRepositoryImpl) FindByID(ctx context.Context, id int64) (*entity.Dependent, error) {
+	return r.findOne(ctx, dependentSelectQuery+" where id = $1", id)
+}
+
+func (r *dependentRepositoryImpl) FindByIDAndUserID(ctx context.Context, id int64, userID int64) (*entity.Dependent, error) {
+	return r.findOne(ctx, dependentSelectQuery+" where id = $1 and user_id = $2 and deleted_at is null", id, userID)
+}
+
+func (r *dependentRepositoryImpl) Save(ctx context.Context, dependent *entity.Dependent) error {
+	query := `
+		insert into dependents(user_id, full_name, relationship, birth_date, weight, medical_notes)
+		values ($1, $2, $3, $4, $5, $6) returning id, created_at, updated_at
+	`
+	tx := transactor.ExtractTx(ctx)
+
+	var err error
+	if tx != nil {
+		err = tx.QueryRowContext(
+			ctx,
+			query,
+			dependent.UserID,
+			dependent.FullName,
+			dependent.Relationship,
+			dependent.BirthDate,
+			dependent.Weight,
+			dependent.MedicalNotes,
+		).Scan(&dependent.ID, &dependent.CreatedAt, &dependent.UpdatedAt)
+	} else {
+		err = r.db.QueryRowContext(
+			ctx,
+			query,
+			dependent.UserID,
+			dependent.FullName,
+			dependent.Relationship,
+			dependent.BirthDate,
+			dependent.Weight,
+			dependent.MedicalNotes,
+		).Scan(&dependent.ID, &dependent.CreatedAt, &dependent.UpdatedAt)
+	}
+
+	return err
+}
+
+func (r *dependentRepositoryImpl) Update(ctx context.Context, dependent *entity.Dependent) error {
+	query := `
+		update dependents set full_name = $3, relationship = $4, birth_date = $5, weight = $6, medical_notes = $7, updated_at = now()
+		where id = $1 and user_id = $2 and deleted_at is null returning created_at, updated_at
+	`
+	tx := transactor.ExtractTx(ctx)
+
+	var err error
+	if tx != nil {
+		err = tx.QueryRowContext(
+			ctx,
+			query,
+			dependent.ID,
+			dependent.UserID,
+			dependent.FullName,
+			dependent.Relationship,
+			dependent.BirthDate,
+			dependent.Weight,
+			dependent.MedicalNotes,
+		).Scan(&dependent.CreatedAt, &dependent.UpdatedAt)
+	} else {
+		err = r.db.QueryRowContext(
+			ctx,
+			query,
+			dependent.ID,
+			dependent.UserID,
+			dependent.FullName,
+			dependent.Relationship,
+			dependent.BirthDate,
+			dependent.Weight,
+			dependent.MedicalNotes,
+		).Scan(&dependent.CreatedAt, &dependent.UpdatedAt)
+	}
+
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return apperrorPkg.NewEntityNotFoundError("dependent")
+		}
+		return err
+	}
+	return nil
+}
+
+func (r *dependentRepositoryImpl) DeleteByIDAndUserID(ctx context.Context, id int64, userID int64) error {
+	query := `
+		update dependents set deleted_at = now() where id = $1 and user_id = $2 and deleted_at is null
+	`
+	tx := transactor.ExtractTx(ctx)
+
+	var (
+		err error
+		res sql.Result
+	)
+	if tx != nil {
+		res, err = tx.ExecContext(ctx, query, id, userID)
+	} else {
+		res, err = r.db.ExecContext(ctx, query, id, userID)
+	}
+
+	if err != nil {
+		return err
+	}
+
+	rowsAffected, _ := res.RowsAffected()
+	if rowsAffected == 0 {
+		return apperrorPkg.NewEntityNotFoundError("dependent")
+	}
+	return nil
+}
+
+func (r *dependentRepositoryImpl) FindAllAllergiesByID(ctx context.Context, id int64) ([]string, error) {
+	query := `
+		select name from dependent_allergies where dependent_id = $1 order by id
+	`
+	tx := transactor.ExtractTx(ctx)
+
+	var (
+		err  error
+		rows *sql.Rows
+	)
+	if tx != nil {
+		rows, err = tx.QueryContext(ctx, query, id)
+	} else {
+		rows, err = r.db.QueryContext(ctx, query, id)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	allergies := []string{}
+	for rows.Next() {
+		var allergy string
+		if err := rows.Scan(&allergy); err != nil {
+			return nil, err
+		}
+		allergies = append(allergies, allergy)
+	}
+
+	err = rows.Err()
+	if err != nil {
+		return nil, err
+	}
+	return allergies, nil
+}
+
+func (r *dependentRepositoryImpl) ReplaceAllergies(ctx context.Context, dependent *entity.Dependent) error {
+	deleteQuery := `
+		delete from dependent_allergies where dependent_id = $1
+	`
+	tx := transactor.ExtractTx(ctx)
+
+	var err error
+	if tx != nil {
+		_, err = tx.ExecContext(ctx, deleteQuery, dependent.ID)
+	} else {
+		_, err = r.db.ExecContext(ctx, deleteQuery, dependent.ID)
+	}
+
+	if err != nil || len(dependent.Allergies) == 0 {
+		return err
+	}
+
+	placeholders := make([]string, len(dependent.Allergies))
+	args := []any{dependent.ID}
+	for i, allergy := range dependent.Allergies {
+		placeholders[i] = fmt.Sprintf("($1, $%v)", len(args)+1)
+		args = append(args, allergy)
+	}
+	insertQuery := "insert into dependent_allergies(dependent_id, name) values " + strings.Join(placeholders, ",")
+
+	if tx != nil {
+		_, err = tx.ExecContext(ctx, insertQuery, args...)
+	} else {
+		_, err = r.db.ExecContext(ctx, insertQuery, args...)
+	}
+
+	return err
+}
+
+func (r *dependentRepositoryImpl) findOne(ctx context.Context, query string, args ...any) (*entity.Dependent, error) {
+	tx := transactor.ExtractTx(ctx)
+
+	var (
+		err       error
+		dependent = new(entity.Dependent)
+	)
+	if tx != nil {
+		err = tx.QueryRowContext(ctx, query, args...).Scan(
+			&dependent.ID,
+			&dependent.UserID,
+			&dependent.FullName,
+			&dependent.Relationship,
+			&dependent.BirthDate,
+			&dependent.Weight,
+			&dependent.MedicalNotes,
+			&dependent.CreatedAt,
+			&dependent.UpdatedAt,
+		)
+	} else {
+		err = r.db.QueryRowContext(ctx, query, args...).Scan(
+			&dependent.ID,
+			&dependent.UserID,
+			&dependent.FullName,
+			&dependent.Relationship,
+			&dependent.BirthDate,
+			&dependent.Weight,
+			&dependent.MedicalNotes,
+			&dependent.CreatedAt,
+			&dependent.UpdatedAt,
+		)
+	}
+
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, apperrorPkg.NewEntityNotFoundError("dependent")
+		}
+		return nil, err
+	}
+	return dependent, nil
+}
@@ -0,0 +1,258 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+
+	"healthcare-app/internal/profile/constant"
+	"healthcare-app/internal/profile/entity"
+	"healthcare-app/pkg/database/transactor"
+)
+
+type MedicalProfileRepository interface {
+	FindByUserID(ctx context.Context, userID int64) (*entity.MedicalProfile, error)
+	FindAllAllergiesByUserID(ctx context.Context, userID int64) ([]string, error)
+	Save(ctx context.Context, profile *entity.MedicalProfile) error
+	ReplaceEntries(ctx context.Context, profile *entity.MedicalProfile) error
+	SaveAccessLog(ctx context.Context, log *entity.MedicalProfileAccessLog) error
+	FindAllAccessLogsByUserID(ctx context.Context, userID int64) ([]*entity.MedicalProfileAccessLog, error)
+}
+
+type medicalProfileRepositoryImpl struct {
+	db *sql.DB
+}
+
+func NewMedicalProfileRepository(db *sql.DB) *medicalProfileRepositoryImpl {
+	return &medicalProfileRepositoryImpl{
+		db: db,
+	}
+}
+
+func (r *medicalProfileRepositoryImpl) FindByUserID(ctx context.Context, userID int64) (*entity.MedicalProfile, error) {
+	query := `
+		select pregnancy_status, created_at, updated_at from medical_profiles
+		where user_id = $1 and deleted_at is null
+	`
+	tx := transactor.ExtractTx(ctx)
+
+	var (
+		err     error
+		profile = &entity.MedicalProfile{UserID: userID, PregnancyStatus: constant.PREGNANCY_STATUS_NONE}
+	)
+	if tx != nil {
+		err = tx.QueryRowContext(ctx, query, userID).Scan(&profile.PregnancyStatus, &profile.CreatedAt, &profile.UpdatedAt)
+	} else {
+		err = r.db.QueryRowContext(ctx, query, userID).Scan(&profile.PregnancyStatus, &profile.CreatedAt, &profile.UpdatedAt)
+	}
+
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return nil, err
+	}
+
+	profile.Entries, err = r.findAllEntriesByUserID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	return profile, nil
+}
+
+func (r *medicalProfileRepositoryImpl) FindAllAllergiesByUserID(ctx context.Context, userID int64) ([]string, error) {
+	query := `
+		select lower(name) from medical_profile_entries
+		where user_id = $1 and entry_type = $2
+	`
+	tx := transactor.ExtractTx(ctx)
+
+	var (
+		err  error
+		rows *sql.Rows
+	)
+	if tx != nil {
+		rows, err = tx.QueryContext(ctx, query, userID, constant.MEDICAL_ENTRY_ALLERGY)
+	} else {
+		rows, err = r.db.QueryContext(ctx, query, userID, constant.MEDICAL_ENTRY_ALLERGY)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	allergies := []string{}
+	for rows.Next() {
+		var allergy string
+		if err := rows.Scan(&allergy); err != nil {
+			return nil, err
+		}
+		allergies = append(allergies, allergy)
+	}
+
+	err = rows.Err()
+	if err != nil {
+		return nil, err
+	}
+	return allergies, nil
+}
+
+func (r *medicalProfileRepositoryImpl) Save(ctx context.Context, profile *entity.MedicalProfile) error {
+	query := `
+		insert into medical_profiles(user_id, pregnancy_status) values ($1, $2)
+		on conflict (user_id) do update set pregnancy_status = excluded.pregnancy_status, updated_at = now(), deleted_at = null
+		returning created_at, updated_at
+	`
+	tx := transactor.ExtractTx(ctx)
+
+	var err error
+	if tx != nil {
+		err = tx.QueryRowContext(ctx, query, profile.UserID, profile.PregnancyStatus).Scan(&profile.CreatedAt, &profile.UpdatedAt)
+	} else {
+		err = r.db.QueryRowContext(ctx, query, profile.UserID, profile.PregnancyStatus).Scan(&profile.CreatedAt, &profile.UpdatedAt)
+	}
+
+	return err
+}
+
+func (r *medicalProfileRepositoryImpl) ReplaceEntries(ctx context.Context, profile *entity.MedicalProfile) error {
+	deleteQuery := `
+		delete from medical_profile_entries where user_id = $1
+	`
+	tx := transactor.ExtractTx(ctx)
+
+	var err error
+	if tx != nil {
+		_, err = tx.ExecContext(ctx, deleteQuery, profile.UserID)
+	} else {
+		_, err = r.db.ExecContext(ctx, deleteQuery, profile.UserID)
+	}
+
+	if err != nil || len(profile.Entries) == 0 {
+		return err
+	}
+
+	placeholders := make([]string, len(profile.Entries))
+	args := []any{profile.UserID}
+	for i, entry := range profile.Entries {
+		placeholders[i] = fmt.Sprintf("($1, $%v, $%v, $%v)", len(args)+1, len(args)+2, len(args)+3)
+		args = append(args, entry.EntryType, entry.Name, entry.Description)
+	}
+	insertQuery := "insert into medical_profile_entries(user_id, entry_type, name, description) values " + strings.Join(placeholders, ",")
+
+	if tx != nil {
+		_, err = tx.ExecContext(ctx, insertQuery, args...)
+	} else {
+		_, err = r.db.ExecContext(ctx, insertQuery, args...)
+	}
+
+	return err
+}
+
+func (r *medicalProfileRepositoryImpl) SaveAccessLog(ctx context.Context, log *entity.MedicalProfileAccessLog) error {
+	query := `
+		insert into medical_profile_access_logs(user_id, accessed_by, accessor_role, action, order_id, dependent_id)
+		values ($1, $2, $3, $4, $5, $6) returning id, created_at
+	`
+	tx := transactor.ExtractTx(ctx)
+
+	var err error
+	if tx != nil {
+		err = tx.QueryRowContext(ctx, query, log.UserID, log.AccessedBy, log.AccessorRole, log.Action, log.OrderID, log.DependentID).Scan(&log.ID, &log.CreatedAt)
+	} else {
+		err = r.db.QueryRowContext(ctx, query, log.UserID, log.AccessedBy, log.AccessorRole, log.Action, log.OrderID, log.DependentID).Scan(&log.ID, &log.CreatedAt)
+	}
+
+	return err
+}
+
+func (r *medicalProfileRepositoryImpl) FindAllAccessLogsByUserID(ctx context.Context, userID int64) ([]*entity.MedicalProfileAccessLog, error) {
+	query := `
+		select l.id, l.user_id, l.accessed_by, coalesce(ud.full_name, u.email), l.accessor_role, l.action, l.order_id, l.dependent_id, l.created_at
+		from medical_profile_access_logs l
+		join users u on u.id = l.accessed_by
+		left join user_details ud on ud.user_id = l.accessed_by
+		where l.user_id = $1
+		order by l.created_at desc
+	`
+	tx := transactor.ExtractTx(ctx)
+
+	var (
+		err  error
+		rows *sql.Rows
+	)
+	if tx != nil {
+		rows, err = tx.QueryContext(ctx, query, userID)
+	} else {
+		rows, err = r.db.QueryContext(ctx, query, userID)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	logs := []*entity.MedicalProfileAccessLog{}
+	for rows.Next() {
+		log := new(entity.MedicalProfileAccessLog)
+		if err := rows.Scan(
+			&log.ID,
+			&log.UserID,
+			&log.AccessedBy,
+			&log.AccessorName,
+			&log.AccessorRole,
+			&log.Action,
+			&log.OrderID,
+			&log.DependentID,
+			&log.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		logs = append(logs, log)
+	}
+
+	err = rows.Err()
+	if err != nil {
+		return nil, err
+	}
+	return logs, nil
+}
+
+func (r *medicalProfileRepositoryImpl) findAllEntriesByUserID(ctx context.Context, userID int64) ([]*entity.MedicalProfileEntry, error) {
+	query := `
+		select id, entry_type, name, description from medical_profile_entries
+		where user_id = $1
+		order by id
+	`
+	tx := transactor.ExtractTx(ctx)
+
+	var (
+		err  error
+		rows *sql.Rows
+	)
+	if tx != nil {
+		rows, err = tx.QueryContext(ctx, query, userID)
+	} else {
+		rows, err = r.db.QueryContext(ctx, query, userID)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	entries := []*entity.MedicalProfileEntry{}
+	for rows.Next() {
+		entry := new(entity.MedicalProfileEntry)
+		if err := rows.Scan(&entry.ID, &entry.EntryType, &entry.Name, &entry.Description); err != nil {
+			return nil, err
+		}
+		entries = append(entries, entry)
+	}
+
+	err = rows.Err()
+	if err != nil {
+		return nil, err
+	}
+	return entries, nil
+}
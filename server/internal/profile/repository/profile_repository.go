@@ -11,6 +11,7 @@ import (
 
 type ProfileRepository interface {
 	PutMyProfile(ctx context.Context, reqBody *dtoProfile.RequestPutMyProfile, userId int64, roleId int64, imgUrl *string) (*entityAuth.UserDetail, error)
+	UpdateLocale(ctx context.Context, userId int64, locale string) error
 }
 
 type profileRepositoryImpl struct {
@@ -80,3 +81,19 @@ func (ar *profileRepositoryImpl) PutMyProfile(ctx context.Context, reqBody *dtoP
 
 	return &userDetail, nil
 }
+
+func (ar *profileRepositoryImpl) UpdateLocale(ctx context.Context, userId int64, locale string) error {
+	query := `
+		update users set locale = $1, updated_at = now() where id = $2
+	`
+	tx := transactor.ExtractTx(ctx)
+
+	var err error
+	if tx != nil {
+		_, err = tx.ExecContext(ctx, query, locale, userId)
+	} else {
+		_, err = ar.db.ExecContext(ctx, query, locale, userId)
+	}
+
+	return err
+}
@@ -34,3 +34,19 @@ func ProfileControllerRoute(c *controller.ProfileController, r *gin.Engine, auth
 	g.PUT("/me", c.PutMyProfile)
 	g.GET("/:userId", authMiddleware.ProtectedRoles(constant.ADMIN), c.GetProfileById)
 }
+
+func MedicalProfileControllerRoute(c *controller.MedicalProfileController, r *gin.Engine, authMiddleware *middleware.AuthMiddleware) {
+	g := r.Group("/users/me/medical-profile", authMiddleware.Authorization(), authMiddleware.ProtectedRoles(constant.USER))
+	g.GET("", c.GetMyMedicalProfile)
+	g.PUT("", c.PutMyMedicalProfile)
+	g.GET("/access-logs", c.GetMyAccessLogs)
+}
+
+func DependentControllerRoute(c *controller.DependentController, r *gin.Engine, authMiddleware *middleware.AuthMiddleware) {
+	g := r.Group("/users/me/dependents", authMiddleware.Authorization(), authMiddleware.ProtectedRoles(constant.USER))
+	g.GET("", c.GetAllMyDependents)
+	g.POST("", c.PostNewDependent)
+	g.GET("/:dependentId", c.GetMyDependent)
+	g.PUT("/:dependentId", c.PutMyDependent)
+	g.DELETE("/:dependentId", c.DeleteMyDependent)
+}
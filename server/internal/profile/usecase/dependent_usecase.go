@@ -0,0 +1,124 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	appErrorProfile "healthcare-app/internal/profile/apperror"
+	"healthcare-app/internal/profile/constant"
+	dtoProfile "healthcare-app/internal/profile/dto"
+	profileRepo "healthcare-app/internal/profile/repository"
+	appErrorPkg "healthcare-app/pkg/apperror"
+	"healthcare-app/pkg/database/transactor"
+)
+
+type DependentUseCase interface {
+	GetAllMyDependents(ctx context.Context, userID int64) ([]*dtoProfile.DependentResponse, error)
+	GetMyDependent(ctx context.Context, id int64, userID int64) (*dtoProfile.DependentResponse, error)
+	PostNewDependent(ctx context.Context, reqBody *dtoProfile.RequestDependent) (*dtoProfile.DependentResponse, error)
+	PutMyDependent(ctx context.Context, reqBody *dtoProfile.RequestDependent) (*dtoProfile.DependentResponse, error)
+	DeleteMyDependent(ctx context.Context, id int64, userID int64) error
+}
+
+type dependentUseCaseImpl struct {
+	dependentRepo profileRepo.DependentRepository
+	transactor    transactor.Transactor
+}
+
+func NewDependentUseCase(
+	dependentRepo profileRepo.DependentRepository,
+	transactor transactor.Transactor,
+) *dependentUseCaseImpl {
+	return &dependentUseCaseImpl{
+		dependentRepo: dependentRepo,
+		transactor:    transactor,
+	}
+}
+
+func (du *dependentUseCaseImpl) GetAllMyDependents(ctx context.Context, userID int64) ([]*dtoProfile.DependentResponse, error) {
+	dependents, err := du.dependentRepo.FindAllByUserID(ctx, userID)
+	if err != nil {
+		return nil, appErrorPkg.NewServerError(err)
+	}
+	for _, dependent := range dependents {
+		if dependent.Allergies, err = du.dependentRepo.FindAllAllergiesByID(ctx, dependent.ID); err != nil {
+			return nil, appErrorPkg.NewServerError(err)
+		}
+	}
+	return dtoProfile.ConvertToDependentResponses(dependents), nil
+}
+
+func (du *dependentUseCaseImpl) GetMyDependent(ctx context.Context, id int64, userID int64) (*dtoProfile.DependentResponse, error) {
+	dependent, err := du.dependentRepo.FindByIDAndUserID(ctx, id, userID)
+	if err != nil {
+		return nil, err
+	}
+	if dependent.Allergies, err = du.dependentRepo.FindAllAllergiesByID(ctx, dependent.ID); err != nil {
+		return nil, appErrorPkg.NewServerError(err)
+	}
+	return dtoProfile.ConvertToDependentResponse(dependent), nil
+}
+
+func (du *dependentUseCaseImpl) PostNewDependent(ctx context.Context, reqBody *dtoProfile.RequestDependent) (*dtoProfile.DependentResponse, error) {
+	if err := validateDependent(reqBody); err != nil {
+		return nil, err
+	}
+	dependent := dtoProfile.DependentRequestToEntity(reqBody)
+
+	err := du.transactor.Atomic(ctx, func(cForTx context.Context) error {
+		count, err := du.dependentRepo.CountByUserID(cForTx, reqBody.UserID)
+		if err != nil {
+			return appErrorPkg.NewServerError(err)
+		}
+		if count >= constant.MAX_DEPENDENTS {
+			return appErrorProfile.NewUserReachMaximumNumberOfDependents()
+		}
+		if err := du.dependentRepo.Save(cForTx, dependent); err != nil {
+			return appErrorPkg.NewServerError(err)
+		}
+		if err := du.dependentRepo.ReplaceAllergies(cForTx, dependent); err != nil {
+			return appErrorPkg.NewServerError(err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return dtoProfile.ConvertToDependentResponse(dependent), nil
+}
+
+func (du *dependentUseCaseImpl) PutMyDependent(ctx context.Context, reqBody *dtoProfile.RequestDependent) (*dtoProfile.DependentResponse, error) {
+	if err := validateDependent(reqBody); err != nil {
+		return nil, err
+	}
+	dependent := dtoProfile.DependentRequestToEntity(reqBody)
+
+	err := du.transactor.Atomic(ctx, func(cForTx context.Context) error {
+		if err := du.dependentRepo.Update(cForTx, dependent); err != nil {
+			return err
+		}
+		if err := du.dependentRepo.ReplaceAllergies(cForTx, dependent); err != nil {
+			return appErrorPkg.NewServerError(err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return dtoProfile.ConvertToDependentResponse(dependent), nil
+}
+
+func (du *dependentUseCaseImpl) DeleteMyDependent(ctx context.Context, id int64, userID int64) error {
+	return du.dependentRepo.DeleteByIDAndUserID(ctx, id, userID)
+}
+
+func validateDependent(reqBody *dtoProfile.RequestDependent) error {
+	birthDate, err := time.Parse(constant.BIRTH_DATE_LAYOUT, reqBody.BirthDate)
+	if err != nil || birthDate.After(time.Now()) {
+		return appErrorProfile.NewInvalidDependentBirthDateError()
+	}
+	if reqBody.Weight != nil && !reqBody.Weight.IsPositive() {
+		return appErrorProfile.NewInvalidDependentWeightError()
+	}
+	return nil
+}
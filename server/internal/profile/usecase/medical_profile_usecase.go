@@ -0,0 +1,79 @@
+package usecase
+
+import (
+	"context"
+
+	"healthcare-app/internal/auth/constant"
+	constantProfile "healthcare-app/internal/profile/constant"
+	dtoProfile "healthcare-app/internal/profile/dto"
+	"healthcare-app/internal/profile/entity"
+	profileRepo "healthcare-app/internal/profile/repository"
+	appErrorPkg "healthcare-app/pkg/apperror"
+	"healthcare-app/pkg/database/transactor"
+	dtoPkg "healthcare-app/pkg/dto"
+	"healthcare-app/pkg/utils/pageutils"
+)
+
+type MedicalProfileUseCase interface {
+	GetMyMedicalProfile(ctx context.Context, userID int64) (*dtoProfile.MedicalProfileResponse, error)
+	PutMyMedicalProfile(ctx context.Context, reqBody *dtoProfile.RequestPutMedicalProfile, userID int64) (*dtoProfile.MedicalProfileResponse, error)
+	GetMyAccessLogs(ctx context.Context, request *dtoProfile.RequestGetMedicalProfileAccessLog) ([]*dtoProfile.MedicalProfileAccessLogResponse, *dtoPkg.PageMetaData, error)
+}
+
+type medicalProfileUseCaseImpl struct {
+	medicalProfileRepo profileRepo.MedicalProfileRepository
+	transactor         transactor.Transactor
+}
+
+func NewMedicalProfileUseCase(
+	medicalProfileRepo profileRepo.MedicalProfileRepository,
+	transactor transactor.Transactor,
+) *medicalProfileUseCaseImpl {
+	return &medicalProfileUseCaseImpl{
+		medicalProfileRepo: medicalProfileRepo,
+		transactor:         transactor,
+	}
+}
+
+func (mu *medicalProfileUseCaseImpl) GetMyMedicalProfile(ctx context.Context, userID int64) (*dtoProfile.MedicalProfileResponse, error) {
+	profile, err := mu.medicalProfileRepo.FindByUserID(ctx, userID)
+	if err != nil {
+		return nil, appErrorPkg.NewServerError(err)
+	}
+	return dtoProfile.ConvertToMedicalProfileResponse(profile), nil
+}
+
+func (mu *medicalProfileUseCaseImpl) PutMyMedicalProfile(ctx context.Context, reqBody *dtoProfile.RequestPutMedicalProfile, userID int64) (*dtoProfile.MedicalProfileResponse, error) {
+	profile := dtoProfile.PutMedicalProfileRequestToEntity(reqBody, userID)
+	err := mu.transactor.Atomic(ctx, func(cForTx context.Context) error {
+		if err := mu.medicalProfileRepo.Save(cForTx, profile); err != nil {
+			return appErrorPkg.NewServerError(err)
+		}
+		if err := mu.medicalProfileRepo.ReplaceEntries(cForTx, profile); err != nil {
+			return appErrorPkg.NewServerError(err)
+		}
+		if err := mu.medicalProfileRepo.SaveAccessLog(cForTx, &entity.MedicalProfileAccessLog{
+			UserID:       userID,
+			AccessedBy:   userID,
+			AccessorRole: constant.USER,
+			Action:       constantProfile.MEDICAL_PROFILE_ACTION_UPDATE,
+		}); err != nil {
+			return appErrorPkg.NewServerError(err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return dtoProfile.ConvertToMedicalProfileResponse(profile), nil
+}
+
+func (mu *medicalProfileUseCaseImpl) GetMyAccessLogs(ctx context.Context, request *dtoProfile.RequestGetMedicalProfileAccessLog) ([]*dtoProfile.MedicalProfileAccessLogResponse, *dtoPkg.PageMetaData, error) {
+	logs, err := mu.medicalProfileRepo.FindAllAccessLogsByUserID(ctx, request.UserID)
+	if err != nil {
+		return nil, nil, appErrorPkg.NewServerError(err)
+	}
+
+	res, metaData := pageutils.CreateMetaData(logs, request.Page, request.Limit)
+	return dtoProfile.ConvertToMedicalProfileAccessLogResponses(res), metaData, nil
+}
@@ -2,7 +2,7 @@ package usecase
 
 import (
 	"context"
-	"path/filepath"
+	"io"
 	"strings"
 
 	appErrorAuth "healthcare-app/internal/auth/apperror"
@@ -17,10 +17,8 @@ import (
 	profileRepo "healthcare-app/internal/profile/repository"
 	appErrorPkg "healthcare-app/pkg/apperror"
 	"healthcare-app/pkg/database/transactor"
-	"healthcare-app/pkg/utils/cloudinaryutils"
-
-	"github.com/cloudinary/cloudinary-go/v2/api"
-	"github.com/cloudinary/cloudinary-go/v2/api/uploader"
+	"healthcare-app/pkg/utils/imageutils"
+	"healthcare-app/pkg/utils/storageutils"
 )
 
 type ProfileUseCase interface {
@@ -29,11 +27,11 @@ type ProfileUseCase interface {
 }
 
 type profileUseCaseImpl struct {
-	profileRepo    profileRepo.ProfileRepository
-	addressRepo    profileRepo.AddressRepository
-	userRepo       authRepo.UserRepository
-	transactor     transactor.Transactor
-	cloudinaryUtil cloudinaryutils.CloudinaryUtil
+	profileRepo   profileRepo.ProfileRepository
+	addressRepo   profileRepo.AddressRepository
+	userRepo      authRepo.UserRepository
+	transactor    transactor.Transactor
+	objectStorage storageutils.ObjectStorage
 }
 
 func NewProfileUseCase(
@@ -41,14 +39,14 @@ func NewProfileUseCase(
 	addressRepo profileRepo.AddressRepository,
 	userRepo authRepo.UserRepository,
 	transactor transactor.Transactor,
-	cloudinaryUtil cloudinaryutils.CloudinaryUtil,
+	objectStorage storageutils.ObjectStorage,
 ) *profileUseCaseImpl {
 	return &profileUseCaseImpl{
-		profileRepo:    profileRepo,
-		addressRepo:    addressRepo,
-		userRepo:       userRepo,
-		transactor:     transactor,
-		cloudinaryUtil: cloudinaryUtil,
+		profileRepo:   profileRepo,
+		addressRepo:   addressRepo,
+		userRepo:      userRepo,
+		transactor:    transactor,
+		objectStorage: objectStorage,
 	}
 }
 
@@ -73,6 +71,7 @@ func (pu *profileUseCaseImpl) GetProfile(ctx context.Context, userId int64, serv
 			Email:      userDb.Email,
 			IsVerified: userDb.IsVerified,
 			IsOauth:    userDb.IsOauth,
+			Locale:     userDb.Locale,
 			CreatedAt:  userDb.CreatedAt,
 			UpdatedAt:  userDb.UpdatedAt,
 			DeletedAt:  userDb.DeletedAt,
@@ -127,9 +126,6 @@ func (pu *profileUseCaseImpl) PutMyProfile(ctx context.Context, reqBody *dtoProf
 		userDetail *dtoAuth.ResponseUserDetail
 	)
 	if reqBody.ProfileImage != nil {
-		if filepath.Ext(reqBody.ProfileImage.Filename) != ".png" && filepath.Ext(reqBody.ProfileImage.Filename) != ".jpg" && filepath.Ext(reqBody.ProfileImage.Filename) != ".jpeg" {
-			return nil, appErrorOrder.NewInvalidImageErrorMessagePaymentProofError()
-		}
 		if reqBody.ProfileImage.Size > productConstant.MAX_IMAGE_SIZE {
 			return nil, appErrorOrder.NewInvalidPhotoMaxSizeError()
 		}
@@ -139,12 +135,19 @@ func (pu *profileUseCaseImpl) PutMyProfile(ctx context.Context, reqBody *dtoProf
 		if err != nil || userDb.ID == 0 {
 			return appErrorPkg.NewForbiddenAccessError()
 		}
+		if reqBody.Locale != "" {
+			if err := pu.profileRepo.UpdateLocale(cForTx, userId, reqBody.Locale); err != nil {
+				return appErrorPkg.NewServerError(err)
+			}
+			userDb.Locale = reqBody.Locale
+		}
 		user = dtoAuth.ResponseUser{
 			ID:         userDb.ID,
 			RoleId:     userDb.Role,
 			Role:       utilsAuth.SpecifyRole(userDb.Role),
 			Email:      userDb.Email,
 			IsVerified: userDb.IsVerified,
+			Locale:     userDb.Locale,
 			CreatedAt:  userDb.CreatedAt,
 			UpdatedAt:  userDb.UpdatedAt,
 			DeletedAt:  userDb.DeletedAt,
@@ -175,11 +178,18 @@ func (pu *profileUseCaseImpl) PutMyProfile(ctx context.Context, reqBody *dtoProf
 			if err != nil {
 				return err
 			}
-			imgUrl, err = pu.cloudinaryUtil.UploadImage(cForTx, f, uploader.UploadParams{
-				PublicID:       strings.ToLower(strings.ReplaceAll(utils.GeneratePhotoProfileTitle(userDb.ID, *checkUserDetail.Fullname), " ", "-")),
-				UniqueFilename: api.Bool(true),
-				Overwrite:      api.Bool(true),
-				Invalidate:     api.Bool(true),
+			defer f.Close()
+
+			data, err := io.ReadAll(f)
+			if err != nil {
+				return appErrorPkg.NewServerError(err)
+			}
+			image, err := imageutils.Process(data, productConstant.THUMBNAIL_MAX_DIMENSION)
+			if err != nil {
+				return appErrorOrder.NewInvalidImageErrorMessagePaymentProofError()
+			}
+			imgUrl, err = pu.objectStorage.Upload(cForTx, image, storageutils.UploadParams{
+				Key: strings.ToLower(strings.ReplaceAll(utils.GeneratePhotoProfileTitle(userDb.ID, *checkUserDetail.Fullname), " ", "-")),
 			})
 			if err != nil {
 				return appErrorPkg.NewServerError(err)
@@ -5,4 +5,6 @@ const (
 	THUMBNAIL_URL       = "thumbnail"
 	SECONDARY_IMAGE_URL = "secondary-image"
 	TERTIARY_IMAGE_URL  = "tertiary-image"
+	IMAGE_WEBP_URL      = "image-webp"
+	THUMBNAIL_WEBP_URL  = "thumbnail-webp"
 )
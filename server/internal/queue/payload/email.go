@@ -18,3 +18,48 @@ type PharmacistAccountEmailPayload struct {
 	Password string `json:"password"`
 	Yoe      int    `json:"yoe"`
 }
+
+type DoctorAccountEmailPayload struct {
+	Email          string `json:"email"`
+	Name           string `json:"name"`
+	License        string `json:"license"`
+	Specialization string `json:"specialization"`
+	Whatsapp       string `json:"whatsapp"`
+	Password       string `json:"password"`
+	Yoe            int    `json:"yoe"`
+}
+
+type RefillReminderEmailPayload struct {
+	UserID     int64    `json:"user_id"`
+	Email      string   `json:"email"`
+	RefillDate string   `json:"refill_date"`
+	Products   []string `json:"products"`
+}
+
+type RefillShortageEmailPayload struct {
+	UserID   int64    `json:"user_id"`
+	Email    string   `json:"email"`
+	Products []string `json:"products"`
+}
+
+// OrderEmailPayload is shared by every order lifecycle email. Amounts are
+// formatted before queueing so the templates only print them.
+type OrderEmailPayload struct {
+	UserID            int64               `json:"user_id"`
+	Email             string              `json:"email"`
+	OrderID           int64               `json:"order_id"`
+	Invoice           string              `json:"invoice"`
+	PharmacyName      string              `json:"pharmacy_name"`
+	Products          []OrderEmailProduct `json:"products"`
+	TotalProductPrice string              `json:"total_product_price"`
+	ShipCost          string              `json:"ship_cost"`
+	TotalPayment      string              `json:"total_payment"`
+	Reason            string              `json:"reason,omitempty"`
+}
+
+type OrderEmailProduct struct {
+	Name     string `json:"name"`
+	Quantity int64  `json:"quantity"`
+	Price    string `json:"price"`
+	Subtotal string `json:"subtotal"`
+}
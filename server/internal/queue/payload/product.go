@@ -1,10 +1,12 @@
 package payload
 
 import (
+	"context"
 	"io"
 	"mime/multipart"
 	"sync"
 
+	authUtils "healthcare-app/internal/auth/utils"
 	"healthcare-app/internal/product/dto"
 	"healthcare-app/internal/product/entity"
 	"healthcare-app/internal/queue/constant"
@@ -20,6 +22,7 @@ type ProductPayload struct {
 	ProductFormID           *int64           `form:"product_form_id"`
 	Name                    string           `json:"name"`
 	GenericName             string           `form:"generic_name"`
+	Strength                *string          `form:"strength"`
 	Description             string           `form:"description"`
 	UnitInPack              *string          `form:"unit_in_pack"`
 	SellingUnit             *string          `form:"selling_unit"`
@@ -104,6 +107,7 @@ func UpdateRequestToProductPayload(
 		ProductFormID:           request.ProductFormID,
 		Name:                    entity.Name,
 		GenericName:             request.GenericName,
+		Strength:                request.Strength,
 		Description:             request.Description,
 		UnitInPack:              request.UnitInPack,
 		SellingUnit:             request.SellingUnit,
@@ -120,6 +124,75 @@ func UpdateRequestToProductPayload(
 	}
 }
 
+type ProductImportPayload struct {
+	ID     int64  `json:"id"`
+	DryRun bool   `json:"dry_run"`
+	File   string `json:"file"`
+	Images string `json:"images"`
+}
+
+func ImportRequestToProductImportPayload(
+	base64Encryptor encryptutils.Base64Encryptor,
+	entity *entity.ProductImport,
+	request *dto.ImportProductRequest,
+) *ProductImportPayload {
+	return &ProductImportPayload{
+		ID:     entity.ID,
+		DryRun: entity.IsDryRun,
+		File:   convertFileToBase64(base64Encryptor, request.File),
+		Images: convertFileToBase64(base64Encryptor, request.Images),
+	}
+}
+
+// PharmacyProductImportPayload carries who started the import and from where,
+// the worker records the stock changes under that pharmacist.
+type PharmacyProductImportPayload struct {
+	ID           int64  `json:"id"`
+	PharmacyID   int64  `json:"pharmacy_id"`
+	PharmacistID int64  `json:"pharmacist_id"`
+	RequestID    string `json:"request_id"`
+	ClientIP     string `json:"client_ip"`
+	File         string `json:"file"`
+}
+
+func ImportRequestToPharmacyProductImportPayload(
+	ctx context.Context,
+	base64Encryptor encryptutils.Base64Encryptor,
+	entity *entity.PharmacyProductImport,
+	request *dto.ImportPharmacyProductRequest,
+) *PharmacyProductImportPayload {
+	return &PharmacyProductImportPayload{
+		ID:           entity.ID,
+		PharmacyID:   entity.PharmacyID,
+		PharmacistID: request.PharmacistID,
+		RequestID:    authUtils.GetRequestIDFromContext(ctx),
+		ClientIP:     authUtils.GetClientIPFromContext(ctx),
+		File:         convertFileToBase64(base64Encryptor, request.File),
+	}
+}
+
+func convertFileToBase64(
+	base64Encryptor encryptutils.Base64Encryptor,
+	file *multipart.FileHeader,
+) string {
+	if file == nil {
+		return ""
+	}
+
+	f, err := file.Open()
+	if err != nil {
+		return ""
+	}
+	defer f.Close()
+
+	bytes, err := io.ReadAll(f)
+	if err != nil {
+		return ""
+	}
+
+	return base64Encryptor.EncodeStd(string(bytes))
+}
+
 func convertImageToBase64(
 	base64Encryptor encryptutils.Base64Encryptor,
 	image *multipart.FileHeader,
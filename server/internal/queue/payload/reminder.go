@@ -0,0 +1,10 @@
+package payload
+
+type MedicationReminderPayload struct {
+	UserID       int64  `json:"user_id"`
+	Email        string `json:"email"`
+	ScheduleID   int64  `json:"schedule_id"`
+	MedicineName string `json:"medicine_name"`
+	Dosage       string `json:"dosage"`
+	ScheduledAt  string `json:"scheduled_at"`
+}
@@ -0,0 +1,5 @@
+package payload
+
+type WebhookDeliveryPayload struct {
+	DeliveryID int64 `json:"delivery_id"`
+}
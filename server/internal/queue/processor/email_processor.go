@@ -5,7 +5,11 @@ import (
 	"encoding/json"
 	"fmt"
 
+	constantNotification "healthcare-app/internal/notification/constant"
+	dtoNotification "healthcare-app/internal/notification/dto"
+	usecaseNotification "healthcare-app/internal/notification/usecase"
 	"healthcare-app/internal/queue/payload"
+	"healthcare-app/pkg/config"
 	"healthcare-app/pkg/utils/encryptutils"
 	"healthcare-app/pkg/utils/smtputils"
 
@@ -13,17 +17,23 @@ import (
 )
 
 type EmailTaskProcessor struct {
-	base64Encryptor encryptutils.Base64Encryptor
-	smtpUtil        smtputils.SMTPUtils
+	appConfig              *config.AppConfig
+	base64Encryptor        encryptutils.Base64Encryptor
+	smtpUtil               smtputils.SMTPUtils
+	notificationDispatcher usecaseNotification.NotificationDispatcher
 }
 
 func NewEmailTaskProcessor(
+	appConfig *config.AppConfig,
 	base64Encryptor encryptutils.Base64Encryptor,
 	smtpUtil smtputils.SMTPUtils,
+	notificationDispatcher usecaseNotification.NotificationDispatcher,
 ) *EmailTaskProcessor {
 	return &EmailTaskProcessor{
-		base64Encryptor: base64Encryptor,
-		smtpUtil:        smtpUtil,
+		appConfig:              appConfig,
+		base64Encryptor:        base64Encryptor,
+		smtpUtil:               smtpUtil,
+		notificationDispatcher: notificationDispatcher,
 	}
 }
 
@@ -41,7 +51,7 @@ func (p *EmailTaskProcessor) HandleVerificationEmail(ctx context.Context, t *asy
 		smtputils.VerificationSubject,
 		smtputils.VerificationTemplate,
 		map[string]any{
-			"Link": fmt.Sprintf("http://localhost:5173/verify-account?token=%v&email=%v", encodedToken, encodedEmail),
+			"Link": fmt.Sprintf("%v/verify-account?token=%v&email=%v", p.appConfig.FrontendURL, encodedToken, encodedEmail),
 		},
 	)
 
@@ -61,7 +71,7 @@ func (p *EmailTaskProcessor) HandleForgotPasswordEmail(ctx context.Context, t *a
 		payload.Email,
 		smtputils.ResetPasswordSubject,
 		smtputils.ResetPasswordTemplate,
-		map[string]any{"Link": fmt.Sprintf("http://localhost:5173/reset-password?token=%v&email=%v", encodedToken, encodedEmail)},
+		map[string]any{"Link": fmt.Sprintf("%v/reset-password?token=%v&email=%v", p.appConfig.FrontendURL, encodedToken, encodedEmail)},
 	)
 
 	return err
@@ -88,3 +98,174 @@ func (p *EmailTaskProcessor) HandlePharmacistAccountEmail(ctx context.Context, t
 
 	return err
 }
+
+func (p *EmailTaskProcessor) HandleDoctorAccountEmail(ctx context.Context, t *asynq.Task) error {
+	payload := new(payload.DoctorAccountEmailPayload)
+	if err := json.Unmarshal(t.Payload(), payload); err != nil {
+		return err
+	}
+
+	err := p.smtpUtil.SendMailHTMLContext(
+		ctx,
+		payload.Email,
+		smtputils.DoctorSubject, smtputils.DoctorTemplate, map[string]any{
+			"Name":           payload.Name,
+			"License":        payload.License,
+			"Specialization": payload.Specialization,
+			"Whatsapp":       payload.Whatsapp,
+			"Yoe":            payload.Yoe,
+			"Email":          payload.Email,
+			"Password":       payload.Password,
+		},
+	)
+
+	return err
+}
+
+func (p *EmailTaskProcessor) HandleRefillReminderEmail(ctx context.Context, t *asynq.Task) error {
+	payload := new(payload.RefillReminderEmailPayload)
+	if err := json.Unmarshal(t.Payload(), payload); err != nil {
+		return err
+	}
+
+	err := p.notificationDispatcher.SendMail(ctx, &dtoNotification.DispatchMail{
+		UserID:   payload.UserID,
+		Type:     constantNotification.TYPE_REFILL_REMINDER,
+		To:       payload.Email,
+		Subject:  smtputils.RefillReminderSubject,
+		Template: smtputils.RefillReminderTemplate,
+		Data: map[string]any{
+			"RefillDate": payload.RefillDate,
+			"Products":   payload.Products,
+			"Link":       fmt.Sprintf("%v/subscriptions", p.appConfig.FrontendURL),
+		},
+	})
+
+	return err
+}
+
+func (p *EmailTaskProcessor) HandleRefillShortageEmail(ctx context.Context, t *asynq.Task) error {
+	payload := new(payload.RefillShortageEmailPayload)
+	if err := json.Unmarshal(t.Payload(), payload); err != nil {
+		return err
+	}
+
+	err := p.notificationDispatcher.SendMail(ctx, &dtoNotification.DispatchMail{
+		UserID:   payload.UserID,
+		Type:     constantNotification.TYPE_REFILL_SHORTAGE,
+		To:       payload.Email,
+		Subject:  smtputils.RefillShortageSubject,
+		Template: smtputils.RefillShortageTemplate,
+		Data: map[string]any{
+			"Products": payload.Products,
+			"Link":     fmt.Sprintf("%v/subscriptions", p.appConfig.FrontendURL),
+		},
+	})
+
+	return err
+}
+
+func (p *EmailTaskProcessor) HandleOrderReceiptEmail(ctx context.Context, t *asynq.Task) error {
+	payload := new(payload.OrderEmailPayload)
+	if err := json.Unmarshal(t.Payload(), payload); err != nil {
+		return err
+	}
+
+	err := p.notificationDispatcher.SendMail(ctx, &dtoNotification.DispatchMail{
+		UserID:   payload.UserID,
+		Type:     constantNotification.TYPE_ORDER_RECEIPT,
+		To:       payload.Email,
+		Subject:  smtputils.OrderReceiptSubject,
+		Template: smtputils.OrderReceiptTemplate,
+		Data:     p.orderEmailData(payload),
+	})
+
+	return err
+}
+
+func (p *EmailTaskProcessor) HandleOrderPaidEmail(ctx context.Context, t *asynq.Task) error {
+	payload := new(payload.OrderEmailPayload)
+	if err := json.Unmarshal(t.Payload(), payload); err != nil {
+		return err
+	}
+
+	err := p.notificationDispatcher.SendMail(ctx, &dtoNotification.DispatchMail{
+		UserID:   payload.UserID,
+		Type:     constantNotification.TYPE_ORDER_PROCESSED,
+		To:       payload.Email,
+		Subject:  smtputils.OrderPaidSubject,
+		Template: smtputils.OrderPaidTemplate,
+		Data:     p.orderEmailData(payload),
+	})
+
+	return err
+}
+
+func (p *EmailTaskProcessor) HandleOrderShippedEmail(ctx context.Context, t *asynq.Task) error {
+	payload := new(payload.OrderEmailPayload)
+	if err := json.Unmarshal(t.Payload(), payload); err != nil {
+		return err
+	}
+
+	err := p.notificationDispatcher.SendMail(ctx, &dtoNotification.DispatchMail{
+		UserID:   payload.UserID,
+		Type:     constantNotification.TYPE_ORDER_SENT,
+		To:       payload.Email,
+		Subject:  smtputils.OrderShippedSubject,
+		Template: smtputils.OrderShippedTemplate,
+		Data:     p.orderEmailData(payload),
+	})
+
+	return err
+}
+
+func (p *EmailTaskProcessor) HandleOrderCancelledEmail(ctx context.Context, t *asynq.Task) error {
+	payload := new(payload.OrderEmailPayload)
+	if err := json.Unmarshal(t.Payload(), payload); err != nil {
+		return err
+	}
+
+	err := p.notificationDispatcher.SendMail(ctx, &dtoNotification.DispatchMail{
+		UserID:   payload.UserID,
+		Type:     constantNotification.TYPE_ORDER_CANCELLED,
+		To:       payload.Email,
+		Subject:  smtputils.OrderCancelledSubject,
+		Template: smtputils.OrderCancelledTemplate,
+		Data:     p.orderEmailData(payload),
+	})
+
+	return err
+}
+
+func (p *EmailTaskProcessor) HandleOrderConfirmedEmail(ctx context.Context, t *asynq.Task) error {
+	payload := new(payload.OrderEmailPayload)
+	if err := json.Unmarshal(t.Payload(), payload); err != nil {
+		return err
+	}
+
+	err := p.notificationDispatcher.SendMail(ctx, &dtoNotification.DispatchMail{
+		UserID:   payload.UserID,
+		Type:     constantNotification.TYPE_ORDER_CONFIRMED,
+		To:       payload.Email,
+		Subject:  smtputils.OrderConfirmedSubject,
+		Template: smtputils.OrderConfirmedTemplate,
+		Data:     p.orderEmailData(payload),
+	})
+
+	return err
+}
+
+// orderEmailData is shared by every order lifecycle template, they only differ
+// in the wording around the same order summary.
+func (p *EmailTaskProcessor) orderEmailData(payload *payload.OrderEmailPayload) map[string]any {
+	return map[string]any{
+		"Invoice":           payload.Invoice,
+		"PharmacyName":      payload.PharmacyName,
+		"Products":          payload.Products,
+		"TotalProductPrice": payload.TotalProductPrice,
+		"ShipCost":          payload.ShipCost,
+		"TotalPayment":      payload.TotalPayment,
+		"Reason":            payload.Reason,
+		"Link":              fmt.Sprintf("%v/orders/%v", p.appConfig.FrontendURL, payload.OrderID),
+	}
+}
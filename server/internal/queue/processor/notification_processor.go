@@ -0,0 +1,45 @@
+package processor
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"healthcare-app/internal/queue/payload"
+	"healthcare-app/pkg/utils/notificationutils"
+
+	"github.com/hibiken/asynq"
+)
+
+type NotificationTaskProcessor struct {
+	channels map[string]notificationutils.Channel
+}
+
+func NewNotificationTaskProcessor(channels ...notificationutils.Channel) *NotificationTaskProcessor {
+	processor := &NotificationTaskProcessor{
+		channels: map[string]notificationutils.Channel{},
+	}
+	for _, channel := range channels {
+		processor.channels[channel.Name()] = channel
+	}
+	return processor
+}
+
+func (p *NotificationTaskProcessor) HandleNotificationDelivery(ctx context.Context, t *asynq.Task) error {
+	payload := new(payload.NotificationDeliveryPayload)
+	if err := json.Unmarshal(t.Payload(), payload); err != nil {
+		return err
+	}
+
+	channel, ok := p.channels[payload.Channel]
+	if !ok {
+		return fmt.Errorf("unknown notification channel %q: %w", payload.Channel, asynq.SkipRetry)
+	}
+	return channel.Send(ctx, &notificationutils.Message{
+		Recipient: payload.Recipient,
+		Subject:   payload.Subject,
+		Title:     payload.Title,
+		Body:      payload.Body,
+		Link:      payload.Link,
+	})
+}
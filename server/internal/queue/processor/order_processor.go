@@ -5,34 +5,55 @@ import (
 	"encoding/json"
 	"strings"
 
+	usecaseNotification "healthcare-app/internal/notification/usecase"
+	utilsNotification "healthcare-app/internal/notification/utils"
+	"healthcare-app/internal/order/constant"
+	"healthcare-app/internal/order/dto"
 	"healthcare-app/internal/order/repository"
+	usecaseOrder "healthcare-app/internal/order/usecase"
 	"healthcare-app/internal/order/utils"
+	repositoryPharmacy "healthcare-app/internal/pharmacy/repository"
 	"healthcare-app/internal/queue/payload"
+	"healthcare-app/internal/queue/tasks"
 	"healthcare-app/pkg/database/transactor"
-	"healthcare-app/pkg/utils/cloudinaryutils"
+	"healthcare-app/pkg/utils/imageutils"
+	"healthcare-app/pkg/utils/storageutils"
 
-	"github.com/cloudinary/cloudinary-go/v2/api"
-	"github.com/cloudinary/cloudinary-go/v2/api/uploader"
 	"github.com/hibiken/asynq"
 )
 
 type OrderTaskProcessor struct {
-	cloudinaryUtil            cloudinaryutils.CloudinaryUtil
+	objectStorage             storageutils.ObjectStorage
+	emailTask                 tasks.EmailTask
+	orderRepository           repository.OrderRepository
 	userOrderRepository       repository.UserOrderRepository
 	pharmacistOrderRepository repository.PharmacistOrderRepository
+	pharmacyRepository        repositoryPharmacy.PharmacyRepository
+	notificationUseCase       usecaseNotification.NotificationUseCase
+	orderEventUseCase         usecaseOrder.OrderEventUseCase
 	transactor                transactor.Transactor
 }
 
 func NewOrderTaskProcessor(
-	cloudinaryUtil cloudinaryutils.CloudinaryUtil,
+	objectStorage storageutils.ObjectStorage,
+	emailTask tasks.EmailTask,
+	orderRepository repository.OrderRepository,
 	userOrderRepository repository.UserOrderRepository,
 	pharmacistOrderRepository repository.PharmacistOrderRepository,
+	pharmacyRepository repositoryPharmacy.PharmacyRepository,
+	notificationUseCase usecaseNotification.NotificationUseCase,
+	orderEventUseCase usecaseOrder.OrderEventUseCase,
 	transactor transactor.Transactor,
 ) *OrderTaskProcessor {
 	return &OrderTaskProcessor{
-		cloudinaryUtil:            cloudinaryUtil,
+		objectStorage:             objectStorage,
+		emailTask:                 emailTask,
+		orderRepository:           orderRepository,
 		userOrderRepository:       userOrderRepository,
 		pharmacistOrderRepository: pharmacistOrderRepository,
+		pharmacyRepository:        pharmacyRepository,
+		notificationUseCase:       notificationUseCase,
+		orderEventUseCase:         orderEventUseCase,
 		transactor:                transactor,
 	}
 }
@@ -43,22 +64,64 @@ func (p *OrderTaskProcessor) HandleProcessOrder(ctx context.Context, t *asynq.Ta
 		return err
 	}
 
-	imgUrl, err := p.cloudinaryUtil.UploadImage(ctx, payload.Image, uploader.UploadParams{
-		PublicID:       strings.ToLower(strings.ReplaceAll(utils.GeneratePaymentProofTitle(payload.ID), " ", "-")),
-		UniqueFilename: api.Bool(true),
-		Overwrite:      api.Bool(true),
-		Invalidate:     api.Bool(true),
+	data, err := storageutils.ReadObject(ctx, payload.Image)
+	if err != nil {
+		return err
+	}
+	image, err := imageutils.Process(data, constant.IMAGE_MAX_DIMENSION)
+	if err != nil {
+		return err
+	}
+
+	imgUrl, err := p.objectStorage.Upload(ctx, image, storageutils.UploadParams{
+		Key:     strings.ToLower(strings.ReplaceAll(utils.GeneratePaymentProofTitle(payload.ID), " ", "-")),
+		Private: true,
 	})
 	if err != nil {
 		return err
 	}
 
-	err = p.userOrderRepository.PostUploadPaymentProof(ctx, imgUrl, payload.ID, payload.UserID)
+	var events []*dto.OrderEvent
+	err = p.transactor.Atomic(ctx, func(txCtx context.Context) error {
+		if err := p.userOrderRepository.PostUploadPaymentProof(txCtx, imgUrl, payload.ID, payload.UserID); err != nil {
+			return err
+		}
+		if err := p.userOrderRepository.ProcessOrder(txCtx, payload.ID); err != nil {
+			return err
+		}
+
+		orders, err := p.orderRepository.FindAllByIDs(txCtx, []int64{payload.ID})
+		if err != nil {
+			return err
+		}
+		orderResponses := dto.ConvertToOrderResponses(orders)
+		events = dto.ConvertToOrderEvents(orderResponses, constant.STATUS_PROCESSED)
+		for _, order := range orderResponses {
+			if err := p.notificationUseCase.Notify(txCtx, utilsNotification.NewOrderProcessedNotification(order.UserID, order.ID, order.VoiceNumber)); err != nil {
+				return err
+			}
+			if err := p.emailTask.QueueOrderPaidEmail(txCtx, utils.ConvertToOrderEmailPayload(order, "")); err != nil {
+				return err
+			}
+
+			pharmacy, err := p.pharmacyRepository.FindByID(txCtx, order.Detail.Pharmacy.ID)
+			if err != nil {
+				return err
+			}
+			if pharmacy.PharmacistID != nil {
+				if err := p.notificationUseCase.Notify(txCtx, utilsNotification.NewNewOrderNotification(*pharmacy.PharmacistID, pharmacy.ID, pharmacy.Name, order.VoiceNumber)); err != nil {
+					return err
+				}
+			}
+		}
+		return nil
+	})
 	if err != nil {
 		return err
 	}
 
-	return p.userOrderRepository.ProcessOrder(ctx, payload.ID)
+	p.orderEventUseCase.Publish(ctx, events...)
+	return nil
 }
 
 func (p *OrderTaskProcessor) HandleConfirmOrder(ctx context.Context, t *asynq.Task) error {
@@ -67,5 +130,33 @@ func (p *OrderTaskProcessor) HandleConfirmOrder(ctx context.Context, t *asynq.Ta
 		return err
 	}
 
-	return p.pharmacistOrderRepository.ConfirmOrderStatus(ctx, payload.IDs)
+	var events []*dto.OrderEvent
+	err := p.transactor.Atomic(ctx, func(txCtx context.Context) error {
+		ids, err := p.pharmacistOrderRepository.ConfirmOrderStatus(txCtx, payload.IDs)
+		if err != nil {
+			return err
+		}
+		if len(ids) == 0 {
+			return nil
+		}
+
+		orders, err := p.orderRepository.FindAllByIDs(txCtx, ids)
+		if err != nil {
+			return err
+		}
+		orderResponses := dto.ConvertToOrderResponses(orders)
+		events = dto.ConvertToOrderEvents(orderResponses, constant.STATUS_CONFIRMED)
+		for _, order := range orderResponses {
+			if err := p.emailTask.QueueOrderConfirmedEmail(txCtx, utils.ConvertToOrderEmailPayload(order, "")); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	p.orderEventUseCase.Publish(ctx, events...)
+	return nil
 }
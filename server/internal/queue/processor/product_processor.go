@@ -1,22 +1,37 @@
 package processor
 
 import (
+	"bytes"
 	"context"
+	"encoding/base64"
+	"encoding/csv"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"image"
+	"path/filepath"
 	"strings"
-	"sync"
+	"time"
 
+	constantAudit "healthcare-app/internal/audit/constant"
+	dtoAudit "healthcare-app/internal/audit/dto"
+	usecaseAudit "healthcare-app/internal/audit/usecase"
+	constantAuth "healthcare-app/internal/auth/constant"
+	apperrorProduct "healthcare-app/internal/product/apperror"
+	productConstant "healthcare-app/internal/product/constant"
+	"healthcare-app/internal/product/dto"
 	"healthcare-app/internal/product/entity"
 	"healthcare-app/internal/product/repository"
+	productUtils "healthcare-app/internal/product/utils"
 	"healthcare-app/internal/queue/constant"
 	"healthcare-app/internal/queue/payload"
 	"healthcare-app/pkg/apperror"
+	pkgConstant "healthcare-app/pkg/constant"
 	"healthcare-app/pkg/database/transactor"
-	"healthcare-app/pkg/utils/cloudinaryutils"
+	"healthcare-app/pkg/utils/encryptutils"
+	"healthcare-app/pkg/utils/imageutils"
+	"healthcare-app/pkg/utils/storageutils"
 
-	"github.com/cloudinary/cloudinary-go/v2/api"
-	"github.com/cloudinary/cloudinary-go/v2/api/uploader"
 	"github.com/hibiken/asynq"
 )
 
@@ -27,20 +42,47 @@ type uploadResult struct {
 }
 
 type ProductTaskProcessor struct {
-	cloudinaryUtil    cloudinaryutils.CloudinaryUtil
-	productRepository repository.ProductRepository
-	transactor        transactor.Transactor
+	base64Encryptor                 encryptutils.Base64Encryptor
+	objectStorage                   storageutils.ObjectStorage
+	auditLogUseCase                 usecaseAudit.AuditLogUseCase
+	manufactureRepository           repository.ManufactureRepository
+	productClassificationRepository repository.ProductClassificationRepository
+	productFormRepository           repository.ProductFormRepository
+	productCategoryRepository       repository.ProductCategoryRepository
+	productImportRepository         repository.ProductImportRepository
+	productRepository               repository.ProductRepository
+	pharmacyProductRepository       repository.PharmacyProductRepository
+	pharmacyProductImportRepository repository.PharmacyProductImportRepository
+	transactor                      transactor.Transactor
 }
 
 func NewProductTaskProcessor(
-	cloudinaryUtil cloudinaryutils.CloudinaryUtil,
+	base64Encryptor encryptutils.Base64Encryptor,
+	objectStorage storageutils.ObjectStorage,
+	auditLogUseCase usecaseAudit.AuditLogUseCase,
+	manufactureRepository repository.ManufactureRepository,
+	productClassificationRepository repository.ProductClassificationRepository,
+	productFormRepository repository.ProductFormRepository,
+	productCategoryRepository repository.ProductCategoryRepository,
+	productImportRepository repository.ProductImportRepository,
 	productRepository repository.ProductRepository,
+	pharmacyProductRepository repository.PharmacyProductRepository,
+	pharmacyProductImportRepository repository.PharmacyProductImportRepository,
 	transactor transactor.Transactor,
 ) *ProductTaskProcessor {
 	return &ProductTaskProcessor{
-		cloudinaryUtil:    cloudinaryUtil,
-		productRepository: productRepository,
-		transactor:        transactor,
+		base64Encryptor:                 base64Encryptor,
+		objectStorage:                   objectStorage,
+		auditLogUseCase:                 auditLogUseCase,
+		manufactureRepository:           manufactureRepository,
+		productClassificationRepository: productClassificationRepository,
+		productFormRepository:           productFormRepository,
+		productCategoryRepository:       productCategoryRepository,
+		productImportRepository:         productImportRepository,
+		productRepository:               productRepository,
+		pharmacyProductRepository:       pharmacyProductRepository,
+		pharmacyProductImportRepository: pharmacyProductImportRepository,
+		transactor:                      transactor,
 	}
 }
 
@@ -52,27 +94,17 @@ func (p *ProductTaskProcessor) HandleCreateProduct(ctx context.Context, t *asynq
 
 	return p.transactor.Atomic(ctx, func(txCtx context.Context) error {
 		product := &entity.Product{ID: payload.ID}
-		resultChan := p.uploadImages(txCtx, payload)
-
-		images := map[string]*string{}
-		var mu sync.Mutex
-
-		for i := 0; i < 4; i++ {
-			result := <-resultChan
-			if result.err != nil {
-				return result.err
-			}
-
-			mu.Lock()
-			images[result.key] = result.url
-			mu.Unlock()
+		images, err := p.uploadImages(txCtx, payload)
+		if err != nil {
+			return err
 		}
-		close(resultChan)
 
 		product.ImageURL = images[constant.IMAGE_URL]
 		product.ThumbnailURL = images[constant.THUMBNAIL_URL]
 		product.SecondaryImageURL = images[constant.SECONDARY_IMAGE_URL]
 		product.TertiaryImageURL = images[constant.TERTIARY_IMAGE_URL]
+		product.ImageWebpURL = images[constant.IMAGE_WEBP_URL]
+		product.ThumbnailWebpURL = images[constant.THUMBNAIL_WEBP_URL]
 		if err := p.productRepository.SaveImages(txCtx, product); err != nil {
 			return err
 		}
@@ -95,28 +127,18 @@ func (p *ProductTaskProcessor) HandleUpdateProduct(ctx context.Context, t *asynq
 	}
 
 	return p.transactor.Atomic(ctx, func(txCtx context.Context) error {
-		product := &entity.Product{ID: payload.ID, Manufacture: entity.Manufacture{ID: payload.ManufactureID}, ProductClassification: entity.ProductClassification{ID: payload.ProductClassificationID}, ProductForm: &entity.ProductForm{ID: payload.ProductFormID}, Name: payload.Name, GenericName: payload.GenericName, Description: payload.Description, UnitInPack: payload.UnitInPack, SellingUnit: payload.SellingUnit, Height: *payload.Height, Weight: *payload.Weight, Length: *payload.Length, Width: *payload.Width, IsActive: payload.IsActive}
-		resultChan := p.uploadImages(txCtx, payload)
-
-		images := map[string]*string{}
-		var mu sync.Mutex
-
-		for i := 0; i < 4; i++ {
-			result := <-resultChan
-			if result.err != nil {
-				return result.err
-			}
-
-			mu.Lock()
-			images[result.key] = result.url
-			mu.Unlock()
+		product := &entity.Product{ID: payload.ID, Manufacture: entity.Manufacture{ID: payload.ManufactureID}, ProductClassification: entity.ProductClassification{ID: payload.ProductClassificationID}, ProductForm: &entity.ProductForm{ID: payload.ProductFormID}, Name: payload.Name, GenericName: payload.GenericName, Strength: payload.Strength, Description: payload.Description, UnitInPack: payload.UnitInPack, SellingUnit: payload.SellingUnit, Height: *payload.Height, Weight: *payload.Weight, Length: *payload.Length, Width: *payload.Width, IsActive: payload.IsActive}
+		images, err := p.uploadImages(txCtx, payload)
+		if err != nil {
+			return err
 		}
-		close(resultChan)
 
 		product.ImageURL = images[constant.IMAGE_URL]
 		product.ThumbnailURL = images[constant.THUMBNAIL_URL]
 		product.SecondaryImageURL = images[constant.SECONDARY_IMAGE_URL]
 		product.TertiaryImageURL = images[constant.TERTIARY_IMAGE_URL]
+		product.ImageWebpURL = images[constant.IMAGE_WEBP_URL]
+		product.ThumbnailWebpURL = images[constant.THUMBNAIL_WEBP_URL]
 		if err := p.productRepository.SaveImages(txCtx, product); err != nil {
 			return err
 		}
@@ -140,33 +162,418 @@ func (p *ProductTaskProcessor) HandleUpdateProduct(ctx context.Context, t *asynq
 	})
 }
 
-func (p *ProductTaskProcessor) uploadImages(ctx context.Context, payload *payload.ProductPayload) chan uploadResult {
-	resultChan := make(chan uploadResult, 4)
+func (p *ProductTaskProcessor) HandleImportProducts(ctx context.Context, t *asynq.Task) error {
+	payload := new(payload.ProductImportPayload)
+	if err := json.Unmarshal(t.Payload(), payload); err != nil {
+		return err
+	}
 
+	if err := p.productImportRepository.UpdateStatus(ctx, payload.ID, productConstant.IMPORT_PROCESSING); err != nil {
+		return err
+	}
+
+	productImport := &entity.ProductImport{ID: payload.ID, IsDryRun: payload.DryRun, Status: productConstant.IMPORT_FAILED}
+	file, err := p.base64Encryptor.DecodeStd(payload.File)
+	if err != nil {
+		return p.productImportRepository.Complete(ctx, productImport)
+	}
+	rows, err := productUtils.ReadProductImportCsv(strings.NewReader(file))
+	if err != nil {
+		return p.productImportRepository.Complete(ctx, productImport)
+	}
+	archive, err := p.base64Encryptor.DecodeStd(payload.Images)
+	if err != nil {
+		return p.productImportRepository.Complete(ctx, productImport)
+	}
+	images, err := productUtils.ReadProductImportImages([]byte(archive))
+	if err != nil {
+		return p.productImportRepository.Complete(ctx, productImport)
+	}
+
+	seen := map[string]struct{}{}
+	results := []*dto.ProductImportRowResult{}
+	for i, record := range rows {
+		result := p.importProductRow(ctx, record, images, seen, payload.DryRun)
+		result.Row = i + 2
+		if result.Status == productConstant.IMPORT_ROW_FAILED {
+			productImport.FailedRows++
+		} else {
+			productImport.SuccessRows++
+		}
+		results = append(results, result)
+	}
+
+	report, err := buildProductImportReport(results)
+	if err != nil {
+		return err
+	}
+
+	productImport.Status = productConstant.IMPORT_COMPLETED
+	productImport.TotalRows = int64(len(rows))
+	productImport.Report = &report
+	return p.productImportRepository.Complete(ctx, productImport)
+}
+
+func (p *ProductTaskProcessor) importProductRow(ctx context.Context, record []string, images map[string][]byte, seen map[string]struct{}, dryRun bool) *dto.ProductImportRowResult {
+	result := &dto.ProductImportRowResult{Status: productConstant.IMPORT_ROW_FAILED}
+	if len(record) > 0 {
+		result.Name = record[0]
+	}
+
+	row, err := productUtils.ParseProductImportRow(record)
+	if err != nil {
+		result.Message = err.Error()
+		return result
+	}
+
+	key := strings.ToLower(fmt.Sprintf("%v|%v|%v", row.Name, row.GenericName, row.ManufactureID))
+	if _, ok := seen[key]; ok {
+		result.Message = "product is duplicated in the same file"
+		return result
+	}
+	seen[key] = struct{}{}
+
+	productImages, err := resolveProductImportImages(row, images)
+	if err != nil {
+		result.Message = err.Error()
+		return result
+	}
+
+	product := dto.ProductImportRowToProductEntity(row)
+	err = p.transactor.Atomic(ctx, func(txCtx context.Context) error {
+		if !p.manufactureRepository.IsExistsByID(txCtx, row.ManufactureID) {
+			return apperror.NewEntityNotFoundError("manufacture")
+		}
+		if !p.productClassificationRepository.IsExistsByID(txCtx, row.ProductClassificationID) {
+			return apperror.NewEntityNotFoundError("product classification")
+		}
+		if row.ProductFormID != nil && !p.productFormRepository.IsExistsByID(txCtx, *row.ProductFormID) {
+			return apperror.NewEntityNotFoundError("product form")
+		}
+		for _, id := range row.ProductCategories {
+			if _, err := p.productCategoryRepository.FindCategoryByID(txCtx, id); err != nil {
+				return apperror.NewEntityNotFoundError("product category")
+			}
+		}
+		if p.productRepository.IsExists(txCtx, product) {
+			return apperrorProduct.NewProductAlreadyExistsError()
+		}
+		if dryRun {
+			return nil
+		}
+
+		if err := p.productRepository.Save(txCtx, product); err != nil {
+			return err
+		}
+
+		productImages.ID = product.ID
+		uploaded, err := p.uploadImages(txCtx, productImages)
+		if err != nil {
+			return err
+		}
+
+		product.ImageURL = uploaded[constant.IMAGE_URL]
+		product.ThumbnailURL = uploaded[constant.THUMBNAIL_URL]
+		product.SecondaryImageURL = uploaded[constant.SECONDARY_IMAGE_URL]
+		product.TertiaryImageURL = uploaded[constant.TERTIARY_IMAGE_URL]
+		product.ImageWebpURL = uploaded[constant.IMAGE_WEBP_URL]
+		product.ThumbnailWebpURL = uploaded[constant.THUMBNAIL_WEBP_URL]
+		if err := p.productRepository.SaveImages(txCtx, product); err != nil {
+			return err
+		}
+
+		categories := []*entity.ProductCategory{}
+		for _, category := range row.ProductCategories {
+			categories = append(categories, &entity.ProductCategory{ID: category})
+		}
+		return p.productRepository.SaveProductCategories(txCtx, product, categories)
+	})
+
+	if err != nil {
+		result.Message = importErrorMessage(err)
+		return result
+	}
+
+	result.Status = productConstant.IMPORT_ROW_CREATED
+	result.ProductID = product.ID
+	if dryRun {
+		result.Status = productConstant.IMPORT_ROW_VALID
+	}
+	return result
+}
+
+func resolveProductImportImages(row *dto.ProductImportRow, images map[string][]byte) (*payload.ProductPayload, error) {
+	resolved := map[string]string{}
 	for key, image := range map[string]string{
-		constant.IMAGE_URL:           payload.Image,
-		constant.THUMBNAIL_URL:       payload.Thumbnail,
-		constant.SECONDARY_IMAGE_URL: payload.SecondaryImage,
-		constant.TERTIARY_IMAGE_URL:  payload.TertiaryImage,
+		constant.IMAGE_URL:           row.Image,
+		constant.THUMBNAIL_URL:       row.Thumbnail,
+		constant.SECONDARY_IMAGE_URL: row.SecondaryImage,
+		constant.TERTIARY_IMAGE_URL:  row.TertiaryImage,
 	} {
-		if image == "" {
-			resultChan <- uploadResult{key: key, url: nil, err: nil}
+		if image == "" || productUtils.IsImportImageURL(image) {
+			resolved[key] = image
 			continue
 		}
 
-		k := key
-		img := image
-		go func(key string, image string) {
+		content, ok := images[filepath.Base(image)]
+		if !ok {
+			return nil, fmt.Errorf("%v is not found in the images archive", image)
+		}
+		if _, err := imageutils.DetectFormat(bytes.NewReader(content)); err != nil || len(content) > productConstant.MAX_IMAGE_SIZE {
+			return nil, apperrorProduct.NewProductImageError()
+		}
+		resolved[key] = "data:image/png;base64," + base64.StdEncoding.EncodeToString(content)
+	}
+
+	return &payload.ProductPayload{
+		ManufactureID:  row.ManufactureID,
+		Name:           row.Name,
+		Image:          resolved[constant.IMAGE_URL],
+		Thumbnail:      resolved[constant.THUMBNAIL_URL],
+		SecondaryImage: resolved[constant.SECONDARY_IMAGE_URL],
+		TertiaryImage:  resolved[constant.TERTIARY_IMAGE_URL],
+	}, nil
+}
+
+func buildProductImportReport(results []*dto.ProductImportRowResult) (string, error) {
+	buf := new(bytes.Buffer)
+	writer := csv.NewWriter(buf)
+
+	if err := writer.Write(productConstant.ProductImportReportHeaders); err != nil {
+		return "", err
+	}
+	for _, result := range results {
+		productID := ""
+		if result.ProductID != 0 {
+			productID = fmt.Sprint(result.ProductID)
+		}
+		if err := writer.Write([]string{fmt.Sprint(result.Row), result.Name, result.Status, productID, result.Message}); err != nil {
+			return "", err
+		}
+	}
+
+	writer.Flush()
+	return buf.String(), writer.Error()
+}
+
+func (p *ProductTaskProcessor) HandleImportPharmacyProducts(ctx context.Context, t *asynq.Task) error {
+	payload := new(payload.PharmacyProductImportPayload)
+	if err := json.Unmarshal(t.Payload(), payload); err != nil {
+		return err
+	}
+
+	if err := p.pharmacyProductImportRepository.UpdateStatus(ctx, payload.ID, productConstant.IMPORT_PROCESSING); err != nil {
+		return err
+	}
+
+	productImport := &entity.PharmacyProductImport{ID: payload.ID, Status: productConstant.IMPORT_FAILED}
+	file, err := p.base64Encryptor.DecodeStd(payload.File)
+	if err != nil {
+		return p.pharmacyProductImportRepository.Complete(ctx, productImport)
+	}
+	rows, err := productUtils.ReadPharmacyProductImportCsv(strings.NewReader(file))
+	if err != nil {
+		return p.pharmacyProductImportRepository.Complete(ctx, productImport)
+	}
+
+	actorCtx := importActorContext(ctx, payload)
+	seen := map[string]struct{}{}
+	results := []*dto.PharmacyProductImportRowResult{}
+	for i, record := range rows {
+		result := p.importPharmacyProductRow(actorCtx, payload.PharmacyID, record, seen)
+		result.Row = i + 2
+		if result.Status == productConstant.IMPORT_ROW_FAILED {
+			productImport.FailedRows++
+		} else {
+			productImport.SuccessRows++
+		}
+		results = append(results, result)
+	}
+
+	report, err := buildPharmacyProductImportReport(results)
+	if err != nil {
+		return err
+	}
+
+	productImport.Status = productConstant.IMPORT_COMPLETED
+	productImport.TotalRows = int64(len(rows))
+	productImport.Report = &report
+	return p.pharmacyProductImportRepository.Complete(ctx, productImport)
+}
+
+func (p *ProductTaskProcessor) importPharmacyProductRow(ctx context.Context, pharmacyID int64, record []string, seen map[string]struct{}) *dto.PharmacyProductImportRowResult {
+	result := &dto.PharmacyProductImportRowResult{Status: productConstant.IMPORT_ROW_FAILED}
+
+	row, err := productUtils.ParsePharmacyProductImportRow(record)
+	if err != nil {
+		result.Message = err.Error()
+		return result
+	}
+	result.ProductID = row.ProductID
+	if row.ID != nil {
+		result.ID = *row.ID
+	}
+
+	keys := []string{}
+	if row.ID != nil {
+		keys = append(keys, fmt.Sprintf("id:%v", *row.ID))
+	}
+	if row.ProductID != 0 {
+		keys = append(keys, fmt.Sprintf("product_id:%v", row.ProductID))
+	}
+	for _, key := range keys {
+		if _, ok := seen[key]; ok {
+			result.Message = "product is duplicated in the same file"
+			return result
+		}
+	}
+	for _, key := range keys {
+		seen[key] = struct{}{}
+	}
+
+	pharmacyProduct := dto.PharmacyProductImportRowToEntity(row, pharmacyID)
+	err = p.transactor.Atomic(ctx, func(txCtx context.Context) error {
+		if row.ID == nil {
+			if !p.productRepository.IsExistsByID(txCtx, row.ProductID) {
+				return apperror.NewEntityNotFoundError("product")
+			}
+			if row.IsActive && !p.pharmacyProductRepository.IsPharmacyActive(txCtx, pharmacyID) {
+				return apperrorProduct.NewPharmacyProductPharmacyInactiveError()
+			}
+			if err := p.pharmacyProductRepository.Save(txCtx, pharmacyProduct); err != nil {
+				return err
+			}
+			if !row.IsActive {
+				if err := p.pharmacyProductRepository.Update(txCtx, pharmacyProduct); err != nil {
+					return err
+				}
+			}
+			return p.recordPharmacyProductImport(txCtx, constantAudit.ACTION_CREATE, pharmacyProduct.ID, pharmacyID, nil)
+		}
+
+		extProduct, err := p.pharmacyProductRepository.FindByID(txCtx, *row.ID, pharmacyID)
+		if err != nil {
+			return err
+		}
+		if row.ProductID != 0 && row.ProductID != extProduct.Product.ID {
+			return apperrorProduct.NewPharmacyProductImportMismatchError()
+		}
+		if row.IsActive && !extProduct.IsActive && !p.pharmacyProductRepository.IsPharmacyActive(txCtx, pharmacyID) {
+			return apperrorProduct.NewPharmacyProductPharmacyInactiveError()
+		}
+
+		if extProduct.StockQuantity != row.StockQuantity {
+			if p.pharmacyProductRepository.IsStockUpdated(txCtx, *row.ID) {
+				return apperrorProduct.NewPharmacyProductStockError()
+			}
+			now := time.Now()
+			pharmacyProduct.StockQuantityUpdatedAt = &now
+		}
+
+		pharmacyProduct.Product.ID = extProduct.Product.ID
+		if err := p.pharmacyProductRepository.Update(txCtx, pharmacyProduct); err != nil {
+			return err
+		}
+		return p.recordPharmacyProductImport(txCtx, constantAudit.ACTION_UPDATE, *row.ID, pharmacyID, extProduct)
+	})
+
+	if err != nil {
+		result.Message = importErrorMessage(err)
+		return result
+	}
+
+	result.ID = pharmacyProduct.ID
+	result.ProductID = pharmacyProduct.Product.ID
+	result.Status = productConstant.IMPORT_ROW_UPDATED
+	if row.ID == nil {
+		result.Status = productConstant.IMPORT_ROW_CREATED
+	}
+	return result
+}
+
+// recordPharmacyProductImport logs the row like a change made through the api,
+// one entry per pharmacy product in the row's own transaction.
+func (p *ProductTaskProcessor) recordPharmacyProductImport(ctx context.Context, action string, id int64, pharmacyID int64, before *entity.PharmacyProduct) error {
+	after, err := p.pharmacyProductRepository.FindByID(ctx, id, pharmacyID)
+	if err != nil {
+		return err
+	}
+	entry := &dtoAudit.AuditEntry{
+		Action:     action,
+		EntityType: constantAudit.ENTITY_PHARMACY_PRODUCT,
+		EntityID:   id,
+		After:      dto.ConvertToPharmacyProductResponse(after),
+	}
+	if before != nil {
+		entry.Before = dto.ConvertToPharmacyProductResponse(before)
+	}
+	return p.auditLogUseCase.Record(ctx, entry)
+}
+
+// importActorContext puts the pharmacist who started the import back in the
+// context, the audit log reads the actor from there as it does for a request.
+func importActorContext(ctx context.Context, payload *payload.PharmacyProductImportPayload) context.Context {
+	var userID pkgConstant.ID = "user_id"
+	ctx = context.WithValue(ctx, userID, payload.PharmacistID)
+
+	var role pkgConstant.Role = "role"
+	ctx = context.WithValue(ctx, role, constantAuth.PHARMACIST)
+
+	var requestID pkgConstant.RequestID = "request_id"
+	ctx = context.WithValue(ctx, requestID, payload.RequestID)
+
+	var clientIP pkgConstant.ClientIP = "client_ip"
+	return context.WithValue(ctx, clientIP, payload.ClientIP)
+}
+
+func buildPharmacyProductImportReport(results []*dto.PharmacyProductImportRowResult) (string, error) {
+	buf := new(bytes.Buffer)
+	writer := csv.NewWriter(buf)
+
+	if err := writer.Write(productConstant.PharmacyProductImportReportHeaders); err != nil {
+		return "", err
+	}
+	for _, result := range results {
+		id, productID := "", ""
+		if result.ID != 0 {
+			id = fmt.Sprint(result.ID)
+		}
+		if result.ProductID != 0 {
+			productID = fmt.Sprint(result.ProductID)
+		}
+		if err := writer.Write([]string{fmt.Sprint(result.Row), id, productID, result.Status, result.Message}); err != nil {
+			return "", err
+		}
+	}
+
+	writer.Flush()
+	return buf.String(), writer.Error()
+}
+
+func importErrorMessage(err error) string {
+	var appErr *apperror.AppError
+	if errors.As(err, &appErr) {
+		return appErr.DisplayMessage()
+	}
+	return pkgConstant.InternalServerErrorMessage
+}
+
+func (p *ProductTaskProcessor) uploadImages(ctx context.Context, payload *payload.ProductPayload) (map[string]*string, error) {
+	objects, err := prepareImages(ctx, payload)
+	if err != nil {
+		return nil, err
+	}
+
+	resultChan := make(chan uploadResult, len(objects))
+	for key, object := range objects {
+		go func(key string, object []byte) {
 			name := fmt.Sprintf("%v-%v-%v", key, strings.ToLower(strings.ReplaceAll(payload.Name, " ", "-")), payload.ManufactureID)
 			if len(name) > 128 {
 				name = name[:128]
 			}
 
-			imgUrl, err := p.cloudinaryUtil.UploadImage(ctx, image, uploader.UploadParams{
-				PublicID:       name,
-				UniqueFilename: api.Bool(true),
-				Overwrite:      api.Bool(true),
-				Invalidate:     api.Bool(true),
+			imgUrl, err := p.objectStorage.Upload(ctx, object, storageutils.UploadParams{
+				Key: name,
 			})
 
 			if err != nil {
@@ -175,8 +582,104 @@ func (p *ProductTaskProcessor) uploadImages(ctx context.Context, payload *payloa
 			}
 
 			resultChan <- uploadResult{key: key, url: &imgUrl, err: nil}
-		}(k, img)
+		}(key, object)
+	}
+
+	images := map[string]*string{}
+	for i := 0; i < len(objects); i++ {
+		result := <-resultChan
+		if result.err != nil {
+			err = result.err
+			continue
+		}
+		images[result.key] = result.url
+	}
+	close(resultChan)
+
+	if err != nil {
+		return nil, err
+	}
+	return images, nil
+}
+
+// prepareImages runs the product images through the image pipeline before they
+// are stored. The thumbnail is generated from the master image when it is not
+// uploaded, and webp variants are generated for both of them when they are
+// smaller than the compressed image.
+func prepareImages(ctx context.Context, payload *payload.ProductPayload) (map[string][]byte, error) {
+	objects := map[string][]byte{}
+
+	master, err := decodeProductImage(ctx, payload.Image)
+	if err != nil {
+		return nil, err
+	}
+	thumbnail := master
+	if payload.Thumbnail != "" {
+		if thumbnail, err = decodeProductImage(ctx, payload.Thumbnail); err != nil {
+			return nil, err
+		}
+	}
+
+	for _, variant := range []struct {
+		key     string
+		webpKey string
+		img     image.Image
+		size    int
+	}{
+		{constant.IMAGE_URL, constant.IMAGE_WEBP_URL, master, productConstant.IMAGE_MAX_DIMENSION},
+		{constant.THUMBNAIL_URL, constant.THUMBNAIL_WEBP_URL, thumbnail, productConstant.THUMBNAIL_MAX_DIMENSION},
+	} {
+		if variant.img == nil {
+			continue
+		}
+
+		resized := imageutils.Resize(variant.img, variant.size)
+		if objects[variant.key], err = imageutils.Compress(resized); err != nil {
+			return nil, apperror.NewServerError(err)
+		}
+		webp, err := imageutils.Encode(resized, imageutils.FormatWebP)
+		if err != nil {
+			return nil, apperror.NewServerError(err)
+		}
+		// the webp encoder is lossless, a photo often comes out larger than
+		// its jpeg and then there is no point in serving it
+		if len(webp) < len(objects[variant.key]) {
+			objects[variant.webpKey] = webp
+		}
+	}
+
+	for key, object := range map[string]string{
+		constant.SECONDARY_IMAGE_URL: payload.SecondaryImage,
+		constant.TERTIARY_IMAGE_URL:  payload.TertiaryImage,
+	} {
+		img, err := decodeProductImage(ctx, object)
+		if err != nil {
+			return nil, err
+		}
+		if img == nil {
+			continue
+		}
+
+		if objects[key], err = imageutils.Compress(imageutils.Resize(img, productConstant.IMAGE_MAX_DIMENSION)); err != nil {
+			return nil, apperror.NewServerError(err)
+		}
 	}
 
-	return resultChan
+	return objects, nil
+}
+
+func decodeProductImage(ctx context.Context, object string) (image.Image, error) {
+	if object == "" {
+		return nil, nil
+	}
+
+	data, err := storageutils.ReadObject(ctx, object)
+	if err != nil {
+		return nil, apperrorProduct.NewProductImageError()
+	}
+	img, err := imageutils.Decode(data)
+	if err != nil {
+		return nil, apperrorProduct.NewProductImageError()
+	}
+	return img, nil
 }
@@ -0,0 +1,48 @@
+package processor
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	constantNotification "healthcare-app/internal/notification/constant"
+	dtoNotification "healthcare-app/internal/notification/dto"
+	usecaseNotification "healthcare-app/internal/notification/usecase"
+	"healthcare-app/internal/queue/payload"
+	"healthcare-app/pkg/config"
+	"healthcare-app/pkg/utils/smtputils"
+
+	"github.com/hibiken/asynq"
+)
+
+type ReminderTaskProcessor struct {
+	appConfig              *config.AppConfig
+	notificationDispatcher usecaseNotification.NotificationDispatcher
+}
+
+func NewReminderTaskProcessor(appConfig *config.AppConfig, notificationDispatcher usecaseNotification.NotificationDispatcher) *ReminderTaskProcessor {
+	return &ReminderTaskProcessor{
+		appConfig:              appConfig,
+		notificationDispatcher: notificationDispatcher,
+	}
+}
+
+func (p *ReminderTaskProcessor) HandleMedicationReminder(ctx context.Context, t *asynq.Task) error {
+	payload := new(payload.MedicationReminderPayload)
+	if err := json.Unmarshal(t.Payload(), payload); err != nil {
+		return err
+	}
+
+	return p.notificationDispatcher.SendMail(ctx, &dtoNotification.DispatchMail{
+		UserID:   payload.UserID,
+		Type:     constantNotification.TYPE_DOSE_REMINDER,
+		To:       payload.Email,
+		Subject:  smtputils.MedicationReminderSubject,
+		Template: smtputils.NotificationTemplate,
+		Data: map[string]any{
+			"Title": "it's time to take your medicine",
+			"Body":  fmt.Sprintf("Please take %v of %v scheduled at %v, then mark the dose as taken or skipped.", payload.Dosage, payload.MedicineName, payload.ScheduledAt),
+			"Link":  fmt.Sprintf("%v/medication-schedules/%v", p.appConfig.FrontendURL, payload.ScheduleID),
+		},
+	})
+}
@@ -0,0 +1,29 @@
+package processor
+
+import (
+	"context"
+	"errors"
+
+	usecaseSubscription "healthcare-app/internal/subscription/usecase"
+
+	"github.com/hibiken/asynq"
+)
+
+type SubscriptionTaskProcessor struct {
+	subscriptionUseCase usecaseSubscription.SubscriptionUseCase
+}
+
+func NewSubscriptionTaskProcessor(subscriptionUseCase usecaseSubscription.SubscriptionUseCase) *SubscriptionTaskProcessor {
+	return &SubscriptionTaskProcessor{
+		subscriptionUseCase: subscriptionUseCase,
+	}
+}
+
+// HandleSubscriptionRefill is not retried, whatever failed is due again and
+// picked up on the next run.
+func (p *SubscriptionTaskProcessor) HandleSubscriptionRefill(ctx context.Context, t *asynq.Task) error {
+	return errors.Join(
+		p.subscriptionUseCase.SendRefillReminders(ctx),
+		p.subscriptionUseCase.ProcessDueRefills(ctx),
+	)
+}
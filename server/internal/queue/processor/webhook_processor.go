@@ -0,0 +1,32 @@
+package processor
+
+import (
+	"context"
+	"encoding/json"
+
+	"healthcare-app/internal/queue/payload"
+	usecaseWebhook "healthcare-app/internal/webhook/usecase"
+
+	"github.com/hibiken/asynq"
+)
+
+type WebhookTaskProcessor struct {
+	webhookDeliveryUseCase usecaseWebhook.WebhookDeliveryUseCase
+}
+
+func NewWebhookTaskProcessor(webhookDeliveryUseCase usecaseWebhook.WebhookDeliveryUseCase) *WebhookTaskProcessor {
+	return &WebhookTaskProcessor{
+		webhookDeliveryUseCase: webhookDeliveryUseCase,
+	}
+}
+
+func (p *WebhookTaskProcessor) HandleWebhookDelivery(ctx context.Context, t *asynq.Task) error {
+	payload := new(payload.WebhookDeliveryPayload)
+	if err := json.Unmarshal(t.Payload(), payload); err != nil {
+		return err
+	}
+
+	retried, _ := asynq.GetRetryCount(ctx)
+	maxRetry, _ := asynq.GetMaxRetry(ctx)
+	return p.webhookDeliveryUseCase.Deliver(ctx, payload.DeliveryID, retried >= maxRetry)
+}
@@ -11,4 +11,12 @@ func EmailTaskRoute(mux *asynq.ServeMux, processor *processor.EmailTaskProcessor
 	mux.HandleFunc(tasks.TypeEmailVerification, processor.HandleVerificationEmail)
 	mux.HandleFunc(tasks.TypeEmailForgotPassword, processor.HandleForgotPasswordEmail)
 	mux.HandleFunc(tasks.TypeEmailPharmacistAccount, processor.HandlePharmacistAccountEmail)
+	mux.HandleFunc(tasks.TypeEmailDoctorAccount, processor.HandleDoctorAccountEmail)
+	mux.HandleFunc(tasks.TypeEmailRefillReminder, processor.HandleRefillReminderEmail)
+	mux.HandleFunc(tasks.TypeEmailRefillShortage, processor.HandleRefillShortageEmail)
+	mux.HandleFunc(tasks.TypeEmailOrderReceipt, processor.HandleOrderReceiptEmail)
+	mux.HandleFunc(tasks.TypeEmailOrderPaid, processor.HandleOrderPaidEmail)
+	mux.HandleFunc(tasks.TypeEmailOrderShipped, processor.HandleOrderShippedEmail)
+	mux.HandleFunc(tasks.TypeEmailOrderCancelled, processor.HandleOrderCancelledEmail)
+	mux.HandleFunc(tasks.TypeEmailOrderConfirmed, processor.HandleOrderConfirmedEmail)
 }
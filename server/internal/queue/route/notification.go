@@ -0,0 +1,12 @@
+package route
+
+import (
+	"healthcare-app/internal/queue/processor"
+	"healthcare-app/internal/queue/tasks"
+
+	"github.com/hibiken/asynq"
+)
+
+func NotificationTaskRoute(mux *asynq.ServeMux, processor *processor.NotificationTaskProcessor) {
+	mux.HandleFunc(tasks.TypeNotificationDelivery, processor.HandleNotificationDelivery)
+}
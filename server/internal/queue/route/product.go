@@ -10,4 +10,6 @@ import (
 func ProductTaskRoute(mux *asynq.ServeMux, processor *processor.ProductTaskProcessor) {
 	mux.HandleFunc(tasks.TypeAdminCreateProduct, processor.HandleCreateProduct)
 	mux.HandleFunc(tasks.TypeAdminUpdateProduct, processor.HandleUpdateProduct)
+	mux.HandleFunc(tasks.TypeAdminImportProduct, processor.HandleImportProducts)
+	mux.HandleFunc(tasks.TypePharmacistImportPharmacyProduct, processor.HandleImportPharmacyProducts)
 }
@@ -0,0 +1,12 @@
+package route
+
+import (
+	"healthcare-app/internal/queue/processor"
+	"healthcare-app/internal/queue/tasks"
+
+	"github.com/hibiken/asynq"
+)
+
+func ReminderTaskRoute(mux *asynq.ServeMux, processor *processor.ReminderTaskProcessor) {
+	mux.HandleFunc(tasks.TypeMedicationReminder, processor.HandleMedicationReminder)
+}
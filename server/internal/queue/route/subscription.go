@@ -0,0 +1,12 @@
+package route
+
+import (
+	"healthcare-app/internal/queue/processor"
+	"healthcare-app/internal/queue/tasks"
+
+	"github.com/hibiken/asynq"
+)
+
+func SubscriptionTaskRoute(mux *asynq.ServeMux, processor *processor.SubscriptionTaskProcessor) {
+	mux.HandleFunc(tasks.TypeSubscriptionRefill, processor.HandleSubscriptionRefill)
+}
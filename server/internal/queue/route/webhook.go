@@ -0,0 +1,12 @@
+package route
+
+import (
+	"healthcare-app/internal/queue/processor"
+	"healthcare-app/internal/queue/tasks"
+
+	"github.com/hibiken/asynq"
+)
+
+func WebhookTaskRoute(mux *asynq.ServeMux, processor *processor.WebhookTaskProcessor) {
+	mux.HandleFunc(tasks.TypeWebhookDelivery, processor.HandleWebhookDelivery)
+}
@@ -14,12 +14,28 @@ const (
 	TypeEmailVerification      = "email:verification"
 	TypeEmailForgotPassword    = "email:forgot-password"
 	TypeEmailPharmacistAccount = "email:pharmacist-account"
+	TypeEmailDoctorAccount     = "email:doctor-account"
+	TypeEmailRefillReminder    = "email:refill-reminder"
+	TypeEmailRefillShortage    = "email:refill-shortage"
+	TypeEmailOrderReceipt      = "email:order-receipt"
+	TypeEmailOrderPaid         = "email:order-paid"
+	TypeEmailOrderShipped      = "email:order-shipped"
+	TypeEmailOrderCancelled    = "email:order-cancelled"
+	TypeEmailOrderConfirmed    = "email:order-confirmed"
 )
 
 type EmailTask interface {
 	QueueVerificationEmail(ctx context.Context, payload *payload.VerificationEmailPayload) error
 	QueueForgotPasswordEmail(ctx context.Context, payload *payload.ForgotPasswordEmailPayload) error
 	QueuePharmacistAccountEmail(ctx context.Context, payload *payload.PharmacistAccountEmailPayload) error
+	QueueDoctorAccountEmail(ctx context.Context, payload *payload.DoctorAccountEmailPayload) error
+	QueueRefillReminderEmail(ctx context.Context, payload *payload.RefillReminderEmailPayload) error
+	QueueRefillShortageEmail(ctx context.Context, payload *payload.RefillShortageEmailPayload) error
+	QueueOrderReceiptEmail(ctx context.Context, payload *payload.OrderEmailPayload) error
+	QueueOrderPaidEmail(ctx context.Context, payload *payload.OrderEmailPayload) error
+	QueueOrderShippedEmail(ctx context.Context, payload *payload.OrderEmailPayload) error
+	QueueOrderCancelledEmail(ctx context.Context, payload *payload.OrderEmailPayload) error
+	QueueOrderConfirmedEmail(ctx context.Context, payload *payload.OrderEmailPayload) error
 }
 
 type emailTaskImpl struct {
@@ -67,3 +83,99 @@ func (t *emailTaskImpl) QueuePharmacistAccountEmail(ctx context.Context, payload
 
 	return err
 }
+
+func (t *emailTaskImpl) QueueDoctorAccountEmail(ctx context.Context, payload *payload.DoctorAccountEmailPayload) error {
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+
+	task := asynq.NewTask(TypeEmailDoctorAccount, data, asynq.Timeout(5*time.Second), asynq.MaxRetry(10))
+	_, err = t.client.EnqueueContext(ctx, task)
+
+	return err
+}
+
+func (t *emailTaskImpl) QueueRefillReminderEmail(ctx context.Context, payload *payload.RefillReminderEmailPayload) error {
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+
+	task := asynq.NewTask(TypeEmailRefillReminder, data, asynq.Timeout(5*time.Second), asynq.MaxRetry(10))
+	_, err = t.client.EnqueueContext(ctx, task)
+
+	return err
+}
+
+func (t *emailTaskImpl) QueueRefillShortageEmail(ctx context.Context, payload *payload.RefillShortageEmailPayload) error {
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+
+	task := asynq.NewTask(TypeEmailRefillShortage, data, asynq.Timeout(5*time.Second), asynq.MaxRetry(10))
+	_, err = t.client.EnqueueContext(ctx, task)
+
+	return err
+}
+
+func (t *emailTaskImpl) QueueOrderReceiptEmail(ctx context.Context, payload *payload.OrderEmailPayload) error {
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+
+	task := asynq.NewTask(TypeEmailOrderReceipt, data, asynq.Timeout(5*time.Second), asynq.MaxRetry(10))
+	_, err = t.client.EnqueueContext(ctx, task)
+
+	return err
+}
+
+func (t *emailTaskImpl) QueueOrderPaidEmail(ctx context.Context, payload *payload.OrderEmailPayload) error {
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+
+	task := asynq.NewTask(TypeEmailOrderPaid, data, asynq.Timeout(5*time.Second), asynq.MaxRetry(10))
+	_, err = t.client.EnqueueContext(ctx, task)
+
+	return err
+}
+
+func (t *emailTaskImpl) QueueOrderShippedEmail(ctx context.Context, payload *payload.OrderEmailPayload) error {
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+
+	task := asynq.NewTask(TypeEmailOrderShipped, data, asynq.Timeout(5*time.Second), asynq.MaxRetry(10))
+	_, err = t.client.EnqueueContext(ctx, task)
+
+	return err
+}
+
+func (t *emailTaskImpl) QueueOrderCancelledEmail(ctx context.Context, payload *payload.OrderEmailPayload) error {
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+
+	task := asynq.NewTask(TypeEmailOrderCancelled, data, asynq.Timeout(5*time.Second), asynq.MaxRetry(10))
+	_, err = t.client.EnqueueContext(ctx, task)
+
+	return err
+}
+
+func (t *emailTaskImpl) QueueOrderConfirmedEmail(ctx context.Context, payload *payload.OrderEmailPayload) error {
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+
+	task := asynq.NewTask(TypeEmailOrderConfirmed, data, asynq.Timeout(5*time.Second), asynq.MaxRetry(10))
+	_, err = t.client.EnqueueContext(ctx, task)
+
+	return err
+}
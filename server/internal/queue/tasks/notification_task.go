@@ -0,0 +1,41 @@
+package tasks
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"healthcare-app/internal/queue/payload"
+
+	"github.com/hibiken/asynq"
+)
+
+const (
+	TypeNotificationDelivery = "notification:delivery"
+)
+
+type NotificationTask interface {
+	QueueNotificationDelivery(ctx context.Context, payload *payload.NotificationDeliveryPayload) error
+}
+
+type notificationTaskImpl struct {
+	client *asynq.Client
+}
+
+func NewNotificationTask(client *asynq.Client) *notificationTaskImpl {
+	return &notificationTaskImpl{
+		client: client,
+	}
+}
+
+func (t *notificationTaskImpl) QueueNotificationDelivery(ctx context.Context, payload *payload.NotificationDeliveryPayload) error {
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+
+	task := asynq.NewTask(TypeNotificationDelivery, data, asynq.Timeout(30*time.Second), asynq.MaxRetry(5))
+	_, err = t.client.EnqueueContext(ctx, task)
+
+	return err
+}
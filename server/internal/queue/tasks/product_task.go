@@ -13,11 +13,16 @@ import (
 const (
 	TypeAdminCreateProduct = "product:admin-create"
 	TypeAdminUpdateProduct = "product:admin-update"
+	TypeAdminImportProduct = "product:admin-import"
+
+	TypePharmacistImportPharmacyProduct = "pharmacy-product:pharmacist-import"
 )
 
 type ProductTask interface {
 	QueueCreateProduct(ctx context.Context, payload *payload.ProductPayload) error
 	QueueUpdateProduct(ctx context.Context, payload *payload.ProductPayload) error
+	QueueImportProducts(ctx context.Context, payload *payload.ProductImportPayload) error
+	QueueImportPharmacyProducts(ctx context.Context, payload *payload.PharmacyProductImportPayload) error
 }
 
 type productTaskImpl struct {
@@ -53,3 +58,27 @@ func (t *productTaskImpl) QueueUpdateProduct(ctx context.Context, payload *paylo
 
 	return err
 }
+
+func (t *productTaskImpl) QueueImportProducts(ctx context.Context, payload *payload.ProductImportPayload) error {
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+
+	task := asynq.NewTask(TypeAdminImportProduct, data, asynq.Queue("low"), asynq.Timeout(30*time.Minute), asynq.MaxRetry(3))
+	_, err = t.client.EnqueueContext(ctx, task)
+
+	return err
+}
+
+func (t *productTaskImpl) QueueImportPharmacyProducts(ctx context.Context, payload *payload.PharmacyProductImportPayload) error {
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+
+	task := asynq.NewTask(TypePharmacistImportPharmacyProduct, data, asynq.Queue("low"), asynq.Timeout(30*time.Minute), asynq.MaxRetry(3))
+	_, err = t.client.EnqueueContext(ctx, task)
+
+	return err
+}
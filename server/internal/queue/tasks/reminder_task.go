@@ -0,0 +1,41 @@
+package tasks
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"healthcare-app/internal/queue/payload"
+
+	"github.com/hibiken/asynq"
+)
+
+const (
+	TypeMedicationReminder = "reminder:medication"
+)
+
+type ReminderTask interface {
+	QueueMedicationReminder(ctx context.Context, payload *payload.MedicationReminderPayload) error
+}
+
+type reminderTaskImpl struct {
+	client *asynq.Client
+}
+
+func NewReminderTask(client *asynq.Client) *reminderTaskImpl {
+	return &reminderTaskImpl{
+		client: client,
+	}
+}
+
+func (t *reminderTaskImpl) QueueMedicationReminder(ctx context.Context, payload *payload.MedicationReminderPayload) error {
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+
+	task := asynq.NewTask(TypeMedicationReminder, data, asynq.Timeout(5*time.Second), asynq.MaxRetry(3))
+	_, err = t.client.EnqueueContext(ctx, task)
+
+	return err
+}
@@ -0,0 +1,18 @@
+package tasks
+
+import (
+	"time"
+
+	"github.com/hibiken/asynq"
+)
+
+const (
+	TypeSubscriptionRefill = "subscription:refill"
+)
+
+// NewSubscriptionRefillTask is put on the queue by the worker's scheduler.
+// Every worker schedules it, Unique keeps a single run per tick in the queue
+// and the subscriptions are claimed before anything is sent or ordered.
+func NewSubscriptionRefillTask() *asynq.Task {
+	return asynq.NewTask(TypeSubscriptionRefill, nil, asynq.Unique(time.Hour), asynq.Timeout(30*time.Minute), asynq.MaxRetry(0))
+}
@@ -0,0 +1,43 @@
+package tasks
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"healthcare-app/internal/queue/payload"
+
+	"github.com/hibiken/asynq"
+)
+
+const (
+	TypeWebhookDelivery = "webhook:delivery"
+)
+
+type WebhookTask interface {
+	QueueWebhookDelivery(ctx context.Context, payload *payload.WebhookDeliveryPayload) error
+}
+
+type webhookTaskImpl struct {
+	client *asynq.Client
+}
+
+func NewWebhookTask(client *asynq.Client) *webhookTaskImpl {
+	return &webhookTaskImpl{
+		client: client,
+	}
+}
+
+// QueueWebhookDelivery leaves the retries to asynq, a failed post is retried
+// with its exponential backoff until the retries run out.
+func (t *webhookTaskImpl) QueueWebhookDelivery(ctx context.Context, payload *payload.WebhookDeliveryPayload) error {
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+
+	task := asynq.NewTask(TypeWebhookDelivery, data, asynq.Timeout(30*time.Second), asynq.MaxRetry(10))
+	_, err = t.client.EnqueueContext(ctx, task)
+
+	return err
+}
@@ -0,0 +1,16 @@
+package apperror
+
+import (
+	"errors"
+
+	"healthcare-app/internal/review/constant"
+	"healthcare-app/pkg/apperror"
+)
+
+func NewReviewAccessError() *apperror.AppError {
+	msg := constant.ReviewAccessErrorMessage
+
+	err := errors.New(msg)
+
+	return apperror.NewAppError(err, apperror.ForbiddenAccessErrorCode, msg)
+}
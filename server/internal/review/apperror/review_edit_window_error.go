@@ -0,0 +1,17 @@
+package apperror
+
+import (
+	"errors"
+	"fmt"
+
+	"healthcare-app/internal/review/constant"
+	"healthcare-app/pkg/apperror"
+)
+
+func NewReviewEditWindowError() *apperror.AppError {
+	msg := fmt.Sprintf(constant.ReviewEditWindowErrorMessage, constant.EDIT_WINDOW.Hours()/24)
+
+	err := errors.New(msg)
+
+	return apperror.NewAppError(err, apperror.DefaultClientErrorCode, msg)
+}
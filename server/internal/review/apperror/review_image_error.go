@@ -0,0 +1,17 @@
+package apperror
+
+import (
+	"errors"
+	"fmt"
+
+	"healthcare-app/internal/review/constant"
+	"healthcare-app/pkg/apperror"
+)
+
+func NewReviewImageError() *apperror.AppError {
+	msg := fmt.Sprintf(constant.ReviewImageErrorMessage, fmt.Sprintf("%vkb", constant.MAX_IMAGE_SIZE/1024))
+
+	err := errors.New(msg)
+
+	return apperror.NewAppError(err, apperror.DefaultClientErrorCode, msg)
+}
@@ -0,0 +1,16 @@
+package apperror
+
+import (
+	"errors"
+
+	"healthcare-app/internal/review/constant"
+	"healthcare-app/pkg/apperror"
+)
+
+func NewReviewNotAllowedError() *apperror.AppError {
+	msg := constant.ReviewNotAllowedErrorMessage
+
+	err := errors.New(msg)
+
+	return apperror.NewAppError(err, apperror.DefaultClientErrorCode, msg)
+}
@@ -0,0 +1,9 @@
+package constant
+
+const (
+	ReviewImageErrorMessage         = "the image must be a png, jpeg, gif or webp image and must not exceed %v in size"
+	ReviewNotAllowedErrorMessage    = "only products from your confirmed orders can be reviewed"
+	ReviewAlreadyExistsErrorMessage = "this product has already been reviewed for the order"
+	ReviewEditWindowErrorMessage    = "review can only be edited within %v days after it was posted"
+	ReviewAccessErrorMessage        = "pharmacist doesn't belong to the pharmacy of the reviewed product"
+)
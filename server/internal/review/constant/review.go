@@ -0,0 +1,9 @@
+package constant
+
+import "time"
+
+const (
+	MAX_IMAGE_SIZE      = 5 * 1024 * 1024 // 5 mb
+	IMAGE_MAX_DIMENSION = 1200
+	EDIT_WINDOW         = 7 * 24 * time.Hour
+)
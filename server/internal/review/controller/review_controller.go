@@ -0,0 +1,176 @@
+package controller
+
+import (
+	"strconv"
+
+	"healthcare-app/internal/auth/utils"
+	"healthcare-app/internal/review/dto"
+	"healthcare-app/internal/review/usecase"
+	"healthcare-app/pkg/apperror"
+	"healthcare-app/pkg/utils/ginutils"
+	"healthcare-app/pkg/utils/pageutils"
+
+	"github.com/gin-gonic/gin"
+)
+
+type ReviewController struct {
+	reviewUseCase usecase.ReviewUseCase
+}
+
+func NewReviewController(reviewUseCase usecase.ReviewUseCase) *ReviewController {
+	return &ReviewController{
+		reviewUseCase: reviewUseCase,
+	}
+}
+
+func (c *ReviewController) SearchProductReview(ctx *gin.Context) {
+	productID, err := strconv.Atoi(ctx.Param("productId"))
+	if err != nil {
+		ctx.Error(apperror.NewInvalidIdError())
+		return
+	}
+
+	req := new(dto.SearchReviewRequest)
+	if err := ctx.ShouldBindQuery(req); err != nil {
+		ctx.Error(err)
+		return
+	}
+	req.ProductID = int64(productID)
+	req.IsHidden = "false"
+
+	c.search(ctx, req)
+}
+
+func (c *ReviewController) SearchMyReview(ctx *gin.Context) {
+	req := new(dto.SearchReviewRequest)
+	if err := ctx.ShouldBindQuery(req); err != nil {
+		ctx.Error(err)
+		return
+	}
+	req.UserID = utils.GetValueUserIdFromToken(ctx)
+
+	c.search(ctx, req)
+}
+
+func (c *ReviewController) SearchPharmacistReview(ctx *gin.Context) {
+	req := new(dto.SearchReviewRequest)
+	if err := ctx.ShouldBindQuery(req); err != nil {
+		ctx.Error(err)
+		return
+	}
+	req.PharmacistID = utils.GetValueUserIdFromToken(ctx)
+
+	c.search(ctx, req)
+}
+
+func (c *ReviewController) SearchAdminReview(ctx *gin.Context) {
+	req := new(dto.SearchReviewRequest)
+	if err := ctx.ShouldBindQuery(req); err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	c.search(ctx, req)
+}
+
+func (c *ReviewController) CreateReview(ctx *gin.Context) {
+	req := &dto.CreateReviewRequest{UserID: utils.GetValueUserIdFromToken(ctx)}
+	if err := ctx.ShouldBind(req); err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	res, err := c.reviewUseCase.Create(ctx, req)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+	ginutils.ResponseCreated(ctx, res)
+}
+
+func (c *ReviewController) UpdateReview(ctx *gin.Context) {
+	reviewID, err := strconv.Atoi(ctx.Param("reviewId"))
+	if err != nil {
+		ctx.Error(apperror.NewInvalidIdError())
+		return
+	}
+
+	req := &dto.UpdateReviewRequest{ID: int64(reviewID), UserID: utils.GetValueUserIdFromToken(ctx)}
+	if err := ctx.ShouldBind(req); err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	res, err := c.reviewUseCase.Update(ctx, req)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+	ginutils.ResponseOK(ctx, res)
+}
+
+func (c *ReviewController) ReplyReview(ctx *gin.Context) {
+	reviewID, err := strconv.Atoi(ctx.Param("reviewId"))
+	if err != nil {
+		ctx.Error(apperror.NewInvalidIdError())
+		return
+	}
+
+	req := &dto.ReplyReviewRequest{ID: int64(reviewID), PharmacistID: utils.GetValueUserIdFromToken(ctx)}
+	if err := ctx.ShouldBindJSON(req); err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	res, err := c.reviewUseCase.Reply(ctx, req)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+	ginutils.ResponseOK(ctx, res)
+}
+
+func (c *ReviewController) UpdateReviewVisibility(ctx *gin.Context) {
+	reviewID, err := strconv.Atoi(ctx.Param("reviewId"))
+	if err != nil {
+		ctx.Error(apperror.NewInvalidIdError())
+		return
+	}
+
+	req := &dto.UpdateReviewVisibilityRequest{ID: int64(reviewID)}
+	if err := ctx.ShouldBindJSON(req); err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	res, err := c.reviewUseCase.UpdateVisibility(ctx, req)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+	ginutils.ResponseOK(ctx, res)
+}
+
+func (c *ReviewController) DeleteReview(ctx *gin.Context) {
+	reviewID, err := strconv.Atoi(ctx.Param("reviewId"))
+	if err != nil {
+		ctx.Error(apperror.NewInvalidIdError())
+		return
+	}
+
+	if err := c.reviewUseCase.Delete(ctx, int64(reviewID)); err != nil {
+		ctx.Error(err)
+		return
+	}
+	ginutils.ResponseOKPlain(ctx)
+}
+
+func (c *ReviewController) search(ctx *gin.Context, req *dto.SearchReviewRequest) {
+	res, paging, err := c.reviewUseCase.Search(ctx, req)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+	paging.Links = pageutils.CreateLinks(ctx.Request, int(paging.Page), int(paging.Size), int(paging.TotalItem), int(paging.TotalPage))
+	ginutils.ResponseOKPagination(ctx, res, paging)
+}
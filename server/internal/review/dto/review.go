@@ -0,0 +1,122 @@
+package dto
+
+import (
+	"mime/multipart"
+	"time"
+
+	"healthcare-app/internal/review/entity"
+)
+
+type ReviewResponse struct {
+	ID        int64          `json:"id"`
+	OrderID   int64          `json:"order_id"`
+	User      reviewUser     `json:"user"`
+	Product   reviewProduct  `json:"product"`
+	Pharmacy  reviewPharmacy `json:"pharmacy"`
+	Rating    int64          `json:"rating"`
+	Comment   *string        `json:"comment"`
+	ImageURL  *string        `json:"image_url"`
+	Reply     *reviewReply   `json:"reply"`
+	IsHidden  bool           `json:"is_hidden"`
+	CreatedAt time.Time      `json:"created_at"`
+	UpdatedAt time.Time      `json:"updated_at"`
+}
+
+type reviewUser struct {
+	ID   int64  `json:"id"`
+	Name string `json:"name"`
+}
+
+type reviewProduct struct {
+	ID                int64  `json:"id"`
+	PharmacyProductID int64  `json:"pharmacy_product_id"`
+	Name              string `json:"name"`
+}
+
+type reviewPharmacy struct {
+	ID   int64  `json:"id"`
+	Name string `json:"name"`
+}
+
+type reviewReply struct {
+	Content   string     `json:"content"`
+	RepliedAt *time.Time `json:"replied_at"`
+}
+
+type CreateReviewRequest struct {
+	OrderID           int64                 `form:"order_id" binding:"required,numeric,gte=1"`
+	PharmacyProductID int64                 `form:"pharmacy_product_id" binding:"required,numeric,gte=1"`
+	Rating            int64                 `form:"rating" binding:"required,gte=1,lte=5"`
+	Comment           *string               `form:"comment" binding:"omitempty,max=1000"`
+	Image             *multipart.FileHeader `form:"image"`
+	UserID            int64                 `form:"-"`
+}
+
+type UpdateReviewRequest struct {
+	Rating  int64                 `form:"rating" binding:"required,gte=1,lte=5"`
+	Comment *string               `form:"comment" binding:"omitempty,max=1000"`
+	Image   *multipart.FileHeader `form:"image"`
+	ID      int64                 `form:"-"`
+	UserID  int64                 `form:"-"`
+}
+
+type ReplyReviewRequest struct {
+	Reply        string `json:"reply" binding:"required,max=1000"`
+	ID           int64  `json:"-"`
+	PharmacistID int64  `json:"-"`
+}
+
+type UpdateReviewVisibilityRequest struct {
+	IsHidden *bool `json:"is_hidden" binding:"required"`
+	ID       int64 `json:"-"`
+}
+
+type SearchReviewRequest struct {
+	Rating       int64  `form:"rating" binding:"omitempty,gte=1,lte=5"`
+	IsHidden     string `form:"is-hidden" binding:"omitempty,boolean"`
+	Limit        int64  `form:"limit" binding:"numeric,gte=1,lte=25"`
+	Page         int64  `form:"page" binding:"numeric,gte=1"`
+	ProductID    int64  `form:"-"`
+	PharmacistID int64  `form:"-"`
+	UserID       int64  `form:"-"`
+}
+
+func ConvertToReviewResponses(reviews []*entity.Review) []*ReviewResponse {
+	res := []*ReviewResponse{}
+	for _, review := range reviews {
+		res = append(res, ConvertToReviewResponse(review))
+	}
+	return res
+}
+
+func ConvertToReviewResponse(review *entity.Review) *ReviewResponse {
+	var reply *reviewReply
+	if review.Reply != nil {
+		reply = &reviewReply{Content: *review.Reply, RepliedAt: review.RepliedAt}
+	}
+
+	return &ReviewResponse{
+		ID:        review.ID,
+		OrderID:   review.OrderID,
+		User:      reviewUser{ID: review.UserID, Name: review.UserName},
+		Product:   reviewProduct{ID: review.ProductID, PharmacyProductID: review.PharmacyProductID, Name: review.ProductName},
+		Pharmacy:  reviewPharmacy{ID: review.PharmacyID, Name: review.PharmacyName},
+		Rating:    review.Rating,
+		Comment:   review.Comment,
+		ImageURL:  review.ImageURL,
+		Reply:     reply,
+		IsHidden:  review.IsHidden,
+		CreatedAt: review.CreatedAt,
+		UpdatedAt: review.UpdatedAt,
+	}
+}
+
+func CreateReviewRequestToEntity(request *CreateReviewRequest) *entity.Review {
+	return &entity.Review{
+		UserID:            request.UserID,
+		OrderID:           request.OrderID,
+		PharmacyProductID: request.PharmacyProductID,
+		Rating:            request.Rating,
+		Comment:           request.Comment,
+	}
+}
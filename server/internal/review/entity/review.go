@@ -0,0 +1,25 @@
+package entity
+
+import "time"
+
+type Review struct {
+	CreatedAt         time.Time
+	UpdatedAt         time.Time
+	RepliedAt         *time.Time
+	Comment           *string
+	ImageURL          *string
+	Reply             *string
+	UserName          string
+	ProductName       string
+	PharmacyName      string
+	ID                int64
+	UserID            int64
+	OrderID           int64
+	PharmacyProductID int64
+	ProductID         int64
+	PharmacyID        int64
+	PharmacistID      *int64
+	RepliedBy         *int64
+	Rating            int64
+	IsHidden          bool
+}
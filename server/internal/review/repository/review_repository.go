@@ -0,0 +1,356 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+
+	orderConstant "healthcare-app/internal/order/constant"
+	apperrorReview "healthcare-app/internal/review/apperror"
+	"healthcare-app/internal/review/dto"
+	"healthcare-app/internal/review/entity"
+	apperrorPkg "healthcare-app/pkg/apperror"
+	"healthcare-app/pkg/database/transactor"
+
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+type ReviewRepository interface {
+	IsReviewable(ctx context.Context, userID, orderID, pharmacyProductID int64) bool
+	Search(ctx context.Context, request *dto.SearchReviewRequest) ([]*entity.Review, error)
+	FindByID(ctx context.Context, id int64) (*entity.Review, error)
+	Save(ctx context.Context, review *entity.Review) error
+	Update(ctx context.Context, review *entity.Review) error
+	UpdateReply(ctx context.Context, review *entity.Review) error
+	UpdateVisibility(ctx context.Context, review *entity.Review) error
+	DeleteByID(ctx context.Context, id int64) error
+}
+
+type reviewRepositoryImpl struct {
+	db *sql.DB
+}
+
+func NewReviewRepository(db *sql.DB) *reviewRepositoryImpl {
+	return &reviewRepositoryImpl{
+		db: db,
+	}
+}
+
+const reviewSelectQuery = `
+	select r.id, r.user_id, ud.full_name, r.order_id, r.pharmacy_product_id, p.id, p.name, ph.id, ph.name, ph.pharmacist_id,
+		r.rating, r.comment, r.image_url, r.reply, r.replied_by, r.replied_at, r.is_hidden, r.created_at, r.updated_at
+	from reviews r
+	join user_details ud on ud.user_id = r.user_id
+	join pharmacy_products pp on pp.id = r.pharmacy_product_id
+	join products p on p.id = pp.product_id
+	join pharmacies ph on ph.id = pp.pharmacy_id
+	where r.deleted_at is null
+`
+
+func (r *reviewRepositoryImpl) IsReviewable(ctx context.Context, userID, orderID, pharmacyProductID int64) bool {
+	query := `
+		select exists(
+			select 1 from orders o
+			join order_products op on op.order_id = o.id
+			where o.id = $1 and o.user_id = $2 and op.pharmacy_product_id = $3 and o.order_status = $4 and o.deleted_at is null
+		)
+	`
+	tx := transactor.ExtractTx(ctx)
+
+	var (
+		err    error
+		exists bool
+	)
+	if tx != nil {
+		err = tx.QueryRowContext(ctx, query, orderID, userID, pharmacyProductID, orderConstant.STATUS_CONFIRMED).Scan(&exists)
+	} else {
+		err = r.db.QueryRowContext(ctx, query, orderID, userID, pharmacyProductID, orderConstant.STATUS_CONFIRMED).Scan(&exists)
+	}
+
+	if err != nil {
+		return false
+	}
+	return exists
+}
+
+func (r *reviewRepositoryImpl) Search(ctx context.Context, request *dto.SearchReviewRequest) ([]*entity.Review, error) {
+	args := []any{}
+	queryBuilder := strings.Builder{}
+	queryBuilder.WriteString(reviewSelectQuery)
+
+	if request.ProductID != 0 {
+		queryBuilder.WriteString(fmt.Sprintf(" and p.id = $%v", len(args)+1))
+		args = append(args, request.ProductID)
+	}
+	if request.PharmacistID != 0 {
+		queryBuilder.WriteString(fmt.Sprintf(" and ph.pharmacist_id = $%v", len(args)+1))
+		args = append(args, request.PharmacistID)
+	}
+	if request.UserID != 0 {
+		queryBuilder.WriteString(fmt.Sprintf(" and r.user_id = $%v", len(args)+1))
+		args = append(args, request.UserID)
+	}
+	if request.Rating != 0 {
+		queryBuilder.WriteString(fmt.Sprintf(" and r.rating = $%v", len(args)+1))
+		args = append(args, request.Rating)
+	}
+	if request.IsHidden != "" {
+		queryBuilder.WriteString(fmt.Sprintf(" and r.is_hidden = $%v", len(args)+1))
+		args = append(args, request.IsHidden)
+	}
+	queryBuilder.WriteString(" order by r.created_at desc")
+
+	tx := transactor.ExtractTx(ctx)
+
+	var (
+		err  error
+		rows *sql.Rows
+	)
+	if tx != nil {
+		rows, err = tx.QueryContext(ctx, queryBuilder.String(), args...)
+	} else {
+		rows, err = r.db.QueryContext(ctx, queryBuilder.String(), args...)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	reviews := []*entity.Review{}
+	for rows.Next() {
+		review := new(entity.Review)
+		if err := rows.Scan(
+			&review.ID,
+			&review.UserID,
+			&review.UserName,
+			&review.OrderID,
+			&review.PharmacyProductID,
+			&review.ProductID,
+			&review.ProductName,
+			&review.PharmacyID,
+			&review.PharmacyName,
+			&review.PharmacistID,
+			&review.Rating,
+			&review.Comment,
+			&review.ImageURL,
+			&review.Reply,
+			&review.RepliedBy,
+			&review.RepliedAt,
+			&review.IsHidden,
+			&review.CreatedAt,
+			&review.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		reviews = append(reviews, review)
+	}
+
+	err = rows.Err()
+	if err != nil {
+		return nil, err
+	}
+	return reviews, nil
+}
+
+func (r *reviewRepositoryImpl) FindByID(ctx context.Context, id int64) (*entity.Review, error) {
+	query := reviewSelectQuery + " and r.id = $1"
+	tx := transactor.ExtractTx(ctx)
+
+	var (
+		err    error
+		review = new(entity.Review)
+	)
+	if tx != nil {
+		err = tx.QueryRowContext(ctx, query, id).Scan(
+			&review.ID,
+			&review.UserID,
+			&review.UserName,
+			&review.OrderID,
+			&review.PharmacyProductID,
+			&review.ProductID,
+			&review.ProductName,
+			&review.PharmacyID,
+			&review.PharmacyName,
+			&review.PharmacistID,
+			&review.Rating,
+			&review.Comment,
+			&review.ImageURL,
+			&review.Reply,
+			&review.RepliedBy,
+			&review.RepliedAt,
+			&review.IsHidden,
+			&review.CreatedAt,
+			&review.UpdatedAt,
+		)
+	} else {
+		err = r.db.QueryRowContext(ctx, query, id).Scan(
+			&review.ID,
+			&review.UserID,
+			&review.UserName,
+			&review.OrderID,
+			&review.PharmacyProductID,
+			&review.ProductID,
+			&review.ProductName,
+			&review.PharmacyID,
+			&review.PharmacyName,
+			&review.PharmacistID,
+			&review.Rating,
+			&review.Comment,
+			&review.ImageURL,
+			&review.Reply,
+			&review.RepliedBy,
+			&review.RepliedAt,
+			&review.IsHidden,
+			&review.CreatedAt,
+			&review.UpdatedAt,
+		)
+	}
+
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, apperrorPkg.NewEntityNotFoundError("review")
+		}
+		return nil, err
+	}
+	return review, nil
+}
+
+func (r *reviewRepositoryImpl) Save(ctx context.Context, review *entity.Review) error {
+	query := `
+		insert into reviews(user_id, order_id, pharmacy_product_id, rating, comment, image_url)
+		values ($1, $2, $3, $4, $5, $6) returning id, created_at, updated_at
+	`
+	tx := transactor.ExtractTx(ctx)
+
+	var err error
+	if tx != nil {
+		err = tx.QueryRowContext(
+			ctx,
+			query,
+			review.UserID,
+			review.OrderID,
+			review.PharmacyProductID,
+			review.Rating,
+			review.Comment,
+			review.ImageURL,
+		).Scan(&review.ID, &review.CreatedAt, &review.UpdatedAt)
+	} else {
+		err = r.db.QueryRowContext(
+			ctx,
+			query,
+			review.UserID,
+			review.OrderID,
+			review.PharmacyProductID,
+			review.Rating,
+			review.Comment,
+			review.ImageURL,
+		).Scan(&review.ID, &review.CreatedAt, &review.UpdatedAt)
+	}
+
+	if err, ok := err.(*pgconn.PgError); ok {
+		if err.SQLState() == "23505" {
+			return apperrorReview.NewReviewAlreadyExistsError()
+		}
+	}
+
+	return err
+}
+
+func (r *reviewRepositoryImpl) Update(ctx context.Context, review *entity.Review) error {
+	query := `
+		update reviews set rating = $2, comment = $3, image_url = $4, updated_at = now()
+		where id = $1 and deleted_at is null returning updated_at
+	`
+	tx := transactor.ExtractTx(ctx)
+
+	var err error
+	if tx != nil {
+		err = tx.QueryRowContext(ctx, query, review.ID, review.Rating, review.Comment, review.ImageURL).Scan(&review.UpdatedAt)
+	} else {
+		err = r.db.QueryRowContext(ctx, query, review.ID, review.Rating, review.Comment, review.ImageURL).Scan(&review.UpdatedAt)
+	}
+
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return apperrorPkg.NewEntityNotFoundError("review")
+		}
+		return err
+	}
+	return nil
+}
+
+func (r *reviewRepositoryImpl) UpdateReply(ctx context.Context, review *entity.Review) error {
+	query := `
+		update reviews set reply = $2, replied_by = $3, replied_at = now(), updated_at = now()
+		where id = $1 and deleted_at is null returning replied_at, updated_at
+	`
+	tx := transactor.ExtractTx(ctx)
+
+	var err error
+	if tx != nil {
+		err = tx.QueryRowContext(ctx, query, review.ID, review.Reply, review.RepliedBy).Scan(&review.RepliedAt, &review.UpdatedAt)
+	} else {
+		err = r.db.QueryRowContext(ctx, query, review.ID, review.Reply, review.RepliedBy).Scan(&review.RepliedAt, &review.UpdatedAt)
+	}
+
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return apperrorPkg.NewEntityNotFoundError("review")
+		}
+		return err
+	}
+	return nil
+}
+
+func (r *reviewRepositoryImpl) UpdateVisibility(ctx context.Context, review *entity.Review) error {
+	query := `
+		update reviews set is_hidden = $2, updated_at = now()
+		where id = $1 and deleted_at is null returning updated_at
+	`
+	tx := transactor.ExtractTx(ctx)
+
+	var err error
+	if tx != nil {
+		err = tx.QueryRowContext(ctx, query, review.ID, review.IsHidden).Scan(&review.UpdatedAt)
+	} else {
+		err = r.db.QueryRowContext(ctx, query, review.ID, review.IsHidden).Scan(&review.UpdatedAt)
+	}
+
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return apperrorPkg.NewEntityNotFoundError("review")
+		}
+		return err
+	}
+	return nil
+}
+
+func (r *reviewRepositoryImpl) DeleteByID(ctx context.Context, id int64) error {
+	query := `
+		update reviews set deleted_at = now() where id = $1 and deleted_at is null
+	`
+	tx := transactor.ExtractTx(ctx)
+
+	var (
+		err error
+		res sql.Result
+	)
+	if tx != nil {
+		res, err = tx.ExecContext(ctx, query, id)
+	} else {
+		res, err = r.db.ExecContext(ctx, query, id)
+	}
+
+	if err != nil {
+		return err
+	}
+
+	rowsAffected, _ := res.RowsAffected()
+	if rowsAffected == 0 {
+		return apperrorPkg.NewEntityNotFoundError("review")
+	}
+	return nil
+}
@@ -0,0 +1,35 @@
+package route
+
+import (
+	"healthcare-app/internal/auth/constant"
+	"healthcare-app/internal/review/controller"
+	"healthcare-app/pkg/middleware"
+
+	"github.com/gin-gonic/gin"
+)
+
+const reviewId = "/:reviewId"
+
+func ReviewControllerRoute(c *controller.ReviewController, r *gin.Engine, authMiddleware *middleware.AuthMiddleware) {
+	r.GET("/products/:productId/reviews", c.SearchProductReview)
+
+	users := r.Group("/users/me/reviews", authMiddleware.Authorization(), authMiddleware.ProtectedRoles(constant.USER))
+	{
+		users.GET("", c.SearchMyReview)
+		users.POST("", c.CreateReview)
+		users.PUT(reviewId, c.UpdateReview)
+	}
+
+	pharmacists := r.Group("/pharmacists/reviews", authMiddleware.Authorization(), authMiddleware.ProtectedRoles(constant.PHARMACIST))
+	{
+		pharmacists.GET("", c.SearchPharmacistReview)
+		pharmacists.PUT(reviewId+"/reply", c.ReplyReview)
+	}
+
+	admins := r.Group("/admin/reviews", authMiddleware.Authorization(), authMiddleware.ProtectedRoles(constant.ADMIN))
+	{
+		admins.GET("", c.SearchAdminReview)
+		admins.PATCH(reviewId, c.UpdateReviewVisibility)
+		admins.DELETE(reviewId, c.DeleteReview)
+	}
+}
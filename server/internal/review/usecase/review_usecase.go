@@ -0,0 +1,229 @@
+package usecase
+
+import (
+	"context"
+	"io"
+	"mime/multipart"
+	"strings"
+	"time"
+
+	apperrorReview "healthcare-app/internal/review/apperror"
+	"healthcare-app/internal/review/constant"
+	dtoReview "healthcare-app/internal/review/dto"
+	"healthcare-app/internal/review/entity"
+	"healthcare-app/internal/review/repository"
+	"healthcare-app/internal/review/utils"
+	apperrorPkg "healthcare-app/pkg/apperror"
+	"healthcare-app/pkg/database/transactor"
+	dtoPkg "healthcare-app/pkg/dto"
+	"healthcare-app/pkg/utils/imageutils"
+	"healthcare-app/pkg/utils/pageutils"
+	"healthcare-app/pkg/utils/storageutils"
+)
+
+type ReviewUseCase interface {
+	Search(ctx context.Context, request *dtoReview.SearchReviewRequest) ([]*dtoReview.ReviewResponse, *dtoPkg.PageMetaData, error)
+	Create(ctx context.Context, request *dtoReview.CreateReviewRequest) (*dtoReview.ReviewResponse, error)
+	Update(ctx context.Context, request *dtoReview.UpdateReviewRequest) (*dtoReview.ReviewResponse, error)
+	Reply(ctx context.Context, request *dtoReview.ReplyReviewRequest) (*dtoReview.ReviewResponse, error)
+	UpdateVisibility(ctx context.Context, request *dtoReview.UpdateReviewVisibilityRequest) (*dtoReview.ReviewResponse, error)
+	Delete(ctx context.Context, id int64) error
+}
+
+type reviewUseCaseImpl struct {
+	reviewRepo    repository.ReviewRepository
+	objectStorage storageutils.ObjectStorage
+	transactor    transactor.Transactor
+}
+
+func NewReviewUseCase(
+	reviewRepo repository.ReviewRepository,
+	objectStorage storageutils.ObjectStorage,
+	transactor transactor.Transactor,
+) *reviewUseCaseImpl {
+	return &reviewUseCaseImpl{
+		reviewRepo:    reviewRepo,
+		objectStorage: objectStorage,
+		transactor:    transactor,
+	}
+}
+
+func (u *reviewUseCaseImpl) Search(ctx context.Context, request *dtoReview.SearchReviewRequest) ([]*dtoReview.ReviewResponse, *dtoPkg.PageMetaData, error) {
+	reviews, err := u.reviewRepo.Search(ctx, request)
+	if err != nil {
+		return nil, nil, apperrorPkg.NewServerError(err)
+	}
+
+	res, metaData := pageutils.CreateMetaData(reviews, request.Page, request.Limit)
+	return dtoReview.ConvertToReviewResponses(res), metaData, nil
+}
+
+func (u *reviewUseCaseImpl) Create(ctx context.Context, request *dtoReview.CreateReviewRequest) (*dtoReview.ReviewResponse, error) {
+	if err := validateReviewImage(request.Image); err != nil {
+		return nil, err
+	}
+
+	review := dtoReview.CreateReviewRequestToEntity(request)
+	err := u.transactor.Atomic(ctx, func(txCtx context.Context) error {
+		if !u.reviewRepo.IsReviewable(txCtx, request.UserID, request.OrderID, request.PharmacyProductID) {
+			return apperrorReview.NewReviewNotAllowedError()
+		}
+
+		imageURL, err := u.uploadImage(txCtx, request.Image, utils.GenerateReviewImageTitle(request.OrderID, request.PharmacyProductID))
+		if err != nil {
+			return err
+		}
+		review.ImageURL = imageURL
+
+		if err := u.reviewRepo.Save(txCtx, review); err != nil {
+			return err
+		}
+
+		review, err = u.reviewRepo.FindByID(txCtx, review.ID)
+		return err
+	})
+
+	if err != nil {
+		return nil, err
+	}
+	return dtoReview.ConvertToReviewResponse(review), nil
+}
+
+func (u *reviewUseCaseImpl) Update(ctx context.Context, request *dtoReview.UpdateReviewRequest) (*dtoReview.ReviewResponse, error) {
+	if err := validateReviewImage(request.Image); err != nil {
+		return nil, err
+	}
+
+	var review *entity.Review
+	err := u.transactor.Atomic(ctx, func(txCtx context.Context) error {
+		var err error
+		review, err = u.reviewRepo.FindByID(txCtx, request.ID)
+		if err != nil {
+			return err
+		}
+		if review.UserID != request.UserID {
+			return apperrorPkg.NewEntityNotFoundError("review")
+		}
+		if time.Since(review.CreatedAt) > constant.EDIT_WINDOW {
+			return apperrorReview.NewReviewEditWindowError()
+		}
+
+		if request.Image != nil {
+			review.ImageURL, err = u.uploadImage(txCtx, request.Image, utils.GenerateReviewImageTitle(review.OrderID, review.PharmacyProductID))
+			if err != nil {
+				return err
+			}
+		}
+		review.Rating = request.Rating
+		review.Comment = request.Comment
+
+		if err := u.reviewRepo.Update(txCtx, review); err != nil {
+			return apperrorPkg.NewServerError(err)
+		}
+		return nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+	return dtoReview.ConvertToReviewResponse(review), nil
+}
+
+func (u *reviewUseCaseImpl) Reply(ctx context.Context, request *dtoReview.ReplyReviewRequest) (*dtoReview.ReviewResponse, error) {
+	var review *entity.Review
+	err := u.transactor.Atomic(ctx, func(txCtx context.Context) error {
+		var err error
+		review, err = u.reviewRepo.FindByID(txCtx, request.ID)
+		if err != nil {
+			return err
+		}
+		if review.PharmacistID == nil || *review.PharmacistID != request.PharmacistID {
+			return apperrorReview.NewReviewAccessError()
+		}
+
+		review.Reply = &request.Reply
+		review.RepliedBy = &request.PharmacistID
+		if err := u.reviewRepo.UpdateReply(txCtx, review); err != nil {
+			return apperrorPkg.NewServerError(err)
+		}
+		return nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+	return dtoReview.ConvertToReviewResponse(review), nil
+}
+
+func (u *reviewUseCaseImpl) UpdateVisibility(ctx context.Context, request *dtoReview.UpdateReviewVisibilityRequest) (*dtoReview.ReviewResponse, error) {
+	var review *entity.Review
+	err := u.transactor.Atomic(ctx, func(txCtx context.Context) error {
+		var err error
+		review, err = u.reviewRepo.FindByID(txCtx, request.ID)
+		if err != nil {
+			return err
+		}
+
+		review.IsHidden = *request.IsHidden
+		if err := u.reviewRepo.UpdateVisibility(txCtx, review); err != nil {
+			return apperrorPkg.NewServerError(err)
+		}
+		return nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+	return dtoReview.ConvertToReviewResponse(review), nil
+}
+
+func (u *reviewUseCaseImpl) Delete(ctx context.Context, id int64) error {
+	return u.reviewRepo.DeleteByID(ctx, id)
+}
+
+func (u *reviewUseCaseImpl) uploadImage(ctx context.Context, header *multipart.FileHeader, key string) (*string, error) {
+	if header == nil {
+		return nil, nil
+	}
+
+	f, err := header.Open()
+	if err != nil {
+		return nil, apperrorPkg.NewServerError(err)
+	}
+	defer f.Close()
+
+	data, err := io.ReadAll(f)
+	if err != nil {
+		return nil, apperrorPkg.NewServerError(err)
+	}
+	image, err := imageutils.Process(data, constant.IMAGE_MAX_DIMENSION)
+	if err != nil {
+		return nil, apperrorReview.NewReviewImageError()
+	}
+
+	imageURL, err := u.objectStorage.Upload(ctx, image, storageutils.UploadParams{Key: strings.ToLower(key)})
+	if err != nil {
+		return nil, apperrorPkg.NewServerError(err)
+	}
+	return &imageURL, nil
+}
+
+func validateReviewImage(header *multipart.FileHeader) error {
+	if header == nil {
+		return nil
+	}
+	if header.Size > constant.MAX_IMAGE_SIZE {
+		return apperrorReview.NewReviewImageError()
+	}
+
+	f, err := header.Open()
+	if err != nil {
+		return apperrorReview.NewReviewImageError()
+	}
+	defer f.Close()
+
+	if _, err := imageutils.DetectFormat(f); err != nil {
+		return apperrorReview.NewReviewImageError()
+	}
+	return nil
+}
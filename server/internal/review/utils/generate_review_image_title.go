@@ -0,0 +1,10 @@
+package utils
+
+import (
+	"fmt"
+	"time"
+)
+
+func GenerateReviewImageTitle(orderID, pharmacyProductID int64) string {
+	return fmt.Sprintf("review-%v-%v-%v", orderID, pharmacyProductID, time.Now().Unix())
+}
@@ -0,0 +1,50 @@
+package apperror
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"healthcare-app/internal/subscription/constant"
+	"healthcare-app/pkg/apperror"
+)
+
+func NewUserReachMaximumNumberOfSubscriptions() *apperror.AppError {
+	msg := fmt.Sprintf(constant.InvalidUserReachesMaximumNumberOfSubscriptions, constant.MAX_SUBSCRIPTIONS)
+
+	err := errors.New(msg)
+
+	return apperror.NewAppError(err, apperror.DefaultClientErrorCode, msg)
+}
+
+func NewInvalidSubscriptionStartDateError() *apperror.AppError {
+	msg := constant.InvalidSubscriptionStartDate
+
+	err := errors.New(msg)
+
+	return apperror.NewAppError(err, apperror.DefaultClientErrorCode, msg)
+}
+
+func NewInvalidSubscriptionProductError() *apperror.AppError {
+	msg := constant.InvalidSubscriptionProduct
+
+	err := errors.New(msg)
+
+	return apperror.NewAppError(err, apperror.DefaultClientErrorCode, msg)
+}
+
+func NewInvalidSubscriptionLogisticError() *apperror.AppError {
+	msg := constant.InvalidSubscriptionLogistic
+
+	err := errors.New(msg)
+
+	return apperror.NewAppError(err, apperror.DefaultClientErrorCode, msg)
+}
+
+func NewInvalidSubscriptionStatusError(status string) *apperror.AppError {
+	msg := fmt.Sprintf(constant.InvalidSubscriptionStatus, strings.ToLower(status))
+
+	err := errors.New(msg)
+
+	return apperror.NewAppError(err, apperror.DefaultClientErrorCode, msg)
+}
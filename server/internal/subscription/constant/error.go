@@ -0,0 +1,9 @@
+package constant
+
+const (
+	InvalidUserReachesMaximumNumberOfSubscriptions = "user can only have up to %d refill subscriptions"
+	InvalidSubscriptionStartDate                   = "start date cannot be in the past"
+	InvalidSubscriptionProduct                     = "product is not available in the selected pharmacy"
+	InvalidSubscriptionLogistic                    = "logistic is not available for the selected pharmacy"
+	InvalidSubscriptionStatus                      = "subscription must be %s to do this action"
+)
@@ -0,0 +1,18 @@
+package constant
+
+const (
+	STATUS_ACTIVE    = "ACTIVE"
+	STATUS_PAUSED    = "PAUSED"
+	STATUS_CANCELLED = "CANCELLED"
+)
+
+const (
+	MAX_SUBSCRIPTIONS   = 10
+	REMINDER_DAYS       = 2
+	SHORTAGE_RETRY_DAYS = 1
+	DATE_LAYOUT         = "2006-01-02"
+)
+
+const (
+	REFILL_DESCRIPTION = "Refill subscription #%d"
+)
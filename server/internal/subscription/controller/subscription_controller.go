@@ -0,0 +1,134 @@
+package controller
+
+import (
+	"strconv"
+
+	"healthcare-app/internal/auth/utils"
+	dtoSubscription "healthcare-app/internal/subscription/dto"
+	"healthcare-app/internal/subscription/usecase"
+	"healthcare-app/pkg/apperror"
+	"healthcare-app/pkg/utils/ginutils"
+
+	"github.com/gin-gonic/gin"
+)
+
+type SubscriptionController struct {
+	subscriptionUseCase usecase.SubscriptionUseCase
+}
+
+func NewSubscriptionController(subscriptionUseCase usecase.SubscriptionUseCase) *SubscriptionController {
+	return &SubscriptionController{
+		subscriptionUseCase: subscriptionUseCase,
+	}
+}
+
+func (sc *SubscriptionController) GetAllMySubscriptions(ctx *gin.Context) {
+	res, err := sc.subscriptionUseCase.GetAllMySubscriptions(ctx, utils.GetValueUserIdFromToken(ctx))
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+	ginutils.ResponseOK(ctx, res)
+}
+
+func (sc *SubscriptionController) GetMySubscription(ctx *gin.Context) {
+	subscriptionID, err := strconv.Atoi(ctx.Param("subscriptionId"))
+	if err != nil {
+		ctx.Error(apperror.NewInvalidIdError())
+		return
+	}
+	res, err := sc.subscriptionUseCase.GetMySubscription(ctx, int64(subscriptionID), utils.GetValueUserIdFromToken(ctx))
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+	ginutils.ResponseOK(ctx, res)
+}
+
+func (sc *SubscriptionController) PostNewSubscription(ctx *gin.Context) {
+	req := &dtoSubscription.RequestSubscription{UserID: utils.GetValueUserIdFromToken(ctx)}
+	if err := ctx.ShouldBindJSON(req); err != nil {
+		ctx.Error(err)
+		return
+	}
+	res, err := sc.subscriptionUseCase.PostNewSubscription(ctx, req)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+	ginutils.ResponseCreated(ctx, res)
+}
+
+func (sc *SubscriptionController) PutMySubscription(ctx *gin.Context) {
+	subscriptionID, err := strconv.Atoi(ctx.Param("subscriptionId"))
+	if err != nil {
+		ctx.Error(apperror.NewInvalidIdError())
+		return
+	}
+	req := &dtoSubscription.RequestSubscription{ID: int64(subscriptionID), UserID: utils.GetValueUserIdFromToken(ctx)}
+	if err := ctx.ShouldBindJSON(req); err != nil {
+		ctx.Error(err)
+		return
+	}
+	res, err := sc.subscriptionUseCase.PutMySubscription(ctx, req)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+	ginutils.ResponseOK(ctx, res)
+}
+
+func (sc *SubscriptionController) PauseMySubscription(ctx *gin.Context) {
+	subscriptionID, err := strconv.Atoi(ctx.Param("subscriptionId"))
+	if err != nil {
+		ctx.Error(apperror.NewInvalidIdError())
+		return
+	}
+	res, err := sc.subscriptionUseCase.PauseMySubscription(ctx, int64(subscriptionID), utils.GetValueUserIdFromToken(ctx))
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+	ginutils.ResponseOK(ctx, res)
+}
+
+func (sc *SubscriptionController) ResumeMySubscription(ctx *gin.Context) {
+	subscriptionID, err := strconv.Atoi(ctx.Param("subscriptionId"))
+	if err != nil {
+		ctx.Error(apperror.NewInvalidIdError())
+		return
+	}
+	res, err := sc.subscriptionUseCase.ResumeMySubscription(ctx, int64(subscriptionID), utils.GetValueUserIdFromToken(ctx))
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+	ginutils.ResponseOK(ctx, res)
+}
+
+func (sc *SubscriptionController) SkipMySubscription(ctx *gin.Context) {
+	subscriptionID, err := strconv.Atoi(ctx.Param("subscriptionId"))
+	if err != nil {
+		ctx.Error(apperror.NewInvalidIdError())
+		return
+	}
+	res, err := sc.subscriptionUseCase.SkipMySubscription(ctx, int64(subscriptionID), utils.GetValueUserIdFromToken(ctx))
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+	ginutils.ResponseOK(ctx, res)
+}
+
+func (sc *SubscriptionController) DeleteMySubscription(ctx *gin.Context) {
+	subscriptionID, err := strconv.Atoi(ctx.Param("subscriptionId"))
+	if err != nil {
+		ctx.Error(apperror.NewInvalidIdError())
+		return
+	}
+	if err := sc.subscriptionUseCase.DeleteMySubscription(ctx, int64(subscriptionID), utils.GetValueUserIdFromToken(ctx)); err != nil {
+		ctx.Error(err)
+		return
+	}
+	ginutils.ResponseOKPlain(ctx)
+}
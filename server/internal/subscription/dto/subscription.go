@@ -0,0 +1,112 @@
+package dto
+
+import (
+	"time"
+
+	"healthcare-app/internal/subscription/constant"
+	"healthcare-app/internal/subscription/entity"
+
+	"github.com/shopspring/decimal"
+)
+
+type SubscriptionResponse struct {
+	ID           int64                       `json:"id"`
+	PharmacyID   int64                       `json:"pharmacy_id"`
+	AddressID    int64                       `json:"address_id"`
+	LogisticID   int64                       `json:"logistic_id"`
+	DependentID  *int64                      `json:"dependent_id"`
+	IntervalDays int                         `json:"interval_days"`
+	Status       string                      `json:"status"`
+	NextRefillAt string                      `json:"next_refill_at"`
+	LastOrderID  *int64                      `json:"last_order_id"`
+	Description  *string                     `json:"description"`
+	Items        []*SubscriptionItemResponse `json:"items"`
+	CreatedAt    time.Time                   `json:"created_at"`
+	UpdatedAt    time.Time                   `json:"updated_at"`
+}
+
+type SubscriptionItemResponse struct {
+	PharmacyProductID int64           `json:"pharmacy_product_id"`
+	ProductID         int64           `json:"product_id"`
+	Name              string          `json:"name"`
+	Price             decimal.Decimal `json:"price"`
+	Quantity          int             `json:"quantity"`
+}
+
+type RequestSubscription struct {
+	PharmacyID   int64                     `json:"pharmacy_id" binding:"required,gte=1"`
+	AddressID    int64                     `json:"address_id" binding:"required,gte=1"`
+	LogisticID   int64                     `json:"logistic_id" binding:"required,gte=1"`
+	DependentID  *int64                    `json:"dependent_id" binding:"omitempty,gte=1"`
+	IntervalDays int                       `json:"interval_days" binding:"required,gte=7,lte=90"`
+	StartDate    string                    `json:"start_date" binding:"required,time_format=2006-01-02"`
+	Description  *string                   `json:"description" binding:"omitempty,max=255"`
+	Items        []RequestSubscriptionItem `json:"items" binding:"required,min=1,max=10,unique=PharmacyProductID,dive"`
+	ID           int64                     `json:"-"`
+	UserID       int64                     `json:"-"`
+}
+
+type RequestSubscriptionItem struct {
+	PharmacyProductID int64 `json:"pharmacy_product_id" binding:"required,gte=1"`
+	Quantity          int   `json:"quantity" binding:"required,gte=1,lte=100"`
+}
+
+func ConvertToSubscriptionResponses(subscriptions []*entity.Subscription) []*SubscriptionResponse {
+	res := []*SubscriptionResponse{}
+	for _, subscription := range subscriptions {
+		res = append(res, ConvertToSubscriptionResponse(subscription))
+	}
+	return res
+}
+
+func ConvertToSubscriptionResponse(subscription *entity.Subscription) *SubscriptionResponse {
+	items := []*SubscriptionItemResponse{}
+	for _, item := range subscription.Items {
+		items = append(items, &SubscriptionItemResponse{
+			PharmacyProductID: item.PharmacyProductID,
+			ProductID:         item.ProductID,
+			Name:              item.Name,
+			Price:             item.Price,
+			Quantity:          item.Quantity,
+		})
+	}
+	return &SubscriptionResponse{
+		ID:           subscription.ID,
+		PharmacyID:   subscription.PharmacyID,
+		AddressID:    subscription.AddressID,
+		LogisticID:   subscription.LogisticID,
+		DependentID:  subscription.DependentID,
+		IntervalDays: subscription.IntervalDays,
+		Status:       subscription.Status,
+		NextRefillAt: subscription.NextRefillAt.Format(constant.DATE_LAYOUT),
+		LastOrderID:  subscription.LastOrderID,
+		Description:  subscription.Description,
+		Items:        items,
+		CreatedAt:    subscription.CreatedAt,
+		UpdatedAt:    subscription.UpdatedAt,
+	}
+}
+
+func SubscriptionRequestToEntity(request *RequestSubscription) *entity.Subscription {
+	nextRefillAt, _ := time.Parse(constant.DATE_LAYOUT, request.StartDate)
+	items := []*entity.SubscriptionItem{}
+	for _, item := range request.Items {
+		items = append(items, &entity.SubscriptionItem{
+			PharmacyProductID: item.PharmacyProductID,
+			Quantity:          item.Quantity,
+		})
+	}
+	return &entity.Subscription{
+		ID:           request.ID,
+		UserID:       request.UserID,
+		PharmacyID:   request.PharmacyID,
+		AddressID:    request.AddressID,
+		LogisticID:   request.LogisticID,
+		DependentID:  request.DependentID,
+		IntervalDays: request.IntervalDays,
+		Status:       constant.STATUS_ACTIVE,
+		NextRefillAt: nextRefillAt,
+		Description:  request.Description,
+		Items:        items,
+	}
+}
@@ -0,0 +1,37 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+type Subscription struct {
+	ID           int64
+	UserID       int64
+	PharmacyID   int64
+	AddressID    int64
+	LogisticID   int64
+	DependentID  *int64
+	IntervalDays int
+	Status       string
+	NextRefillAt time.Time
+	RemindedAt   *time.Time
+	LastOrderID  *int64
+	Description  *string
+	Items        []*SubscriptionItem
+	CreatedAt    time.Time
+	UpdatedAt    time.Time
+}
+
+type SubscriptionItem struct {
+	ID                int64
+	SubscriptionID    int64
+	PharmacyProductID int64
+	ProductID         int64
+	Name              string
+	Price             decimal.Decimal
+	StockQuantity     int
+	IsActive          bool
+	Quantity          int
+}
@@ -0,0 +1,506 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"healthcare-app/internal/subscription/constant"
+	"healthcare-app/internal/subscription/entity"
+	apperrorPkg "healthcare-app/pkg/apperror"
+	"healthcare-app/pkg/database/transactor"
+)
+
+type SubscriptionRepository interface {
+	CountByUserID(ctx context.Context, userID int64) (int64, error)
+	FindAllByUserID(ctx context.Context, userID int64) ([]*entity.Subscription, error)
+	FindByIDAndUserID(ctx context.Context, id int64, userID int64) (*entity.Subscription, error)
+	FindAllDueForRefill(ctx context.Context, date time.Time) ([]*entity.Subscription, error)
+	FindAllDueForReminder(ctx context.Context, date time.Time) ([]*entity.Subscription, error)
+	FindAllItemsBySubscriptionID(ctx context.Context, subscriptionID int64) ([]*entity.SubscriptionItem, error)
+	FindAllPharmacyProducts(ctx context.Context, pharmacyID int64, pharmacyProductIDs []int64) ([]*entity.SubscriptionItem, error)
+	Save(ctx context.Context, subscription *entity.Subscription) error
+	Update(ctx context.Context, subscription *entity.Subscription) error
+	UpdateSchedule(ctx context.Context, subscription *entity.Subscription) error
+	ClaimSchedule(ctx context.Context, subscription *entity.Subscription) (bool, error)
+	ReplaceItems(ctx context.Context, subscription *entity.Subscription) error
+	DeleteByIDAndUserID(ctx context.Context, id int64, userID int64) error
+}
+
+type subscriptionRepositoryImpl struct {
+	db *sql.DB
+}
+
+func NewSubscriptionRepository(db *sql.DB) *subscriptionRepositoryImpl {
+	return &subscriptionRepositoryImpl{
+		db: db,
+	}
+}
+
+const subscriptionSelectQuery = `
+	select id, user_id, pharmacy_id, address_id, logistic_id, dependent_id, interval_days, status, next_refill_at, reminded_at, last_order_id, description, created_at, updated_at
+	from refill_subscriptions
+`
+
+func (r *subscriptionRepositoryImpl) CountByUserID(ctx context.Context, userID int64) (int64, error) {
+	query := `
+		select count(*) from refill_subscriptions where user_id = $1 and deleted_at is null
+	`
+	tx := transactor.ExtractTx(ctx)
+
+	var (
+		err   error
+		count int64
+	)
+	if tx != nil {
+		err = tx.QueryRowContext(ctx, query, userID).Scan(&count)
+	} else {
+		err = r.db.QueryRowContext(ctx, query, userID).Scan(&count)
+	}
+
+	if err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+func (r *subscriptionRepositoryImpl) FindAllByUserID(ctx context.Context, userID int64) ([]*entity.Subscription, error) {
+	return r.findAll(ctx, subscriptionSelectQuery+" where user_id = $1 and deleted_at is null order by next_refill_at", userID)
+}
+
+func (r *subscriptionRepositoryImpl) FindByIDAndUserID(ctx context.Context, id int64, userID int64) (*entity.Subscription, error) {
+	query := subscriptionSelectQuery + " where id = $1 and user_id = $2 and deleted_at is null"
+	tx := transactor.ExtractTx(ctx)
+
+	var (
+		err          error
+		subscription entity.Subscription
+	)
+	if tx != nil {
+		err = tx.QueryRowContext(ctx, query, id, userID).Scan(
+			&subscription.ID,
+			&subscription.UserID,
+			&subscription.PharmacyID,
+			&subscription.AddressID,
+			&subscription.LogisticID,
+			&subscription.DependentID,
+			&subscription.IntervalDays,
+			&subscription.Status,
+			&subscription.NextRefillAt,
+			&subscription.RemindedAt,
+			&subscription.LastOrderID,
+			&subscription.Description,
+			&subscription.CreatedAt,
+			&subscription.UpdatedAt,
+		)
+	} else {
+		err = r.db.QueryRowContext(ctx, query, id, userID).Scan(
+			&subscription.ID,
+			&subscription.UserID,
+			&subscription.PharmacyID,
+			&subscription.AddressID,
+			&subscription.LogisticID,
+			&subscription.DependentID,
+			&subscription.IntervalDays,
+			&subscription.Status,
+			&subscription.NextRefillAt,
+			&subscription.RemindedAt,
+			&subscription.LastOrderID,
+			&subscription.Description,
+			&subscription.CreatedAt,
+			&subscription.UpdatedAt,
+		)
+	}
+
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, apperrorPkg.NewEntityNotFoundError("subscription")
+		}
+		return nil, err
+	}
+	return &subscription, nil
+}
+
+func (r *subscriptionRepositoryImpl) FindAllDueForRefill(ctx context.Context, date time.Time) ([]*entity.Subscription, error) {
+	query := subscriptionSelectQuery + " where status = $1 and next_refill_at <= $2 and deleted_at is null order by next_refill_at"
+	return r.findAll(ctx, query, constant.STATUS_ACTIVE, date)
+}
+
+func (r *subscriptionRepositoryImpl) FindAllDueForReminder(ctx context.Context, date time.Time) ([]*entity.Subscription, error) {
+	query := subscriptionSelectQuery + " where status = $1 and next_refill_at <= $2 and reminded_at is null and deleted_at is null order by next_refill_at"
+	return r.findAll(ctx, query, constant.STATUS_ACTIVE, date)
+}
+
+func (r *subscriptionRepositoryImpl) FindAllItemsBySubscriptionID(ctx context.Context, subscriptionID int64) ([]*entity.SubscriptionItem, error) {
+	query := `
+		select rsi.id, rsi.subscription_id, rsi.pharmacy_product_id, p.id, p.name, pp.price, pp.stock_quantity, pp.is_active and p.is_active, rsi.quantity
+		from refill_subscription_items rsi
+		join pharmacy_products pp on pp.id = rsi.pharmacy_product_id
+		join products p on p.id = pp.product_id
+		where rsi.subscription_id = $1
+		order by rsi.id
+	`
+	tx := transactor.ExtractTx(ctx)
+
+	var (
+		err  error
+		rows *sql.Rows
+	)
+	if tx != nil {
+		rows, err = tx.QueryContext(ctx, query, subscriptionID)
+	} else {
+		rows, err = r.db.QueryContext(ctx, query, subscriptionID)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	items := []*entity.SubscriptionItem{}
+	for rows.Next() {
+		item := new(entity.SubscriptionItem)
+		if err := rows.Scan(
+			&item.ID,
+			&item.SubscriptionID,
+			&item.PharmacyProductID,
+			&item.ProductID,
+			&item.Name,
+			&item.Price,
+			&item.StockQuantity,
+			&item.IsActive,
+			&item.Quantity,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, item)
+	}
+
+	err = rows.Err()
+	if err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+func (r *subscriptionRepositoryImpl) FindAllPharmacyProducts(ctx context.Context, pharmacyID int64, pharmacyProductIDs []int64) ([]*entity.SubscriptionItem, error) {
+	query := `
+		select pp.id, p.id, p.name, pp.price, pp.stock_quantity, pp.is_active and p.is_active
+		from pharmacy_products pp
+		join products p on p.id = pp.product_id
+		where pp.pharmacy_id = $1 and pp.id = any($2) and pp.deleted_at is null and p.deleted_at is null
+	`
+	tx := transactor.ExtractTx(ctx)
+
+	var (
+		err  error
+		rows *sql.Rows
+	)
+	if tx != nil {
+		rows, err = tx.QueryContext(ctx, query, pharmacyID, pharmacyProductIDs)
+	} else {
+		rows, err = r.db.QueryContext(ctx, query, pharmacyID, pharmacyProductIDs)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	items := []*entity.SubscriptionItem{}
+	for rows.Next() {
+		item := new(entity.SubscriptionItem)
+		if err := rows.Scan(
+			&item.PharmacyProductID,
+			&item.ProductID,
+			&item.Name,
+			&item.Price,
+			&item.StockQuantity,
+			&item.IsActive,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, item)
+	}
+
+	err = rows.Err()
+	if err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+func (r *subscriptionRepositoryImpl) Save(ctx context.Context, subscription *entity.Subscription) error {
+	query := `
+		insert into refill_subscriptions(user_id, pharmacy_id, address_id, logistic_id, dependent_id, interval_days, status, next_refill_at, description)
+		values ($1, $2, $3, $4, $5, $6, $7, $8, $9) returning id, created_at, updated_at
+	`
+	tx := transactor.ExtractTx(ctx)
+
+	var err error
+	if tx != nil {
+		err = tx.QueryRowContext(
+			ctx,
+			query,
+			subscription.UserID,
+			subscription.PharmacyID,
+			subscription.AddressID,
+			subscription.LogisticID,
+			subscription.DependentID,
+			subscription.IntervalDays,
+			subscription.Status,
+			subscription.NextRefillAt,
+			subscription.Description,
+		).Scan(&subscription.ID, &subscription.CreatedAt, &subscription.UpdatedAt)
+	} else {
+		err = r.db.QueryRowContext(
+			ctx,
+			query,
+			subscription.UserID,
+			subscription.PharmacyID,
+			subscription.AddressID,
+			subscription.LogisticID,
+			subscription.DependentID,
+			subscription.IntervalDays,
+			subscription.Status,
+			subscription.NextRefillAt,
+			subscription.Description,
+		).Scan(&subscription.ID, &subscription.CreatedAt, &subscription.UpdatedAt)
+	}
+
+	return err
+}
+
+func (r *subscriptionRepositoryImpl) Update(ctx context.Context, subscription *entity.Subscription) error {
+	query := `
+		update refill_subscriptions
+		set pharmacy_id = $3, address_id = $4, logistic_id = $5, dependent_id = $6, interval_days = $7, next_refill_at = $8, description = $9, reminded_at = null, updated_at = now()
+		where id = $1 and user_id = $2 and deleted_at is null
+		returning status, last_order_id, created_at, updated_at
+	`
+	tx := transactor.ExtractTx(ctx)
+
+	var err error
+	if tx != nil {
+		err = tx.QueryRowContext(
+			ctx,
+			query,
+			subscription.ID,
+			subscription.UserID,
+			subscription.PharmacyID,
+			subscription.AddressID,
+			subscription.LogisticID,
+			subscription.DependentID,
+			subscription.IntervalDays,
+			subscription.NextRefillAt,
+			subscription.Description,
+		).Scan(&subscription.Status, &subscription.LastOrderID, &subscription.CreatedAt, &subscription.UpdatedAt)
+	} else {
+		err = r.db.QueryRowContext(
+			ctx,
+			query,
+			subscription.ID,
+			subscription.UserID,
+			subscription.PharmacyID,
+			subscription.AddressID,
+			subscription.LogisticID,
+			subscription.DependentID,
+			subscription.IntervalDays,
+			subscription.NextRefillAt,
+			subscription.Description,
+		).Scan(&subscription.Status, &subscription.LastOrderID, &subscription.CreatedAt, &subscription.UpdatedAt)
+	}
+
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return apperrorPkg.NewEntityNotFoundError("subscription")
+		}
+		return err
+	}
+	return nil
+}
+
+// ClaimSchedule locks the subscription for the running transaction if its
+// schedule is still the one it was read with. It returns false when another
+// process holds the row or already moved the schedule, the caller skips it
+// then. The lock is held until the transaction ends.
+func (r *subscriptionRepositoryImpl) ClaimSchedule(ctx context.Context, subscription *entity.Subscription) (bool, error) {
+	query := `
+		select id from refill_subscriptions
+		where id = $1 and status = $2 and next_refill_at = $3::date and reminded_at is not distinct from $4::timestamp and deleted_at is null
+		for update skip locked
+	`
+	tx := transactor.ExtractTx(ctx)
+
+	var (
+		err error
+		id  int64
+	)
+	if tx != nil {
+		err = tx.QueryRowContext(ctx, query, subscription.ID, subscription.Status, subscription.NextRefillAt, subscription.RemindedAt).Scan(&id)
+	} else {
+		err = r.db.QueryRowContext(ctx, query, subscription.ID, subscription.Status, subscription.NextRefillAt, subscription.RemindedAt).Scan(&id)
+	}
+
+	if errors.Is(err, sql.ErrNoRows) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+func (r *subscriptionRepositoryImpl) UpdateSchedule(ctx context.Context, subscription *entity.Subscription) error {
+	query := `
+		update refill_subscriptions
+		set status = $2, next_refill_at = $3, reminded_at = $4, last_order_id = $5, updated_at = now()
+		where id = $1 and deleted_at is null
+		returning updated_at
+	`
+	tx := transactor.ExtractTx(ctx)
+
+	var err error
+	if tx != nil {
+		err = tx.QueryRowContext(
+			ctx,
+			query,
+			subscription.ID,
+			subscription.Status,
+			subscription.NextRefillAt,
+			subscription.RemindedAt,
+			subscription.LastOrderID,
+		).Scan(&subscription.UpdatedAt)
+	} else {
+		err = r.db.QueryRowContext(
+			ctx,
+			query,
+			subscription.ID,
+			subscription.Status,
+			subscription.NextRefillAt,
+			subscription.RemindedAt,
+			subscription.LastOrderID,
+		).Scan(&subscription.UpdatedAt)
+	}
+
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return apperrorPkg.NewEntityNotFoundError("subscription")
+		}
+		return err
+	}
+	return nil
+}
+
+func (r *subscriptionRepositoryImpl) ReplaceItems(ctx context.Context, subscription *entity.Subscription) error {
+	deleteQuery := `
+		delete from refill_subscription_items where subscription_id = $1
+	`
+	insertQuery := `
+		insert into refill_subscription_items(subscription_id, pharmacy_product_id, quantity)
+		values ($1, $2, $3) returning id
+	`
+	tx := transactor.ExtractTx(ctx)
+
+	var err error
+	if tx != nil {
+		_, err = tx.ExecContext(ctx, deleteQuery, subscription.ID)
+	} else {
+		_, err = r.db.ExecContext(ctx, deleteQuery, subscription.ID)
+	}
+	if err != nil {
+		return err
+	}
+
+	for _, item := range subscription.Items {
+		item.SubscriptionID = subscription.ID
+		if tx != nil {
+			err = tx.QueryRowContext(ctx, insertQuery, item.SubscriptionID, item.PharmacyProductID, item.Quantity).Scan(&item.ID)
+		} else {
+			err = r.db.QueryRowContext(ctx, insertQuery, item.SubscriptionID, item.PharmacyProductID, item.Quantity).Scan(&item.ID)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (r *subscriptionRepositoryImpl) DeleteByIDAndUserID(ctx context.Context, id int64, userID int64) error {
+	query := `
+		update refill_subscriptions set status = $3, deleted_at = now() where id = $1 and user_id = $2 and deleted_at is null
+	`
+	tx := transactor.ExtractTx(ctx)
+
+	var (
+		err error
+		res sql.Result
+	)
+	if tx != nil {
+		res, err = tx.ExecContext(ctx, query, id, userID, constant.STATUS_CANCELLED)
+	} else {
+		res, err = r.db.ExecContext(ctx, query, id, userID, constant.STATUS_CANCELLED)
+	}
+	if err != nil {
+		return err
+	}
+
+	rowsAffected, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rowsAffected == 0 {
+		return apperrorPkg.NewEntityNotFoundError("subscription")
+	}
+	return nil
+}
+
+func (r *subscriptionRepositoryImpl) findAll(ctx context.Context, query string, args ...any) ([]*entity.Subscription, error) {
+	tx := transactor.ExtractTx(ctx)
+
+	var (
+		err  error
+		rows *sql.Rows
+	)
+	if tx != nil {
+		rows, err = tx.QueryContext(ctx, query, args...)
+	} else {
+		rows, err = r.db.QueryContext(ctx, query, args...)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	subscriptions := []*entity.Subscription{}
+	for rows.Next() {
+		subscription := new(entity.Subscription)
+		if err := rows.Scan(
+			&subscription.ID,
+			&subscription.UserID,
+			&subscription.PharmacyID,
+			&subscription.AddressID,
+			&subscription.LogisticID,
+			&subscription.DependentID,
+			&subscription.IntervalDays,
+			&subscription.Status,
+			&subscription.NextRefillAt,
+			&subscription.RemindedAt,
+			&subscription.LastOrderID,
+			&subscription.Description,
+			&subscription.CreatedAt,
+			&subscription.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		subscriptions = append(subscriptions, subscription)
+	}
+
+	err = rows.Err()
+	if err != nil {
+		return nil, err
+	}
+	return subscriptions, nil
+}
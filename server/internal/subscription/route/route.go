@@ -0,0 +1,25 @@
+package route
+
+import (
+	"healthcare-app/internal/auth/constant"
+	"healthcare-app/internal/subscription/controller"
+	"healthcare-app/pkg/middleware"
+
+	"github.com/gin-gonic/gin"
+)
+
+const subscriptionId = "/:subscriptionId"
+
+func SubscriptionControllerRoute(c *controller.SubscriptionController, r *gin.Engine, authMiddleware *middleware.AuthMiddleware) {
+	g := r.Group("/users/me/subscriptions", authMiddleware.Authorization(), authMiddleware.ProtectedRoles(constant.USER))
+	{
+		g.GET("", c.GetAllMySubscriptions)
+		g.POST("", c.PostNewSubscription)
+		g.GET(subscriptionId, c.GetMySubscription)
+		g.PUT(subscriptionId, c.PutMySubscription)
+		g.DELETE(subscriptionId, c.DeleteMySubscription)
+		g.PATCH(subscriptionId+"/pause", c.PauseMySubscription)
+		g.PATCH(subscriptionId+"/resume", c.ResumeMySubscription)
+		g.PATCH(subscriptionId+"/skip", c.SkipMySubscription)
+	}
+}
@@ -0,0 +1,435 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	authRepository "healthcare-app/internal/auth/repository"
+	appErrorOrder "healthcare-app/internal/order/apperror"
+	orderDto "healthcare-app/internal/order/dto"
+	orderUseCase "healthcare-app/internal/order/usecase"
+	dtoPharmacy "healthcare-app/internal/pharmacy/dto"
+	pharmacyRepository "healthcare-app/internal/pharmacy/repository"
+	appErrorProfile "healthcare-app/internal/profile/apperror"
+	profileRepository "healthcare-app/internal/profile/repository"
+	"healthcare-app/internal/queue/payload"
+	"healthcare-app/internal/queue/tasks"
+	appErrorSubscription "healthcare-app/internal/subscription/apperror"
+	"healthcare-app/internal/subscription/constant"
+	dtoSubscription "healthcare-app/internal/subscription/dto"
+	"healthcare-app/internal/subscription/entity"
+	subscriptionRepository "healthcare-app/internal/subscription/repository"
+	appErrorPkg "healthcare-app/pkg/apperror"
+	"healthcare-app/pkg/database/transactor"
+)
+
+type SubscriptionUseCase interface {
+	GetAllMySubscriptions(ctx context.Context, userID int64) ([]*dtoSubscription.SubscriptionResponse, error)
+	GetMySubscription(ctx context.Context, id int64, userID int64) (*dtoSubscription.SubscriptionResponse, error)
+	PostNewSubscription(ctx context.Context, reqBody *dtoSubscription.RequestSubscription) (*dtoSubscription.SubscriptionResponse, error)
+	PutMySubscription(ctx context.Context, reqBody *dtoSubscription.RequestSubscription) (*dtoSubscription.SubscriptionResponse, error)
+	PauseMySubscription(ctx context.Context, id int64, userID int64) (*dtoSubscription.SubscriptionResponse, error)
+	ResumeMySubscription(ctx context.Context, id int64, userID int64) (*dtoSubscription.SubscriptionResponse, error)
+	SkipMySubscription(ctx context.Context, id int64, userID int64) (*dtoSubscription.SubscriptionResponse, error)
+	DeleteMySubscription(ctx context.Context, id int64, userID int64) error
+	SendRefillReminders(ctx context.Context) error
+	ProcessDueRefills(ctx context.Context) error
+}
+
+type subscriptionUseCaseImpl struct {
+	subscriptionRepo subscriptionRepository.SubscriptionRepository
+	userRepo         authRepository.UserRepository
+	addressRepo      profileRepository.AddressRepository
+	dependentRepo    profileRepository.DependentRepository
+	pharmacyRepo     pharmacyRepository.PharmacyRepository
+	logisticRepo     pharmacyRepository.LogisticRepository
+	userOrderUseCase orderUseCase.UserOrderUseCase
+	emailTask        tasks.EmailTask
+	transactor       transactor.Transactor
+}
+
+func NewSubscriptionUseCase(
+	subscriptionRepo subscriptionRepository.SubscriptionRepository,
+	userRepo authRepository.UserRepository,
+	addressRepo profileRepository.AddressRepository,
+	dependentRepo profileRepository.DependentRepository,
+	pharmacyRepo pharmacyRepository.PharmacyRepository,
+	logisticRepo pharmacyRepository.LogisticRepository,
+	userOrderUseCase orderUseCase.UserOrderUseCase,
+	emailTask tasks.EmailTask,
+	transactor transactor.Transactor,
+) *subscriptionUseCaseImpl {
+	return &subscriptionUseCaseImpl{
+		subscriptionRepo: subscriptionRepo,
+		userRepo:         userRepo,
+		addressRepo:      addressRepo,
+		dependentRepo:    dependentRepo,
+		pharmacyRepo:     pharmacyRepo,
+		logisticRepo:     logisticRepo,
+		userOrderUseCase: userOrderUseCase,
+		emailTask:        emailTask,
+		transactor:       transactor,
+	}
+}
+
+func (u *subscriptionUseCaseImpl) GetAllMySubscriptions(ctx context.Context, userID int64) ([]*dtoSubscription.SubscriptionResponse, error) {
+	subscriptions, err := u.subscriptionRepo.FindAllByUserID(ctx, userID)
+	if err != nil {
+		return nil, appErrorPkg.NewServerError(err)
+	}
+	for _, subscription := range subscriptions {
+		subscription.Items, err = u.subscriptionRepo.FindAllItemsBySubscriptionID(ctx, subscription.ID)
+		if err != nil {
+			return nil, appErrorPkg.NewServerError(err)
+		}
+	}
+	return dtoSubscription.ConvertToSubscriptionResponses(subscriptions), nil
+}
+
+func (u *subscriptionUseCaseImpl) GetMySubscription(ctx context.Context, id int64, userID int64) (*dtoSubscription.SubscriptionResponse, error) {
+	subscription, err := u.findMySubscription(ctx, id, userID)
+	if err != nil {
+		return nil, err
+	}
+	return dtoSubscription.ConvertToSubscriptionResponse(subscription), nil
+}
+
+func (u *subscriptionUseCaseImpl) PostNewSubscription(ctx context.Context, reqBody *dtoSubscription.RequestSubscription) (*dtoSubscription.SubscriptionResponse, error) {
+	subscription := dtoSubscription.SubscriptionRequestToEntity(reqBody)
+
+	err := u.transactor.Atomic(ctx, func(cForTx context.Context) error {
+		count, err := u.subscriptionRepo.CountByUserID(cForTx, reqBody.UserID)
+		if err != nil {
+			return appErrorPkg.NewServerError(err)
+		}
+		if count >= constant.MAX_SUBSCRIPTIONS {
+			return appErrorSubscription.NewUserReachMaximumNumberOfSubscriptions()
+		}
+		if err := u.validateSubscription(cForTx, subscription); err != nil {
+			return err
+		}
+		if err := u.subscriptionRepo.Save(cForTx, subscription); err != nil {
+			return appErrorPkg.NewServerError(err)
+		}
+		if err := u.subscriptionRepo.ReplaceItems(cForTx, subscription); err != nil {
+			return appErrorPkg.NewServerError(err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return u.GetMySubscription(ctx, subscription.ID, subscription.UserID)
+}
+
+func (u *subscriptionUseCaseImpl) PutMySubscription(ctx context.Context, reqBody *dtoSubscription.RequestSubscription) (*dtoSubscription.SubscriptionResponse, error) {
+	subscription := dtoSubscription.SubscriptionRequestToEntity(reqBody)
+
+	err := u.transactor.Atomic(ctx, func(cForTx context.Context) error {
+		if err := u.validateSubscription(cForTx, subscription); err != nil {
+			return err
+		}
+		if err := u.subscriptionRepo.Update(cForTx, subscription); err != nil {
+			return err
+		}
+		if err := u.subscriptionRepo.ReplaceItems(cForTx, subscription); err != nil {
+			return appErrorPkg.NewServerError(err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return u.GetMySubscription(ctx, subscription.ID, subscription.UserID)
+}
+
+func (u *subscriptionUseCaseImpl) PauseMySubscription(ctx context.Context, id int64, userID int64) (*dtoSubscription.SubscriptionResponse, error) {
+	subscription, err := u.findMySubscription(ctx, id, userID)
+	if err != nil {
+		return nil, err
+	}
+	if subscription.Status != constant.STATUS_ACTIVE {
+		return nil, appErrorSubscription.NewInvalidSubscriptionStatusError(constant.STATUS_ACTIVE)
+	}
+
+	subscription.Status = constant.STATUS_PAUSED
+	if err := u.subscriptionRepo.UpdateSchedule(ctx, subscription); err != nil {
+		return nil, err
+	}
+	return dtoSubscription.ConvertToSubscriptionResponse(subscription), nil
+}
+
+func (u *subscriptionUseCaseImpl) ResumeMySubscription(ctx context.Context, id int64, userID int64) (*dtoSubscription.SubscriptionResponse, error) {
+	subscription, err := u.findMySubscription(ctx, id, userID)
+	if err != nil {
+		return nil, err
+	}
+	if subscription.Status != constant.STATUS_PAUSED {
+		return nil, appErrorSubscription.NewInvalidSubscriptionStatusError(constant.STATUS_PAUSED)
+	}
+
+	// A refill that became due while paused is pushed back so the user still
+	// gets the reminder before it is ordered.
+	earliestRefillAt := today().AddDate(0, 0, constant.REMINDER_DAYS)
+	if subscription.NextRefillAt.Before(earliestRefillAt) {
+		subscription.NextRefillAt = earliestRefillAt
+		subscription.RemindedAt = nil
+	}
+	subscription.Status = constant.STATUS_ACTIVE
+	if err := u.subscriptionRepo.UpdateSchedule(ctx, subscription); err != nil {
+		return nil, err
+	}
+	return dtoSubscription.ConvertToSubscriptionResponse(subscription), nil
+}
+
+func (u *subscriptionUseCaseImpl) SkipMySubscription(ctx context.Context, id int64, userID int64) (*dtoSubscription.SubscriptionResponse, error) {
+	subscription, err := u.findMySubscription(ctx, id, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	subscription.NextRefillAt = subscription.NextRefillAt.AddDate(0, 0, subscription.IntervalDays)
+	subscription.RemindedAt = nil
+	if err := u.subscriptionRepo.UpdateSchedule(ctx, subscription); err != nil {
+		return nil, err
+	}
+	return dtoSubscription.ConvertToSubscriptionResponse(subscription), nil
+}
+
+func (u *subscriptionUseCaseImpl) DeleteMySubscription(ctx context.Context, id int64, userID int64) error {
+	return u.subscriptionRepo.DeleteByIDAndUserID(ctx, id, userID)
+}
+
+func (u *subscriptionUseCaseImpl) SendRefillReminders(ctx context.Context) error {
+	subscriptions, err := u.subscriptionRepo.FindAllDueForReminder(ctx, today().AddDate(0, 0, constant.REMINDER_DAYS))
+	if err != nil {
+		return err
+	}
+
+	var errs []error
+	for _, subscription := range subscriptions {
+		if err := u.sendRefillReminder(ctx, subscription); err != nil {
+			errs = append(errs, fmt.Errorf("subscription %d: %w", subscription.ID, err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+func (u *subscriptionUseCaseImpl) ProcessDueRefills(ctx context.Context) error {
+	subscriptions, err := u.subscriptionRepo.FindAllDueForRefill(ctx, today())
+	if err != nil {
+		return err
+	}
+
+	var errs []error
+	for _, subscription := range subscriptions {
+		if err := u.refill(ctx, subscription); err != nil {
+			errs = append(errs, fmt.Errorf("subscription %d: %w", subscription.ID, err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// sendRefillReminder and refill run from the worker's scheduled task, which
+// can overlap across workers, so each one claims the subscription first and
+// only one of them acts on it.
+func (u *subscriptionUseCaseImpl) sendRefillReminder(ctx context.Context, subscription *entity.Subscription) error {
+	return u.transactor.Atomic(ctx, func(cForTx context.Context) error {
+		claimed, err := u.subscriptionRepo.ClaimSchedule(cForTx, subscription)
+		if err != nil || !claimed {
+			return err
+		}
+
+		user, err := u.userRepo.FindByID(cForTx, subscription.UserID)
+		if err != nil {
+			return err
+		}
+		items, err := u.subscriptionRepo.FindAllItemsBySubscriptionID(cForTx, subscription.ID)
+		if err != nil {
+			return err
+		}
+
+		if err := u.emailTask.QueueRefillReminderEmail(cForTx, &payload.RefillReminderEmailPayload{
+			UserID:     user.ID,
+			Email:      user.Email,
+			RefillDate: subscription.NextRefillAt.Format(constant.DATE_LAYOUT),
+			Products:   itemNames(items),
+		}); err != nil {
+			return err
+		}
+
+		remindedAt := time.Now()
+		subscription.RemindedAt = &remindedAt
+		return u.subscriptionRepo.UpdateSchedule(cForTx, subscription)
+	})
+}
+
+func (u *subscriptionUseCaseImpl) refill(ctx context.Context, subscription *entity.Subscription) error {
+	return u.transactor.Atomic(ctx, func(cForTx context.Context) error {
+		claimed, err := u.subscriptionRepo.ClaimSchedule(cForTx, subscription)
+		if err != nil || !claimed {
+			return err
+		}
+
+		items, err := u.subscriptionRepo.FindAllItemsBySubscriptionID(cForTx, subscription.ID)
+		if err != nil {
+			return err
+		}
+
+		shortages := []*entity.SubscriptionItem{}
+		for _, item := range items {
+			if !item.IsActive || item.StockQuantity < item.Quantity {
+				shortages = append(shortages, item)
+			}
+		}
+		if len(shortages) > 0 {
+			return u.postponeRefill(cForTx, subscription, shortages)
+		}
+
+		logistic, err := u.logisticRepo.FindByID(cForTx, subscription.LogisticID)
+		if err != nil {
+			return err
+		}
+		shipCost, err := u.logisticRepo.CalculateShipCost(cForTx, &dtoPharmacy.CalculateOfficialCostRequest{
+			AddressID:  subscription.AddressID,
+			PharmacyID: subscription.PharmacyID,
+			Logistic:   logistic,
+		})
+		if err != nil {
+			return err
+		}
+
+		description := fmt.Sprintf(constant.REFILL_DESCRIPTION, subscription.ID)
+		if subscription.Description != nil {
+			description = *subscription.Description
+		}
+		req := &orderDto.RequestOrder{
+			AddressID:   subscription.AddressID,
+			PharmacyID:  subscription.PharmacyID,
+			Description: &description,
+			DependentID: subscription.DependentID,
+			ShipCost:    shipCost,
+		}
+		for _, item := range items {
+			req.OrderProducts = append(req.OrderProducts, orderDto.RequestListOrderProduct{
+				PharmacyProductId: item.PharmacyProductID,
+				Quantity:          item.Quantity,
+				Price:             item.Price.IntPart(),
+			})
+		}
+
+		order, err := u.userOrderUseCase.PostRefillOrder(cForTx, req, subscription.UserID)
+		if err != nil {
+			return err
+		}
+
+		subscription.LastOrderID = &order.ID
+		subscription.NextRefillAt = today().AddDate(0, 0, subscription.IntervalDays)
+		subscription.RemindedAt = nil
+		return u.subscriptionRepo.UpdateSchedule(cForTx, subscription)
+	})
+}
+
+func (u *subscriptionUseCaseImpl) postponeRefill(ctx context.Context, subscription *entity.Subscription, shortages []*entity.SubscriptionItem) error {
+	user, err := u.userRepo.FindByID(ctx, subscription.UserID)
+	if err != nil {
+		return err
+	}
+
+	if err := u.emailTask.QueueRefillShortageEmail(ctx, &payload.RefillShortageEmailPayload{
+		UserID:   user.ID,
+		Email:    user.Email,
+		Products: itemNames(shortages),
+	}); err != nil {
+		return err
+	}
+
+	subscription.NextRefillAt = today().AddDate(0, 0, constant.SHORTAGE_RETRY_DAYS)
+	return u.subscriptionRepo.UpdateSchedule(ctx, subscription)
+}
+
+func (u *subscriptionUseCaseImpl) findMySubscription(ctx context.Context, id int64, userID int64) (*entity.Subscription, error) {
+	subscription, err := u.subscriptionRepo.FindByIDAndUserID(ctx, id, userID)
+	if err != nil {
+		return nil, err
+	}
+	subscription.Items, err = u.subscriptionRepo.FindAllItemsBySubscriptionID(ctx, subscription.ID)
+	if err != nil {
+		return nil, appErrorPkg.NewServerError(err)
+	}
+	return subscription, nil
+}
+
+func (u *subscriptionUseCaseImpl) validateSubscription(ctx context.Context, subscription *entity.Subscription) error {
+	if subscription.NextRefillAt.Before(today()) {
+		return appErrorSubscription.NewInvalidSubscriptionStartDateError()
+	}
+
+	address, err := u.addressRepo.FindAddressByIDandUserID(ctx, subscription.AddressID, subscription.UserID)
+	if err != nil || address == nil {
+		return appErrorProfile.NewInvalidAddressNotFoundError()
+	}
+
+	if subscription.DependentID != nil {
+		if _, err := u.dependentRepo.FindByIDAndUserID(ctx, *subscription.DependentID, subscription.UserID); err != nil {
+			return err
+		}
+	}
+
+	pharmacy, err := u.pharmacyRepo.FindByID(ctx, subscription.PharmacyID)
+	if err != nil {
+		return err
+	}
+	if !pharmacy.IsActive {
+		return appErrorOrder.NewInvalidOrderPharmacy()
+	}
+
+	logistics, err := u.logisticRepo.FindAllByPharmacyID(ctx, subscription.PharmacyID)
+	if err != nil {
+		return appErrorPkg.NewServerError(err)
+	}
+	logisticFound := false
+	for _, logistic := range logistics {
+		if logistic.ID == subscription.LogisticID {
+			logisticFound = true
+			break
+		}
+	}
+	if !logisticFound {
+		return appErrorSubscription.NewInvalidSubscriptionLogisticError()
+	}
+
+	pharmacyProductIDs := []int64{}
+	for _, item := range subscription.Items {
+		pharmacyProductIDs = append(pharmacyProductIDs, item.PharmacyProductID)
+	}
+	products, err := u.subscriptionRepo.FindAllPharmacyProducts(ctx, subscription.PharmacyID, pharmacyProductIDs)
+	if err != nil {
+		return appErrorPkg.NewServerError(err)
+	}
+	activeProducts := map[int64]bool{}
+	for _, product := range products {
+		activeProducts[product.PharmacyProductID] = product.IsActive
+	}
+	for _, item := range subscription.Items {
+		if !activeProducts[item.PharmacyProductID] {
+			return appErrorSubscription.NewInvalidSubscriptionProductError()
+		}
+	}
+	return nil
+}
+
+func itemNames(items []*entity.SubscriptionItem) []string {
+	names := []string{}
+	for _, item := range items {
+		names = append(names, item.Name)
+	}
+	return names
+}
+
+func today() time.Time {
+	year, month, day := time.Now().Date()
+	return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+}
@@ -0,0 +1,14 @@
+package apperror
+
+import (
+	"errors"
+
+	"healthcare-app/internal/webhook/constant"
+	"healthcare-app/pkg/apperror"
+)
+
+func NewWebhookEndpointInactiveError() *apperror.AppError {
+	msg := constant.InvalidWebhookEndpointInactive
+	err := errors.New(msg)
+	return apperror.NewAppError(err, apperror.DefaultClientErrorCode, msg)
+}
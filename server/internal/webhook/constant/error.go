@@ -0,0 +1,5 @@
+package constant
+
+const (
+	InvalidWebhookEndpointInactive = "webhook endpoint is not active"
+)
@@ -0,0 +1,45 @@
+package constant
+
+import (
+	"time"
+
+	constantOrder "healthcare-app/internal/order/constant"
+)
+
+const (
+	EVENT_ORDER_CREATED   = "order.created"
+	EVENT_ORDER_PROCESSED = "order.processed"
+	EVENT_ORDER_SENT      = "order.sent"
+	EVENT_ORDER_CANCELLED = "order.cancelled"
+	EVENT_STOCK_LOW       = "stock.low"
+)
+
+const (
+	DELIVERY_PENDING = "PENDING"
+	DELIVERY_SUCCESS = "SUCCESS"
+	DELIVERY_FAILED  = "FAILED"
+)
+
+const (
+	HEADER_EVENT     = "X-Webhook-Event"
+	HEADER_DELIVERY  = "X-Webhook-Delivery"
+	HEADER_SIGNATURE = "X-Webhook-Signature"
+)
+
+const (
+	SECRET_LENGTH     = 32
+	REQUEST_TIMEOUT   = 10 * time.Second
+	MAX_RESPONSE_BODY = 1024
+)
+
+var (
+	// OrderStatusEvents maps the status an order moves to onto the event
+	// partners subscribe to. Orders are only published as WAITING when they
+	// are placed, confirmed orders are not pushed out.
+	OrderStatusEvents = map[string]string{
+		constantOrder.STATUS_WAITING:   EVENT_ORDER_CREATED,
+		constantOrder.STATUS_PROCESSED: EVENT_ORDER_PROCESSED,
+		constantOrder.STATUS_SENT:      EVENT_ORDER_SENT,
+		constantOrder.STATUS_CANCELLED: EVENT_ORDER_CANCELLED,
+	}
+)
@@ -0,0 +1,155 @@
+package controller
+
+import (
+	"strconv"
+
+	"healthcare-app/internal/webhook/dto"
+	"healthcare-app/internal/webhook/usecase"
+	"healthcare-app/pkg/apperror"
+	"healthcare-app/pkg/utils/ginutils"
+	"healthcare-app/pkg/utils/pageutils"
+
+	"github.com/gin-gonic/gin"
+)
+
+type WebhookController struct {
+	webhookEndpointUseCase usecase.WebhookEndpointUseCase
+	webhookDeliveryUseCase usecase.WebhookDeliveryUseCase
+}
+
+func NewWebhookController(
+	webhookEndpointUseCase usecase.WebhookEndpointUseCase,
+	webhookDeliveryUseCase usecase.WebhookDeliveryUseCase,
+) *WebhookController {
+	return &WebhookController{
+		webhookEndpointUseCase: webhookEndpointUseCase,
+		webhookDeliveryUseCase: webhookDeliveryUseCase,
+	}
+}
+
+func (c *WebhookController) Search(ctx *gin.Context) {
+	req := new(dto.SearchWebhookEndpointRequest)
+	if err := ctx.ShouldBindQuery(req); err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	res, paging, err := c.webhookEndpointUseCase.Search(ctx, req)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+	paging.Links = pageutils.CreateLinks(ctx.Request, int(paging.Page), int(paging.Size), int(paging.TotalItem), int(paging.TotalPage))
+	ginutils.ResponseOKPagination(ctx, res, paging)
+}
+
+func (c *WebhookController) Get(ctx *gin.Context) {
+	webhookID, err := strconv.Atoi(ctx.Param("webhookId"))
+	if err != nil {
+		ctx.Error(apperror.NewInvalidIdError())
+		return
+	}
+
+	res, err := c.webhookEndpointUseCase.Get(ctx, int64(webhookID))
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+	ginutils.ResponseOK(ctx, res)
+}
+
+func (c *WebhookController) Create(ctx *gin.Context) {
+	req := new(dto.WebhookEndpointRequest)
+	if err := ctx.ShouldBindJSON(req); err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	res, err := c.webhookEndpointUseCase.Create(ctx, req)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+	ginutils.ResponseCreated(ctx, res)
+}
+
+func (c *WebhookController) Update(ctx *gin.Context) {
+	webhookID, err := strconv.Atoi(ctx.Param("webhookId"))
+	if err != nil {
+		ctx.Error(apperror.NewInvalidIdError())
+		return
+	}
+
+	req := &dto.UpdateWebhookEndpointRequest{ID: int64(webhookID)}
+	if err := ctx.ShouldBindJSON(req); err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	res, err := c.webhookEndpointUseCase.Update(ctx, req)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+	ginutils.ResponseOK(ctx, res)
+}
+
+func (c *WebhookController) Delete(ctx *gin.Context) {
+	webhookID, err := strconv.Atoi(ctx.Param("webhookId"))
+	if err != nil {
+		ctx.Error(apperror.NewInvalidIdError())
+		return
+	}
+
+	if err := c.webhookEndpointUseCase.Delete(ctx, int64(webhookID)); err != nil {
+		ctx.Error(err)
+		return
+	}
+	ginutils.ResponseOKPlain(ctx)
+}
+
+func (c *WebhookController) SearchDeliveries(ctx *gin.Context) {
+	req := new(dto.SearchWebhookDeliveryRequest)
+	if err := ctx.ShouldBindQuery(req); err != nil {
+		ctx.Error(err)
+		return
+	}
+
+	res, paging, err := c.webhookDeliveryUseCase.Search(ctx, req)
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+	paging.Links = pageutils.CreateLinks(ctx.Request, int(paging.Page), int(paging.Size), int(paging.TotalItem), int(paging.TotalPage))
+	ginutils.ResponseOKPagination(ctx, res, paging)
+}
+
+func (c *WebhookController) GetDelivery(ctx *gin.Context) {
+	deliveryID, err := strconv.Atoi(ctx.Param("deliveryId"))
+	if err != nil {
+		ctx.Error(apperror.NewInvalidIdError())
+		return
+	}
+
+	res, err := c.webhookDeliveryUseCase.Get(ctx, int64(deliveryID))
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+	ginutils.ResponseOK(ctx, res)
+}
+
+func (c *WebhookController) Redeliver(ctx *gin.Context) {
+	deliveryID, err := strconv.Atoi(ctx.Param("deliveryId"))
+	if err != nil {
+		ctx.Error(apperror.NewInvalidIdError())
+		return
+	}
+
+	res, err := c.webhookDeliveryUseCase.Redeliver(ctx, int64(deliveryID))
+	if err != nil {
+		ctx.Error(err)
+		return
+	}
+	ginutils.ResponseOK(ctx, res)
+}
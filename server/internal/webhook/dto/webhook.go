@@ -0,0 +1,135 @@
+package dto
+
+import (
+	"encoding/json"
+	"time"
+
+	"healthcare-app/internal/webhook/entity"
+)
+
+type WebhookEndpointResponse struct {
+	ID        int64     `json:"id"`
+	PartnerID int64     `json:"partner_id"`
+	URL       string    `json:"url"`
+	Events    []string  `json:"events"`
+	IsActive  bool      `json:"is_active"`
+	Secret    string    `json:"secret,omitempty"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+type WebhookDeliveryResponse struct {
+	ID                int64           `json:"id"`
+	WebhookEndpointID int64           `json:"webhook_id"`
+	Event             string          `json:"event"`
+	Payload           json.RawMessage `json:"payload"`
+	Status            string          `json:"status"`
+	Attempts          int             `json:"attempts"`
+	ResponseStatus    *int            `json:"response_status"`
+	ResponseBody      *string         `json:"response_body"`
+	Error             *string         `json:"error"`
+	LastAttemptAt     *time.Time      `json:"last_attempt_at"`
+	DeliveredAt       *time.Time      `json:"delivered_at"`
+	CreatedAt         time.Time       `json:"created_at"`
+	UpdatedAt         time.Time       `json:"updated_at"`
+}
+
+type WebhookEndpointRequest struct {
+	PartnerID int64    `json:"partner_id" binding:"required,gte=1"`
+	URL       string   `json:"url" binding:"required,http_url,max=2048"`
+	Events    []string `json:"events" binding:"required,min=1,no_duplicates,dive,oneof=order.created order.processed order.sent order.cancelled stock.low"`
+	IsActive  *bool    `json:"is_active"`
+}
+
+type UpdateWebhookEndpointRequest struct {
+	URL          string   `json:"url" binding:"required,http_url,max=2048"`
+	Events       []string `json:"events" binding:"required,min=1,no_duplicates,dive,oneof=order.created order.processed order.sent order.cancelled stock.low"`
+	IsActive     *bool    `json:"is_active" binding:"required"`
+	RotateSecret bool     `json:"rotate_secret"`
+	ID           int64    `json:"-"`
+}
+
+type SearchWebhookEndpointRequest struct {
+	PartnerID int64  `form:"partner_id" binding:"omitempty,gte=1"`
+	Event     string `form:"event"`
+	Limit     int64  `form:"limit" binding:"numeric,gte=1,lte=25"`
+	Page      int64  `form:"page" binding:"numeric,gte=1"`
+}
+
+type SearchWebhookDeliveryRequest struct {
+	WebhookEndpointID int64  `form:"webhook_id" binding:"omitempty,gte=1"`
+	Event             string `form:"event"`
+	Status            string `form:"status" binding:"omitempty,oneof=PENDING SUCCESS FAILED"`
+	Limit             int64  `form:"limit" binding:"numeric,gte=1,lte=25"`
+	Page              int64  `form:"page" binding:"numeric,gte=1"`
+}
+
+// WebhookPayload is the body posted to every endpoint subscribed to the
+// event, Data depends on the event.
+type WebhookPayload struct {
+	Event     string    `json:"event"`
+	CreatedAt time.Time `json:"created_at"`
+	Data      any       `json:"data"`
+}
+
+type StockLowData struct {
+	PharmacyID   int64  `json:"pharmacy_id"`
+	PharmacyName string `json:"pharmacy_name"`
+	ProductID    int64  `json:"product_id"`
+	ProductName  string `json:"product_name"`
+	Stock        int    `json:"stock"`
+}
+
+func ConvertToWebhookEndpointResponses(endpoints []*entity.WebhookEndpoint) []*WebhookEndpointResponse {
+	res := []*WebhookEndpointResponse{}
+	for _, endpoint := range endpoints {
+		res = append(res, ConvertToWebhookEndpointResponse(endpoint))
+	}
+	return res
+}
+
+func ConvertToWebhookEndpointResponse(endpoint *entity.WebhookEndpoint) *WebhookEndpointResponse {
+	return &WebhookEndpointResponse{
+		ID:        endpoint.ID,
+		PartnerID: endpoint.PartnerID,
+		URL:       endpoint.URL,
+		Events:    endpoint.Events,
+		IsActive:  endpoint.IsActive,
+		CreatedAt: endpoint.CreatedAt,
+		UpdatedAt: endpoint.UpdatedAt,
+	}
+}
+
+// ConvertToWebhookEndpointAuditLog keeps the secret so a rotation shows up in
+// the audit log, the audit log masks its value.
+func ConvertToWebhookEndpointAuditLog(endpoint *entity.WebhookEndpoint) *WebhookEndpointResponse {
+	res := ConvertToWebhookEndpointResponse(endpoint)
+	res.Secret = endpoint.Secret
+	return res
+}
+
+func ConvertToWebhookDeliveryResponses(deliveries []*entity.WebhookDelivery) []*WebhookDeliveryResponse {
+	res := []*WebhookDeliveryResponse{}
+	for _, delivery := range deliveries {
+		res = append(res, ConvertToWebhookDeliveryResponse(delivery))
+	}
+	return res
+}
+
+func ConvertToWebhookDeliveryResponse(delivery *entity.WebhookDelivery) *WebhookDeliveryResponse {
+	return &WebhookDeliveryResponse{
+		ID:                delivery.ID,
+		WebhookEndpointID: delivery.WebhookEndpointID,
+		Event:             delivery.Event,
+		Payload:           delivery.Payload,
+		Status:            delivery.Status,
+		Attempts:          delivery.Attempts,
+		ResponseStatus:    delivery.ResponseStatus,
+		ResponseBody:      delivery.ResponseBody,
+		Error:             delivery.Error,
+		LastAttemptAt:     delivery.LastAttemptAt,
+		DeliveredAt:       delivery.DeliveredAt,
+		CreatedAt:         delivery.CreatedAt,
+		UpdatedAt:         delivery.UpdatedAt,
+	}
+}
@@ -0,0 +1,30 @@
+package entity
+
+import "time"
+
+type WebhookEndpoint struct {
+	ID        int64
+	PartnerID int64
+	URL       string
+	Secret    string
+	Events    []string
+	IsActive  bool
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+type WebhookDelivery struct {
+	ID                int64
+	WebhookEndpointID int64
+	Event             string
+	Payload           []byte
+	Status            string
+	Attempts          int
+	ResponseStatus    *int
+	ResponseBody      *string
+	Error             *string
+	LastAttemptAt     *time.Time
+	DeliveredAt       *time.Time
+	CreatedAt         time.Time
+	UpdatedAt         time.Time
+}
@@ -0,0 +1,178 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+
+	"healthcare-app/internal/webhook/constant"
+	"healthcare-app/internal/webhook/dto"
+	"healthcare-app/internal/webhook/entity"
+	apperrorPkg "healthcare-app/pkg/apperror"
+	"healthcare-app/pkg/database/transactor"
+)
+
+type WebhookDeliveryRepository interface {
+	Search(ctx context.Context, request *dto.SearchWebhookDeliveryRequest) ([]*entity.WebhookDelivery, error)
+	FindByID(ctx context.Context, id int64) (*entity.WebhookDelivery, error)
+	Save(ctx context.Context, delivery *entity.WebhookDelivery) error
+	UpdateAttempt(ctx context.Context, delivery *entity.WebhookDelivery) error
+	UpdateStatus(ctx context.Context, delivery *entity.WebhookDelivery) error
+}
+
+type webhookDeliveryRepositoryImpl struct {
+	db *sql.DB
+}
+
+func NewWebhookDeliveryRepository(db *sql.DB) *webhookDeliveryRepositoryImpl {
+	return &webhookDeliveryRepositoryImpl{
+		db: db,
+	}
+}
+
+const webhookDeliverySelectQuery = `
+	select id, webhook_endpoint_id, event, payload, status, attempts, response_status, response_body, error, last_attempt_at, delivered_at, created_at, updated_at
+	from webhook_deliveries
+	where true
+`
+
+func (r *webhookDeliveryRepositoryImpl) Search(ctx context.Context, request *dto.SearchWebhookDeliveryRequest) ([]*entity.WebhookDelivery, error) {
+	args := []any{}
+	queryBuilder := strings.Builder{}
+	queryBuilder.WriteString(webhookDeliverySelectQuery)
+
+	if request.WebhookEndpointID != 0 {
+		queryBuilder.WriteString(fmt.Sprintf(" and webhook_endpoint_id = $%v", len(args)+1))
+		args = append(args, request.WebhookEndpointID)
+	}
+	if request.Event != "" {
+		queryBuilder.WriteString(fmt.Sprintf(" and event = $%v", len(args)+1))
+		args = append(args, request.Event)
+	}
+	if request.Status != "" {
+		queryBuilder.WriteString(fmt.Sprintf(" and status = $%v", len(args)+1))
+		args = append(args, request.Status)
+	}
+	queryBuilder.WriteString(" order by created_at desc, id desc")
+
+	return r.findAll(ctx, queryBuilder.String(), args...)
+}
+
+func (r *webhookDeliveryRepositoryImpl) FindByID(ctx context.Context, id int64) (*entity.WebhookDelivery, error) {
+	deliveries, err := r.findAll(ctx, webhookDeliverySelectQuery+" and id = $1", id)
+	if err != nil {
+		return nil, err
+	}
+	if len(deliveries) == 0 {
+		return nil, apperrorPkg.NewEntityNotFoundError("webhook delivery")
+	}
+	return deliveries[0], nil
+}
+
+func (r *webhookDeliveryRepositoryImpl) Save(ctx context.Context, delivery *entity.WebhookDelivery) error {
+	query := `
+		insert into webhook_deliveries(webhook_endpoint_id, event, payload, status)
+		values ($1, $2, $3, $4)
+		returning id, created_at, updated_at
+	`
+	tx := transactor.ExtractTx(ctx)
+
+	var err error
+	if tx != nil {
+		err = tx.QueryRowContext(ctx, query, delivery.WebhookEndpointID, delivery.Event, delivery.Payload, delivery.Status).Scan(&delivery.ID, &delivery.CreatedAt, &delivery.UpdatedAt)
+	} else {
+		err = r.db.QueryRowContext(ctx, query, delivery.WebhookEndpointID, delivery.Event, delivery.Payload, delivery.Status).Scan(&delivery.ID, &delivery.CreatedAt, &delivery.UpdatedAt)
+	}
+
+	return err
+}
+
+// UpdateAttempt records the outcome of one post to the endpoint.
+func (r *webhookDeliveryRepositoryImpl) UpdateAttempt(ctx context.Context, delivery *entity.WebhookDelivery) error {
+	query := `
+		update webhook_deliveries
+		set status = $1, attempts = attempts + 1, response_status = $2, response_body = $3, error = $4,
+			last_attempt_at = now(), delivered_at = case when $1::varchar = $5 then now() else delivered_at end, updated_at = now()
+		where id = $6
+		returning attempts, last_attempt_at, delivered_at, updated_at
+	`
+	tx := transactor.ExtractTx(ctx)
+
+	var err error
+	if tx != nil {
+		err = tx.QueryRowContext(ctx, query, delivery.Status, delivery.ResponseStatus, delivery.ResponseBody, delivery.Error, constant.DELIVERY_SUCCESS, delivery.ID).
+			Scan(&delivery.Attempts, &delivery.LastAttemptAt, &delivery.DeliveredAt, &delivery.UpdatedAt)
+	} else {
+		err = r.db.QueryRowContext(ctx, query, delivery.Status, delivery.ResponseStatus, delivery.ResponseBody, delivery.Error, constant.DELIVERY_SUCCESS, delivery.ID).
+			Scan(&delivery.Attempts, &delivery.LastAttemptAt, &delivery.DeliveredAt, &delivery.UpdatedAt)
+	}
+
+	return err
+}
+
+func (r *webhookDeliveryRepositoryImpl) UpdateStatus(ctx context.Context, delivery *entity.WebhookDelivery) error {
+	query := `
+		update webhook_deliveries set status = $1, updated_at = now()
+		where id = $2
+		returning updated_at
+	`
+	tx := transactor.ExtractTx(ctx)
+
+	var err error
+	if tx != nil {
+		err = tx.QueryRowContext(ctx, query, delivery.Status, delivery.ID).Scan(&delivery.UpdatedAt)
+	} else {
+		err = r.db.QueryRowContext(ctx, query, delivery.Status, delivery.ID).Scan(&delivery.UpdatedAt)
+	}
+
+	return err
+}
+
+func (r *webhookDeliveryRepositoryImpl) findAll(ctx context.Context, query string, args ...any) ([]*entity.WebhookDelivery, error) {
+	tx := transactor.ExtractTx(ctx)
+
+	var (
+		err  error
+		rows *sql.Rows
+	)
+	if tx != nil {
+		rows, err = tx.QueryContext(ctx, query, args...)
+	} else {
+		rows, err = r.db.QueryContext(ctx, query, args...)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	deliveries := []*entity.WebhookDelivery{}
+	for rows.Next() {
+		delivery := new(entity.WebhookDelivery)
+		if err := rows.Scan(
+			&delivery.ID,
+			&delivery.WebhookEndpointID,
+			&delivery.Event,
+			&delivery.Payload,
+			&delivery.Status,
+			&delivery.Attempts,
+			&delivery.ResponseStatus,
+			&delivery.ResponseBody,
+			&delivery.Error,
+			&delivery.LastAttemptAt,
+			&delivery.DeliveredAt,
+			&delivery.CreatedAt,
+			&delivery.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		deliveries = append(deliveries, delivery)
+	}
+
+	err = rows.Err()
+	if err != nil {
+		return nil, err
+	}
+	return deliveries, nil
+}
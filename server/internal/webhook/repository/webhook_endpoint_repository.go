@@ -0,0 +1,215 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	"healthcare-app/internal/webhook/dto"
+	"healthcare-app/internal/webhook/entity"
+	apperrorPkg "healthcare-app/pkg/apperror"
+	"healthcare-app/pkg/database/transactor"
+)
+
+type WebhookEndpointRepository interface {
+	Search(ctx context.Context, request *dto.SearchWebhookEndpointRequest) ([]*entity.WebhookEndpoint, error)
+	FindByID(ctx context.Context, id int64) (*entity.WebhookEndpoint, error)
+	FindByDeliveryID(ctx context.Context, deliveryID int64) (*entity.WebhookEndpoint, error)
+	FindAllActiveByPharmacyIDAndEvent(ctx context.Context, pharmacyID int64, event string) ([]*entity.WebhookEndpoint, error)
+	Save(ctx context.Context, endpoint *entity.WebhookEndpoint) error
+	Update(ctx context.Context, endpoint *entity.WebhookEndpoint) error
+	DeleteByID(ctx context.Context, id int64) error
+}
+
+type webhookEndpointRepositoryImpl struct {
+	db *sql.DB
+}
+
+func NewWebhookEndpointRepository(db *sql.DB) *webhookEndpointRepositoryImpl {
+	return &webhookEndpointRepositoryImpl{
+		db: db,
+	}
+}
+
+// events are read back as json, database/sql can not scan a postgres array
+// into a slice.
+const webhookEndpointSelectQuery = `
+	select we.id, we.partner_id, we.url, we.secret, array_to_json(we.events), we.is_active, we.created_at, we.updated_at
+	from webhook_endpoints we
+`
+
+func (r *webhookEndpointRepositoryImpl) Search(ctx context.Context, request *dto.SearchWebhookEndpointRequest) ([]*entity.WebhookEndpoint, error) {
+	args := []any{}
+	queryBuilder := strings.Builder{}
+	queryBuilder.WriteString(webhookEndpointSelectQuery)
+	queryBuilder.WriteString(" where we.deleted_at is null")
+
+	if request.PartnerID != 0 {
+		queryBuilder.WriteString(fmt.Sprintf(" and we.partner_id = $%v", len(args)+1))
+		args = append(args, request.PartnerID)
+	}
+	if request.Event != "" {
+		queryBuilder.WriteString(fmt.Sprintf(" and $%v = any(we.events)", len(args)+1))
+		args = append(args, request.Event)
+	}
+	queryBuilder.WriteString(" order by we.id")
+
+	return r.findAll(ctx, queryBuilder.String(), args...)
+}
+
+func (r *webhookEndpointRepositoryImpl) FindByID(ctx context.Context, id int64) (*entity.WebhookEndpoint, error) {
+	endpoints, err := r.findAll(ctx, webhookEndpointSelectQuery+" where we.id = $1 and we.deleted_at is null", id)
+	if err != nil {
+		return nil, err
+	}
+	if len(endpoints) == 0 {
+		return nil, apperrorPkg.NewEntityNotFoundError("webhook")
+	}
+	return endpoints[0], nil
+}
+
+// FindByDeliveryID also returns deleted endpoints, they come back inactive so
+// their pending deliveries can be closed.
+func (r *webhookEndpointRepositoryImpl) FindByDeliveryID(ctx context.Context, deliveryID int64) (*entity.WebhookEndpoint, error) {
+	query := `
+		select we.id, we.partner_id, we.url, we.secret, array_to_json(we.events), we.is_active and we.deleted_at is null, we.created_at, we.updated_at
+		from webhook_endpoints we
+		join webhook_deliveries wd on wd.webhook_endpoint_id = we.id
+		where wd.id = $1
+	`
+	endpoints, err := r.findAll(ctx, query, deliveryID)
+	if err != nil {
+		return nil, err
+	}
+	if len(endpoints) == 0 {
+		return nil, apperrorPkg.NewEntityNotFoundError("webhook")
+	}
+	return endpoints[0], nil
+}
+
+// FindAllActiveByPharmacyIDAndEvent returns the endpoints of the partner that
+// runs the pharmacy which subscribed to the event.
+func (r *webhookEndpointRepositoryImpl) FindAllActiveByPharmacyIDAndEvent(ctx context.Context, pharmacyID int64, event string) ([]*entity.WebhookEndpoint, error) {
+	query := webhookEndpointSelectQuery + `
+		join pharmacies p on p.partner_id = we.partner_id
+		where p.id = $1 and $2 = any(we.events) and we.is_active and we.deleted_at is null
+	`
+	return r.findAll(ctx, query, pharmacyID, event)
+}
+
+func (r *webhookEndpointRepositoryImpl) Save(ctx context.Context, endpoint *entity.WebhookEndpoint) error {
+	query := `
+		insert into webhook_endpoints(partner_id, url, secret, events, is_active)
+		values ($1, $2, $3, $4, $5)
+		returning id, created_at, updated_at
+	`
+	tx := transactor.ExtractTx(ctx)
+
+	var err error
+	if tx != nil {
+		err = tx.QueryRowContext(ctx, query, endpoint.PartnerID, endpoint.URL, endpoint.Secret, endpoint.Events, endpoint.IsActive).Scan(&endpoint.ID, &endpoint.CreatedAt, &endpoint.UpdatedAt)
+	} else {
+		err = r.db.QueryRowContext(ctx, query, endpoint.PartnerID, endpoint.URL, endpoint.Secret, endpoint.Events, endpoint.IsActive).Scan(&endpoint.ID, &endpoint.CreatedAt, &endpoint.UpdatedAt)
+	}
+
+	return err
+}
+
+func (r *webhookEndpointRepositoryImpl) Update(ctx context.Context, endpoint *entity.WebhookEndpoint) error {
+	query := `
+		update webhook_endpoints
+		set url = $1, secret = $2, events = $3, is_active = $4, updated_at = now()
+		where id = $5 and deleted_at is null
+		returning partner_id, created_at, updated_at
+	`
+	tx := transactor.ExtractTx(ctx)
+
+	var err error
+	if tx != nil {
+		err = tx.QueryRowContext(ctx, query, endpoint.URL, endpoint.Secret, endpoint.Events, endpoint.IsActive, endpoint.ID).Scan(&endpoint.PartnerID, &endpoint.CreatedAt, &endpoint.UpdatedAt)
+	} else {
+		err = r.db.QueryRowContext(ctx, query, endpoint.URL, endpoint.Secret, endpoint.Events, endpoint.IsActive, endpoint.ID).Scan(&endpoint.PartnerID, &endpoint.CreatedAt, &endpoint.UpdatedAt)
+	}
+
+	if errors.Is(err, sql.ErrNoRows) {
+		return apperrorPkg.NewEntityNotFoundError("webhook")
+	}
+	return err
+}
+
+// DeleteByID only hides the endpoint, its deliveries stay in the log.
+func (r *webhookEndpointRepositoryImpl) DeleteByID(ctx context.Context, id int64) error {
+	query := `
+		update webhook_endpoints set deleted_at = now() where id = $1 and deleted_at is null
+	`
+	tx := transactor.ExtractTx(ctx)
+
+	var (
+		err error
+		res sql.Result
+	)
+	if tx != nil {
+		res, err = tx.ExecContext(ctx, query, id)
+	} else {
+		res, err = r.db.ExecContext(ctx, query, id)
+	}
+	if err != nil {
+		return err
+	}
+
+	rowsAffected, _ := res.RowsAffected()
+	if rowsAffected == 0 {
+		return apperrorPkg.NewEntityNotFoundError("webhook")
+	}
+	return nil
+}
+
+func (r *webhookEndpointRepositoryImpl) findAll(ctx context.Context, query string, args ...any) ([]*entity.WebhookEndpoint, error) {
+	tx := transactor.ExtractTx(ctx)
+
+	var (
+		err  error
+		rows *sql.Rows
+	)
+	if tx != nil {
+		rows, err = tx.QueryContext(ctx, query, args...)
+	} else {
+		rows, err = r.db.QueryContext(ctx, query, args...)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	endpoints := []*entity.WebhookEndpoint{}
+	for rows.Next() {
+		var events []byte
+		endpoint := new(entity.WebhookEndpoint)
+		if err := rows.Scan(
+			&endpoint.ID,
+			&endpoint.PartnerID,
+			&endpoint.URL,
+			&endpoint.Secret,
+			&events,
+			&endpoint.IsActive,
+			&endpoint.CreatedAt,
+			&endpoint.UpdatedAt,
+		); err != nil {
+			return nil, err
+		}
+		if err := json.Unmarshal(events, &endpoint.Events); err != nil {
+			return nil, err
+		}
+		endpoints = append(endpoints, endpoint)
+	}
+
+	err = rows.Err()
+	if err != nil {
+		return nil, err
+	}
+	return endpoints, nil
+}
@@ -0,0 +1,32 @@
+package route
+
+import (
+	"healthcare-app/internal/auth/constant"
+	"healthcare-app/internal/webhook/controller"
+	"healthcare-app/pkg/middleware"
+
+	"github.com/gin-gonic/gin"
+)
+
+const (
+	webhookId  = "/:webhookId"
+	deliveryId = "/:deliveryId"
+)
+
+func WebhookControllerRoute(c *controller.WebhookController, r *gin.Engine, authMiddleware *middleware.AuthMiddleware) {
+	g := r.Group("/admin/webhooks", authMiddleware.Authorization(), authMiddleware.ProtectedRoles(constant.ADMIN))
+	{
+		g.GET("", c.Search)
+		g.GET(webhookId, c.Get)
+		g.POST("", c.Create)
+		g.PUT(webhookId, c.Update)
+		g.DELETE(webhookId, c.Delete)
+	}
+
+	d := r.Group("/admin/webhook-deliveries", authMiddleware.Authorization(), authMiddleware.ProtectedRoles(constant.ADMIN))
+	{
+		d.GET("", c.SearchDeliveries)
+		d.GET(deliveryId, c.GetDelivery)
+		d.POST(deliveryId+"/redeliver", c.Redeliver)
+	}
+}
@@ -0,0 +1,205 @@
+package usecase
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"healthcare-app/internal/queue/payload"
+	"healthcare-app/internal/queue/tasks"
+	apperrorWebhook "healthcare-app/internal/webhook/apperror"
+	"healthcare-app/internal/webhook/constant"
+	dtoWebhook "healthcare-app/internal/webhook/dto"
+	"healthcare-app/internal/webhook/entity"
+	"healthcare-app/internal/webhook/repository"
+	"healthcare-app/internal/webhook/utils"
+	apperrorPkg "healthcare-app/pkg/apperror"
+	dtoPkg "healthcare-app/pkg/dto"
+	"healthcare-app/pkg/utils/pageutils"
+)
+
+type WebhookDeliveryUseCase interface {
+	Trigger(ctx context.Context, pharmacyID int64, event string, data any) error
+	Deliver(ctx context.Context, deliveryID int64, lastAttempt bool) error
+	Search(ctx context.Context, request *dtoWebhook.SearchWebhookDeliveryRequest) ([]*dtoWebhook.WebhookDeliveryResponse, *dtoPkg.PageMetaData, error)
+	Get(ctx context.Context, id int64) (*dtoWebhook.WebhookDeliveryResponse, error)
+	Redeliver(ctx context.Context, id int64) (*dtoWebhook.WebhookDeliveryResponse, error)
+}
+
+type webhookDeliveryUseCaseImpl struct {
+	webhookTask               tasks.WebhookTask
+	webhookEndpointRepository repository.WebhookEndpointRepository
+	webhookDeliveryRepository repository.WebhookDeliveryRepository
+	client                    *http.Client
+}
+
+func NewWebhookDeliveryUseCase(
+	webhookTask tasks.WebhookTask,
+	webhookEndpointRepository repository.WebhookEndpointRepository,
+	webhookDeliveryRepository repository.WebhookDeliveryRepository,
+) *webhookDeliveryUseCaseImpl {
+	return &webhookDeliveryUseCaseImpl{
+		webhookTask:               webhookTask,
+		webhookEndpointRepository: webhookEndpointRepository,
+		webhookDeliveryRepository: webhookDeliveryRepository,
+		client:                    &http.Client{Timeout: constant.REQUEST_TIMEOUT},
+	}
+}
+
+// Trigger logs a delivery for every endpoint of the pharmacy's partner that
+// subscribed to the event and queues them, the posts happen in the worker.
+func (u *webhookDeliveryUseCaseImpl) Trigger(ctx context.Context, pharmacyID int64, event string, data any) error {
+	endpoints, err := u.webhookEndpointRepository.FindAllActiveByPharmacyIDAndEvent(ctx, pharmacyID, event)
+	if err != nil {
+		return err
+	}
+	if len(endpoints) == 0 {
+		return nil
+	}
+
+	body, err := json.Marshal(&dtoWebhook.WebhookPayload{
+		Event:     event,
+		CreatedAt: time.Now(),
+		Data:      data,
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, endpoint := range endpoints {
+		delivery := &entity.WebhookDelivery{
+			WebhookEndpointID: endpoint.ID,
+			Event:             event,
+			Payload:           body,
+			Status:            constant.DELIVERY_PENDING,
+		}
+		if err := u.webhookDeliveryRepository.Save(ctx, delivery); err != nil {
+			return err
+		}
+		if err := u.webhookTask.QueueWebhookDelivery(ctx, &payload.WebhookDeliveryPayload{DeliveryID: delivery.ID}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Deliver posts the delivery once and records the outcome. It returns an
+// error while the endpoint keeps failing so the task is retried, the delivery
+// only turns FAILED on the last attempt.
+func (u *webhookDeliveryUseCaseImpl) Deliver(ctx context.Context, deliveryID int64, lastAttempt bool) error {
+	delivery, err := u.webhookDeliveryRepository.FindByID(ctx, deliveryID)
+	if err != nil {
+		return err
+	}
+	if delivery.Status == constant.DELIVERY_SUCCESS {
+		return nil
+	}
+
+	endpoint, err := u.webhookEndpointRepository.FindByDeliveryID(ctx, deliveryID)
+	if err != nil {
+		return err
+	}
+	if !endpoint.IsActive {
+		delivery.Status = constant.DELIVERY_FAILED
+		return u.webhookDeliveryRepository.UpdateStatus(ctx, delivery)
+	}
+
+	responseStatus, responseBody, postErr := u.post(ctx, endpoint, delivery)
+	if postErr == nil && (responseStatus < http.StatusOK || responseStatus >= http.StatusMultipleChoices) {
+		postErr = fmt.Errorf("webhook endpoint responded with status %d", responseStatus)
+	}
+
+	delivery.Status = constant.DELIVERY_SUCCESS
+	delivery.Error = nil
+	if postErr != nil {
+		delivery.Status = constant.DELIVERY_PENDING
+		if lastAttempt {
+			delivery.Status = constant.DELIVERY_FAILED
+		}
+		errMsg := postErr.Error()
+		delivery.Error = &errMsg
+	}
+	delivery.ResponseStatus = nil
+	delivery.ResponseBody = nil
+	if responseStatus != 0 {
+		delivery.ResponseStatus = &responseStatus
+		delivery.ResponseBody = &responseBody
+	}
+	if err := u.webhookDeliveryRepository.UpdateAttempt(ctx, delivery); err != nil {
+		return err
+	}
+	return postErr
+}
+
+func (u *webhookDeliveryUseCaseImpl) Search(ctx context.Context, request *dtoWebhook.SearchWebhookDeliveryRequest) ([]*dtoWebhook.WebhookDeliveryResponse, *dtoPkg.PageMetaData, error) {
+	deliveries, err := u.webhookDeliveryRepository.Search(ctx, request)
+	if err != nil {
+		return nil, nil, apperrorPkg.NewServerError(err)
+	}
+
+	res, metaData := pageutils.CreateMetaData(deliveries, request.Page, request.Limit)
+	return dtoWebhook.ConvertToWebhookDeliveryResponses(res), metaData, nil
+}
+
+func (u *webhookDeliveryUseCaseImpl) Get(ctx context.Context, id int64) (*dtoWebhook.WebhookDeliveryResponse, error) {
+	delivery, err := u.webhookDeliveryRepository.FindByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return dtoWebhook.ConvertToWebhookDeliveryResponse(delivery), nil
+}
+
+// Redeliver queues the same payload again, whatever happened to the earlier
+// attempts. Partners dedupe on the delivery header.
+func (u *webhookDeliveryUseCaseImpl) Redeliver(ctx context.Context, id int64) (*dtoWebhook.WebhookDeliveryResponse, error) {
+	delivery, err := u.webhookDeliveryRepository.FindByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	endpoint, err := u.webhookEndpointRepository.FindByDeliveryID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if !endpoint.IsActive {
+		return nil, apperrorWebhook.NewWebhookEndpointInactiveError()
+	}
+
+	delivery.Status = constant.DELIVERY_PENDING
+	if err := u.webhookDeliveryRepository.UpdateStatus(ctx, delivery); err != nil {
+		return nil, apperrorPkg.NewServerError(err)
+	}
+	if err := u.webhookTask.QueueWebhookDelivery(ctx, &payload.WebhookDeliveryPayload{DeliveryID: delivery.ID}); err != nil {
+		return nil, apperrorPkg.NewServerError(err)
+	}
+	return dtoWebhook.ConvertToWebhookDeliveryResponse(delivery), nil
+}
+
+func (u *webhookDeliveryUseCaseImpl) post(ctx context.Context, endpoint *entity.WebhookEndpoint, delivery *entity.WebhookDelivery) (int, string, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint.URL, bytes.NewReader(delivery.Payload))
+	if err != nil {
+		return 0, "", err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(constant.HEADER_EVENT, delivery.Event)
+	req.Header.Set(constant.HEADER_DELIVERY, strconv.Itoa(int(delivery.ID)))
+	req.Header.Set(constant.HEADER_SIGNATURE, utils.SignPayload(endpoint.Secret, time.Now().Unix(), delivery.Payload))
+
+	res, err := u.client.Do(req)
+	if err != nil {
+		return 0, "", err
+	}
+	defer res.Body.Close()
+
+	body, err := io.ReadAll(io.LimitReader(res.Body, constant.MAX_RESPONSE_BODY))
+	if err != nil {
+		return res.StatusCode, "", err
+	}
+	// the body is stored as text, postgres rejects invalid utf-8 and nul bytes
+	return res.StatusCode, strings.ReplaceAll(strings.ToValidUTF8(string(body), ""), "\x00", ""), nil
+}
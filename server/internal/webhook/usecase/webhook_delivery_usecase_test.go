@@ -0,0 +1,207 @@
+package usecase_test
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+
+	"healthcare-app/internal/webhook/constant"
+	dtoWebhook "healthcare-app/internal/webhook/dto"
+	"healthcare-app/internal/webhook/entity"
+	"healthcare-app/internal/webhook/usecase"
+	"healthcare-app/internal/webhook/utils"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type fakeDeliveryRepository struct {
+	delivery *entity.WebhookDelivery
+	attempts []entity.WebhookDelivery
+}
+
+func (r *fakeDeliveryRepository) Search(ctx context.Context, request *dtoWebhook.SearchWebhookDeliveryRequest) ([]*entity.WebhookDelivery, error) {
+	return []*entity.WebhookDelivery{r.delivery}, nil
+}
+
+func (r *fakeDeliveryRepository) FindByID(ctx context.Context, id int64) (*entity.WebhookDelivery, error) {
+	delivery := *r.delivery
+	return &delivery, nil
+}
+
+func (r *fakeDeliveryRepository) Save(ctx context.Context, delivery *entity.WebhookDelivery) error {
+	r.delivery = delivery
+	return nil
+}
+
+func (r *fakeDeliveryRepository) UpdateAttempt(ctx context.Context, delivery *entity.WebhookDelivery) error {
+	r.attempts = append(r.attempts, *delivery)
+	r.delivery = delivery
+	return nil
+}
+
+func (r *fakeDeliveryRepository) UpdateStatus(ctx context.Context, delivery *entity.WebhookDelivery) error {
+	r.delivery = delivery
+	return nil
+}
+
+type fakeEndpointRepository struct {
+	endpoint *entity.WebhookEndpoint
+}
+
+func (r *fakeEndpointRepository) Search(ctx context.Context, request *dtoWebhook.SearchWebhookEndpointRequest) ([]*entity.WebhookEndpoint, error) {
+	return []*entity.WebhookEndpoint{r.endpoint}, nil
+}
+
+func (r *fakeEndpointRepository) FindByID(ctx context.Context, id int64) (*entity.WebhookEndpoint, error) {
+	return r.endpoint, nil
+}
+
+func (r *fakeEndpointRepository) FindByDeliveryID(ctx context.Context, deliveryID int64) (*entity.WebhookEndpoint, error) {
+	return r.endpoint, nil
+}
+
+func (r *fakeEndpointRepository) FindAllActiveByPharmacyIDAndEvent(ctx context.Context, pharmacyID int64, event string) ([]*entity.WebhookEndpoint, error) {
+	return []*entity.WebhookEndpoint{r.endpoint}, nil
+}
+
+func (r *fakeEndpointRepository) Save(ctx context.Context, endpoint *entity.WebhookEndpoint) error {
+	return nil
+}
+
+func (r *fakeEndpointRepository) Update(ctx context.Context, endpoint *entity.WebhookEndpoint) error {
+	return nil
+}
+
+func (r *fakeEndpointRepository) DeleteByID(ctx context.Context, id int64) error {
+	return nil
+}
+
+func TestWebhookDeliveryUseCaseDeliver(t *testing.T) {
+	const (
+		secret = "whsec_test"
+		body   = `{"event":"order.created"}`
+	)
+
+	tests := []struct {
+		name           string
+		status         string
+		lastAttempt    bool
+		responseStatus int
+		wantStatus     string
+		wantRequests   int
+		wantErr        bool
+	}{
+		{
+			name:           "2xx marks the delivery as success",
+			status:         constant.DELIVERY_PENDING,
+			responseStatus: http.StatusNoContent,
+			wantStatus:     constant.DELIVERY_SUCCESS,
+			wantRequests:   1,
+		},
+		{
+			name:           "non-2xx keeps the delivery pending for a retry",
+			status:         constant.DELIVERY_PENDING,
+			responseStatus: http.StatusInternalServerError,
+			wantStatus:     constant.DELIVERY_PENDING,
+			wantRequests:   1,
+			wantErr:        true,
+		},
+		{
+			name:           "non-2xx on the last attempt fails the delivery",
+			status:         constant.DELIVERY_PENDING,
+			lastAttempt:    true,
+			responseStatus: http.StatusBadGateway,
+			wantStatus:     constant.DELIVERY_FAILED,
+			wantRequests:   1,
+			wantErr:        true,
+		},
+		{
+			name:           "redirect is not a success",
+			status:         constant.DELIVERY_PENDING,
+			responseStatus: http.StatusNotModified,
+			wantStatus:     constant.DELIVERY_PENDING,
+			wantRequests:   1,
+			wantErr:        true,
+		},
+		{
+			name:           "delivered payload is not posted again",
+			status:         constant.DELIVERY_SUCCESS,
+			responseStatus: http.StatusOK,
+			wantStatus:     constant.DELIVERY_SUCCESS,
+			wantRequests:   0,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			requests := 0
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				requests++
+				received, _ := io.ReadAll(r.Body)
+				assert.Equal(t, body, string(received))
+				assert.Equal(t, constant.EVENT_ORDER_CREATED, r.Header.Get(constant.HEADER_EVENT))
+				assert.Equal(t, "1", r.Header.Get(constant.HEADER_DELIVERY))
+
+				signature := r.Header.Get(constant.HEADER_SIGNATURE)
+				timestamp, err := strconv.ParseInt(strings.TrimPrefix(strings.Split(signature, ",")[0], "t="), 10, 64)
+				assert.NoError(t, err)
+				assert.Equal(t, utils.SignPayload(secret, timestamp, received), signature)
+
+				w.WriteHeader(tt.responseStatus)
+			}))
+			defer server.Close()
+
+			deliveryRepository := &fakeDeliveryRepository{delivery: &entity.WebhookDelivery{
+				ID:      1,
+				Event:   constant.EVENT_ORDER_CREATED,
+				Payload: []byte(body),
+				Status:  tt.status,
+			}}
+			endpointRepository := &fakeEndpointRepository{endpoint: &entity.WebhookEndpoint{
+				URL:      server.URL,
+				Secret:   secret,
+				IsActive: true,
+			}}
+			u := usecase.NewWebhookDeliveryUseCase(nil, endpointRepository, deliveryRepository)
+
+			err := u.Deliver(context.Background(), 1, tt.lastAttempt)
+
+			assert.Equal(t, tt.wantErr, err != nil)
+			assert.Equal(t, tt.wantRequests, requests)
+			assert.Len(t, deliveryRepository.attempts, tt.wantRequests)
+			assert.Equal(t, tt.wantStatus, deliveryRepository.delivery.Status)
+			if tt.wantRequests > 0 {
+				assert.Equal(t, tt.responseStatus, *deliveryRepository.delivery.ResponseStatus)
+				assert.Equal(t, tt.wantErr, deliveryRepository.delivery.Error != nil)
+			}
+		})
+	}
+}
+
+func TestWebhookDeliveryUseCaseDeliverInactiveEndpoint(t *testing.T) {
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+	}))
+	defer server.Close()
+
+	deliveryRepository := &fakeDeliveryRepository{delivery: &entity.WebhookDelivery{
+		ID:     1,
+		Status: constant.DELIVERY_PENDING,
+	}}
+	endpointRepository := &fakeEndpointRepository{endpoint: &entity.WebhookEndpoint{
+		URL:      server.URL,
+		IsActive: false,
+	}}
+	u := usecase.NewWebhookDeliveryUseCase(nil, endpointRepository, deliveryRepository)
+
+	err := u.Deliver(context.Background(), 1, false)
+
+	assert.NoError(t, err)
+	assert.Zero(t, requests)
+	assert.Equal(t, constant.DELIVERY_FAILED, deliveryRepository.delivery.Status)
+}
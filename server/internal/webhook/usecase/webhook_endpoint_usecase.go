@@ -0,0 +1,162 @@
+package usecase
+
+import (
+	"context"
+
+	constantAudit "healthcare-app/internal/audit/constant"
+	dtoAudit "healthcare-app/internal/audit/dto"
+	usecaseAudit "healthcare-app/internal/audit/usecase"
+	repositoryPharmacy "healthcare-app/internal/pharmacy/repository"
+	dtoWebhook "healthcare-app/internal/webhook/dto"
+	"healthcare-app/internal/webhook/entity"
+	"healthcare-app/internal/webhook/repository"
+	"healthcare-app/internal/webhook/utils"
+	apperrorPkg "healthcare-app/pkg/apperror"
+	"healthcare-app/pkg/database/transactor"
+	dtoPkg "healthcare-app/pkg/dto"
+	"healthcare-app/pkg/utils/pageutils"
+)
+
+type WebhookEndpointUseCase interface {
+	Search(ctx context.Context, request *dtoWebhook.SearchWebhookEndpointRequest) ([]*dtoWebhook.WebhookEndpointResponse, *dtoPkg.PageMetaData, error)
+	Get(ctx context.Context, id int64) (*dtoWebhook.WebhookEndpointResponse, error)
+	Create(ctx context.Context, request *dtoWebhook.WebhookEndpointRequest) (*dtoWebhook.WebhookEndpointResponse, error)
+	Update(ctx context.Context, request *dtoWebhook.UpdateWebhookEndpointRequest) (*dtoWebhook.WebhookEndpointResponse, error)
+	Delete(ctx context.Context, id int64) error
+}
+
+type webhookEndpointUseCaseImpl struct {
+	auditLogUseCase           usecaseAudit.AuditLogUseCase
+	webhookEndpointRepository repository.WebhookEndpointRepository
+	partnerRepository         repositoryPharmacy.PartnerRepository
+	transactor                transactor.Transactor
+}
+
+func NewWebhookEndpointUseCase(
+	auditLogUseCase usecaseAudit.AuditLogUseCase,
+	webhookEndpointRepository repository.WebhookEndpointRepository,
+	partnerRepository repositoryPharmacy.PartnerRepository,
+	transactor transactor.Transactor,
+) *webhookEndpointUseCaseImpl {
+	return &webhookEndpointUseCaseImpl{
+		auditLogUseCase:           auditLogUseCase,
+		webhookEndpointRepository: webhookEndpointRepository,
+		partnerRepository:         partnerRepository,
+		transactor:                transactor,
+	}
+}
+
+func (u *webhookEndpointUseCaseImpl) Search(ctx context.Context, request *dtoWebhook.SearchWebhookEndpointRequest) ([]*dtoWebhook.WebhookEndpointResponse, *dtoPkg.PageMetaData, error) {
+	endpoints, err := u.webhookEndpointRepository.Search(ctx, request)
+	if err != nil {
+		return nil, nil, apperrorPkg.NewServerError(err)
+	}
+
+	res, metaData := pageutils.CreateMetaData(endpoints, request.Page, request.Limit)
+	return dtoWebhook.ConvertToWebhookEndpointResponses(res), metaData, nil
+}
+
+func (u *webhookEndpointUseCaseImpl) Get(ctx context.Context, id int64) (*dtoWebhook.WebhookEndpointResponse, error) {
+	endpoint, err := u.webhookEndpointRepository.FindByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return dtoWebhook.ConvertToWebhookEndpointResponse(endpoint), nil
+}
+
+// Create generates the signing secret, it is only shown here and when it is
+// rotated.
+func (u *webhookEndpointUseCaseImpl) Create(ctx context.Context, request *dtoWebhook.WebhookEndpointRequest) (*dtoWebhook.WebhookEndpointResponse, error) {
+	if _, err := u.partnerRepository.FindByID(ctx, request.PartnerID); err != nil {
+		return nil, err
+	}
+
+	secret, err := utils.GenerateSecret()
+	if err != nil {
+		return nil, apperrorPkg.NewServerError(err)
+	}
+
+	endpoint := &entity.WebhookEndpoint{
+		PartnerID: request.PartnerID,
+		URL:       request.URL,
+		Secret:    secret,
+		Events:    request.Events,
+		IsActive:  request.IsActive == nil || *request.IsActive,
+	}
+	err = u.transactor.Atomic(ctx, func(txCtx context.Context) error {
+		if err := u.webhookEndpointRepository.Save(txCtx, endpoint); err != nil {
+			return apperrorPkg.NewServerError(err)
+		}
+		return u.auditLogUseCase.Record(txCtx, &dtoAudit.AuditEntry{
+			Action:     constantAudit.ACTION_CREATE,
+			EntityType: constantAudit.ENTITY_WEBHOOK_ENDPOINT,
+			EntityID:   endpoint.ID,
+			After:      dtoWebhook.ConvertToWebhookEndpointAuditLog(endpoint),
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	res := dtoWebhook.ConvertToWebhookEndpointResponse(endpoint)
+	res.Secret = endpoint.Secret
+	return res, nil
+}
+
+func (u *webhookEndpointUseCaseImpl) Update(ctx context.Context, request *dtoWebhook.UpdateWebhookEndpointRequest) (*dtoWebhook.WebhookEndpointResponse, error) {
+	var endpoint *entity.WebhookEndpoint
+	err := u.transactor.Atomic(ctx, func(txCtx context.Context) error {
+		var err error
+		endpoint, err = u.webhookEndpointRepository.FindByID(txCtx, request.ID)
+		if err != nil {
+			return err
+		}
+		before := dtoWebhook.ConvertToWebhookEndpointAuditLog(endpoint)
+
+		endpoint.URL = request.URL
+		endpoint.Events = request.Events
+		endpoint.IsActive = *request.IsActive
+		if request.RotateSecret {
+			if endpoint.Secret, err = utils.GenerateSecret(); err != nil {
+				return apperrorPkg.NewServerError(err)
+			}
+		}
+		if err := u.webhookEndpointRepository.Update(txCtx, endpoint); err != nil {
+			return err
+		}
+		return u.auditLogUseCase.Record(txCtx, &dtoAudit.AuditEntry{
+			Action:     constantAudit.ACTION_UPDATE,
+			EntityType: constantAudit.ENTITY_WEBHOOK_ENDPOINT,
+			EntityID:   endpoint.ID,
+			Before:     before,
+			After:      dtoWebhook.ConvertToWebhookEndpointAuditLog(endpoint),
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	res := dtoWebhook.ConvertToWebhookEndpointResponse(endpoint)
+	if request.RotateSecret {
+		res.Secret = endpoint.Secret
+	}
+	return res, nil
+}
+
+func (u *webhookEndpointUseCaseImpl) Delete(ctx context.Context, id int64) error {
+	return u.transactor.Atomic(ctx, func(txCtx context.Context) error {
+		endpoint, err := u.webhookEndpointRepository.FindByID(txCtx, id)
+		if err != nil {
+			return err
+		}
+		if err := u.webhookEndpointRepository.DeleteByID(txCtx, id); err != nil {
+			return err
+		}
+		return u.auditLogUseCase.Record(txCtx, &dtoAudit.AuditEntry{
+			Action:     constantAudit.ACTION_DELETE,
+			EntityType: constantAudit.ENTITY_WEBHOOK_ENDPOINT,
+			EntityID:   id,
+			Before:     dtoWebhook.ConvertToWebhookEndpointAuditLog(endpoint),
+		})
+	})
+}
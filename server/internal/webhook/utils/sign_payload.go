@@ -0,0 +1,32 @@
+package utils
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+
+	"healthcare-app/internal/webhook/constant"
+)
+
+// GenerateSecret returns SECRET_LENGTH random bytes from crypto/rand, hex
+// encoded. The secret is all that stands between a partner and a forged
+// signature, it must not be derivable from when it was made.
+func GenerateSecret() (string, error) {
+	b := make([]byte, constant.SECRET_LENGTH)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
+
+// SignPayload signs the timestamp and the body together so a captured request
+// can not be replayed with a fresh timestamp. Partners recompute it with their
+// secret and compare it to the v1 value of the signature header.
+func SignPayload(secret string, timestamp int64, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(fmt.Sprintf("%d.", timestamp)))
+	mac.Write(body)
+	return fmt.Sprintf("t=%d,v1=%s", timestamp, hex.EncodeToString(mac.Sum(nil)))
+}
@@ -0,0 +1,55 @@
+package utils_test
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"healthcare-app/internal/webhook/constant"
+	"healthcare-app/internal/webhook/utils"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSignPayload(t *testing.T) {
+	tests := []struct {
+		name      string
+		secret    string
+		timestamp int64
+		body      string
+		want      string
+	}{
+		{
+			name:      "signs the timestamp and the body",
+			secret:    "whsec_test",
+			timestamp: 1700000000,
+			body:      `{"event":"order.created"}`,
+			want:      "t=1700000000,v1=44ccdd37cc0cde29381624e0495514ce79007393020fddb05c89075cd26cc6bd",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, utils.SignPayload(tt.secret, tt.timestamp, []byte(tt.body)))
+		})
+	}
+}
+
+func TestSignPayloadChangesWithInput(t *testing.T) {
+	signature := utils.SignPayload("whsec_test", 1700000000, []byte(`{"event":"order.created"}`))
+
+	assert.NotEqual(t, signature, utils.SignPayload("whsec_other", 1700000000, []byte(`{"event":"order.created"}`)))
+	assert.NotEqual(t, signature, utils.SignPayload("whsec_test", 1700000001, []byte(`{"event":"order.created"}`)))
+	assert.NotEqual(t, signature, utils.SignPayload("whsec_test", 1700000000, []byte(`{"event":"order.paid"}`)))
+}
+
+func TestGenerateSecret(t *testing.T) {
+	first, err := utils.GenerateSecret()
+	assert.NoError(t, err)
+	second, err := utils.GenerateSecret()
+	assert.NoError(t, err)
+
+	decoded, err := hex.DecodeString(first)
+	assert.NoError(t, err)
+	assert.Len(t, decoded, constant.SECRET_LENGTH)
+	assert.NotEqual(t, first, second)
+}
@@ -10,12 +10,16 @@ const (
 	TooManyRequestsErrorCode
 	ForbiddenAccessErrorCode
 	UnauthorizedErrorCode
+	ConflictErrorCode
+	UnprocessableEntityErrorCode
+	RequestEntityTooLargeErrorCode
 )
 
 type AppError struct {
 	err  error
 	code int
 	msg  string
+	data any
 }
 
 func NewAppError(err error, code int, msg string) *AppError {
@@ -26,6 +30,17 @@ func NewAppError(err error, code int, msg string) *AppError {
 	}
 }
 
+// NewAppErrorWithData attaches data the client can act on, it is sent as the
+// data of the error response next to the message.
+func NewAppErrorWithData(err error, code int, msg string, data any) *AppError {
+	return &AppError{
+		err:  err,
+		code: code,
+		msg:  msg,
+		data: data,
+	}
+}
+
 func (e AppError) Error() string {
 	if e.msg == "" {
 		return e.OriginalMessage()
@@ -65,3 +80,7 @@ func (e AppError) OriginalMessage() string {
 func (e AppError) DisplayMessage() string {
 	return e.msg
 }
+
+func (e AppError) Data() any {
+	return e.data
+}
@@ -0,0 +1,31 @@
+package apperror
+
+import (
+	"errors"
+
+	"healthcare-app/pkg/constant"
+)
+
+func NewInvalidIdempotencyKeyError() *AppError {
+	msg := constant.InvalidIdempotencyKeyErrorMessage
+
+	err := errors.New(msg)
+
+	return NewAppError(err, DefaultClientErrorCode, msg)
+}
+
+func NewIdempotencyKeyInUseError() *AppError {
+	msg := constant.IdempotencyKeyInUseErrorMessage
+
+	err := errors.New(msg)
+
+	return NewAppError(err, ConflictErrorCode, msg)
+}
+
+func NewIdempotencyKeyReusedError() *AppError {
+	msg := constant.IdempotencyKeyReusedErrorMessage
+
+	err := errors.New(msg)
+
+	return NewAppError(err, UnprocessableEntityErrorCode, msg)
+}
@@ -0,0 +1,15 @@
+package apperror
+
+import (
+	"errors"
+
+	"healthcare-app/pkg/constant"
+)
+
+func NewRequestBodyTooLargeError() *AppError {
+	msg := constant.RequestBodyTooLargeErrorMessage
+
+	err := errors.New(msg)
+
+	return NewAppError(err, RequestEntityTooLargeErrorCode, msg)
+}
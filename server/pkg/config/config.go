@@ -19,21 +19,25 @@ type Config struct {
 	Logger     *LoggerConfig
 	Google     *GoogleConfig
 	RajaOngkir *RajaOngkirConfig
+	Storage    *StorageConfig
 }
 
 type AppConfig struct {
 	Environment string `mapstructure:"APP_ENVIRONMENT"`
 	BCryptCost  int    `mapstructure:"APP_BCRYPT_COST"`
+	FrontendURL string `mapstructure:"APP_FRONTEND_URL"`
 }
 
 type HttpServerConfig struct {
-	SessionSecret        string `mapstructure:"HTTP_SERVER_SESSION_SECRET"`
-	Host                 string `mapstructure:"HTTP_SERVER_HOST"`
-	Port                 int    `mapstructure:"HTTP_SERVER_PORT"`
-	SessionAge           int    `mapstructure:"HTTP_SERVER_SESSION_AGE"`
-	GracePeriod          int    `mapstructure:"HTTP_SERVER_GRACE_PERIOD"`
-	MaxRequestPerSecond  int    `mapstructure:"HTTP_SERVER_MAX_REQUEST_PER_SECOND"`
-	RequestTimeoutPeriod int    `mapstructure:"HTTP_SERVER_REQUEST_TIMEOUT_PERIOD"`
+	SessionSecret        string   `mapstructure:"HTTP_SERVER_SESSION_SECRET"`
+	Host                 string   `mapstructure:"HTTP_SERVER_HOST"`
+	Port                 int      `mapstructure:"HTTP_SERVER_PORT"`
+	SessionAge           int      `mapstructure:"HTTP_SERVER_SESSION_AGE"`
+	GracePeriod          int      `mapstructure:"HTTP_SERVER_GRACE_PERIOD"`
+	MaxRequestPerSecond  int      `mapstructure:"HTTP_SERVER_MAX_REQUEST_PER_SECOND"`
+	RequestTimeoutPeriod int      `mapstructure:"HTTP_SERVER_REQUEST_TIMEOUT_PERIOD"`
+	IdempotencyKeyTTL    int      `mapstructure:"HTTP_SERVER_IDEMPOTENCY_KEY_TTL"`
+	TrustedProxies       []string `mapstructure:"HTTP_SERVER_TRUSTED_PROXIES"`
 }
 
 type DatabaseConfig struct {
@@ -74,6 +78,20 @@ type RajaOngkirConfig struct {
 	BaseURL string `mapstructure:"RAJAONGKIR_BASE_URL"`
 }
 
+type StorageConfig struct {
+	Driver          string `mapstructure:"STORAGE_DRIVER"`
+	LocalPath       string `mapstructure:"STORAGE_LOCAL_PATH"`
+	LocalBaseURL    string `mapstructure:"STORAGE_LOCAL_BASE_URL"`
+	SigningKey      string `mapstructure:"STORAGE_SIGNING_KEY"`
+	SignedURLExpiry int    `mapstructure:"STORAGE_SIGNED_URL_EXPIRY"`
+	S3Endpoint      string `mapstructure:"STORAGE_S3_ENDPOINT"`
+	S3Region        string `mapstructure:"STORAGE_S3_REGION"`
+	S3Bucket        string `mapstructure:"STORAGE_S3_BUCKET"`
+	S3AccessKey     string `mapstructure:"STORAGE_S3_ACCESS_KEY"`
+	S3SecretKey     string `mapstructure:"STORAGE_S3_SECRET_KEY"`
+	S3PublicURL     string `mapstructure:"STORAGE_S3_PUBLIC_URL"`
+}
+
 type ESConfig struct {
 	Addresses []string `mapstructure:"ES_ADDRESSES"`
 }
@@ -111,6 +129,7 @@ func InitConfig() *Config {
 		Logger:     initLoggerConfig(),
 		Google:     initGoogleConfig(),
 		RajaOngkir: initRajaOngkirConfig(),
+		Storage:    initStorageConfig(),
 	}
 }
 
@@ -174,6 +193,16 @@ func initRajaOngkirConfig() *RajaOngkirConfig {
 	return rajaOngkirConfig
 }
 
+func initStorageConfig() *StorageConfig {
+	storageConfig := &StorageConfig{}
+
+	if err := viper.Unmarshal(&storageConfig); err != nil {
+		log.Fatalf("error mapping storage config: %v", err)
+	}
+
+	return storageConfig
+}
+
 func initESConfig() *ESConfig {
 	esConfig := &ESConfig{}
 
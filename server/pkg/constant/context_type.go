@@ -4,3 +4,5 @@ type ID string
 type Role string
 type Ctx string
 type JTI string
+type RequestID string
+type ClientIP string
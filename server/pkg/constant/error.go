@@ -15,4 +15,8 @@ const (
 	JsonSyntaxErrorMessage            = "invalid JSON syntax"
 	InvalidJsonValueTypeErrorMessage  = "invalid value for %s"
 	InvalidIDErrorMessage             = "expected a numeric value"
+	InvalidIdempotencyKeyErrorMessage = "idempotency key must not be longer than 255 characters"
+	IdempotencyKeyInUseErrorMessage   = "a request with this idempotency key is still being processed"
+	IdempotencyKeyReusedErrorMessage  = "idempotency key was already used for a different request"
+	RequestBodyTooLargeErrorMessage   = "request body is too large"
 )
@@ -20,13 +20,18 @@ func NewTransactor(db *sql.DB) *transactorImpl {
 }
 
 func (t *transactorImpl) Atomic(ctx context.Context, fn func(context.Context) error) error {
+	if ExtractTx(ctx) != nil {
+		return fn(ctx)
+	}
+
 	tx, err := t.db.BeginTx(ctx, nil)
 	if err != nil {
 		return err
 	}
 	defer tx.Rollback()
 
-	err = fn(injectTx(ctx, tx))
+	hooks := &afterCommitHooks{}
+	err = fn(context.WithValue(injectTx(ctx, tx), afterCommitKey{}, hooks))
 	if err != nil {
 		if errRollback := tx.Rollback(); errRollback != nil {
 			return err
@@ -38,9 +43,28 @@ func (t *transactorImpl) Atomic(ctx context.Context, fn func(context.Context) er
 		return err
 	}
 
+	for _, hook := range hooks.fns {
+		hook()
+	}
 	return nil
 }
 
+type afterCommitKey struct{}
+
+type afterCommitHooks struct {
+	fns []func()
+}
+
+// AfterCommit runs fn once the outermost transaction in ctx commits, and
+// never when it rolls back. Without a transaction fn runs right away.
+func AfterCommit(ctx context.Context, fn func()) {
+	if hooks, ok := ctx.Value(afterCommitKey{}).(*afterCommitHooks); ok {
+		hooks.fns = append(hooks.fns, fn)
+		return
+	}
+	fn()
+}
+
 type TxKey struct{}
 
 func injectTx(ctx context.Context, tx *sql.Tx) context.Context {
@@ -52,6 +52,31 @@ func (m *AuthMiddleware) Authorization() gin.HandlerFunc {
 	}
 }
 
+// WebSocketAuthorization authorizes a websocket handshake or an event stream.
+// Browsers cannot set headers on the upgrade request nor on an EventSource, so
+// the access token may also be passed in the access_token query parameter.
+func (m *AuthMiddleware) WebSocketAuthorization() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		accessToken, err := m.parseAccessToken(ctx)
+		if err != nil {
+			accessToken = ctx.Query("access_token")
+		}
+		if accessToken == "" {
+			ctx.Error(apperror.NewUnauthorizedError())
+			ctx.Abort()
+			return
+		}
+
+		if err := m.refreshAccessToken(accessToken, ctx); err != nil {
+			ctx.Error(err)
+			ctx.Abort()
+			return
+		}
+
+		ctx.Next()
+	}
+}
+
 func (m *AuthMiddleware) ProtectedRoles(allowedRoles ...int) gin.HandlerFunc {
 	return func(ctx *gin.Context) {
 		role := utils.GetValueRoleUserFromToken(ctx)
@@ -21,13 +21,16 @@ import (
 )
 
 var codeMap = map[int]int{
-	apperror.DefaultClientErrorCode:   http.StatusBadRequest,
-	apperror.DefaultServerErrorCode:   http.StatusInternalServerError,
-	apperror.NotFoundErrorCode:        http.StatusNotFound,
-	apperror.RequestTimeoutErrorCode:  http.StatusRequestTimeout,
-	apperror.TooManyRequestsErrorCode: http.StatusTooManyRequests,
-	apperror.ForbiddenAccessErrorCode: http.StatusForbidden,
-	apperror.UnauthorizedErrorCode:    http.StatusUnauthorized,
+	apperror.DefaultClientErrorCode:         http.StatusBadRequest,
+	apperror.DefaultServerErrorCode:         http.StatusInternalServerError,
+	apperror.NotFoundErrorCode:              http.StatusNotFound,
+	apperror.RequestTimeoutErrorCode:        http.StatusRequestTimeout,
+	apperror.TooManyRequestsErrorCode:       http.StatusTooManyRequests,
+	apperror.ForbiddenAccessErrorCode:       http.StatusForbidden,
+	apperror.UnauthorizedErrorCode:          http.StatusUnauthorized,
+	apperror.ConflictErrorCode:              http.StatusConflict,
+	apperror.UnprocessableEntityErrorCode:   http.StatusUnprocessableEntity,
+	apperror.RequestEntityTooLargeErrorCode: http.StatusRequestEntityTooLarge,
 }
 
 var (
@@ -54,6 +57,7 @@ func ErrorHandler() gin.HandlerFunc {
 			case *apperror.AppError:
 				ctx.AbortWithStatusJSON(codeMap[e.GetCode()], dto.WebResponse[any]{
 					Message: e.DisplayMessage(),
+					Data:    e.Data(),
 				})
 			default:
 				if errors.Is(e, io.EOF) {
@@ -0,0 +1,224 @@
+package middleware
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"mime/multipart"
+	"net/http"
+	"strings"
+	"time"
+
+	"healthcare-app/internal/auth/utils"
+	"healthcare-app/pkg/apperror"
+	"healthcare-app/pkg/config"
+	"healthcare-app/pkg/logger"
+	"healthcare-app/pkg/utils/redisutils"
+
+	"github.com/gin-gonic/gin"
+)
+
+const (
+	idempotencyKeyHeader      = "Idempotency-Key"
+	idempotencyReplayedHeader = "Idempotent-Replayed"
+	idempotencyKeyMaxLength   = 255
+
+	// how long a lock outlives the request timeout, a lock left behind by a
+	// crashed instance frees the key soon after
+	idempotencyLockMargin = 10 * time.Second
+)
+
+type IdempotencyMiddleware struct {
+	redisUtil redisutils.RedisUtil
+	ttl       time.Duration
+	lockTTL   time.Duration
+}
+
+func NewIdempotencyMiddleware(cfg *config.HttpServerConfig, redisUtil redisutils.RedisUtil) *IdempotencyMiddleware {
+	return &IdempotencyMiddleware{
+		redisUtil: redisUtil,
+		ttl:       time.Duration(cfg.IdempotencyKeyTTL) * time.Second,
+		lockTTL:   time.Duration(cfg.RequestTimeoutPeriod)*time.Second + idempotencyLockMargin,
+	}
+}
+
+// idempotencyRecord is what a key holds, a lock while the first request runs
+// and its response once it succeeded.
+type idempotencyRecord struct {
+	Fingerprint string `json:"fingerprint"`
+	Completed   bool   `json:"completed"`
+	StatusCode  int    `json:"status_code"`
+	ContentType string `json:"content_type"`
+	Body        []byte `json:"body"`
+}
+
+// Idempotent makes a request carrying an Idempotency-Key run once per user and
+// key. Retries with the same request get the first response back, a different
+// request under the same key is rejected. Only successful responses are kept,
+// a failed request releases the key so it can be retried. It has to run after
+// Authorization, keys are scoped to the user. The body is read up front to
+// fingerprint it, so it is capped at maxBodySize.
+func (m *IdempotencyMiddleware) Idempotent(maxBodySize int64) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		idempotencyKey := ctx.GetHeader(idempotencyKeyHeader)
+		if idempotencyKey == "" {
+			ctx.Next()
+			return
+		}
+		if len(idempotencyKey) > idempotencyKeyMaxLength {
+			ctx.Error(apperror.NewInvalidIdempotencyKeyError())
+			ctx.Abort()
+			return
+		}
+
+		ctx.Request.Body = http.MaxBytesReader(ctx.Writer, ctx.Request.Body, maxBodySize)
+		fingerprint, err := fingerprintRequest(ctx)
+		if err != nil {
+			ctx.Error(err)
+			ctx.Abort()
+			return
+		}
+
+		key := fmt.Sprintf("idempotency:%v:%v", utils.GetValueUserIdFromToken(ctx), idempotencyKey)
+		acquired, err := m.redisUtil.SetNXJSON(ctx, key, &idempotencyRecord{Fingerprint: fingerprint}, m.lockTTL)
+		if err != nil {
+			ctx.Error(apperror.NewServerError(err))
+			ctx.Abort()
+			return
+		}
+		if !acquired {
+			m.replay(ctx, key, fingerprint)
+			return
+		}
+
+		// the request context may be done by the time the handler returns
+		storeCtx := context.WithoutCancel(ctx.Request.Context())
+		writer := &responseRecorder{ResponseWriter: ctx.Writer}
+		ctx.Writer = writer
+
+		completed := false
+		defer func() {
+			if completed {
+				return
+			}
+			if err := m.redisUtil.Delete(storeCtx, key); err != nil {
+				logger.Log.Warn("failed to release idempotency key:", err)
+			}
+		}()
+
+		ctx.Next()
+
+		status := writer.Status()
+		if len(ctx.Errors) > 0 || status < 200 || status >= 300 {
+			return
+		}
+		record := &idempotencyRecord{
+			Fingerprint: fingerprint,
+			Completed:   true,
+			StatusCode:  status,
+			ContentType: writer.Header().Get("Content-Type"),
+			Body:        writer.body.Bytes(),
+		}
+		if err := m.redisUtil.SetJSON(storeCtx, key, record, m.ttl); err != nil {
+			logger.Log.Warn("failed to store idempotent response:", err)
+			return
+		}
+		completed = true
+	}
+}
+
+func (m *IdempotencyMiddleware) replay(ctx *gin.Context, key string, fingerprint string) {
+	record := new(idempotencyRecord)
+	if err := m.redisUtil.GetWithScanJSON(ctx, key, record); err != nil {
+		ctx.Error(apperror.NewServerError(err))
+		ctx.Abort()
+		return
+	}
+
+	switch {
+	case record.Fingerprint == "":
+		// the key expired between the lock and this read, the client can
+		// simply retry
+		ctx.Error(apperror.NewIdempotencyKeyInUseError())
+		ctx.Abort()
+	case record.Fingerprint != fingerprint:
+		ctx.Error(apperror.NewIdempotencyKeyReusedError())
+		ctx.Abort()
+	case !record.Completed:
+		ctx.Error(apperror.NewIdempotencyKeyInUseError())
+		ctx.Abort()
+	default:
+		ctx.Header(idempotencyReplayedHeader, "true")
+		ctx.Data(record.StatusCode, record.ContentType, record.Body)
+		ctx.Abort()
+	}
+}
+
+// fingerprintRequest hashes the method, the path and the body. Multipart
+// bodies are hashed part by part, a retried upload comes with a new boundary.
+func fingerprintRequest(ctx *gin.Context) (string, error) {
+	body, err := io.ReadAll(ctx.Request.Body)
+	if err != nil {
+		var maxBytesErr *http.MaxBytesError
+		if errors.As(err, &maxBytesErr) {
+			return "", apperror.NewRequestBodyTooLargeError()
+		}
+		return "", err
+	}
+	ctx.Request.Body = io.NopCloser(bytes.NewReader(body))
+
+	request := fmt.Sprintf("%v %v\n", ctx.Request.Method, ctx.Request.URL.Path)
+	mediaType, params, err := mime.ParseMediaType(ctx.GetHeader("Content-Type"))
+	if err == nil && strings.HasPrefix(mediaType, "multipart/") {
+		hash := sha256.New()
+		hash.Write([]byte(request))
+		if err := hashMultipart(hash, body, params["boundary"]); err == nil {
+			return hex.EncodeToString(hash.Sum(nil)), nil
+		}
+	}
+
+	// a malformed multipart body is left for the handler to reject
+	hash := sha256.New()
+	hash.Write([]byte(request))
+	hash.Write(body)
+	return hex.EncodeToString(hash.Sum(nil)), nil
+}
+
+func hashMultipart(w io.Writer, body []byte, boundary string) error {
+	reader := multipart.NewReader(bytes.NewReader(body), boundary)
+	for {
+		part, err := reader.NextPart()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		fmt.Fprintf(w, "%v %v\n", part.FormName(), part.FileName())
+		if _, err := io.Copy(w, part); err != nil {
+			return err
+		}
+	}
+}
+
+// responseRecorder keeps a copy of what the handler writes so it can be
+// replayed.
+type responseRecorder struct {
+	gin.ResponseWriter
+	body bytes.Buffer
+}
+
+func (w *responseRecorder) Write(data []byte) (int, error) {
+	w.body.Write(data)
+	return w.ResponseWriter.Write(data)
+}
+
+func (w *responseRecorder) WriteString(s string) (int, error) {
+	w.body.WriteString(s)
+	return w.ResponseWriter.WriteString(s)
+}
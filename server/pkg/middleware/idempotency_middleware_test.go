@@ -0,0 +1,247 @@
+package middleware
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"healthcare-app/pkg/apperror"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+// memRedis keeps the values in memory and remembers the ttl each key was last
+// written with, expiry itself is not simulated.
+type memRedis struct {
+	mu     sync.Mutex
+	values map[string]string
+	ttls   map[string]time.Duration
+}
+
+func newMemRedis() *memRedis {
+	return &memRedis{values: map[string]string{}, ttls: map[string]time.Duration{}}
+}
+
+func (r *memRedis) Set(ctx context.Context, key string, value any, duration time.Duration) error {
+	return r.SetJSON(ctx, key, value, duration)
+}
+
+func (r *memRedis) SetJSON(ctx context.Context, key string, value any, duration time.Duration) error {
+	data, err := json.Marshal(value)
+	if err != nil {
+		return err
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.values[key] = string(data)
+	r.ttls[key] = duration
+	return nil
+}
+
+func (r *memRedis) SetNXJSON(ctx context.Context, key string, value any, duration time.Duration) (bool, error) {
+	r.mu.Lock()
+	_, ok := r.values[key]
+	r.mu.Unlock()
+	if ok {
+		return false, nil
+	}
+	return true, r.SetJSON(ctx, key, value, duration)
+}
+
+func (r *memRedis) Get(ctx context.Context, key string) (string, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.values[key], nil
+}
+
+func (r *memRedis) GetWithScan(ctx context.Context, key string, dest any) error {
+	return r.GetWithScanJSON(ctx, key, dest)
+}
+
+func (r *memRedis) GetWithScanJSON(ctx context.Context, key string, dest any) error {
+	r.mu.Lock()
+	val, ok := r.values[key]
+	r.mu.Unlock()
+	if !ok {
+		return nil
+	}
+	return json.Unmarshal([]byte(val), dest)
+}
+
+func (r *memRedis) Delete(ctx context.Context, keys ...string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, key := range keys {
+		delete(r.values, key)
+		delete(r.ttls, key)
+	}
+	return nil
+}
+
+type idempotentRequest struct {
+	body         string
+	contentType  string
+	wantCode     int
+	wantBody     string
+	wantReplayed bool
+}
+
+func multipartBody(t *testing.T, boundary string) (string, string) {
+	buf := new(bytes.Buffer)
+	writer := multipart.NewWriter(buf)
+	if err := writer.SetBoundary(boundary); err != nil {
+		t.Fatal(err)
+	}
+	writer.WriteField("order_id", "1")
+	part, _ := writer.CreateFormFile("file", "proof.png")
+	part.Write([]byte("image"))
+	writer.Close()
+	return buf.String(), writer.FormDataContentType()
+}
+
+func TestIdempotencyMiddlewareIdempotent(t *testing.T) {
+	const (
+		key         = "key-1"
+		maxBodySize = 1024
+	)
+	multipartA, contentTypeA := multipartBody(t, "boundary-a")
+	multipartB, contentTypeB := multipartBody(t, "boundary-b")
+
+	serve := func(r *gin.Engine, req idempotentRequest) *httptest.ResponseRecorder {
+		httpReq := httptest.NewRequest(http.MethodPost, "/orders", strings.NewReader(req.body))
+		httpReq.Header.Set(idempotencyKeyHeader, key)
+		contentType := req.contentType
+		if contentType == "" {
+			contentType = "application/json"
+		}
+		httpReq.Header.Set("Content-Type", contentType)
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, httpReq)
+		return w
+	}
+
+	tests := []struct {
+		name      string
+		handler   func(t *testing.T, r *gin.Engine, call int, ctx *gin.Context)
+		requests  []idempotentRequest
+		wantCalls int
+		wantKept  bool
+	}{
+		{
+			name: "identical retry replays the first response",
+			handler: func(t *testing.T, r *gin.Engine, call int, ctx *gin.Context) {
+				ctx.JSON(http.StatusCreated, gin.H{"call": call})
+			},
+			requests: []idempotentRequest{
+				{body: `{"qty":1}`, wantCode: http.StatusCreated, wantBody: `{"call":1}`},
+				{body: `{"qty":1}`, wantCode: http.StatusCreated, wantBody: `{"call":1}`, wantReplayed: true},
+			},
+			wantCalls: 1,
+			wantKept:  true,
+		},
+		{
+			name: "different body under the same key is rejected",
+			handler: func(t *testing.T, r *gin.Engine, call int, ctx *gin.Context) {
+				ctx.JSON(http.StatusCreated, gin.H{"call": call})
+			},
+			requests: []idempotentRequest{
+				{body: `{"qty":1}`, wantCode: http.StatusCreated},
+				{body: `{"qty":2}`, wantCode: http.StatusUnprocessableEntity},
+			},
+			wantCalls: 1,
+			wantKept:  true,
+		},
+		{
+			name: "retry while the first request runs conflicts",
+			handler: func(t *testing.T, r *gin.Engine, call int, ctx *gin.Context) {
+				w := serve(r, idempotentRequest{body: `{"qty":1}`})
+				assert.Equal(t, http.StatusConflict, w.Code)
+				ctx.JSON(http.StatusCreated, gin.H{"call": call})
+			},
+			requests: []idempotentRequest{
+				{body: `{"qty":1}`, wantCode: http.StatusCreated},
+			},
+			wantCalls: 1,
+			wantKept:  true,
+		},
+		{
+			name: "failed request releases the key",
+			handler: func(t *testing.T, r *gin.Engine, call int, ctx *gin.Context) {
+				if call == 1 {
+					ctx.Error(apperror.NewServerError(assert.AnError))
+					return
+				}
+				ctx.JSON(http.StatusCreated, gin.H{"call": call})
+			},
+			requests: []idempotentRequest{
+				{body: `{"qty":1}`, wantCode: http.StatusInternalServerError},
+				{body: `{"qty":1}`, wantCode: http.StatusCreated, wantBody: `{"call":2}`},
+			},
+			wantCalls: 2,
+			wantKept:  true,
+		},
+		{
+			name: "multipart retry with a new boundary replays",
+			handler: func(t *testing.T, r *gin.Engine, call int, ctx *gin.Context) {
+				ctx.JSON(http.StatusCreated, gin.H{"call": call})
+			},
+			requests: []idempotentRequest{
+				{body: multipartA, contentType: contentTypeA, wantCode: http.StatusCreated},
+				{body: multipartB, contentType: contentTypeB, wantCode: http.StatusCreated, wantBody: `{"call":1}`, wantReplayed: true},
+			},
+			wantCalls: 1,
+			wantKept:  true,
+		},
+		{
+			name: "body over the limit is rejected",
+			handler: func(t *testing.T, r *gin.Engine, call int, ctx *gin.Context) {
+				ctx.JSON(http.StatusCreated, gin.H{"call": call})
+			},
+			requests: []idempotentRequest{
+				{body: strings.Repeat("a", maxBodySize+1), wantCode: http.StatusRequestEntityTooLarge},
+			},
+			wantCalls: 0,
+			wantKept:  false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gin.SetMode(gin.TestMode)
+			redis := newMemRedis()
+			m := &IdempotencyMiddleware{redisUtil: redis, ttl: time.Hour, lockTTL: time.Minute}
+
+			calls := 0
+			r := gin.New()
+			r.Use(ErrorHandler())
+			r.POST("/orders", m.Idempotent(maxBodySize), func(ctx *gin.Context) {
+				calls++
+				tt.handler(t, r, calls, ctx)
+			})
+
+			for _, req := range tt.requests {
+				w := serve(r, req)
+				assert.Equal(t, req.wantCode, w.Code)
+				if req.wantBody != "" {
+					assert.JSONEq(t, req.wantBody, w.Body.String())
+				}
+				assert.Equal(t, req.wantReplayed, w.Header().Get(idempotencyReplayedHeader) == "true")
+			}
+			assert.Equal(t, tt.wantCalls, calls)
+
+			ttl, kept := redis.ttls["idempotency:0:"+key]
+			assert.Equal(t, tt.wantKept, kept)
+			if kept {
+				assert.Equal(t, m.ttl, ttl)
+			}
+		})
+	}
+}
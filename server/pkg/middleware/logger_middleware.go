@@ -24,6 +24,7 @@ func Logger() gin.HandlerFunc {
 			"method":      ctx.Request.Method,
 			"latency":     time.Since(start).String(),
 			"path":        path,
+			"request_id":  ctx.Writer.Header().Get(requestIDHeader),
 		}
 
 		if len(ctx.Errors) == 0 {
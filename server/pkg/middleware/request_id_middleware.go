@@ -0,0 +1,44 @@
+package middleware
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+
+	"healthcare-app/pkg/constant"
+
+	"github.com/gin-gonic/gin"
+)
+
+const (
+	requestIDHeader    = "X-Request-Id"
+	requestIDMaxLength = 64
+)
+
+// RequestID tags the request with the id the client or proxy sent, or a new
+// one, and echoes it back. The id and the client ip are kept in the request
+// context for whatever needs to trace the request later, like the audit log.
+func RequestID() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		requestID := ctx.GetHeader(requestIDHeader)
+		if requestID == "" || len(requestID) > requestIDMaxLength {
+			requestID = newRequestID()
+		}
+		ctx.Header(requestIDHeader, requestID)
+
+		var requestIDKey constant.RequestID = "request_id"
+		ctxRequestID := context.WithValue(ctx.Request.Context(), requestIDKey, requestID)
+		ctx.Request = ctx.Request.WithContext(ctxRequestID)
+
+		var clientIPKey constant.ClientIP = "client_ip"
+		ctx.Request = ctx.Request.WithContext(context.WithValue(ctx.Request.Context(), clientIPKey, ctx.ClientIP()))
+
+		ctx.Next()
+	}
+}
+
+func newRequestID() string {
+	b := make([]byte, 16)
+	rand.Read(b)
+	return hex.EncodeToString(b)
+}
@@ -10,8 +10,20 @@ import (
 	"github.com/gin-gonic/gin"
 )
 
-func RequestTimeout(cfg *config.Config) gin.HandlerFunc {
+// RequestTimeout bounds every request except the long-lived routes, matched on
+// the registered route so a client can't opt out with its headers.
+func RequestTimeout(cfg *config.Config, longLivedRoutes ...string) gin.HandlerFunc {
+	exempt := map[string]bool{}
+	for _, route := range longLivedRoutes {
+		exempt[route] = true
+	}
+
 	return func(ctx *gin.Context) {
+		if exempt[ctx.FullPath()] {
+			ctx.Next()
+			return
+		}
+
 		timeoutCtx, cancel := context.WithTimeout(
 			ctx.Request.Context(),
 			time.Duration(cfg.HttpServer.RequestTimeoutPeriod)*time.Second,
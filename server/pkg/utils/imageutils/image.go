@@ -0,0 +1,197 @@
+package imageutils
+
+import (
+	"bytes"
+	"errors"
+	"image"
+	"image/color"
+	"image/draw"
+	"image/gif"
+	"image/jpeg"
+	"image/png"
+	"io"
+	"net/http"
+
+	"github.com/HugoSmits86/nativewebp"
+	xdraw "golang.org/x/image/draw"
+	"golang.org/x/image/webp"
+)
+
+const (
+	FormatJPEG = "jpeg"
+	FormatPNG  = "png"
+	FormatGIF  = "gif"
+	FormatWebP = "webp"
+
+	jpegQuality = 82
+
+	// a decoded image takes 4 bytes per pixel and more for 16 bit pngs, a small
+	// file can declare dimensions that would not fit in memory
+	maxPixels = 40_000_000
+)
+
+var (
+	ErrUnsupportedImage = errors.New("unsupported image type")
+	ErrImageTooLarge    = errors.New("image dimensions are too large")
+)
+
+// DetectFormat sniffs the real format of an image from its leading bytes
+// instead of trusting the file extension.
+func DetectFormat(r io.Reader) (string, error) {
+	header := make([]byte, 512)
+	n, err := io.ReadFull(r, header)
+	if err != nil && !errors.Is(err, io.ErrUnexpectedEOF) {
+		return "", ErrUnsupportedImage
+	}
+	return detectFormat(header[:n])
+}
+
+func detectFormat(data []byte) (string, error) {
+	switch http.DetectContentType(data) {
+	case "image/jpeg":
+		return FormatJPEG, nil
+	case "image/png":
+		return FormatPNG, nil
+	case "image/gif":
+		return FormatGIF, nil
+	case "image/webp":
+		return FormatWebP, nil
+	default:
+		return "", ErrUnsupportedImage
+	}
+}
+
+// Decode sniffs the real content type of data instead of trusting the file
+// extension and decodes it. Re-encoding the returned image drops every metadata
+// segment of the original file, EXIF included, so the orientation tag is
+// applied to the pixels here before it is lost. The header is checked against
+// maxPixels before any pixel is allocated.
+func Decode(data []byte) (image.Image, error) {
+	format, err := detectFormat(data)
+	if err != nil {
+		return nil, err
+	}
+
+	var config image.Config
+	switch format {
+	case FormatJPEG:
+		config, err = jpeg.DecodeConfig(bytes.NewReader(data))
+	case FormatPNG:
+		config, err = png.DecodeConfig(bytes.NewReader(data))
+	case FormatGIF:
+		config, err = gif.DecodeConfig(bytes.NewReader(data))
+	case FormatWebP:
+		config, err = webp.DecodeConfig(bytes.NewReader(data))
+	}
+	if err != nil {
+		return nil, ErrUnsupportedImage
+	}
+	if int64(config.Width)*int64(config.Height) > maxPixels {
+		return nil, ErrImageTooLarge
+	}
+
+	var img image.Image
+	reader := bytes.NewReader(data)
+	switch format {
+	case FormatJPEG:
+		img, err = jpeg.Decode(reader)
+		if err == nil {
+			img = orient(img, exifOrientation(data))
+		}
+	case FormatPNG:
+		img, err = png.Decode(reader)
+	case FormatGIF:
+		img, err = gif.Decode(reader)
+	case FormatWebP:
+		img, err = webp.Decode(reader)
+	}
+
+	if err != nil {
+		return nil, ErrUnsupportedImage
+	}
+	return img, nil
+}
+
+// Resize scales img down so that it fits in a maxSize square, keeping the
+// aspect ratio. Images that already fit are returned untouched.
+func Resize(img image.Image, maxSize int) image.Image {
+	bounds := img.Bounds()
+	width, height := bounds.Dx(), bounds.Dy()
+	if width <= maxSize && height <= maxSize {
+		return img
+	}
+
+	if width >= height {
+		height = max(1, height*maxSize/width)
+		width = maxSize
+	} else {
+		width = max(1, width*maxSize/height)
+		height = maxSize
+	}
+
+	dst := image.NewRGBA(image.Rect(0, 0, width, height))
+	xdraw.CatmullRom.Scale(dst, dst.Bounds(), img, bounds, xdraw.Over, nil)
+	return dst
+}
+
+// Encode writes img in the given format. Jpeg has no alpha channel, so
+// transparent pixels are flattened on a white background first. Webp is only
+// encoded lossless.
+func Encode(img image.Image, format string) ([]byte, error) {
+	buf := new(bytes.Buffer)
+
+	var err error
+	switch format {
+	case FormatJPEG:
+		err = jpeg.Encode(buf, flatten(img), &jpeg.Options{Quality: jpegQuality})
+	case FormatPNG:
+		encoder := png.Encoder{CompressionLevel: png.BestCompression}
+		err = encoder.Encode(buf, img)
+	case FormatWebP:
+		err = nativewebp.Encode(buf, img, nil)
+	default:
+		return nil, ErrUnsupportedImage
+	}
+
+	if err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// Compress re-encodes img keeping png for images with transparency and jpeg for
+// everything else.
+func Compress(img image.Image) ([]byte, error) {
+	if isOpaque(img) {
+		return Encode(img, FormatJPEG)
+	}
+	return Encode(img, FormatPNG)
+}
+
+// Process is the pipeline shared by every single image upload: sniff, decode,
+// strip the metadata, scale down to maxSize and compress.
+func Process(data []byte, maxSize int) ([]byte, error) {
+	img, err := Decode(data)
+	if err != nil {
+		return nil, err
+	}
+	return Compress(Resize(img, maxSize))
+}
+
+func isOpaque(img image.Image) bool {
+	if o, ok := img.(interface{ Opaque() bool }); ok {
+		return o.Opaque()
+	}
+	return false
+}
+
+func flatten(img image.Image) image.Image {
+	if isOpaque(img) {
+		return img
+	}
+
+	dst := image.NewRGBA(img.Bounds())
+	draw.Draw(dst, dst.Bounds(), image.NewUniform(color.White), image.Point{}, draw.Src)
+	draw.Draw(dst, dst.Bounds(), img, img.Bounds().Min, draw.Over)
+	return dst
+}
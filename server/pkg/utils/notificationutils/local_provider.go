@@ -0,0 +1,25 @@
+package notificationutils
+
+import (
+	"context"
+
+	"healthcare-app/pkg/logger"
+)
+
+// localProvider stands in for the whatsapp and push gateways until real ones
+// are configured, it only writes what would have been sent to the log.
+type localProvider struct{}
+
+func NewLocalProvider() *localProvider {
+	return &localProvider{}
+}
+
+func (p *localProvider) SendMessage(ctx context.Context, to string, text string) error {
+	logger.Log.WithFields(map[string]any{"channel": ChannelWhatsapp, "to": to}).Info(text)
+	return nil
+}
+
+func (p *localProvider) Push(ctx context.Context, to string, title string, body string, link string) error {
+	logger.Log.WithFields(map[string]any{"channel": ChannelPush, "to": to, "link": link}).Info(title, ": ", body)
+	return nil
+}
@@ -0,0 +1,26 @@
+package notificationutils
+
+import "context"
+
+const (
+	ChannelEmail    = "email"
+	ChannelInApp    = "in_app"
+	ChannelWhatsapp = "whatsapp"
+	ChannelPush     = "push"
+)
+
+// Message is a channel agnostic notification. Each channel decides how the
+// subject, title, body and link are rendered for its medium, and what the
+// recipient is: an email address, a whatsapp number or a user id for push.
+type Message struct {
+	Recipient string
+	Subject   string
+	Title     string
+	Body      string
+	Link      string
+}
+
+type Channel interface {
+	Name() string
+	Send(ctx context.Context, message *Message) error
+}
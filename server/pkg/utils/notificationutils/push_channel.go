@@ -0,0 +1,27 @@
+package notificationutils
+
+import "context"
+
+// PushProvider is the gateway that delivers a push notification to every
+// device the user signed in on, to is the user id.
+type PushProvider interface {
+	Push(ctx context.Context, to string, title string, body string, link string) error
+}
+
+type pushChannel struct {
+	provider PushProvider
+}
+
+func NewPushChannel(provider PushProvider) *pushChannel {
+	return &pushChannel{
+		provider: provider,
+	}
+}
+
+func (c *pushChannel) Name() string {
+	return ChannelPush
+}
+
+func (c *pushChannel) Send(ctx context.Context, message *Message) error {
+	return c.provider.Push(ctx, message.Recipient, message.Title, message.Body, message.Link)
+}
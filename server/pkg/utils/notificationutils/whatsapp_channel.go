@@ -0,0 +1,34 @@
+package notificationutils
+
+import (
+	"context"
+	"fmt"
+)
+
+// WhatsappProvider is the gateway that actually delivers a whatsapp message,
+// to is the number as stored on the user detail.
+type WhatsappProvider interface {
+	SendMessage(ctx context.Context, to string, text string) error
+}
+
+type whatsappChannel struct {
+	provider WhatsappProvider
+}
+
+func NewWhatsappChannel(provider WhatsappProvider) *whatsappChannel {
+	return &whatsappChannel{
+		provider: provider,
+	}
+}
+
+func (c *whatsappChannel) Name() string {
+	return ChannelWhatsapp
+}
+
+func (c *whatsappChannel) Send(ctx context.Context, message *Message) error {
+	text := fmt.Sprintf("*%v*\n%v", message.Title, message.Body)
+	if message.Link != "" {
+		text = fmt.Sprintf("%v\n%v", text, message.Link)
+	}
+	return c.provider.SendMessage(ctx, message.Recipient, text)
+}
@@ -0,0 +1,133 @@
+package pubsubutils
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"sync"
+
+	"github.com/redis/go-redis/v9"
+)
+
+const (
+	channelPrefix = "broker:"
+	bufferSize    = 16
+)
+
+// Broker fans events out to the subscribers of a topic on every replica.
+// Events go through Redis, so a subscriber connected to one replica still
+// receives what was published on another.
+type Broker interface {
+	Publish(ctx context.Context, topic string, event any) error
+	Subscribe(topic string) *Subscriber
+	Unsubscribe(subscriber *Subscriber)
+	Run(ctx context.Context) error
+	Close()
+}
+
+type Subscriber struct {
+	Topic  string
+	Events chan []byte
+}
+
+type redisBroker struct {
+	client      *redis.Client
+	mu          sync.RWMutex
+	subscribers map[string]map[*Subscriber]struct{}
+}
+
+func NewRedisBroker(rdb *redis.Client) *redisBroker {
+	return &redisBroker{
+		client:      rdb,
+		subscribers: map[string]map[*Subscriber]struct{}{},
+	}
+}
+
+func (b *redisBroker) Publish(ctx context.Context, topic string, event any) error {
+	data, err := json.Marshal(event)
+	if err != nil {
+		return err
+	}
+	return b.client.Publish(ctx, channelPrefix+topic, data).Err()
+}
+
+func (b *redisBroker) Subscribe(topic string) *Subscriber {
+	subscriber := &Subscriber{
+		Topic:  topic,
+		Events: make(chan []byte, bufferSize),
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if _, ok := b.subscribers[topic]; !ok {
+		b.subscribers[topic] = map[*Subscriber]struct{}{}
+	}
+	b.subscribers[topic][subscriber] = struct{}{}
+	return subscriber
+}
+
+func (b *redisBroker) Unsubscribe(subscriber *Subscriber) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	subscribers, ok := b.subscribers[subscriber.Topic]
+	if !ok {
+		return
+	}
+	if _, ok := subscribers[subscriber]; !ok {
+		return
+	}
+	delete(subscribers, subscriber)
+	if len(subscribers) == 0 {
+		delete(b.subscribers, subscriber.Topic)
+	}
+	close(subscriber.Events)
+}
+
+// Close ends every local subscription, letting long lived connections that
+// wait on their events finish before the server shuts down.
+func (b *redisBroker) Close() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	for topic, subscribers := range b.subscribers {
+		for subscriber := range subscribers {
+			close(subscriber.Events)
+		}
+		delete(b.subscribers, topic)
+	}
+}
+
+// Run listens to every topic on a single Redis connection and hands each
+// event to the local subscribers of its topic. A subscriber that is not
+// keeping up misses the event instead of blocking the others.
+func (b *redisBroker) Run(ctx context.Context) error {
+	pubSub := b.client.PSubscribe(ctx, channelPrefix+"*")
+	defer pubSub.Close()
+
+	if _, err := pubSub.Receive(ctx); err != nil {
+		return err
+	}
+
+	messages := pubSub.Channel()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case message, ok := <-messages:
+			if !ok {
+				return nil
+			}
+			b.deliver(strings.TrimPrefix(message.Channel, channelPrefix), []byte(message.Payload))
+		}
+	}
+}
+
+func (b *redisBroker) deliver(topic string, data []byte) {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+	for subscriber := range b.subscribers[topic] {
+		select {
+		case subscriber.Events <- data:
+		default:
+		}
+	}
+}
@@ -51,6 +51,21 @@ func (r *redisUtilLRU) SetJSON(ctx context.Context, key string, value any, durat
 	return r.Set(ctx, key, value, duration)
 }
 
+func (r *redisUtilLRU) SetNXJSON(ctx context.Context, key string, value any, duration time.Duration) (bool, error) {
+	bytes, err := json.Marshal(value)
+	if err != nil {
+		return false, err
+	}
+
+	ok, err := r.client.SetNX(ctx, key, string(bytes), duration).Result()
+	if err != nil || !ok {
+		return ok, err
+	}
+
+	r.cache.Add(key, string(bytes))
+	return true, nil
+}
+
 func (r *redisUtilLRU) Get(ctx context.Context, key string) (string, error) {
 	if val, found := r.cache.Get(key); found {
 		return val, nil
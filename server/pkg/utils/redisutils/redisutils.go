@@ -13,6 +13,7 @@ import (
 type RedisUtil interface {
 	Set(ctx context.Context, key string, value any, duration time.Duration) error
 	SetJSON(ctx context.Context, key string, value any, duration time.Duration) error
+	SetNXJSON(ctx context.Context, key string, value any, duration time.Duration) (bool, error)
 	Get(ctx context.Context, key string) (string, error)
 	GetWithScan(ctx context.Context, key string, dest any) error
 	GetWithScanJSON(ctx context.Context, key string, dest any) error
@@ -62,6 +63,20 @@ func (r *redisUtil) SetJSON(ctx context.Context, key string, value any, duration
 	return r.client.Set(ctx, key, string(data), duration).Err()
 }
 
+// SetNXJSON only sets the key when it does not exist yet and reports whether
+// it did.
+func (r *redisUtil) SetNXJSON(ctx context.Context, key string, value any, duration time.Duration) (bool, error) {
+	if duration == -1 {
+		duration = time.Duration(r.cfg.DefaultExpiration) * time.Second
+	}
+
+	data, err := json.Marshal(value)
+	if err != nil {
+		return false, err
+	}
+	return r.client.SetNX(ctx, key, string(data), duration).Result()
+}
+
 func (r *redisUtil) Get(ctx context.Context, key string) (string, error) {
 	val, err := r.client.Get(ctx, key).Result()
 	if err == redis.Nil {
@@ -2,21 +2,78 @@ package smtputils
 
 import (
 	"embed"
+	"path"
+	"strings"
 )
 
-//go:embed templates/*.html
+//go:embed templates/*.html templates/layouts/*.html
 var EmailHTMLTemplates embed.FS
 
+// layoutTemplates are the {{define}} blocks shared by several emails, like the
+// layout and item table of the order emails. They are parsed next to every
+// template, embedded or stored.
+const layoutTemplates = "templates/layouts/*.html"
+
 const (
-	ResetPasswordSubject = "[Favipiravir] Please reset your password"
-	VerificationSubject  = "[Favipiravir] Verify your account"
-	PharmacistSubject    = "[Favipiravir] Pharmacist account"
+	ResetPasswordSubject      = "[Favipiravir] Please reset your password"
+	VerificationSubject       = "[Favipiravir] Verify your account"
+	PharmacistSubject         = "[Favipiravir] Pharmacist account"
+	DoctorSubject             = "[Favipiravir] Doctor account"
+	RefillReminderSubject     = "[Favipiravir] Your refill is coming up"
+	RefillShortageSubject     = "[Favipiravir] Your refill is delayed"
+	MedicationReminderSubject = "[Favipiravir] Time to take your medicine"
+	OrderReceiptSubject       = "[Favipiravir] We received your order"
+	OrderPaidSubject          = "[Favipiravir] Payment received"
+	OrderShippedSubject       = "[Favipiravir] Your order is on the way"
+	OrderCancelledSubject     = "[Favipiravir] Your order was cancelled"
+	OrderConfirmedSubject     = "[Favipiravir] Your order is complete"
 )
 
-type emailTemplate string
+type EmailTemplate string
 
 const (
-	ResetPasswordTemplate emailTemplate = "templates/forgot-password.html"
-	VerificationTemplate  emailTemplate = "templates/verification.html"
-	PharmacistTemplate    emailTemplate = "templates/pharmacist.html"
+	ResetPasswordTemplate  EmailTemplate = "templates/forgot-password.html"
+	VerificationTemplate   EmailTemplate = "templates/verification.html"
+	PharmacistTemplate     EmailTemplate = "templates/pharmacist.html"
+	DoctorTemplate         EmailTemplate = "templates/doctor.html"
+	RefillReminderTemplate EmailTemplate = "templates/refill-reminder.html"
+	RefillShortageTemplate EmailTemplate = "templates/refill-stock-shortage.html"
+	NotificationTemplate   EmailTemplate = "templates/notification.html"
+	OrderReceiptTemplate   EmailTemplate = "templates/order-receipt.html"
+	OrderPaidTemplate      EmailTemplate = "templates/order-paid.html"
+	OrderShippedTemplate   EmailTemplate = "templates/order-shipped.html"
+	OrderCancelledTemplate EmailTemplate = "templates/order-cancelled.html"
+	OrderConfirmedTemplate EmailTemplate = "templates/order-confirmed.html"
 )
+
+// DefaultSubjects pairs every embedded template with its subject, both are
+// used whenever no version of the template is stored in the database.
+var DefaultSubjects = map[EmailTemplate]string{
+	ResetPasswordTemplate:  ResetPasswordSubject,
+	VerificationTemplate:   VerificationSubject,
+	PharmacistTemplate:     PharmacistSubject,
+	DoctorTemplate:         DoctorSubject,
+	RefillReminderTemplate: RefillReminderSubject,
+	RefillShortageTemplate: RefillShortageSubject,
+	NotificationTemplate:   MedicationReminderSubject,
+	OrderReceiptTemplate:   OrderReceiptSubject,
+	OrderPaidTemplate:      OrderPaidSubject,
+	OrderShippedTemplate:   OrderShippedSubject,
+	OrderCancelledTemplate: OrderCancelledSubject,
+	OrderConfirmedTemplate: OrderConfirmedSubject,
+}
+
+// Code is the name a template is stored under, the file name without its
+// extension.
+func (t EmailTemplate) Code() string {
+	return strings.TrimSuffix(path.Base(string(t)), path.Ext(string(t)))
+}
+
+func ParseEmailTemplate(code string) (EmailTemplate, bool) {
+	for emailTemplate := range DefaultSubjects {
+		if emailTemplate.Code() == code {
+			return emailTemplate, true
+		}
+	}
+	return "", false
+}
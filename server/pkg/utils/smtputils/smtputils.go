@@ -24,8 +24,10 @@ func initDialer(config *config.SMTPConfig) {
 
 type SMTPUtils interface {
 	SendMail(to, subject, body string) error
-	SendMailHTML(to, subject string, emailTemplate emailTemplate, data map[string]any) error
-	SendMailHTMLContext(ctx context.Context, to, subject string, emailTemplate emailTemplate, data map[string]any) error
+	SendMailHTML(to, subject string, emailTemplate EmailTemplate, data map[string]any) error
+	SendMailHTMLContext(ctx context.Context, to, subject string, emailTemplate EmailTemplate, data map[string]any) error
+	SendMailHTMLBody(to, subject, body string) error
+	SendMailHTMLBodyContext(ctx context.Context, to, subject, body string) error
 }
 
 type smtpUtils struct {
@@ -51,31 +53,59 @@ func (s *smtpUtils) SendMail(to, subject, body string) error {
 	return dialer.DialAndSend(m)
 }
 
-func (s *smtpUtils) SendMailHTML(to, subject string, emailTemplate emailTemplate, data map[string]any) error {
-	tmpl, err := template.ParseFS(EmailHTMLTemplates, string(emailTemplate))
+func (s *smtpUtils) SendMailHTML(to, subject string, emailTemplate EmailTemplate, data map[string]any) error {
+	body, err := RenderHTML(emailTemplate, data)
 	if err != nil {
 		return err
 	}
 
-	var body bytes.Buffer
-	if err := tmpl.Execute(&body, data); err != nil {
-		return err
+	return s.SendMailHTMLBody(to, subject, body)
+}
+
+func (s *smtpUtils) SendMailHTMLContext(ctx context.Context, to, subject string, emailTemplate EmailTemplate, data map[string]any) error {
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	default:
+		return s.SendMailHTML(to, subject, emailTemplate, data)
 	}
+}
 
+func (s *smtpUtils) SendMailHTMLBody(to, subject, body string) error {
 	m := gomail.NewMessage()
 	m.SetHeader("From", s.config.Email)
 	m.SetHeader("To", to)
 	m.SetHeader("Subject", subject)
-	m.SetBody("text/html", body.String())
+	m.SetBody("text/html", body)
 
 	return dialer.DialAndSend(m)
 }
 
-func (s *smtpUtils) SendMailHTMLContext(ctx context.Context, to, subject string, emailTemplate emailTemplate, data map[string]any) error {
+func (s *smtpUtils) SendMailHTMLBodyContext(ctx context.Context, to, subject, body string) error {
 	select {
 	case <-ctx.Done():
 		return ctx.Err()
 	default:
-		return s.SendMailHTML(to, subject, emailTemplate, data)
+		return s.SendMailHTMLBody(to, subject, body)
+	}
+}
+
+// RenderHTML executes one of the embedded templates.
+func RenderHTML(emailTemplate EmailTemplate, data map[string]any) (string, error) {
+	tmpl, err := template.ParseFS(EmailHTMLTemplates, string(emailTemplate), layoutTemplates)
+	if err != nil {
+		return "", err
 	}
+
+	var body bytes.Buffer
+	if err := tmpl.Execute(&body, data); err != nil {
+		return "", err
+	}
+	return body.String(), nil
+}
+
+// ParseLayouts adds the shared layouts to tmpl, so a template stored in the
+// database can use them the same way the embedded ones do.
+func ParseLayouts(tmpl *template.Template) (*template.Template, error) {
+	return tmpl.ParseFS(EmailHTMLTemplates, layoutTemplates)
 }
@@ -0,0 +1,85 @@
+package storageutils
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"log"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/cloudinary/cloudinary-go/v2"
+	"github.com/cloudinary/cloudinary-go/v2/api"
+	"github.com/cloudinary/cloudinary-go/v2/api/uploader"
+)
+
+type cloudinaryStorage struct {
+	cld    *cloudinary.Cloudinary
+	expiry time.Duration
+}
+
+func NewCloudinaryStorage(expiry time.Duration) *cloudinaryStorage {
+	cld, err := cloudinary.New()
+	if err != nil {
+		log.Fatal(err)
+	}
+	cld.Config.URL.Secure = true
+
+	return &cloudinaryStorage{
+		cld:    cld,
+		expiry: expiry,
+	}
+}
+
+func (s *cloudinaryStorage) Upload(ctx context.Context, object any, params UploadParams) (string, error) {
+	data, err := ReadObject(ctx, object)
+	if err != nil {
+		return "", err
+	}
+
+	uploadParams := uploader.UploadParams{
+		PublicID:       params.Key,
+		UniqueFilename: api.Bool(true),
+		Overwrite:      api.Bool(true),
+		Invalidate:     api.Bool(true),
+	}
+	if params.Private {
+		uploadParams.Type = api.Authenticated
+	}
+
+	result, err := s.cld.Upload.Upload(ctx, bytes.NewReader(data), uploadParams)
+	if err != nil {
+		return "", err
+	}
+	if result.Error.Message != "" {
+		return "", errors.New(result.Error.Message)
+	}
+
+	if params.Private {
+		return result.PublicID + "." + result.Format, nil
+	}
+
+	qsImg, err := s.cld.Image(params.Key)
+	if err != nil {
+		return "", err
+	}
+
+	return qsImg.String()
+}
+
+func (s *cloudinaryStorage) SignedURL(ctx context.Context, key string) (string, error) {
+	if isURL(key) {
+		return key, nil
+	}
+
+	ext := path.Ext(key)
+	expiresAt := time.Now().Add(s.expiry)
+
+	return s.cld.Upload.PrivateDownloadURL(uploader.PrivateDownloadURLParams{
+		PublicID:     strings.TrimSuffix(key, ext),
+		Format:       strings.TrimPrefix(ext, "."),
+		DeliveryType: api.Authenticated,
+		ExpiresAt:    &expiresAt,
+	})
+}
@@ -0,0 +1,137 @@
+package storageutils
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"healthcare-app/pkg/config"
+)
+
+const LocalRoutePrefix = "/storage"
+
+var (
+	ErrObjectNotFound   = errors.New("object not found")
+	ErrInvalidSignature = errors.New("invalid or expired signature")
+)
+
+// LocalObjectStorage is implemented by the local disk driver, which has no
+// server of its own and relies on the http server to serve the stored files.
+type LocalObjectStorage interface {
+	ObjectStorage
+	Resolve(key, expires, signature string) (string, error)
+}
+
+type localStorage struct {
+	path       string
+	baseURL    string
+	signingKey []byte
+	expiry     time.Duration
+}
+
+func NewLocalStorage(cfg *config.StorageConfig, expiry time.Duration) *localStorage {
+	return &localStorage{
+		path:       cfg.LocalPath,
+		baseURL:    strings.TrimSuffix(cfg.LocalBaseURL, "/"),
+		signingKey: []byte(cfg.SigningKey),
+		expiry:     expiry,
+	}
+}
+
+func (s *localStorage) Upload(ctx context.Context, object any, params UploadParams) (string, error) {
+	data, err := ReadObject(ctx, object)
+	if err != nil {
+		return "", err
+	}
+	_, ext := detectContentType(data)
+
+	dir := publicPrefix
+	if params.Private {
+		dir = privatePrefix
+	}
+	key := dir + "/" + params.Key + ext
+
+	filePath, err := s.filePath(key)
+	if err != nil {
+		return "", err
+	}
+	if err := os.MkdirAll(filepath.Dir(filePath), 0o755); err != nil {
+		return "", err
+	}
+	if err := os.WriteFile(filePath, data, 0o644); err != nil {
+		return "", err
+	}
+
+	if params.Private {
+		return key, nil
+	}
+	return s.objectURL(key), nil
+}
+
+func (s *localStorage) SignedURL(ctx context.Context, key string) (string, error) {
+	if isURL(key) {
+		return key, nil
+	}
+
+	expires := strconv.FormatInt(time.Now().Add(s.expiry).Unix(), 10)
+	query := url.Values{}
+	query.Set("expires", expires)
+	query.Set("signature", s.sign(key, expires))
+
+	return s.objectURL(key) + "?" + query.Encode(), nil
+}
+
+func (s *localStorage) Resolve(key, expires, signature string) (string, error) {
+	key = strings.TrimPrefix(key, "/")
+
+	if strings.HasPrefix(key, privatePrefix+"/") {
+		expiresAt, err := strconv.ParseInt(expires, 10, 64)
+		if err != nil || time.Now().Unix() > expiresAt {
+			return "", ErrInvalidSignature
+		}
+		if !hmac.Equal([]byte(signature), []byte(s.sign(key, expires))) {
+			return "", ErrInvalidSignature
+		}
+	} else if !strings.HasPrefix(key, publicPrefix+"/") {
+		return "", ErrObjectNotFound
+	}
+
+	filePath, err := s.filePath(key)
+	if err != nil {
+		return "", err
+	}
+
+	info, err := os.Stat(filePath)
+	if err != nil || !info.Mode().IsRegular() {
+		return "", ErrObjectNotFound
+	}
+	return filePath, nil
+}
+
+func (s *localStorage) filePath(key string) (string, error) {
+	for _, part := range strings.Split(key, "/") {
+		if part == "" || part == "." || part == ".." {
+			return "", ErrObjectNotFound
+		}
+	}
+	return filepath.Join(s.path, filepath.FromSlash(key)), nil
+}
+
+func (s *localStorage) objectURL(key string) string {
+	return fmt.Sprintf("%v%v/%v", s.baseURL, LocalRoutePrefix, key)
+}
+
+func (s *localStorage) sign(key, expires string) string {
+	mac := hmac.New(sha256.New, s.signingKey)
+	mac.Write([]byte(key + ":" + expires))
+	return hex.EncodeToString(mac.Sum(nil))
+}
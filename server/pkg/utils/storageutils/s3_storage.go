@@ -0,0 +1,180 @@
+package storageutils
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"healthcare-app/pkg/config"
+)
+
+const (
+	s3Algorithm       = "AWS4-HMAC-SHA256"
+	s3Service         = "s3"
+	s3UnsignedPayload = "UNSIGNED-PAYLOAD"
+	s3DateFormat      = "20060102"
+	s3TimeFormat      = "20060102T150405Z"
+)
+
+// s3Storage talks to any S3 compatible service (AWS, MinIO, R2, ...) using
+// path style requests signed with signature version 4. Objects uploaded as
+// private are placed under the private/ prefix which the bucket policy is
+// expected to keep unreadable for anonymous clients.
+type s3Storage struct {
+	client    *http.Client
+	endpoint  string
+	region    string
+	bucket    string
+	accessKey string
+	secretKey string
+	publicURL string
+	expiry    time.Duration
+}
+
+func NewS3Storage(cfg *config.StorageConfig, expiry time.Duration) *s3Storage {
+	publicURL := strings.TrimSuffix(cfg.S3PublicURL, "/")
+	endpoint := strings.TrimSuffix(cfg.S3Endpoint, "/")
+	if publicURL == "" {
+		publicURL = endpoint + "/" + cfg.S3Bucket
+	}
+
+	return &s3Storage{
+		client:    &http.Client{Timeout: time.Minute},
+		endpoint:  endpoint,
+		region:    cfg.S3Region,
+		bucket:    cfg.S3Bucket,
+		accessKey: cfg.S3AccessKey,
+		secretKey: cfg.S3SecretKey,
+		publicURL: publicURL,
+		expiry:    expiry,
+	}
+}
+
+func (s *s3Storage) Upload(ctx context.Context, object any, params UploadParams) (string, error) {
+	data, err := ReadObject(ctx, object)
+	if err != nil {
+		return "", err
+	}
+	contentType, ext := detectContentType(data)
+
+	dir := publicPrefix
+	if params.Private {
+		dir = privatePrefix
+	}
+	key := dir + "/" + params.Key + ext
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPut, s.objectURL(key), bytes.NewReader(data))
+	if err != nil {
+		return "", err
+	}
+
+	now := time.Now().UTC()
+	payloadHash := hashHex(data)
+	req.Header.Set("Content-Type", contentType)
+	req.Header.Set("X-Amz-Content-Sha256", payloadHash)
+	req.Header.Set("X-Amz-Date", now.Format(s3TimeFormat))
+
+	signedHeaders := "content-type;host;x-amz-content-sha256;x-amz-date"
+	canonicalHeaders := fmt.Sprintf(
+		"content-type:%v\nhost:%v\nx-amz-content-sha256:%v\nx-amz-date:%v\n",
+		contentType, req.URL.Host, payloadHash, now.Format(s3TimeFormat),
+	)
+	signature := s.signature(now, http.MethodPut, req.URL.EscapedPath(), "", canonicalHeaders, signedHeaders, payloadHash)
+	req.Header.Set("Authorization", fmt.Sprintf(
+		"%v Credential=%v/%v, SignedHeaders=%v, Signature=%v",
+		s3Algorithm, s.accessKey, s.scope(now), signedHeaders, signature,
+	))
+
+	res, err := s.client.Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(res.Body)
+		return "", fmt.Errorf("failed to upload object: %v %v", res.Status, string(body))
+	}
+
+	if params.Private {
+		return key, nil
+	}
+	return s.publicURL + "/" + escapePath(key), nil
+}
+
+func (s *s3Storage) SignedURL(ctx context.Context, key string) (string, error) {
+	if isURL(key) {
+		return key, nil
+	}
+
+	u, err := url.Parse(s.objectURL(key))
+	if err != nil {
+		return "", err
+	}
+
+	now := time.Now().UTC()
+	query := url.Values{}
+	query.Set("X-Amz-Algorithm", s3Algorithm)
+	query.Set("X-Amz-Credential", s.accessKey+"/"+s.scope(now))
+	query.Set("X-Amz-Date", now.Format(s3TimeFormat))
+	query.Set("X-Amz-Expires", strconv.Itoa(int(s.expiry.Seconds())))
+	query.Set("X-Amz-SignedHeaders", "host")
+
+	canonicalQuery := strings.ReplaceAll(query.Encode(), "+", "%20")
+	signature := s.signature(now, http.MethodGet, u.EscapedPath(), canonicalQuery, "host:"+u.Host+"\n", "host", s3UnsignedPayload)
+
+	return fmt.Sprintf("%v?%v&X-Amz-Signature=%v", s.objectURL(key), canonicalQuery, signature), nil
+}
+
+func (s *s3Storage) objectURL(key string) string {
+	return fmt.Sprintf("%v/%v/%v", s.endpoint, s.bucket, escapePath(key))
+}
+
+func (s *s3Storage) scope(t time.Time) string {
+	return fmt.Sprintf("%v/%v/%v/aws4_request", t.Format(s3DateFormat), s.region, s3Service)
+}
+
+func (s *s3Storage) signature(t time.Time, method, path, query, headers, signedHeaders, payloadHash string) string {
+	canonicalRequest := strings.Join([]string{method, path, query, headers, signedHeaders, payloadHash}, "\n")
+	stringToSign := strings.Join([]string{
+		s3Algorithm,
+		t.Format(s3TimeFormat),
+		s.scope(t),
+		hashHex([]byte(canonicalRequest)),
+	}, "\n")
+
+	key := hmacSHA256([]byte("AWS4"+s.secretKey), t.Format(s3DateFormat))
+	key = hmacSHA256(key, s.region)
+	key = hmacSHA256(key, s3Service)
+	key = hmacSHA256(key, "aws4_request")
+
+	return hex.EncodeToString(hmacSHA256(key, stringToSign))
+}
+
+func escapePath(key string) string {
+	parts := strings.Split(key, "/")
+	for i, part := range parts {
+		parts[i] = strings.ReplaceAll(url.PathEscape(part), "+", "%2B")
+	}
+	return strings.Join(parts, "/")
+}
+
+func hashHex(data []byte) string {
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
+
+func hmacSHA256(key []byte, data string) []byte {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(data))
+	return mac.Sum(nil)
+}
@@ -0,0 +1,175 @@
+package storageutils
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"strings"
+	"syscall"
+	"time"
+
+	"healthcare-app/pkg/config"
+)
+
+const (
+	DriverCloudinary = "cloudinary"
+	DriverLocal      = "local"
+	DriverS3         = "s3"
+
+	publicPrefix  = "public"
+	privatePrefix = "private"
+
+	defaultSignedURLExpiry = 15 * time.Minute
+
+	maxDownloadSize = 5 * 1024 * 1024 // 5 mb
+	downloadTimeout = 30 * time.Second
+)
+
+var (
+	ErrDownloadTooLarge     = errors.New("downloaded object is too large")
+	ErrDownloadForbidden    = errors.New("download destination is not allowed")
+	ErrUnknownStorageDriver = errors.New("unknown storage driver")
+)
+
+// downloadClient refuses to connect to private, loopback and link-local
+// addresses. The check runs on the dialed address, so it also holds for
+// redirects and hostnames that resolve to an internal address.
+var downloadClient = &http.Client{
+	Timeout: downloadTimeout,
+	Transport: &http.Transport{
+		DialContext: (&net.Dialer{
+			Timeout: 10 * time.Second,
+			Control: func(network, address string, _ syscall.RawConn) error {
+				host, _, err := net.SplitHostPort(address)
+				if err != nil {
+					return err
+				}
+				ip := net.ParseIP(host)
+				if ip == nil || !isPublicIP(ip) {
+					return ErrDownloadForbidden
+				}
+				return nil
+			},
+		}).DialContext,
+		TLSHandshakeTimeout:   10 * time.Second,
+		ResponseHeaderTimeout: downloadTimeout,
+	},
+}
+
+type UploadParams struct {
+	Key     string
+	Private bool
+}
+
+// ObjectStorage stores uploaded objects. Public uploads return a URL that can be
+// saved as is, private uploads return a key which has to be resolved through
+// SignedURL every time it is exposed to a client.
+type ObjectStorage interface {
+	Upload(ctx context.Context, object any, params UploadParams) (string, error)
+	SignedURL(ctx context.Context, key string) (string, error)
+}
+
+func NewObjectStorage(cfg *config.StorageConfig) (ObjectStorage, error) {
+	expiry := defaultSignedURLExpiry
+	if cfg.SignedURLExpiry > 0 {
+		expiry = time.Duration(cfg.SignedURLExpiry) * time.Second
+	}
+
+	switch cfg.Driver {
+	case "", DriverCloudinary:
+		return NewCloudinaryStorage(expiry), nil
+	case DriverLocal:
+		return NewLocalStorage(cfg, expiry), nil
+	case DriverS3:
+		return NewS3Storage(cfg, expiry), nil
+	default:
+		return nil, fmt.Errorf("%w: %v", ErrUnknownStorageDriver, cfg.Driver)
+	}
+}
+
+// ReadObject accepts the shapes uploads arrive in across the app: a multipart
+// file, raw bytes, a base64 data uri coming from the queue payloads, or a remote
+// url coming from the catalog imports.
+func ReadObject(ctx context.Context, object any) ([]byte, error) {
+	switch o := object.(type) {
+	case []byte:
+		return o, nil
+	case io.Reader:
+		return io.ReadAll(o)
+	case string:
+		if strings.HasPrefix(o, "data:") {
+			_, data, ok := strings.Cut(o, ",")
+			if !ok {
+				return nil, errors.New("invalid data uri")
+			}
+			return base64.StdEncoding.DecodeString(data)
+		}
+		if strings.HasPrefix(o, "http://") || strings.HasPrefix(o, "https://") {
+			return download(ctx, o)
+		}
+		return base64.StdEncoding.DecodeString(o)
+	default:
+		return nil, fmt.Errorf("unsupported object type %T", object)
+	}
+}
+
+func download(ctx context.Context, url string) ([]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := downloadClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("failed to download object: %v", res.Status)
+	}
+	data, err := io.ReadAll(io.LimitReader(res.Body, maxDownloadSize+1))
+	if err != nil {
+		return nil, err
+	}
+	if len(data) > maxDownloadSize {
+		return nil, ErrDownloadTooLarge
+	}
+	return data, nil
+}
+
+func isPublicIP(ip net.IP) bool {
+	return !ip.IsLoopback() &&
+		!ip.IsPrivate() &&
+		!ip.IsLinkLocalUnicast() &&
+		!ip.IsLinkLocalMulticast() &&
+		!ip.IsInterfaceLocalMulticast() &&
+		!ip.IsMulticast() &&
+		!ip.IsUnspecified()
+}
+
+func detectContentType(data []byte) (string, string) {
+	contentType := http.DetectContentType(data)
+	switch contentType {
+	case "image/png":
+		return contentType, ".png"
+	case "image/jpeg":
+		return contentType, ".jpg"
+	case "image/gif":
+		return contentType, ".gif"
+	case "image/webp":
+		return contentType, ".webp"
+	case "application/pdf":
+		return contentType, ".pdf"
+	default:
+		return contentType, ""
+	}
+}
+
+func isURL(key string) bool {
+	return strings.HasPrefix(key, "http://") || strings.HasPrefix(key, "https://")
+}
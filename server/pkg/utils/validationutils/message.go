@@ -2,6 +2,7 @@ package validationutils
 
 import (
 	"fmt"
+	"strings"
 
 	"healthcare-app/pkg/constant"
 
@@ -58,8 +59,12 @@ func TagToMsg(fe validator.FieldError) string {
 		return fmt.Sprintf("please send time in format of %s", constant.ConvertGoTimeLayoutToReadable(fe.Param()))
 	case "no_duplicates":
 		return fmt.Sprintf("%s duplicate values are not allowed", fe.Field())
+	case "unique":
+		return fmt.Sprintf("%s duplicate %v values are not allowed", fe.Field(), fe.Param())
 	case "day_of_weeks":
 		return fmt.Sprintf("%s must be a valid day of the week (e.g, Sunday, Monday, etc)", fe.Field())
+	case "oneof":
+		return fmt.Sprintf("%s must be one of %v", fe.Field(), strings.ReplaceAll(fe.Param(), " ", ", "))
 	case "clean_input":
 		return fmt.Sprintf("%s must contain at least 4 characters with excluding whitespaces and special character", fe.Field())
 	default:
@@ -0,0 +1,100 @@
+package xlsxutils
+
+import (
+	"archive/zip"
+	"bufio"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"strings"
+)
+
+const (
+	contentTypes = `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<Types xmlns="http://schemas.openxmlformats.org/package/2006/content-types"><Default Extension="rels" ContentType="application/vnd.openxmlformats-package.relationships+xml"/><Default Extension="xml" ContentType="application/xml"/><Override PartName="/xl/workbook.xml" ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.sheet.main+xml"/><Override PartName="/xl/worksheets/sheet1.xml" ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.worksheet+xml"/></Types>`
+	rootRels = `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships"><Relationship Id="rId1" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/officeDocument" Target="xl/workbook.xml"/></Relationships>`
+	workbook = `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<workbook xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main" xmlns:r="http://schemas.openxmlformats.org/officeDocument/2006/relationships"><sheets><sheet name="%v" sheetId="1" r:id="rId1"/></sheets></workbook>`
+	workbookRels = `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships"><Relationship Id="rId1" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/worksheet" Target="worksheets/sheet1.xml"/></Relationships>`
+	sheetHeader = `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<worksheet xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main"><sheetData>`
+	sheetFooter = `</sheetData></worksheet>`
+)
+
+type Writer struct {
+	zw        *zip.Writer
+	sheet     *bufio.Writer
+	sheetName string
+	rows      int
+	err       error
+}
+
+func NewWriter(w io.Writer, sheetName string) *Writer {
+	zw := zip.NewWriter(w)
+	writer := &Writer{zw: zw, sheetName: sheetName}
+
+	sheet, err := zw.Create("xl/worksheets/sheet1.xml")
+	if err != nil {
+		writer.err = err
+		return writer
+	}
+	writer.sheet = bufio.NewWriter(sheet)
+	_, writer.err = writer.sheet.WriteString(sheetHeader)
+	return writer
+}
+
+func (w *Writer) Write(record []string) error {
+	if w.err != nil {
+		return w.err
+	}
+
+	w.rows++
+	builder := strings.Builder{}
+	builder.WriteString(fmt.Sprintf(`<row r="%v">`, w.rows))
+	for _, value := range record {
+		builder.WriteString(`<c t="inlineStr"><is><t xml:space="preserve">`)
+		if err := xml.EscapeText(&builder, []byte(value)); err != nil {
+			w.err = err
+			return err
+		}
+		builder.WriteString(`</t></is></c>`)
+	}
+	builder.WriteString(`</row>`)
+
+	_, w.err = w.sheet.WriteString(builder.String())
+	return w.err
+}
+
+func (w *Writer) Close() error {
+	if w.err != nil {
+		return w.err
+	}
+	if _, err := w.sheet.WriteString(sheetFooter); err != nil {
+		return err
+	}
+	if err := w.sheet.Flush(); err != nil {
+		return err
+	}
+
+	var name strings.Builder
+	if err := xml.EscapeText(&name, []byte(w.sheetName)); err != nil {
+		return err
+	}
+	for file, content := range map[string]string{
+		"[Content_Types].xml":        contentTypes,
+		"_rels/.rels":                rootRels,
+		"xl/workbook.xml":            fmt.Sprintf(workbook, name.String()),
+		"xl/_rels/workbook.xml.rels": workbookRels,
+	} {
+		f, err := w.zw.Create(file)
+		if err != nil {
+			return err
+		}
+		if _, err := io.WriteString(f, content); err != nil {
+			return err
+		}
+	}
+	return w.zw.Close()
+}